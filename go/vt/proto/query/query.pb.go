@@ -4176,6 +4176,407 @@ func (m *TransactionMetadata) GetParticipants() []*Target {
 	return nil
 }
 
+// ReserveExecuteRequest is the payload to ReserveExecute
+type ReserveExecuteRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Query             *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// transaction_id, if set, reserves the connection of that
+	// transaction instead of a new one.
+	TransactionId int64           `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Options       *ExecuteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	// pre_queries are executed on the connection before the query.
+	// They're typically the SET statements of the session.
+	PreQueries           []string `protobuf:"bytes,7,rep,name=pre_queries,json=preQueries,proto3" json:"pre_queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveExecuteRequest) Reset()         { *m = ReserveExecuteRequest{} }
+func (m *ReserveExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveExecuteRequest) ProtoMessage()    {}
+func (*ReserveExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{60}
+}
+
+func (m *ReserveExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveExecuteRequest.Unmarshal(m, b)
+}
+func (m *ReserveExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveExecuteRequest.Merge(m, src)
+}
+func (m *ReserveExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveExecuteRequest.Size(m)
+}
+func (m *ReserveExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveExecuteRequest proto.InternalMessageInfo
+
+func (m *ReserveExecuteRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetQuery() *BoundQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetTransactionId() int64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *ReserveExecuteRequest) GetOptions() *ExecuteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ReserveExecuteRequest) GetPreQueries() []string {
+	if m != nil {
+		return m.PreQueries
+	}
+	return nil
+}
+
+// ReserveExecuteResponse is the returned value from ReserveExecute
+type ReserveExecuteResponse struct {
+	// error contains an application level error if necessary. Note the
+	// reserved_id may be set, even when an error is returned, if the
+	// reservation worked but the execute failed.
+	Error  *vtrpc.RPCError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *QueryResult    `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// reserved_id might be non-zero even if an error is present.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveExecuteResponse) Reset()         { *m = ReserveExecuteResponse{} }
+func (m *ReserveExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveExecuteResponse) ProtoMessage()    {}
+func (*ReserveExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{61}
+}
+
+func (m *ReserveExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveExecuteResponse.Unmarshal(m, b)
+}
+func (m *ReserveExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveExecuteResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveExecuteResponse.Merge(m, src)
+}
+func (m *ReserveExecuteResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveExecuteResponse.Size(m)
+}
+func (m *ReserveExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveExecuteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveExecuteResponse proto.InternalMessageInfo
+
+func (m *ReserveExecuteResponse) GetError() *vtrpc.RPCError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReserveExecuteResponse) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReserveExecuteResponse) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReserveBeginExecuteRequest is the payload to ReserveBeginExecute
+type ReserveBeginExecuteRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Query             *BoundQuery     `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Options           *ExecuteOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	// pre_queries are executed on the newly reserved connection
+	// before the transaction is started.
+	PreQueries []string `protobuf:"bytes,6,rep,name=pre_queries,json=preQueries,proto3" json:"pre_queries,omitempty"`
+	// reserved_id, if set, starts the transaction on that reserved
+	// connection instead of reserving a new one.
+	ReservedId           int64    `protobuf:"varint,7,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveBeginExecuteRequest) Reset()         { *m = ReserveBeginExecuteRequest{} }
+func (m *ReserveBeginExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveBeginExecuteRequest) ProtoMessage()    {}
+func (*ReserveBeginExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{62}
+}
+
+func (m *ReserveBeginExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Unmarshal(m, b)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveBeginExecuteRequest.Merge(m, src)
+}
+func (m *ReserveBeginExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveBeginExecuteRequest.Size(m)
+}
+func (m *ReserveBeginExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveBeginExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveBeginExecuteRequest proto.InternalMessageInfo
+
+func (m *ReserveBeginExecuteRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetQuery() *BoundQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetOptions() *ExecuteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetPreQueries() []string {
+	if m != nil {
+		return m.PreQueries
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteRequest) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReserveBeginExecuteResponse is the returned value from ReserveBeginExecute
+type ReserveBeginExecuteResponse struct {
+	// error contains an application level error if necessary. Note the
+	// reserved_id may be set, even when an error is returned, if the
+	// reservation worked but the begin or the execute failed.
+	Error  *vtrpc.RPCError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *QueryResult    `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// reserved_id is also the id of the transaction.
+	// It might be non-zero even if an error is present.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveBeginExecuteResponse) Reset()         { *m = ReserveBeginExecuteResponse{} }
+func (m *ReserveBeginExecuteResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveBeginExecuteResponse) ProtoMessage()    {}
+func (*ReserveBeginExecuteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{63}
+}
+
+func (m *ReserveBeginExecuteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Unmarshal(m, b)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveBeginExecuteResponse.Merge(m, src)
+}
+func (m *ReserveBeginExecuteResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveBeginExecuteResponse.Size(m)
+}
+func (m *ReserveBeginExecuteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveBeginExecuteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveBeginExecuteResponse proto.InternalMessageInfo
+
+func (m *ReserveBeginExecuteResponse) GetError() *vtrpc.RPCError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteResponse) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ReserveBeginExecuteResponse) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReleaseRequest is the payload to Release
+type ReleaseRequest struct {
+	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId    *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target               *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ReservedId           int64           `protobuf:"varint,4,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseRequest) Reset()         { *m = ReleaseRequest{} }
+func (m *ReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRequest) ProtoMessage()    {}
+func (*ReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{64}
+}
+
+func (m *ReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseRequest.Unmarshal(m, b)
+}
+func (m *ReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRequest.Merge(m, src)
+}
+func (m *ReleaseRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseRequest.Size(m)
+}
+func (m *ReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRequest proto.InternalMessageInfo
+
+func (m *ReleaseRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReleaseResponse is the returned value from Release
+type ReleaseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseResponse) Reset()         { *m = ReleaseResponse{} }
+func (m *ReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResponse) ProtoMessage()    {}
+func (*ReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{65}
+}
+
+func (m *ReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseResponse.Unmarshal(m, b)
+}
+func (m *ReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResponse.Merge(m, src)
+}
+func (m *ReleaseResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseResponse.Size(m)
+}
+func (m *ReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("query.MySqlFlag", MySqlFlag_name, MySqlFlag_value)
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
@@ -4253,209 +4654,216 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0xdb, 0x48,
-	0x7a, 0x37, 0xf8, 0x12, 0xf9, 0x51, 0xa4, 0x5a, 0x4d, 0xc9, 0xe6, 0x68, 0x5e, 0x5a, 0xec, 0xce,
-	0xae, 0xa2, 0xdd, 0xc8, 0x1e, 0x8d, 0xd7, 0x71, 0x66, 0x37, 0x89, 0x21, 0x0a, 0xf2, 0x70, 0x4c,
-	0x82, 0x74, 0x13, 0xb4, 0xd7, 0x53, 0x5b, 0x85, 0x82, 0xc8, 0x36, 0x85, 0x12, 0x08, 0xd0, 0x00,
-	0x28, 0x59, 0x37, 0x27, 0x9b, 0xcd, 0xfb, 0x31, 0x79, 0x4e, 0x36, 0xa9, 0x4c, 0x52, 0x95, 0x43,
-	0x2a, 0x97, 0xfc, 0x0d, 0xa9, 0x1c, 0x72, 0xcc, 0x2d, 0x87, 0x24, 0x87, 0x9c, 0x52, 0xb9, 0x6d,
-	0xe5, 0x94, 0x43, 0x0e, 0xa9, 0x54, 0x3f, 0x00, 0x82, 0x12, 0x67, 0xec, 0x75, 0x72, 0x91, 0x67,
-	0x6e, 0xdd, 0xdf, 0xf7, 0xf5, 0xe3, 0xf7, 0xfb, 0x3e, 0x7c, 0xdd, 0xe8, 0x6e, 0x28, 0x3f, 0x99,
-	0xd2, 0xe0, 0x6c, 0x67, 0x12, 0xf8, 0x91, 0x8f, 0xf3, 0xbc, 0xb2, 0x51, 0x8d, 0xfc, 0x89, 0x3f,
-	0xb4, 0x23, 0x5b, 0x88, 0x37, 0xca, 0x27, 0x51, 0x30, 0x19, 0x88, 0x8a, 0xfa, 0x43, 0x05, 0x0a,
-	0xa6, 0x1d, 0x8c, 0x68, 0x84, 0x37, 0xa0, 0x78, 0x4c, 0xcf, 0xc2, 0x89, 0x3d, 0xa0, 0x75, 0x65,
-	0x53, 0xd9, 0x2a, 0x91, 0xa4, 0x8e, 0xd7, 0x20, 0x1f, 0x1e, 0xd9, 0xc1, 0xb0, 0x9e, 0xe1, 0x0a,
-	0x51, 0xc1, 0xdf, 0x86, 0x72, 0x64, 0x1f, 0xba, 0x34, 0xb2, 0xa2, 0xb3, 0x09, 0xad, 0x67, 0x37,
-	0x95, 0xad, 0xea, 0xee, 0xda, 0x4e, 0x32, 0x9e, 0xc9, 0x95, 0xe6, 0xd9, 0x84, 0x12, 0x88, 0x92,
-	0x32, 0xc6, 0x90, 0x1b, 0x50, 0xd7, 0xad, 0xe7, 0x78, 0x5f, 0xbc, 0xac, 0xee, 0x43, 0xf5, 0x81,
-	0x79, 0xd7, 0x8e, 0x68, 0xc3, 0x76, 0x5d, 0x1a, 0x34, 0xf7, 0xd9, 0x74, 0xa6, 0x21, 0x0d, 0x3c,
-	0x7b, 0x9c, 0x4c, 0x27, 0xae, 0xe3, 0xab, 0x50, 0x18, 0x05, 0xfe, 0x74, 0x12, 0xd6, 0x33, 0x9b,
-	0xd9, 0xad, 0x12, 0x91, 0x35, 0xf5, 0xfb, 0x00, 0xfa, 0x09, 0xf5, 0x22, 0xd3, 0x3f, 0xa6, 0x1e,
-	0x7e, 0x03, 0x4a, 0x91, 0x33, 0xa6, 0x61, 0x64, 0x8f, 0x27, 0xbc, 0x8b, 0x2c, 0x99, 0x09, 0x3e,
-	0x03, 0xd2, 0x06, 0x14, 0x27, 0x7e, 0xe8, 0x44, 0x8e, 0xef, 0x71, 0x3c, 0x25, 0x92, 0xd4, 0xd5,
-	0x9f, 0x87, 0xfc, 0x03, 0xdb, 0x9d, 0x52, 0xfc, 0x36, 0xe4, 0x38, 0x60, 0x85, 0x03, 0x2e, 0xef,
-	0x08, 0xd2, 0x39, 0x4e, 0xae, 0x60, 0x7d, 0x9f, 0x30, 0x4b, 0xde, 0xf7, 0x32, 0x11, 0x15, 0xf5,
-	0x18, 0x96, 0xf7, 0x1c, 0x6f, 0xf8, 0xc0, 0x0e, 0x1c, 0x46, 0xc6, 0x4b, 0x76, 0x83, 0xbf, 0x06,
-	0x05, 0x5e, 0x08, 0xeb, 0xd9, 0xcd, 0xec, 0x56, 0x79, 0x77, 0x59, 0x36, 0xe4, 0x73, 0x23, 0x52,
-	0xa7, 0xfe, 0xbd, 0x02, 0xb0, 0xe7, 0x4f, 0xbd, 0xe1, 0x7d, 0xa6, 0xc4, 0x08, 0xb2, 0xe1, 0x13,
-	0x57, 0x12, 0xc9, 0x8a, 0xf8, 0x1e, 0x54, 0x0f, 0x1d, 0x6f, 0x68, 0x9d, 0xc8, 0xe9, 0x08, 0x2e,
-	0xcb, 0xbb, 0x5f, 0x93, 0xdd, 0xcd, 0x1a, 0xef, 0xa4, 0x67, 0x1d, 0xea, 0x5e, 0x14, 0x9c, 0x91,
-	0xca, 0x61, 0x5a, 0xb6, 0xd1, 0x07, 0x7c, 0xd1, 0x88, 0x0d, 0x7a, 0x4c, 0xcf, 0xe2, 0x41, 0x8f,
-	0xe9, 0x19, 0xfe, 0xa9, 0x34, 0xa2, 0xf2, 0x6e, 0x2d, 0x1e, 0x2b, 0xd5, 0x56, 0xc2, 0x7c, 0x3f,
-	0x73, 0x5b, 0x51, 0xff, 0xa2, 0x00, 0x55, 0xfd, 0x29, 0x1d, 0x4c, 0x23, 0xda, 0x99, 0x30, 0x1f,
-	0x84, 0x78, 0x07, 0x6a, 0x8e, 0x37, 0x70, 0xa7, 0x43, 0x6a, 0x51, 0xe6, 0x6a, 0x2b, 0x62, 0xbe,
-	0xe6, 0xfd, 0x15, 0xc9, 0xaa, 0x54, 0xa5, 0x82, 0x40, 0x83, 0xda, 0xc0, 0x1f, 0x4f, 0xec, 0x60,
-	0xde, 0x3e, 0xcb, 0xc7, 0x5f, 0x95, 0xe3, 0xcf, 0xec, 0xc9, 0xaa, 0xb4, 0x4e, 0x75, 0xd1, 0x86,
-	0x15, 0xd9, 0xef, 0xd0, 0x7a, 0xec, 0x50, 0x77, 0x18, 0xf2, 0xd0, 0xad, 0x26, 0x54, 0xcd, 0x4f,
-	0x71, 0xa7, 0x29, 0x8d, 0x0f, 0xb8, 0x2d, 0xa9, 0x3a, 0x73, 0x75, 0xbc, 0x0d, 0xab, 0x03, 0xd7,
-	0x61, 0x53, 0x79, 0xcc, 0x28, 0xb6, 0x02, 0xff, 0x34, 0xac, 0xe7, 0xf9, 0xfc, 0x57, 0x84, 0xe2,
-	0x80, 0xc9, 0x89, 0x7f, 0x1a, 0xe2, 0xf7, 0xa1, 0x78, 0xea, 0x07, 0xc7, 0xae, 0x6f, 0x0f, 0xeb,
-	0x05, 0x3e, 0xe6, 0x5b, 0x8b, 0xc7, 0x7c, 0x28, 0xad, 0x48, 0x62, 0x8f, 0xb7, 0x00, 0x85, 0x4f,
-	0x5c, 0x2b, 0xa4, 0x2e, 0x1d, 0x44, 0x96, 0xeb, 0x8c, 0x9d, 0xa8, 0x5e, 0xe4, 0x5f, 0x41, 0x35,
-	0x7c, 0xe2, 0xf6, 0xb8, 0xb8, 0xc5, 0xa4, 0xd8, 0x82, 0xf5, 0x28, 0xb0, 0xbd, 0xd0, 0x1e, 0xb0,
-	0xce, 0x2c, 0x27, 0xf4, 0x5d, 0x9b, 0x7f, 0x01, 0x25, 0x3e, 0xe4, 0xf6, 0xe2, 0x21, 0xcd, 0x59,
-	0x93, 0x66, 0xdc, 0x82, 0xac, 0x45, 0x0b, 0xa4, 0xf8, 0x5d, 0x58, 0x0f, 0x8f, 0x9d, 0x89, 0xc5,
-	0xfb, 0xb1, 0x26, 0xae, 0xed, 0x59, 0x03, 0x7b, 0x70, 0x44, 0xeb, 0xc0, 0x61, 0x63, 0xa6, 0xe4,
-	0xa1, 0xd6, 0x75, 0x6d, 0xaf, 0xc1, 0x34, 0xea, 0x77, 0xa0, 0x3a, 0xcf, 0x23, 0x5e, 0x85, 0x8a,
-	0xf9, 0xa8, 0xab, 0x5b, 0x9a, 0xb1, 0x6f, 0x19, 0x5a, 0x5b, 0x47, 0x57, 0x70, 0x05, 0x4a, 0x5c,
-	0xd4, 0x31, 0x5a, 0x8f, 0x90, 0x82, 0x97, 0x20, 0xab, 0xb5, 0x5a, 0x28, 0xa3, 0xde, 0x86, 0x62,
-	0x4c, 0x08, 0x5e, 0x81, 0x72, 0xdf, 0xe8, 0x75, 0xf5, 0x46, 0xf3, 0xa0, 0xa9, 0xef, 0xa3, 0x2b,
-	0xb8, 0x08, 0xb9, 0x4e, 0xcb, 0xec, 0x22, 0x45, 0x94, 0xb4, 0x2e, 0xca, 0xb0, 0x96, 0xfb, 0x7b,
-	0x1a, 0xca, 0xaa, 0x7f, 0xad, 0xc0, 0xda, 0x22, 0x60, 0xb8, 0x0c, 0x4b, 0xfb, 0xfa, 0x81, 0xd6,
-	0x6f, 0x99, 0xe8, 0x0a, 0xae, 0xc1, 0x0a, 0xd1, 0xbb, 0xba, 0x66, 0x6a, 0x7b, 0x2d, 0xdd, 0x22,
-	0xba, 0xb6, 0x8f, 0x14, 0x8c, 0xa1, 0xca, 0x4a, 0x56, 0xa3, 0xd3, 0x6e, 0x37, 0x4d, 0x53, 0xdf,
-	0x47, 0x19, 0xbc, 0x06, 0x88, 0xcb, 0xfa, 0xc6, 0x4c, 0x9a, 0xc5, 0x08, 0x96, 0x7b, 0x3a, 0x69,
-	0x6a, 0xad, 0xe6, 0x47, 0xac, 0x03, 0x94, 0xc3, 0x5f, 0x81, 0x37, 0x1b, 0x1d, 0xa3, 0xd7, 0xec,
-	0x99, 0xba, 0x61, 0x5a, 0x3d, 0x43, 0xeb, 0xf6, 0x3e, 0xe8, 0x98, 0xbc, 0x67, 0x01, 0x2e, 0x8f,
-	0xab, 0x00, 0x5a, 0xdf, 0xec, 0x88, 0x7e, 0x50, 0xe1, 0xc3, 0x5c, 0x51, 0x41, 0x19, 0xf5, 0x93,
-	0x0c, 0xe4, 0x39, 0x3f, 0x2c, 0xab, 0xa6, 0x72, 0x25, 0x2f, 0x27, 0x19, 0x26, 0xf3, 0x39, 0x19,
-	0x86, 0x27, 0x66, 0x99, 0xeb, 0x44, 0x05, 0xbf, 0x0e, 0x25, 0x3f, 0x18, 0x59, 0x42, 0x23, 0xb2,
-	0x74, 0xd1, 0x0f, 0x46, 0x3c, 0x9d, 0xb3, 0x0c, 0xc9, 0x92, 0xfb, 0xa1, 0x1d, 0x52, 0x1e, 0xb5,
-	0x25, 0x92, 0xd4, 0xf1, 0x6b, 0xc0, 0xec, 0x2c, 0x3e, 0x8f, 0x02, 0xd7, 0x2d, 0xf9, 0xc1, 0xc8,
-	0x60, 0x53, 0xf9, 0x2a, 0x54, 0x06, 0xbe, 0x3b, 0x1d, 0x7b, 0x96, 0x4b, 0xbd, 0x51, 0x74, 0x54,
-	0x5f, 0xda, 0x54, 0xb6, 0x2a, 0x64, 0x59, 0x08, 0x5b, 0x5c, 0x86, 0xeb, 0xb0, 0x34, 0x38, 0xb2,
-	0x83, 0x90, 0x8a, 0x48, 0xad, 0x90, 0xb8, 0xca, 0x47, 0xa5, 0x03, 0x67, 0x6c, 0xbb, 0x21, 0x8f,
-	0xca, 0x0a, 0x49, 0xea, 0x0c, 0xc4, 0x63, 0xd7, 0x1e, 0x85, 0x3c, 0x9a, 0x2a, 0x44, 0x54, 0xd4,
-	0x9f, 0x81, 0x2c, 0xf1, 0x4f, 0x59, 0x97, 0x62, 0xc0, 0xb0, 0xae, 0x6c, 0x66, 0xb7, 0x30, 0x89,
-	0xab, 0x6c, 0x11, 0x91, 0x79, 0x54, 0xa4, 0xd7, 0x38, 0x73, 0x7e, 0x1f, 0x96, 0x09, 0x0d, 0xa7,
-	0x6e, 0xa4, 0x3f, 0x8d, 0x02, 0x3b, 0xc4, 0xbb, 0x50, 0x4e, 0x67, 0x0e, 0xe5, 0xb3, 0x32, 0x07,
-	0xd0, 0x59, 0xca, 0xa8, 0xc3, 0xd2, 0xe3, 0x80, 0x86, 0x47, 0x34, 0x90, 0x99, 0x29, 0xae, 0xb2,
-	0xbc, 0x5c, 0xe6, 0xa1, 0x2e, 0xc6, 0x60, 0xd9, 0x5c, 0xe6, 0x14, 0x65, 0x2e, 0x9b, 0x73, 0xa7,
-	0x12, 0xa9, 0x63, 0xec, 0xb1, 0x34, 0x61, 0xd9, 0x8f, 0x1f, 0xd3, 0x41, 0x44, 0xc5, 0xa2, 0x95,
-	0x23, 0xcb, 0x4c, 0xa8, 0x49, 0x19, 0x73, 0x9b, 0xe3, 0x85, 0x34, 0x88, 0x2c, 0x67, 0xc8, 0x1d,
-	0x9a, 0x23, 0x45, 0x21, 0x68, 0x0e, 0xf1, 0x5b, 0x90, 0xe3, 0x89, 0x26, 0xc7, 0x47, 0x01, 0x39,
-	0x0a, 0xf1, 0x4f, 0x09, 0x97, 0xe3, 0x6f, 0x42, 0x81, 0x72, 0xbc, 0xdc, 0xa9, 0xb3, 0xd4, 0x9c,
-	0xa6, 0x82, 0x48, 0x13, 0xf5, 0xbb, 0xb0, 0xcc, 0x31, 0x3c, 0xb4, 0x03, 0xcf, 0xf1, 0x46, 0x7c,
-	0x45, 0xf7, 0x87, 0x22, 0xf6, 0x2a, 0x84, 0x97, 0x19, 0x05, 0x63, 0x1a, 0x86, 0xf6, 0x88, 0xca,
-	0x15, 0x36, 0xae, 0xaa, 0x7f, 0x99, 0x85, 0x72, 0x2f, 0x0a, 0xa8, 0x3d, 0xe6, 0xec, 0xe1, 0xef,
-	0x02, 0x84, 0x91, 0x1d, 0xd1, 0x31, 0xf5, 0xa2, 0x98, 0x86, 0x37, 0xe4, 0xf0, 0x29, 0xbb, 0x9d,
-	0x5e, 0x6c, 0x44, 0x52, 0xf6, 0xe7, 0xdd, 0x93, 0x79, 0x01, 0xf7, 0x6c, 0x7c, 0x9a, 0x81, 0x52,
-	0xd2, 0x1b, 0xd6, 0xa0, 0x38, 0xb0, 0x23, 0x3a, 0xf2, 0x83, 0x33, 0xb9, 0x16, 0xbf, 0xf3, 0x79,
-	0xa3, 0xef, 0x34, 0xa4, 0x31, 0x49, 0x9a, 0xe1, 0x37, 0x41, 0x6c, 0x70, 0x44, 0xe8, 0x0b, 0xbc,
-	0x25, 0x2e, 0xe1, 0xc1, 0xff, 0x3e, 0xe0, 0x49, 0xe0, 0x8c, 0xed, 0xe0, 0xcc, 0x3a, 0xa6, 0x67,
-	0xf1, 0x22, 0x92, 0x5d, 0xe0, 0x70, 0x24, 0xed, 0xee, 0xd1, 0x33, 0x99, 0xf6, 0x6e, 0xcf, 0xb7,
-	0x95, 0x21, 0x7b, 0xd1, 0x8d, 0xa9, 0x96, 0x7c, 0x27, 0x10, 0xc6, 0x6b, 0x7e, 0x9e, 0x47, 0x37,
-	0x2b, 0xaa, 0xdf, 0x80, 0x62, 0x3c, 0x79, 0x5c, 0x82, 0xbc, 0x1e, 0x04, 0x7e, 0x80, 0xae, 0xf0,
-	0xec, 0xd7, 0x6e, 0x89, 0x04, 0xba, 0xbf, 0xcf, 0x12, 0xe8, 0xdf, 0x65, 0x92, 0x85, 0x97, 0xd0,
-	0x27, 0x53, 0x1a, 0x46, 0xf8, 0x17, 0xa0, 0x46, 0x79, 0xa4, 0x39, 0x27, 0xd4, 0x1a, 0xf0, 0x5d,
-	0x1a, 0x8b, 0x33, 0xf1, 0x39, 0xac, 0xec, 0x88, 0x4d, 0x65, 0xbc, 0x7b, 0x23, 0xab, 0x89, 0xad,
-	0x14, 0x0d, 0xb1, 0x0e, 0x35, 0x67, 0x3c, 0xa6, 0x43, 0xc7, 0x8e, 0xd2, 0x1d, 0x08, 0x87, 0xad,
-	0xc7, 0x9b, 0x98, 0xb9, 0x4d, 0x20, 0x59, 0x4d, 0x5a, 0x24, 0xdd, 0xbc, 0x03, 0x85, 0x88, 0x6f,
-	0x58, 0xe5, 0x1a, 0x5e, 0x89, 0xb3, 0x1a, 0x17, 0x12, 0xa9, 0xc4, 0xdf, 0x00, 0xb1, 0xfd, 0xe5,
-	0xf9, 0x6b, 0x16, 0x10, 0xb3, 0x5d, 0x0d, 0x11, 0x7a, 0xfc, 0x0e, 0x54, 0xe7, 0x16, 0xbf, 0x21,
-	0x27, 0x2c, 0x4b, 0x2a, 0xe9, 0x95, 0x6c, 0x88, 0xaf, 0xc3, 0x92, 0x2f, 0x16, 0x3e, 0x9e, 0xd9,
-	0x66, 0x33, 0x9e, 0x5f, 0x15, 0x49, 0x6c, 0xa5, 0xfe, 0x1c, 0xac, 0x24, 0x0c, 0x86, 0x13, 0xdf,
-	0x0b, 0x29, 0xde, 0x86, 0x42, 0xc0, 0x3f, 0x27, 0xc9, 0x1a, 0x96, 0x5d, 0xa4, 0xf2, 0x01, 0x91,
-	0x16, 0xea, 0x10, 0x56, 0x84, 0xe4, 0xa1, 0x13, 0x1d, 0x71, 0x47, 0xe1, 0x77, 0x20, 0x4f, 0x59,
-	0xe1, 0x1c, 0xe7, 0xa4, 0xdb, 0xe0, 0x7a, 0x22, 0xb4, 0xa9, 0x51, 0x32, 0xcf, 0x1d, 0xe5, 0x3f,
-	0x33, 0x50, 0x93, 0xb3, 0xdc, 0xb3, 0xa3, 0xc1, 0xd1, 0x25, 0x75, 0xf6, 0x37, 0x61, 0x89, 0xc9,
-	0x9d, 0xe4, 0xc3, 0x58, 0xe0, 0xee, 0xd8, 0x82, 0x39, 0xdc, 0x0e, 0xad, 0x94, 0x77, 0xe5, 0xe6,
-	0xab, 0x62, 0x87, 0xa9, 0x95, 0x7f, 0x41, 0x5c, 0x14, 0x9e, 0x13, 0x17, 0x4b, 0x2f, 0x14, 0x17,
-	0xfb, 0xb0, 0x36, 0xcf, 0xb8, 0x0c, 0x8e, 0x6f, 0xc1, 0x92, 0x70, 0x4a, 0x9c, 0x02, 0x17, 0xf9,
-	0x2d, 0x36, 0x51, 0xff, 0x21, 0x03, 0x6b, 0x32, 0x3b, 0x7d, 0x31, 0x3e, 0xd3, 0x14, 0xcf, 0xf9,
-	0x17, 0xe1, 0xf9, 0x05, 0xfd, 0xa7, 0x36, 0x60, 0xfd, 0x1c, 0x8f, 0x2f, 0xf1, 0xb1, 0xfe, 0x58,
-	0x81, 0xe5, 0x3d, 0x3a, 0x72, 0xbc, 0x4b, 0xea, 0x85, 0x14, 0xb9, 0xb9, 0x17, 0x0a, 0xe2, 0x5b,
-	0x50, 0x91, 0x78, 0x25, 0x5b, 0x17, 0xd9, 0x56, 0x16, 0xb1, 0xfd, 0xef, 0x0a, 0x54, 0x1a, 0xfe,
-	0x78, 0xec, 0x44, 0x97, 0x94, 0xa9, 0x8b, 0x38, 0x73, 0x8b, 0x70, 0x22, 0xa8, 0xc6, 0x30, 0x05,
-	0x41, 0xea, 0x7f, 0x28, 0xb0, 0x42, 0x7c, 0xd7, 0x3d, 0xb4, 0x07, 0xc7, 0xaf, 0x36, 0x76, 0x0c,
-	0x68, 0x06, 0x54, 0xa2, 0xff, 0x6f, 0x05, 0xaa, 0xdd, 0x80, 0xb2, 0x1f, 0xeb, 0x57, 0x1a, 0x3c,
-	0xdb, 0x09, 0x0f, 0x23, 0xb9, 0x87, 0x28, 0x11, 0x5e, 0x56, 0x57, 0x61, 0x25, 0xc1, 0x2e, 0xf9,
-	0xf8, 0x17, 0x05, 0xd6, 0x45, 0x80, 0x48, 0xcd, 0xf0, 0x92, 0xd2, 0x12, 0xe3, 0xcd, 0xa5, 0xf0,
-	0xd6, 0xe1, 0xea, 0x79, 0x6c, 0x12, 0xf6, 0x0f, 0x32, 0x70, 0x2d, 0x8e, 0x8d, 0x4b, 0x0e, 0xfc,
-	0xff, 0x10, 0x0f, 0x1b, 0x50, 0xbf, 0x48, 0x82, 0x64, 0xe8, 0xe3, 0x0c, 0xd4, 0x1b, 0x01, 0xb5,
-	0x23, 0x9a, 0xda, 0x8b, 0xbc, 0x3a, 0xb1, 0x81, 0xdf, 0x85, 0xe5, 0x89, 0x1d, 0x44, 0xce, 0xc0,
-	0x99, 0xd8, 0xec, 0x6f, 0x2f, 0xcf, 0xb7, 0x3a, 0xe7, 0x3a, 0x98, 0x33, 0x51, 0x5f, 0x87, 0xd7,
-	0x16, 0x30, 0x22, 0xf9, 0xfa, 0x1f, 0x05, 0x70, 0x2f, 0xb2, 0x83, 0xe8, 0x0b, 0xb0, 0xaa, 0x2c,
-	0x0c, 0xa6, 0x75, 0xa8, 0xcd, 0xe1, 0x4f, 0xf3, 0x42, 0xa3, 0x2f, 0xc4, 0x8a, 0xf3, 0x99, 0xbc,
-	0xa4, 0xf1, 0x4b, 0x5e, 0xfe, 0x4d, 0x81, 0x8d, 0x86, 0x2f, 0x0e, 0x16, 0x5f, 0xc9, 0x2f, 0x4c,
-	0x7d, 0x13, 0x5e, 0x5f, 0x08, 0x50, 0x12, 0xf0, 0xaf, 0x0a, 0x5c, 0x25, 0xd4, 0x1e, 0xbe, 0x9a,
-	0xe0, 0xef, 0xc3, 0xb5, 0x0b, 0xe0, 0xe4, 0x0e, 0xf5, 0x16, 0x14, 0xc7, 0x34, 0xb2, 0x87, 0x76,
-	0x64, 0x4b, 0x48, 0x1b, 0x71, 0xbf, 0x33, 0xeb, 0xb6, 0xb4, 0x20, 0x89, 0xad, 0xfa, 0x69, 0x06,
-	0x6a, 0x7c, 0xaf, 0xfb, 0xe5, 0x8f, 0xd6, 0xe2, 0x7f, 0x81, 0x8f, 0x15, 0x58, 0x9b, 0x27, 0x28,
-	0xf9, 0x27, 0xf8, 0xff, 0x3e, 0xaf, 0x58, 0x90, 0x10, 0xb2, 0x8b, 0xb6, 0xa0, 0xff, 0x98, 0x81,
-	0x7a, 0x7a, 0x4a, 0x5f, 0x9e, 0x6d, 0xcc, 0x9f, 0x6d, 0xfc, 0xc4, 0x87, 0x59, 0x9f, 0x28, 0xf0,
-	0xda, 0x02, 0x42, 0x7f, 0x32, 0x47, 0xa7, 0x4e, 0x38, 0x32, 0xcf, 0x3d, 0xe1, 0x78, 0x51, 0x57,
-	0xff, 0xb3, 0x02, 0x6b, 0x6d, 0x71, 0xb0, 0x2c, 0xfe, 0xe3, 0x2f, 0x6f, 0x36, 0xe3, 0x67, 0xc7,
-	0xb9, 0xd9, 0xf5, 0x8d, 0xda, 0x80, 0xf5, 0x73, 0xd0, 0x5e, 0xe2, 0x6c, 0xe2, 0xbf, 0x14, 0x58,
-	0x95, 0xbd, 0x68, 0x97, 0x76, 0x23, 0xb0, 0x80, 0x1d, 0xfc, 0x16, 0x64, 0x9d, 0x61, 0xbc, 0x83,
-	0x9c, 0xbf, 0x04, 0x67, 0x0a, 0xf5, 0x0e, 0xe0, 0x34, 0xee, 0x97, 0xa0, 0xee, 0x9f, 0xb2, 0xb0,
-	0xda, 0x9b, 0xb8, 0x4e, 0x24, 0x95, 0xaf, 0x76, 0xe2, 0xff, 0x0a, 0x2c, 0x87, 0x0c, 0xac, 0x25,
-	0xae, 0xe4, 0x38, 0xb1, 0x25, 0x52, 0xe6, 0xb2, 0x06, 0x17, 0xe1, 0xb7, 0xa1, 0x1c, 0x9b, 0x4c,
-	0xbd, 0x48, 0x1e, 0xa8, 0x81, 0xb4, 0x98, 0x7a, 0x11, 0xbe, 0x09, 0xd7, 0xbc, 0xe9, 0x98, 0x5f,
-	0x69, 0x5b, 0x13, 0x1a, 0xc4, 0x17, 0xbe, 0x76, 0x10, 0x5f, 0x3d, 0xd7, 0xbc, 0xe9, 0x98, 0xf8,
-	0xa7, 0x61, 0x97, 0x06, 0xe2, 0xc2, 0xd7, 0x0e, 0x22, 0x7c, 0x07, 0x4a, 0xb6, 0x3b, 0xf2, 0x03,
-	0x27, 0x3a, 0x1a, 0xcb, 0x3b, 0x67, 0x35, 0xbe, 0x81, 0x39, 0x4f, 0xff, 0x8e, 0x16, 0x5b, 0x92,
-	0x59, 0x23, 0xf5, 0x5b, 0x50, 0x4a, 0xe4, 0x18, 0xc1, 0xb2, 0x7e, 0xbf, 0xaf, 0xb5, 0xac, 0x5e,
-	0xb7, 0xd5, 0x34, 0x7b, 0xe2, 0x9e, 0xf8, 0xa0, 0xdf, 0x6a, 0x59, 0xbd, 0x86, 0x66, 0x20, 0x45,
-	0x25, 0x00, 0xbc, 0x4b, 0xde, 0xf9, 0x8c, 0x20, 0xe5, 0x39, 0x04, 0xbd, 0x0e, 0xa5, 0xc0, 0x3f,
-	0x95, 0xd8, 0x33, 0x1c, 0x4e, 0x31, 0xf0, 0x4f, 0x39, 0x72, 0x55, 0x03, 0x9c, 0x9e, 0xab, 0x8c,
-	0xb6, 0x54, 0xf2, 0x56, 0xe6, 0x92, 0xf7, 0x6c, 0xfc, 0x24, 0x79, 0x8b, 0xad, 0x3c, 0xfb, 0xce,
-	0x3f, 0xa0, 0xb6, 0x1b, 0xc5, 0xeb, 0x95, 0xfa, 0x57, 0x19, 0xa8, 0x10, 0x26, 0x71, 0xc6, 0xb4,
-	0x17, 0xd9, 0x51, 0xc8, 0x3c, 0x75, 0xc4, 0x4d, 0xac, 0x59, 0xda, 0x2d, 0x91, 0xb2, 0x90, 0x89,
-	0xbb, 0x82, 0x5d, 0x58, 0x0f, 0xe9, 0xc0, 0xf7, 0x86, 0xa1, 0x75, 0x48, 0x8f, 0x1c, 0x6f, 0x68,
-	0x8d, 0xed, 0x30, 0x92, 0xd7, 0x91, 0x15, 0x52, 0x93, 0xca, 0x3d, 0xae, 0x6b, 0x73, 0x15, 0xbe,
-	0x01, 0x6b, 0x87, 0x8e, 0xe7, 0xfa, 0x23, 0x6b, 0xe2, 0xda, 0x67, 0x34, 0x08, 0x25, 0x54, 0x16,
-	0x5e, 0x79, 0x82, 0x85, 0xae, 0x2b, 0x54, 0xc2, 0xdd, 0x1f, 0xc1, 0xf6, 0xc2, 0x51, 0xac, 0xc7,
-	0x8e, 0x1b, 0xd1, 0x80, 0x0e, 0xad, 0x80, 0x4e, 0x5c, 0x67, 0x20, 0x5e, 0x13, 0x88, 0xbd, 0xfb,
-	0xd7, 0x17, 0x0c, 0x7d, 0x20, 0xcd, 0xc9, 0xcc, 0x9a, 0xb1, 0x3d, 0x98, 0x4c, 0xad, 0x29, 0xbf,
-	0x41, 0x64, 0xab, 0x98, 0x42, 0x8a, 0x83, 0xc9, 0xb4, 0xcf, 0xea, 0x18, 0x41, 0xf6, 0xc9, 0x44,
-	0x2c, 0x5e, 0x0a, 0x61, 0x45, 0xf5, 0xc7, 0x0a, 0x54, 0xb5, 0xd1, 0x28, 0xa0, 0x23, 0x3b, 0x92,
-	0x34, 0xdd, 0x80, 0x35, 0x41, 0xc9, 0x99, 0x25, 0x9f, 0x29, 0x09, 0x3c, 0x8a, 0xc0, 0x23, 0x75,
-	0xe2, 0x91, 0x52, 0x1c, 0xbe, 0x57, 0xa7, 0xde, 0xc2, 0x36, 0x19, 0xde, 0x66, 0x2d, 0xd1, 0xa6,
-	0x5b, 0xfd, 0x2c, 0xbc, 0xb6, 0x98, 0x85, 0xb1, 0x23, 0x1e, 0x9a, 0x54, 0xc8, 0xd5, 0x05, 0xa0,
-	0xdb, 0x8e, 0xf7, 0x39, 0x4d, 0xed, 0xa7, 0x9c, 0xaf, 0xcf, 0x68, 0x6a, 0x3f, 0x55, 0xff, 0x26,
-	0xb9, 0x01, 0x88, 0xc3, 0x25, 0x59, 0x8d, 0xe3, 0xbc, 0xa0, 0x7c, 0x5e, 0x5e, 0xa8, 0xc3, 0x52,
-	0x48, 0x83, 0x13, 0xc7, 0x1b, 0xc5, 0x57, 0xd4, 0xb2, 0x8a, 0x7b, 0xf0, 0x75, 0x89, 0x9d, 0x3e,
-	0x8d, 0x68, 0xe0, 0xd9, 0xae, 0x7b, 0x66, 0x89, 0x83, 0x0a, 0x2f, 0xa2, 0x43, 0x6b, 0xf6, 0xa8,
-	0x4a, 0xac, 0xc8, 0x5f, 0x15, 0xd6, 0x7a, 0x62, 0x4c, 0x12, 0x5b, 0x33, 0x79, 0x6e, 0xf5, 0x1d,
-	0xa8, 0x06, 0x32, 0x88, 0xad, 0x90, 0xb9, 0x47, 0xe6, 0xa3, 0xb5, 0xe4, 0x9e, 0x39, 0x15, 0xe1,
-	0xa4, 0x12, 0xcc, 0x05, 0xfc, 0x6d, 0x58, 0x96, 0x33, 0xb2, 0x5d, 0xc7, 0x9e, 0x6d, 0x4c, 0xcf,
-	0xbd, 0x34, 0xd3, 0x98, 0x92, 0xc8, 0x37, 0x69, 0xbc, 0xf2, 0x61, 0xae, 0x58, 0x40, 0x4b, 0xec,
-	0x6f, 0xb8, 0xd6, 0x9f, 0x0c, 0x79, 0x64, 0x5c, 0xe2, 0x3d, 0x42, 0xfa, 0x71, 0x5a, 0x6e, 0xfe,
-	0x71, 0xda, 0xfc, 0x63, 0xb7, 0xfc, 0xb9, 0xc7, 0x6e, 0xea, 0x1d, 0x58, 0x9b, 0xc7, 0x2f, 0x63,
-	0x65, 0x0b, 0xf2, 0xfc, 0x5a, 0xfc, 0xdc, 0x62, 0x98, 0xba, 0xf7, 0x26, 0xc2, 0x40, 0xfd, 0x5b,
-	0x05, 0x6a, 0x0b, 0x7e, 0x94, 0x92, 0xbf, 0x30, 0x25, 0x75, 0xc8, 0xf3, 0xd3, 0x90, 0xe7, 0x17,
-	0xf4, 0xf2, 0xdd, 0xc9, 0xb5, 0x8b, 0xff, 0x59, 0xfc, 0x32, 0x9d, 0x08, 0x2b, 0x96, 0xce, 0x78,
-	0x58, 0x0c, 0xf8, 0x29, 0x4f, 0xbc, 0xcf, 0x2b, 0x33, 0x99, 0x38, 0xf8, 0xb9, 0x78, 0x6c, 0x94,
-	0x7b, 0xee, 0xb1, 0xd1, 0xf6, 0xef, 0x67, 0xa1, 0xd4, 0x3e, 0xeb, 0x3d, 0x71, 0x0f, 0x5c, 0x7b,
-	0xc4, 0x6f, 0xbb, 0xdb, 0x5d, 0xf3, 0x11, 0xba, 0x82, 0x57, 0xa1, 0x62, 0x74, 0x4c, 0xcb, 0x60,
-	0x0b, 0xc2, 0x41, 0x4b, 0xbb, 0x8b, 0x14, 0xb6, 0x62, 0x74, 0x49, 0xd3, 0xba, 0xa7, 0x3f, 0x12,
-	0x92, 0x0c, 0xae, 0xc1, 0x4a, 0xdf, 0x68, 0xde, 0xef, 0xeb, 0x33, 0x61, 0x0e, 0xaf, 0xc3, 0x6a,
-	0xbb, 0xdf, 0x32, 0x9b, 0xdd, 0x56, 0x4a, 0x5c, 0x64, 0xab, 0xcb, 0x5e, 0xab, 0xb3, 0x27, 0xaa,
-	0x88, 0xf5, 0xdf, 0x37, 0x7a, 0xcd, 0xbb, 0x86, 0xbe, 0x2f, 0x44, 0x9b, 0x4c, 0xf4, 0x91, 0x4e,
-	0x3a, 0x07, 0xcd, 0x78, 0xc8, 0x3b, 0x18, 0x41, 0x79, 0xaf, 0x69, 0x68, 0x44, 0xf6, 0xf2, 0x4c,
-	0xc1, 0x55, 0x28, 0xe9, 0x46, 0xbf, 0x2d, 0xeb, 0x19, 0x5c, 0x87, 0x9a, 0xd6, 0x37, 0x3b, 0x56,
-	0xd3, 0x68, 0x10, 0xbd, 0xad, 0x1b, 0xa6, 0xd4, 0xe4, 0x70, 0x0d, 0xaa, 0x66, 0xb3, 0xad, 0xf7,
-	0x4c, 0xad, 0xdd, 0x95, 0x42, 0x36, 0x8b, 0x62, 0x4f, 0x8f, 0x6d, 0x10, 0xde, 0x80, 0x75, 0xa3,
-	0x63, 0xc9, 0x27, 0x4b, 0xd6, 0x03, 0xad, 0xd5, 0xd7, 0xa5, 0x6e, 0x13, 0x5f, 0x03, 0xdc, 0x31,
-	0xac, 0x7e, 0x77, 0x5f, 0x33, 0x75, 0xcb, 0xe8, 0x3c, 0x94, 0x8a, 0x3b, 0xb8, 0x0a, 0xc5, 0xd9,
-	0x0c, 0x9e, 0x31, 0x16, 0x2a, 0x5d, 0x8d, 0x98, 0x33, 0xb0, 0xcf, 0x9e, 0x31, 0xb2, 0xe0, 0x2e,
-	0xe9, 0xf4, 0xbb, 0x33, 0xb3, 0x55, 0x28, 0x4b, 0xb2, 0xa4, 0x28, 0xc7, 0x44, 0x7b, 0x4d, 0xa3,
-	0x91, 0xcc, 0xef, 0x59, 0x71, 0x23, 0x83, 0x94, 0xed, 0x63, 0xc8, 0x71, 0x77, 0x14, 0x21, 0x67,
-	0x74, 0x0c, 0x1d, 0x5d, 0xc1, 0x2b, 0x00, 0xcd, 0x5e, 0xd3, 0x30, 0xf5, 0xbb, 0x44, 0x6b, 0x31,
-	0xd8, 0x5c, 0x10, 0x13, 0xc8, 0xd0, 0x2e, 0xc3, 0x52, 0xb3, 0x77, 0xd0, 0xea, 0x68, 0xa6, 0x84,
-	0xd9, 0xec, 0xdd, 0xef, 0x77, 0x4c, 0xa6, 0x44, 0xb8, 0x0c, 0x85, 0x66, 0xcf, 0xd4, 0xbf, 0x67,
-	0x32, 0x5c, 0x5c, 0x27, 0x58, 0x45, 0xcf, 0xee, 0x6c, 0xff, 0x28, 0x0b, 0x39, 0xfe, 0xe0, 0xb4,
-	0x02, 0x25, 0xee, 0x6d, 0xf3, 0x51, 0x97, 0x0d, 0x59, 0x82, 0x5c, 0xd3, 0x30, 0x6f, 0xa3, 0x5f,
-	0xcc, 0x60, 0x80, 0x7c, 0x9f, 0x97, 0x7f, 0xa9, 0xc0, 0xca, 0x4d, 0xc3, 0x7c, 0xf7, 0x16, 0xfa,
-	0x41, 0x86, 0x75, 0xdb, 0x17, 0x95, 0x5f, 0x8e, 0x15, 0xbb, 0x37, 0xd1, 0x0f, 0x13, 0xc5, 0xee,
-	0x4d, 0xf4, 0x2b, 0xb1, 0xe2, 0xbd, 0x5d, 0xf4, 0xab, 0x89, 0xe2, 0xbd, 0x5d, 0xf4, 0x6b, 0xb1,
-	0xe2, 0xd6, 0x4d, 0xf4, 0xeb, 0x89, 0xe2, 0xd6, 0x4d, 0xf4, 0x1b, 0x05, 0x86, 0x85, 0x23, 0x79,
-	0x6f, 0x17, 0xfd, 0x66, 0x31, 0xa9, 0xdd, 0xba, 0x89, 0x7e, 0xab, 0xc8, 0xfc, 0x9f, 0x78, 0x15,
-	0xfd, 0x36, 0x62, 0xd3, 0x64, 0x0e, 0x42, 0xbf, 0xc3, 0x8b, 0x4c, 0x85, 0x7e, 0x17, 0x31, 0x8c,
-	0x4c, 0xca, 0xab, 0x1f, 0x73, 0xcd, 0x23, 0x5d, 0x23, 0xe8, 0xf7, 0x0a, 0xe2, 0x85, 0x5a, 0xa3,
-	0xd9, 0xd6, 0x5a, 0x08, 0xf3, 0x16, 0x8c, 0x95, 0x3f, 0xb8, 0xc1, 0x8a, 0x2c, 0x3c, 0xd1, 0x1f,
-	0x76, 0xd9, 0x80, 0x0f, 0x34, 0xd2, 0xf8, 0x40, 0x23, 0xe8, 0x8f, 0x6e, 0xb0, 0x01, 0x1f, 0x68,
-	0x44, 0xf2, 0xf5, 0xc7, 0x5d, 0x66, 0xc8, 0x55, 0x9f, 0xdc, 0x60, 0x93, 0x96, 0xf2, 0x3f, 0xe9,
-	0xe2, 0x22, 0x64, 0xf7, 0x9a, 0x26, 0xfa, 0x11, 0x1f, 0x8d, 0x85, 0x28, 0xfa, 0x53, 0xc4, 0x84,
-	0x3d, 0xdd, 0x44, 0x7f, 0xc6, 0x84, 0x79, 0xb3, 0xdf, 0x6d, 0xe9, 0xe8, 0x0d, 0x36, 0xb9, 0xbb,
-	0x7a, 0xa7, 0xad, 0x9b, 0xe4, 0x11, 0xfa, 0x73, 0x6e, 0xfe, 0x61, 0xaf, 0x63, 0xa0, 0x4f, 0x11,
-	0xae, 0x02, 0xe8, 0xdf, 0xeb, 0x12, 0xbd, 0xd7, 0x6b, 0x76, 0x0c, 0xf4, 0xf6, 0xf6, 0x01, 0xa0,
-	0xf3, 0xe9, 0x80, 0x01, 0xe8, 0x1b, 0xf7, 0x8c, 0xce, 0x43, 0x03, 0x5d, 0x61, 0x95, 0x2e, 0xd1,
-	0xbb, 0x1a, 0xd1, 0x91, 0x82, 0x01, 0x0a, 0xf2, 0xdd, 0x5b, 0x06, 0x2f, 0x43, 0x91, 0x74, 0x5a,
-	0xad, 0x3d, 0xad, 0x71, 0x0f, 0x65, 0xf7, 0xbe, 0x0d, 0x2b, 0x8e, 0xbf, 0x73, 0xe2, 0x44, 0x34,
-	0x0c, 0xc5, 0x93, 0xe6, 0x8f, 0x54, 0x59, 0x73, 0xfc, 0xeb, 0xa2, 0x74, 0x7d, 0xe4, 0x5f, 0x3f,
-	0x89, 0xae, 0x73, 0xed, 0x75, 0x9e, 0x31, 0x0e, 0x0b, 0xbc, 0xf2, 0xde, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x99, 0x5d, 0x63, 0x30, 0x2d, 0x00, 0x00,
+	// 3361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x93, 0x1b, 0x49,
+	0x5a, 0x77, 0xe9, 0xd5, 0xd2, 0xa7, 0x96, 0x3a, 0x3b, 0xbb, 0xdb, 0xd6, 0xb4, 0xe7, 0xd1, 0x5b,
+	0xbb, 0xb3, 0xdb, 0xf4, 0x2e, 0x6d, 0x4f, 0x8f, 0xd7, 0x98, 0xd9, 0x05, 0x5c, 0xad, 0xae, 0xf6,
+	0x68, 0x2c, 0x95, 0xe4, 0x54, 0xc9, 0x5e, 0x4f, 0x6c, 0x44, 0x45, 0xb5, 0x94, 0x56, 0x57, 0x74,
+	0xa9, 0x4a, 0xae, 0x2a, 0xb5, 0xdd, 0x37, 0xc3, 0xb2, 0x3c, 0x17, 0x98, 0xe5, 0x35, 0x2c, 0x04,
+	0x03, 0x11, 0x1c, 0x08, 0x2e, 0xfc, 0x0d, 0x04, 0x07, 0x8e, 0xdc, 0x38, 0x00, 0x07, 0xb8, 0x10,
+	0xcb, 0x89, 0xe0, 0xc4, 0x81, 0x03, 0x41, 0xe4, 0xa3, 0x4a, 0xa5, 0x6e, 0xf9, 0xb1, 0x5e, 0x88,
+	0x8d, 0xf6, 0xf8, 0x96, 0xf9, 0x7d, 0x5f, 0x3e, 0x7e, 0xbf, 0xef, 0xd3, 0x97, 0x59, 0x99, 0x29,
+	0x28, 0x3f, 0x9c, 0xd0, 0xe0, 0x64, 0x7b, 0x1c, 0xf8, 0x91, 0x8f, 0xf3, 0xbc, 0xb2, 0x5e, 0x8d,
+	0xfc, 0xb1, 0x3f, 0xb0, 0x23, 0x5b, 0x88, 0xd7, 0xcb, 0xc7, 0x51, 0x30, 0xee, 0x8b, 0x8a, 0xfa,
+	0x5d, 0x05, 0x0a, 0xa6, 0x1d, 0x0c, 0x69, 0x84, 0xd7, 0xa1, 0x78, 0x44, 0x4f, 0xc2, 0xb1, 0xdd,
+	0xa7, 0x35, 0x65, 0x43, 0xd9, 0x2c, 0x91, 0xa4, 0x8e, 0x57, 0x21, 0x1f, 0x1e, 0xda, 0xc1, 0xa0,
+	0x96, 0xe1, 0x0a, 0x51, 0xc1, 0x5f, 0x87, 0x72, 0x64, 0x1f, 0xb8, 0x34, 0xb2, 0xa2, 0x93, 0x31,
+	0xad, 0x65, 0x37, 0x94, 0xcd, 0xea, 0xce, 0xea, 0x76, 0x32, 0x9e, 0xc9, 0x95, 0xe6, 0xc9, 0x98,
+	0x12, 0x88, 0x92, 0x32, 0xc6, 0x90, 0xeb, 0x53, 0xd7, 0xad, 0xe5, 0x78, 0x5f, 0xbc, 0xac, 0xee,
+	0x41, 0xf5, 0xae, 0x79, 0xcb, 0x8e, 0x68, 0xdd, 0x76, 0x5d, 0x1a, 0x34, 0xf6, 0xd8, 0x74, 0x26,
+	0x21, 0x0d, 0x3c, 0x7b, 0x94, 0x4c, 0x27, 0xae, 0xe3, 0x8b, 0x50, 0x18, 0x06, 0xfe, 0x64, 0x1c,
+	0xd6, 0x32, 0x1b, 0xd9, 0xcd, 0x12, 0x91, 0x35, 0xf5, 0xdb, 0x00, 0xfa, 0x31, 0xf5, 0x22, 0xd3,
+	0x3f, 0xa2, 0x1e, 0x7e, 0x13, 0x4a, 0x91, 0x33, 0xa2, 0x61, 0x64, 0x8f, 0xc6, 0xbc, 0x8b, 0x2c,
+	0x99, 0x0a, 0x9e, 0x02, 0x69, 0x1d, 0x8a, 0x63, 0x3f, 0x74, 0x22, 0xc7, 0xf7, 0x38, 0x9e, 0x12,
+	0x49, 0xea, 0xea, 0xcf, 0x43, 0xfe, 0xae, 0xed, 0x4e, 0x28, 0x7e, 0x07, 0x72, 0x1c, 0xb0, 0xc2,
+	0x01, 0x97, 0xb7, 0x05, 0xe9, 0x1c, 0x27, 0x57, 0xb0, 0xbe, 0x8f, 0x99, 0x25, 0xef, 0x7b, 0x91,
+	0x88, 0x8a, 0x7a, 0x04, 0x8b, 0xbb, 0x8e, 0x37, 0xb8, 0x6b, 0x07, 0x0e, 0x23, 0xe3, 0x25, 0xbb,
+	0xc1, 0x5f, 0x82, 0x02, 0x2f, 0x84, 0xb5, 0xec, 0x46, 0x76, 0xb3, 0xbc, 0xb3, 0x28, 0x1b, 0xf2,
+	0xb9, 0x11, 0xa9, 0x53, 0xff, 0x56, 0x01, 0xd8, 0xf5, 0x27, 0xde, 0xe0, 0x0e, 0x53, 0x62, 0x04,
+	0xd9, 0xf0, 0xa1, 0x2b, 0x89, 0x64, 0x45, 0x7c, 0x1b, 0xaa, 0x07, 0x8e, 0x37, 0xb0, 0x8e, 0xe5,
+	0x74, 0x04, 0x97, 0xe5, 0x9d, 0x2f, 0xc9, 0xee, 0xa6, 0x8d, 0xb7, 0xd3, 0xb3, 0x0e, 0x75, 0x2f,
+	0x0a, 0x4e, 0x48, 0xe5, 0x20, 0x2d, 0x5b, 0xef, 0x01, 0x3e, 0x6b, 0xc4, 0x06, 0x3d, 0xa2, 0x27,
+	0xf1, 0xa0, 0x47, 0xf4, 0x04, 0xff, 0x54, 0x1a, 0x51, 0x79, 0x67, 0x25, 0x1e, 0x2b, 0xd5, 0x56,
+	0xc2, 0xfc, 0x20, 0x73, 0x43, 0x51, 0xff, 0xac, 0x00, 0x55, 0xfd, 0x31, 0xed, 0x4f, 0x22, 0xda,
+	0x1e, 0x33, 0x1f, 0x84, 0x78, 0x1b, 0x56, 0x1c, 0xaf, 0xef, 0x4e, 0x06, 0xd4, 0xa2, 0xcc, 0xd5,
+	0x56, 0xc4, 0x7c, 0xcd, 0xfb, 0x2b, 0x92, 0x65, 0xa9, 0x4a, 0x05, 0x81, 0x06, 0x2b, 0x7d, 0x7f,
+	0x34, 0xb6, 0x83, 0x59, 0xfb, 0x2c, 0x1f, 0x7f, 0x59, 0x8e, 0x3f, 0xb5, 0x27, 0xcb, 0xd2, 0x3a,
+	0xd5, 0x45, 0x0b, 0x96, 0x64, 0xbf, 0x03, 0xeb, 0x81, 0x43, 0xdd, 0x41, 0xc8, 0x43, 0xb7, 0x9a,
+	0x50, 0x35, 0x3b, 0xc5, 0xed, 0x86, 0x34, 0xde, 0xe7, 0xb6, 0xa4, 0xea, 0xcc, 0xd4, 0xf1, 0x16,
+	0x2c, 0xf7, 0x5d, 0x87, 0x4d, 0xe5, 0x01, 0xa3, 0xd8, 0x0a, 0xfc, 0x47, 0x61, 0x2d, 0xcf, 0xe7,
+	0xbf, 0x24, 0x14, 0xfb, 0x4c, 0x4e, 0xfc, 0x47, 0x21, 0xfe, 0x00, 0x8a, 0x8f, 0xfc, 0xe0, 0xc8,
+	0xf5, 0xed, 0x41, 0xad, 0xc0, 0xc7, 0x7c, 0x7b, 0xfe, 0x98, 0xf7, 0xa4, 0x15, 0x49, 0xec, 0xf1,
+	0x26, 0xa0, 0xf0, 0xa1, 0x6b, 0x85, 0xd4, 0xa5, 0xfd, 0xc8, 0x72, 0x9d, 0x91, 0x13, 0xd5, 0x8a,
+	0xfc, 0x57, 0x50, 0x0d, 0x1f, 0xba, 0x5d, 0x2e, 0x6e, 0x32, 0x29, 0xb6, 0x60, 0x2d, 0x0a, 0x6c,
+	0x2f, 0xb4, 0xfb, 0xac, 0x33, 0xcb, 0x09, 0x7d, 0xd7, 0x66, 0xa5, 0x5a, 0x89, 0x0f, 0xb9, 0x35,
+	0x7f, 0x48, 0x73, 0xda, 0xa4, 0x11, 0xb7, 0x20, 0xab, 0xd1, 0x1c, 0x29, 0x7e, 0x0f, 0xd6, 0xc2,
+	0x23, 0x67, 0x6c, 0xf1, 0x7e, 0xac, 0xb1, 0x6b, 0x7b, 0x56, 0xdf, 0xee, 0x1f, 0xd2, 0x1a, 0x70,
+	0xd8, 0x98, 0x29, 0x79, 0xa8, 0x75, 0x5c, 0xdb, 0xab, 0x33, 0x8d, 0xfa, 0x0d, 0xa8, 0xce, 0xf2,
+	0x88, 0x97, 0xa1, 0x62, 0xde, 0xef, 0xe8, 0x96, 0x66, 0xec, 0x59, 0x86, 0xd6, 0xd2, 0xd1, 0x05,
+	0x5c, 0x81, 0x12, 0x17, 0xb5, 0x8d, 0xe6, 0x7d, 0xa4, 0xe0, 0x05, 0xc8, 0x6a, 0xcd, 0x26, 0xca,
+	0xa8, 0x37, 0xa0, 0x18, 0x13, 0x82, 0x97, 0xa0, 0xdc, 0x33, 0xba, 0x1d, 0xbd, 0xde, 0xd8, 0x6f,
+	0xe8, 0x7b, 0xe8, 0x02, 0x2e, 0x42, 0xae, 0xdd, 0x34, 0x3b, 0x48, 0x11, 0x25, 0xad, 0x83, 0x32,
+	0xac, 0xe5, 0xde, 0xae, 0x86, 0xb2, 0xea, 0x5f, 0x2a, 0xb0, 0x3a, 0x0f, 0x18, 0x2e, 0xc3, 0xc2,
+	0x9e, 0xbe, 0xaf, 0xf5, 0x9a, 0x26, 0xba, 0x80, 0x57, 0x60, 0x89, 0xe8, 0x1d, 0x5d, 0x33, 0xb5,
+	0xdd, 0xa6, 0x6e, 0x11, 0x5d, 0xdb, 0x43, 0x0a, 0xc6, 0x50, 0x65, 0x25, 0xab, 0xde, 0x6e, 0xb5,
+	0x1a, 0xa6, 0xa9, 0xef, 0xa1, 0x0c, 0x5e, 0x05, 0xc4, 0x65, 0x3d, 0x63, 0x2a, 0xcd, 0x62, 0x04,
+	0x8b, 0x5d, 0x9d, 0x34, 0xb4, 0x66, 0xe3, 0x63, 0xd6, 0x01, 0xca, 0xe1, 0x2f, 0xc0, 0x5b, 0xf5,
+	0xb6, 0xd1, 0x6d, 0x74, 0x4d, 0xdd, 0x30, 0xad, 0xae, 0xa1, 0x75, 0xba, 0x1f, 0xb6, 0x4d, 0xde,
+	0xb3, 0x00, 0x97, 0xc7, 0x55, 0x00, 0xad, 0x67, 0xb6, 0x45, 0x3f, 0xa8, 0xf0, 0x51, 0xae, 0xa8,
+	0xa0, 0x8c, 0xfa, 0x69, 0x06, 0xf2, 0x9c, 0x1f, 0x96, 0x55, 0x53, 0xb9, 0x92, 0x97, 0x93, 0x0c,
+	0x93, 0x79, 0x46, 0x86, 0xe1, 0x89, 0x59, 0xe6, 0x3a, 0x51, 0xc1, 0x97, 0xa1, 0xe4, 0x07, 0x43,
+	0x4b, 0x68, 0x44, 0x96, 0x2e, 0xfa, 0xc1, 0x90, 0xa7, 0x73, 0x96, 0x21, 0x59, 0x72, 0x3f, 0xb0,
+	0x43, 0xca, 0xa3, 0xb6, 0x44, 0x92, 0x3a, 0x7e, 0x03, 0x98, 0x9d, 0xc5, 0xe7, 0x51, 0xe0, 0xba,
+	0x05, 0x3f, 0x18, 0x1a, 0x6c, 0x2a, 0x5f, 0x84, 0x4a, 0xdf, 0x77, 0x27, 0x23, 0xcf, 0x72, 0xa9,
+	0x37, 0x8c, 0x0e, 0x6b, 0x0b, 0x1b, 0xca, 0x66, 0x85, 0x2c, 0x0a, 0x61, 0x93, 0xcb, 0x70, 0x0d,
+	0x16, 0xfa, 0x87, 0x76, 0x10, 0x52, 0x11, 0xa9, 0x15, 0x12, 0x57, 0xf9, 0xa8, 0xb4, 0xef, 0x8c,
+	0x6c, 0x37, 0xe4, 0x51, 0x59, 0x21, 0x49, 0x9d, 0x81, 0x78, 0xe0, 0xda, 0xc3, 0x90, 0x47, 0x53,
+	0x85, 0x88, 0x8a, 0xfa, 0x33, 0x90, 0x25, 0xfe, 0x23, 0xd6, 0xa5, 0x18, 0x30, 0xac, 0x29, 0x1b,
+	0xd9, 0x4d, 0x4c, 0xe2, 0x2a, 0x5b, 0x44, 0x64, 0x1e, 0x15, 0xe9, 0x35, 0xce, 0x9c, 0xdf, 0x86,
+	0x45, 0x42, 0xc3, 0x89, 0x1b, 0xe9, 0x8f, 0xa3, 0xc0, 0x0e, 0xf1, 0x0e, 0x94, 0xd3, 0x99, 0x43,
+	0x79, 0x5a, 0xe6, 0x00, 0x9a, 0x94, 0xd9, 0xa8, 0x0f, 0x02, 0x1a, 0x1e, 0xd2, 0x40, 0x66, 0xa6,
+	0xb8, 0xca, 0xf2, 0x72, 0x99, 0x87, 0xba, 0x18, 0x83, 0x65, 0x73, 0x99, 0x53, 0x94, 0x99, 0x6c,
+	0xce, 0x9d, 0x4a, 0xa4, 0x8e, 0xb1, 0xc7, 0xd2, 0x84, 0x65, 0x3f, 0x78, 0x40, 0xfb, 0x11, 0x15,
+	0x8b, 0x56, 0x8e, 0x2c, 0x32, 0xa1, 0x26, 0x65, 0xcc, 0x6d, 0x8e, 0x17, 0xd2, 0x20, 0xb2, 0x9c,
+	0x01, 0x77, 0x68, 0x8e, 0x14, 0x85, 0xa0, 0x31, 0xc0, 0x6f, 0x43, 0x8e, 0x27, 0x9a, 0x1c, 0x1f,
+	0x05, 0xe4, 0x28, 0xc4, 0x7f, 0x44, 0xb8, 0x1c, 0x7f, 0x15, 0x0a, 0x94, 0xe3, 0xad, 0xe5, 0x67,
+	0x52, 0x73, 0x9a, 0x0a, 0x22, 0x4d, 0xd4, 0x6f, 0xc2, 0x22, 0xc7, 0x70, 0xcf, 0x0e, 0x3c, 0xc7,
+	0x1b, 0xf2, 0x15, 0xdd, 0x1f, 0x88, 0xd8, 0xab, 0x10, 0x5e, 0x66, 0x14, 0x8c, 0x68, 0x18, 0xda,
+	0x43, 0x2a, 0x57, 0xd8, 0xb8, 0xaa, 0xfe, 0x79, 0x16, 0xca, 0xdd, 0x28, 0xa0, 0xf6, 0x88, 0xb3,
+	0x87, 0xbf, 0x09, 0x10, 0x46, 0x76, 0x44, 0x47, 0xd4, 0x8b, 0x62, 0x1a, 0xde, 0x94, 0xc3, 0xa7,
+	0xec, 0xb6, 0xbb, 0xb1, 0x11, 0x49, 0xd9, 0x9f, 0x76, 0x4f, 0xe6, 0x05, 0xdc, 0xb3, 0xfe, 0x59,
+	0x06, 0x4a, 0x49, 0x6f, 0x58, 0x83, 0x62, 0xdf, 0x8e, 0xe8, 0xd0, 0x0f, 0x4e, 0xe4, 0x5a, 0xfc,
+	0xee, 0xb3, 0x46, 0xdf, 0xae, 0x4b, 0x63, 0x92, 0x34, 0xc3, 0x6f, 0x81, 0xd8, 0xe0, 0x88, 0xd0,
+	0x17, 0x78, 0x4b, 0x5c, 0xc2, 0x83, 0xff, 0x03, 0xc0, 0xe3, 0xc0, 0x19, 0xd9, 0xc1, 0x89, 0x75,
+	0x44, 0x4f, 0xe2, 0x45, 0x24, 0x3b, 0xc7, 0xe1, 0x48, 0xda, 0xdd, 0xa6, 0x27, 0x32, 0xed, 0xdd,
+	0x98, 0x6d, 0x2b, 0x43, 0xf6, 0xac, 0x1b, 0x53, 0x2d, 0xf9, 0x4e, 0x20, 0x8c, 0xd7, 0xfc, 0x3c,
+	0x8f, 0x6e, 0x56, 0x54, 0xbf, 0x02, 0xc5, 0x78, 0xf2, 0xb8, 0x04, 0x79, 0x3d, 0x08, 0xfc, 0x00,
+	0x5d, 0xe0, 0xd9, 0xaf, 0xd5, 0x14, 0x09, 0x74, 0x6f, 0x8f, 0x25, 0xd0, 0xbf, 0xc9, 0x24, 0x0b,
+	0x2f, 0xa1, 0x0f, 0x27, 0x34, 0x8c, 0xf0, 0x2f, 0xc0, 0x0a, 0xe5, 0x91, 0xe6, 0x1c, 0x53, 0xab,
+	0xcf, 0x77, 0x69, 0x2c, 0xce, 0xc4, 0xcf, 0x61, 0x69, 0x5b, 0x6c, 0x2a, 0xe3, 0xdd, 0x1b, 0x59,
+	0x4e, 0x6c, 0xa5, 0x68, 0x80, 0x75, 0x58, 0x71, 0x46, 0x23, 0x3a, 0x70, 0xec, 0x28, 0xdd, 0x81,
+	0x70, 0xd8, 0x5a, 0xbc, 0x89, 0x99, 0xd9, 0x04, 0x92, 0xe5, 0xa4, 0x45, 0xd2, 0xcd, 0xbb, 0x50,
+	0x88, 0xf8, 0x86, 0x55, 0xae, 0xe1, 0x95, 0x38, 0xab, 0x71, 0x21, 0x91, 0x4a, 0xfc, 0x15, 0x10,
+	0xdb, 0x5f, 0x9e, 0xbf, 0xa6, 0x01, 0x31, 0xdd, 0xd5, 0x10, 0xa1, 0xc7, 0xef, 0x42, 0x75, 0x66,
+	0xf1, 0x1b, 0x70, 0xc2, 0xb2, 0xa4, 0x92, 0x92, 0x36, 0x06, 0xf8, 0x0a, 0x2c, 0xf8, 0x62, 0xe1,
+	0xab, 0x15, 0x66, 0x66, 0x3c, 0xbb, 0x2a, 0x92, 0xd8, 0x4a, 0xfd, 0x39, 0x58, 0x4a, 0x18, 0x0c,
+	0xc7, 0xbe, 0x17, 0x52, 0xbc, 0x05, 0x85, 0x80, 0xff, 0x9c, 0x24, 0x6b, 0x58, 0x76, 0x91, 0xca,
+	0x07, 0x44, 0x5a, 0xa8, 0x03, 0x58, 0x12, 0x92, 0x7b, 0x4e, 0x74, 0xc8, 0x1d, 0x85, 0xdf, 0x85,
+	0x3c, 0x65, 0x85, 0x53, 0x9c, 0x93, 0x4e, 0x9d, 0xeb, 0x89, 0xd0, 0xa6, 0x46, 0xc9, 0x3c, 0x77,
+	0x94, 0xff, 0xcc, 0xc0, 0x8a, 0x9c, 0xe5, 0xae, 0x1d, 0xf5, 0x0f, 0xcf, 0xa9, 0xb3, 0xbf, 0x0a,
+	0x0b, 0x4c, 0xee, 0x24, 0x3f, 0x8c, 0x39, 0xee, 0x8e, 0x2d, 0x98, 0xc3, 0xed, 0xd0, 0x4a, 0x79,
+	0x57, 0x6e, 0xbe, 0x2a, 0x76, 0x98, 0x5a, 0xf9, 0xe7, 0xc4, 0x45, 0xe1, 0x39, 0x71, 0xb1, 0xf0,
+	0x42, 0x71, 0xb1, 0x07, 0xab, 0xb3, 0x8c, 0xcb, 0xe0, 0xf8, 0x1a, 0x2c, 0x08, 0xa7, 0xc4, 0x29,
+	0x70, 0x9e, 0xdf, 0x62, 0x13, 0xf5, 0xef, 0x32, 0xb0, 0x2a, 0xb3, 0xd3, 0xe7, 0xe3, 0x67, 0x9a,
+	0xe2, 0x39, 0xff, 0x22, 0x3c, 0xbf, 0xa0, 0xff, 0xd4, 0x3a, 0xac, 0x9d, 0xe2, 0xf1, 0x25, 0x7e,
+	0xac, 0xff, 0xa1, 0xc0, 0xe2, 0x2e, 0x1d, 0x3a, 0xde, 0x39, 0xf5, 0x42, 0x8a, 0xdc, 0xdc, 0x0b,
+	0x05, 0xf1, 0x75, 0xa8, 0x48, 0xbc, 0x92, 0xad, 0xb3, 0x6c, 0x2b, 0xf3, 0xd8, 0xfe, 0x37, 0x05,
+	0x2a, 0x75, 0x7f, 0x34, 0x72, 0xa2, 0x73, 0xca, 0xd4, 0x59, 0x9c, 0xb9, 0x79, 0x38, 0x11, 0x54,
+	0x63, 0x98, 0x82, 0x20, 0xf5, 0x87, 0x0a, 0x2c, 0x11, 0xdf, 0x75, 0x0f, 0xec, 0xfe, 0xd1, 0xab,
+	0x8d, 0x1d, 0x03, 0x9a, 0x02, 0x95, 0xe8, 0xff, 0x5b, 0x81, 0x6a, 0x27, 0xa0, 0xec, 0xc3, 0xfa,
+	0x95, 0x06, 0xcf, 0x76, 0xc2, 0x83, 0x48, 0xee, 0x21, 0x4a, 0x84, 0x97, 0xd5, 0x65, 0x58, 0x4a,
+	0xb0, 0x4b, 0x3e, 0xfe, 0x49, 0x81, 0x35, 0x11, 0x20, 0x52, 0x33, 0x38, 0xa7, 0xb4, 0xc4, 0x78,
+	0x73, 0x29, 0xbc, 0x35, 0xb8, 0x78, 0x1a, 0x9b, 0x84, 0xfd, 0x9d, 0x0c, 0x5c, 0x8a, 0x63, 0xe3,
+	0x9c, 0x03, 0xff, 0x31, 0xe2, 0x61, 0x1d, 0x6a, 0x67, 0x49, 0x90, 0x0c, 0x7d, 0x92, 0x81, 0x5a,
+	0x3d, 0xa0, 0x76, 0x44, 0x53, 0x7b, 0x91, 0x57, 0x27, 0x36, 0xf0, 0x7b, 0xb0, 0x38, 0xb6, 0x83,
+	0xc8, 0xe9, 0x3b, 0x63, 0x9b, 0x7d, 0xed, 0xe5, 0x37, 0xb2, 0x67, 0x3b, 0x98, 0x31, 0x51, 0x2f,
+	0xc3, 0x1b, 0x73, 0x18, 0x91, 0x7c, 0xfd, 0x8f, 0x02, 0xb8, 0x1b, 0xd9, 0x41, 0xf4, 0x39, 0x58,
+	0x55, 0xe6, 0x06, 0xd3, 0x1a, 0xac, 0xcc, 0xe0, 0x4f, 0xf3, 0x42, 0xa3, 0xcf, 0xc5, 0x8a, 0xf3,
+	0x54, 0x5e, 0xd2, 0xf8, 0x25, 0x2f, 0xff, 0xa2, 0xc0, 0x7a, 0xdd, 0x17, 0x07, 0x8b, 0xaf, 0xe4,
+	0x2f, 0x4c, 0x7d, 0x0b, 0x2e, 0xcf, 0x05, 0x28, 0x09, 0xf8, 0x67, 0x05, 0x2e, 0x12, 0x6a, 0x0f,
+	0x5e, 0x4d, 0xf0, 0x77, 0xe0, 0xd2, 0x19, 0x70, 0x72, 0x87, 0x7a, 0x1d, 0x8a, 0x23, 0x1a, 0xd9,
+	0xec, 0xac, 0x52, 0x42, 0x5a, 0x8f, 0xfb, 0x9d, 0x5a, 0xb7, 0xa4, 0x05, 0x49, 0x6c, 0xd5, 0xcf,
+	0x32, 0xb0, 0xc2, 0xf7, 0xba, 0xaf, 0x3f, 0xb4, 0xe6, 0x7f, 0x0b, 0x7c, 0xa2, 0xc0, 0xea, 0x2c,
+	0x41, 0xc9, 0x37, 0xc1, 0xff, 0xf5, 0x79, 0xc5, 0x9c, 0x84, 0x90, 0x9d, 0xb7, 0x05, 0xfd, 0xfb,
+	0x0c, 0xd4, 0xd2, 0x53, 0x7a, 0x7d, 0xb6, 0x31, 0x7b, 0xb6, 0xf1, 0x23, 0x1f, 0x66, 0x7d, 0xaa,
+	0xc0, 0x1b, 0x73, 0x08, 0xfd, 0xd1, 0x1c, 0x9d, 0x3a, 0xe1, 0xc8, 0x3c, 0xf7, 0x84, 0xe3, 0x45,
+	0x5d, 0xfd, 0x8f, 0x0a, 0xac, 0xb6, 0xc4, 0xc1, 0xb2, 0xf8, 0x8e, 0x3f, 0xbf, 0xd9, 0x8c, 0x9f,
+	0x1d, 0xe7, 0xa6, 0xd7, 0x37, 0xec, 0x6c, 0xe2, 0x14, 0xb4, 0x97, 0x38, 0x9b, 0xf8, 0x2f, 0x05,
+	0x96, 0x65, 0x2f, 0x5a, 0xff, 0xe8, 0xd5, 0x61, 0x07, 0xbf, 0x0d, 0x59, 0x67, 0x10, 0xef, 0x20,
+	0x67, 0x2f, 0xc1, 0x99, 0x42, 0xbd, 0x09, 0x38, 0x8d, 0xfb, 0x25, 0xa8, 0xfb, 0x87, 0x2c, 0x2c,
+	0x77, 0xc7, 0xae, 0x13, 0x49, 0xe5, 0xab, 0x9d, 0xf8, 0xbf, 0x00, 0x8b, 0x21, 0x03, 0x6b, 0x89,
+	0x2b, 0x39, 0x4e, 0x6c, 0x89, 0x94, 0xb9, 0xac, 0xce, 0x45, 0xf8, 0x1d, 0x28, 0xc7, 0x26, 0x13,
+	0x2f, 0x92, 0x07, 0x6a, 0x20, 0x2d, 0x26, 0x5e, 0x84, 0xaf, 0xc1, 0x25, 0x6f, 0x32, 0xe2, 0x57,
+	0xda, 0xd6, 0x98, 0x06, 0xf1, 0x85, 0xaf, 0x1d, 0xc4, 0x57, 0xcf, 0x2b, 0xde, 0x64, 0xc4, 0x6e,
+	0xb6, 0x3b, 0x34, 0x10, 0x17, 0xbe, 0x76, 0x10, 0xe1, 0x9b, 0x50, 0xb2, 0xdd, 0xa1, 0x1f, 0x38,
+	0xd1, 0xe1, 0x48, 0xde, 0x39, 0xab, 0xf1, 0x0d, 0xcc, 0x69, 0xfa, 0xb7, 0xb5, 0xd8, 0x92, 0x4c,
+	0x1b, 0xa9, 0x5f, 0x83, 0x52, 0x22, 0x67, 0xd7, 0xab, 0xfa, 0x9d, 0x9e, 0xd6, 0xb4, 0xba, 0x9d,
+	0x66, 0xc3, 0xec, 0x8a, 0x7b, 0xe2, 0xfd, 0x5e, 0xb3, 0x69, 0x75, 0xeb, 0x9a, 0x81, 0x14, 0x95,
+	0x00, 0xf0, 0x2e, 0x79, 0xe7, 0x53, 0x82, 0x94, 0xe7, 0x10, 0x74, 0x19, 0x4a, 0x81, 0xff, 0x48,
+	0x62, 0xcf, 0x70, 0x38, 0xc5, 0xc0, 0x7f, 0xc4, 0x91, 0xab, 0x1a, 0xe0, 0xf4, 0x5c, 0x65, 0xb4,
+	0xa5, 0x92, 0xb7, 0x32, 0x93, 0xbc, 0xa7, 0xe3, 0x27, 0xc9, 0x5b, 0x6c, 0xe5, 0xd9, 0xef, 0xfc,
+	0x43, 0x6a, 0xbb, 0x51, 0xbc, 0x5e, 0xa9, 0x7f, 0x91, 0x81, 0x0a, 0x61, 0x12, 0x67, 0x44, 0xd9,
+	0x25, 0x54, 0xc8, 0x3c, 0x75, 0xc8, 0x4d, 0xac, 0x69, 0xda, 0x2d, 0x91, 0xb2, 0x90, 0x89, 0xbb,
+	0x82, 0x1d, 0x58, 0x0b, 0x69, 0xdf, 0xf7, 0x06, 0xa1, 0x75, 0x40, 0x0f, 0xd9, 0x3b, 0x8f, 0x91,
+	0x1d, 0x46, 0xf2, 0x3a, 0xb2, 0x42, 0x56, 0xa4, 0x72, 0x97, 0xeb, 0x5a, 0x5c, 0x85, 0xaf, 0xc2,
+	0xea, 0x81, 0xe3, 0xb9, 0xfe, 0x90, 0xdd, 0xd0, 0x9f, 0xd0, 0x20, 0x94, 0x50, 0x59, 0x78, 0xe5,
+	0x09, 0x16, 0xba, 0x8e, 0x50, 0x09, 0x77, 0x7f, 0x0c, 0x5b, 0x73, 0x47, 0xb1, 0x1e, 0x38, 0x6e,
+	0x44, 0x03, 0x3a, 0xb0, 0x02, 0x3a, 0x76, 0x9d, 0xbe, 0x78, 0x4d, 0x20, 0xf6, 0xee, 0x5f, 0x9e,
+	0x33, 0xf4, 0xbe, 0x34, 0x27, 0x53, 0x6b, 0xc6, 0x76, 0x7f, 0x3c, 0xb1, 0x26, 0xfc, 0x06, 0x91,
+	0xad, 0x62, 0x0a, 0x29, 0xf6, 0xc7, 0x93, 0x1e, 0xab, 0xb3, 0xab, 0xad, 0x87, 0x63, 0xb1, 0x78,
+	0x29, 0x84, 0x15, 0xd9, 0x11, 0x6c, 0x55, 0x1b, 0x0e, 0x03, 0x3a, 0xb4, 0x23, 0x49, 0xd3, 0x55,
+	0x58, 0x15, 0x94, 0x9c, 0x58, 0xf2, 0x99, 0x92, 0xc0, 0xa3, 0x08, 0x3c, 0x52, 0x27, 0x1e, 0x29,
+	0xc5, 0xe1, 0x7b, 0x71, 0xe2, 0xcd, 0x6d, 0x93, 0xe1, 0x6d, 0x56, 0x27, 0xde, 0x9c, 0x56, 0x3f,
+	0x0b, 0x6f, 0xcc, 0x67, 0x61, 0xe4, 0x88, 0x87, 0x26, 0x15, 0x72, 0x71, 0x0e, 0xe8, 0x96, 0xe3,
+	0x3d, 0xa3, 0xa9, 0xfd, 0xb8, 0x96, 0x7b, 0x7a, 0x53, 0xfb, 0xb1, 0xfa, 0x57, 0xc9, 0x0d, 0x40,
+	0x1c, 0x2e, 0xc9, 0x6a, 0x1c, 0xe7, 0x05, 0xe5, 0x59, 0x79, 0xa1, 0x06, 0x0b, 0x21, 0x0d, 0x8e,
+	0x1d, 0x6f, 0x18, 0x5f, 0x51, 0xcb, 0x2a, 0xee, 0xc2, 0x97, 0x25, 0x76, 0xfa, 0x38, 0xa2, 0x81,
+	0x67, 0xbb, 0xee, 0x89, 0x25, 0x0e, 0x2a, 0xbc, 0x88, 0x0e, 0xac, 0xe9, 0xa3, 0x2a, 0xb1, 0x22,
+	0x7f, 0x51, 0x58, 0xeb, 0x89, 0x31, 0x49, 0x6c, 0xcd, 0xd8, 0x14, 0x7f, 0x03, 0xaa, 0x81, 0x0c,
+	0x62, 0x2b, 0x64, 0xee, 0x91, 0xf9, 0x68, 0x35, 0xb9, 0x67, 0x4e, 0x45, 0x38, 0xa9, 0x04, 0xe9,
+	0x2a, 0xbe, 0x01, 0x8b, 0x72, 0x46, 0xb6, 0xeb, 0xd8, 0xd3, 0x8d, 0xe9, 0xa9, 0x97, 0x66, 0x1a,
+	0x53, 0x92, 0x72, 0x34, 0xad, 0x7c, 0x94, 0x2b, 0x16, 0xd0, 0x02, 0xfb, 0x1a, 0x5e, 0xe9, 0x8d,
+	0x07, 0x3c, 0x32, 0xce, 0xf1, 0x1e, 0x21, 0xfd, 0x38, 0x2d, 0x37, 0xfb, 0x38, 0x6d, 0xf6, 0xb1,
+	0x5b, 0xfe, 0xd4, 0x63, 0x37, 0xf5, 0x26, 0xac, 0xce, 0xe2, 0x97, 0xb1, 0xb2, 0x09, 0x79, 0x7e,
+	0x2d, 0x7e, 0x6a, 0x31, 0x4c, 0xdd, 0x7b, 0x13, 0x61, 0xa0, 0xfe, 0xb5, 0x02, 0x2b, 0x73, 0x3e,
+	0x94, 0x92, 0xaf, 0x30, 0x25, 0x75, 0xc8, 0xf3, 0xd3, 0x90, 0x67, 0x2e, 0x8e, 0xdf, 0x9d, 0x5c,
+	0x3a, 0xfb, 0x9d, 0xc5, 0xdc, 0x4a, 0x89, 0xb0, 0x62, 0xe9, 0x8c, 0x87, 0x45, 0x9f, 0x9f, 0xf2,
+	0xc4, 0xfb, 0xbc, 0x32, 0x93, 0x89, 0x83, 0x9f, 0xb3, 0xc7, 0x46, 0xb9, 0xe7, 0x1f, 0x1b, 0xfd,
+	0x7b, 0x06, 0xd6, 0x08, 0x65, 0x31, 0x4d, 0x5f, 0xdf, 0x64, 0xff, 0x38, 0x37, 0xd9, 0x6c, 0xd5,
+	0x1f, 0x07, 0xd4, 0x8a, 0x17, 0xb2, 0x05, 0xbe, 0x2f, 0x80, 0x71, 0x40, 0xef, 0xc8, 0x85, 0xeb,
+	0x7b, 0xfc, 0x4c, 0x61, 0x96, 0xea, 0xff, 0xbf, 0x6f, 0xc0, 0x77, 0xa0, 0x1c, 0x88, 0xc1, 0x06,
+	0xd3, 0xaf, 0x02, 0x88, 0x45, 0x8d, 0x81, 0xfa, 0xc3, 0x0c, 0xac, 0xcb, 0xe9, 0xbc, 0xfe, 0x70,
+	0x7f, 0x71, 0xbf, 0x16, 0x4e, 0xfb, 0xf5, 0x34, 0xd3, 0x0b, 0x67, 0x98, 0xfe, 0xbe, 0x02, 0x97,
+	0xe7, 0x32, 0xfd, 0x13, 0xf4, 0xfe, 0xbf, 0x2a, 0x50, 0x25, 0xd4, 0xa5, 0x76, 0x78, 0x5e, 0x3d,
+	0x7e, 0x0a, 0x62, 0xee, 0x0c, 0xc4, 0x65, 0x58, 0x4a, 0x10, 0x0a, 0xa6, 0xb7, 0x7e, 0x37, 0x0b,
+	0xa5, 0xd6, 0x49, 0xf7, 0xa1, 0xbb, 0xef, 0xda, 0x43, 0xfe, 0xb6, 0xa7, 0xd5, 0x31, 0xef, 0xa3,
+	0x0b, 0xec, 0xd5, 0xa4, 0xd1, 0x36, 0x2d, 0x83, 0x6d, 0x7f, 0xf7, 0x9b, 0xda, 0x2d, 0xa4, 0xb0,
+	0xfd, 0x71, 0x87, 0x34, 0xac, 0xdb, 0xfa, 0x7d, 0x21, 0xc9, 0xb0, 0xf7, 0x8c, 0x3d, 0xa3, 0x71,
+	0xa7, 0xa7, 0x4f, 0x85, 0x39, 0xbc, 0x06, 0xcb, 0xad, 0x5e, 0xd3, 0x6c, 0x74, 0x9a, 0x29, 0x71,
+	0x91, 0xed, 0xa5, 0x77, 0x9b, 0xed, 0x5d, 0x51, 0x45, 0xac, 0xff, 0x9e, 0xd1, 0x6d, 0xdc, 0x32,
+	0xf4, 0x3d, 0x21, 0xda, 0x60, 0xa2, 0x8f, 0x75, 0xd2, 0xde, 0x6f, 0xc4, 0x43, 0xde, 0xc4, 0x08,
+	0xca, 0xbb, 0x0d, 0x43, 0x23, 0xb2, 0x97, 0x27, 0x0a, 0xae, 0x42, 0x49, 0x37, 0x7a, 0x2d, 0x59,
+	0xcf, 0xe0, 0x1a, 0xac, 0xb0, 0xe7, 0x8d, 0x56, 0xc3, 0xa8, 0x13, 0xbd, 0xc5, 0x5e, 0x41, 0x0a,
+	0x4d, 0x0e, 0xaf, 0x40, 0xd5, 0x6c, 0xb4, 0xf4, 0xae, 0xa9, 0xb5, 0x3a, 0x52, 0xc8, 0x66, 0x51,
+	0xec, 0xea, 0xb1, 0x0d, 0xc2, 0xeb, 0xb0, 0x66, 0xb4, 0x2d, 0xf9, 0x40, 0xd3, 0xba, 0xab, 0x35,
+	0x7b, 0xba, 0xd4, 0x6d, 0xe0, 0x4b, 0x80, 0xdb, 0x86, 0xd5, 0xeb, 0xec, 0x69, 0xa6, 0x6e, 0x19,
+	0xed, 0x7b, 0x52, 0x71, 0x13, 0x57, 0xa1, 0x38, 0x9d, 0xc1, 0x13, 0xc6, 0x42, 0xa5, 0xa3, 0x11,
+	0x73, 0x0a, 0xf6, 0xc9, 0x13, 0x46, 0x16, 0xdc, 0x22, 0xed, 0x5e, 0x67, 0x6a, 0xb6, 0x0c, 0x65,
+	0x49, 0x96, 0x14, 0xe5, 0x98, 0x68, 0xb7, 0x61, 0xd4, 0x93, 0xf9, 0x3d, 0x29, 0xae, 0x67, 0x90,
+	0xb2, 0x75, 0x04, 0x39, 0xee, 0x8e, 0x22, 0xe4, 0x8c, 0xb6, 0xc1, 0x1e, 0xac, 0x2e, 0x01, 0x34,
+	0xba, 0x0d, 0xc3, 0xd4, 0x6f, 0x11, 0xad, 0xc9, 0x60, 0x73, 0x41, 0x4c, 0x20, 0x43, 0xbb, 0x08,
+	0x0b, 0x8d, 0xee, 0x7e, 0xb3, 0xad, 0x99, 0x12, 0x66, 0xa3, 0x7b, 0xa7, 0xd7, 0x66, 0xef, 0x46,
+	0x9f, 0x20, 0x5c, 0x86, 0x02, 0x7b, 0x22, 0xfa, 0x2d, 0x93, 0xe1, 0xe2, 0x3a, 0xc1, 0x2a, 0x7a,
+	0x72, 0x73, 0xeb, 0x07, 0x59, 0xc8, 0xf1, 0xe7, 0xf5, 0x15, 0x28, 0x71, 0x6f, 0xb3, 0x97, 0xb1,
+	0xe8, 0x02, 0x2e, 0x41, 0xae, 0x61, 0x98, 0x37, 0xd0, 0x2f, 0x66, 0x30, 0x40, 0xbe, 0xc7, 0xcb,
+	0xbf, 0x54, 0x60, 0xe5, 0x86, 0x61, 0xbe, 0x77, 0x1d, 0x7d, 0x27, 0xc3, 0xba, 0xed, 0x89, 0xca,
+	0x2f, 0xc7, 0x8a, 0x9d, 0x6b, 0xe8, 0xbb, 0x89, 0x62, 0xe7, 0x1a, 0xfa, 0x95, 0x58, 0xf1, 0xfe,
+	0x0e, 0xfa, 0xd5, 0x44, 0xf1, 0xfe, 0x0e, 0xfa, 0xb5, 0x58, 0x71, 0xfd, 0x1a, 0xfa, 0xf5, 0x44,
+	0x71, 0xfd, 0x1a, 0xfa, 0x8d, 0x02, 0xc3, 0xc2, 0x91, 0xbc, 0xbf, 0x83, 0x7e, 0xb3, 0x98, 0xd4,
+	0xae, 0x5f, 0x43, 0xdf, 0x2b, 0x32, 0xff, 0x27, 0x5e, 0x45, 0xbf, 0x85, 0xd8, 0x34, 0x99, 0x83,
+	0xd0, 0x6f, 0xf3, 0x22, 0x53, 0xa1, 0xdf, 0x41, 0x0c, 0x23, 0x93, 0xf2, 0xea, 0x27, 0x5c, 0x73,
+	0x5f, 0xd7, 0x08, 0xfa, 0x7e, 0x41, 0xbc, 0xc7, 0xad, 0x37, 0x5a, 0x5a, 0x13, 0x61, 0xde, 0x82,
+	0xb1, 0xf2, 0x7b, 0x57, 0x59, 0x91, 0x85, 0x27, 0xfa, 0xfd, 0x0e, 0x1b, 0xf0, 0xae, 0x46, 0xea,
+	0x1f, 0x6a, 0x04, 0xfd, 0xc1, 0x55, 0x36, 0xe0, 0x5d, 0x8d, 0x48, 0xbe, 0xfe, 0xb0, 0xc3, 0x0c,
+	0xb9, 0xea, 0xd3, 0xab, 0x6c, 0xd2, 0x52, 0xfe, 0x47, 0x1d, 0x5c, 0x84, 0xec, 0x6e, 0xc3, 0x44,
+	0x3f, 0xe0, 0xa3, 0xb1, 0x10, 0x45, 0x7f, 0x8c, 0x98, 0xb0, 0xab, 0x9b, 0xe8, 0x4f, 0x98, 0x30,
+	0x6f, 0xf6, 0x3a, 0x4d, 0x1d, 0xbd, 0xc9, 0x26, 0x77, 0x4b, 0x6f, 0xb7, 0x74, 0x93, 0xdc, 0x47,
+	0x7f, 0xca, 0xcd, 0x3f, 0xea, 0xb6, 0x0d, 0xf4, 0x19, 0x62, 0x6f, 0x75, 0xf5, 0x6f, 0x75, 0x88,
+	0xde, 0xed, 0x36, 0xda, 0x06, 0x7a, 0x67, 0x6b, 0x1f, 0xd0, 0xe9, 0xcd, 0x0f, 0x03, 0xd0, 0x33,
+	0x6e, 0x1b, 0xed, 0x7b, 0x06, 0xba, 0xc0, 0x2a, 0x1d, 0xa2, 0x77, 0x34, 0xa2, 0x23, 0x05, 0x03,
+	0x14, 0xe4, 0x2b, 0xdf, 0x0c, 0x5e, 0x84, 0x22, 0x69, 0x37, 0x9b, 0xbb, 0x5a, 0xfd, 0x36, 0xca,
+	0xee, 0x7e, 0x1d, 0x96, 0x1c, 0x7f, 0xfb, 0xd8, 0x89, 0x68, 0x18, 0x8a, 0x3f, 0x70, 0x7c, 0xac,
+	0xca, 0x9a, 0xe3, 0x5f, 0x11, 0xa5, 0x2b, 0x43, 0xff, 0xca, 0x71, 0x74, 0x85, 0x6b, 0xaf, 0xf0,
+	0xfc, 0x72, 0x50, 0xe0, 0x95, 0xf7, 0xff, 0x77, 0x00, 0xf1, 0x5c, 0x59, 0xc3, 0x1e, 0x32, 0x00,
+	0x00,
 }
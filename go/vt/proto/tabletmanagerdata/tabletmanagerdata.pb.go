@@ -3821,6 +3821,113 @@ func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+type CheckThrottlerRequest struct {
+	// app is the name of the app that wants to write.
+	App                  string   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckThrottlerRequest) Reset()         { *m = CheckThrottlerRequest{} }
+func (m *CheckThrottlerRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckThrottlerRequest) ProtoMessage()    {}
+func (*CheckThrottlerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
+}
+
+func (m *CheckThrottlerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckThrottlerRequest.Unmarshal(m, b)
+}
+func (m *CheckThrottlerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckThrottlerRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckThrottlerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckThrottlerRequest.Merge(m, src)
+}
+func (m *CheckThrottlerRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckThrottlerRequest.Size(m)
+}
+func (m *CheckThrottlerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckThrottlerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckThrottlerRequest proto.InternalMessageInfo
+
+func (m *CheckThrottlerRequest) GetApp() string {
+	if m != nil {
+		return m.App
+	}
+	return ""
+}
+
+type CheckThrottlerResponse struct {
+	// status_code is 200 (OK) if the app may write now. It's also
+	// the HTTP status code of the same check on /throttler/check.
+	StatusCode int32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// lag is the replication lag that was observed, in seconds.
+	Lag float64 `protobuf:"fixed64,2,opt,name=lag,proto3" json:"lag,omitempty"`
+	// threshold is the lag above which writes are throttled, in seconds.
+	Threshold            float64  `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckThrottlerResponse) Reset()         { *m = CheckThrottlerResponse{} }
+func (m *CheckThrottlerResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckThrottlerResponse) ProtoMessage()    {}
+func (*CheckThrottlerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
+}
+
+func (m *CheckThrottlerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckThrottlerResponse.Unmarshal(m, b)
+}
+func (m *CheckThrottlerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckThrottlerResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckThrottlerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckThrottlerResponse.Merge(m, src)
+}
+func (m *CheckThrottlerResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckThrottlerResponse.Size(m)
+}
+func (m *CheckThrottlerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckThrottlerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckThrottlerResponse proto.InternalMessageInfo
+
+func (m *CheckThrottlerResponse) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *CheckThrottlerResponse) GetLag() float64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *CheckThrottlerResponse) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *CheckThrottlerResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -3921,142 +4028,149 @@ func init() {
 	proto.RegisterType((*BackupResponse)(nil), "tabletmanagerdata.BackupResponse")
 	proto.RegisterType((*RestoreFromBackupRequest)(nil), "tabletmanagerdata.RestoreFromBackupRequest")
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
+	proto.RegisterType((*CheckThrottlerRequest)(nil), "tabletmanagerdata.CheckThrottlerRequest")
+	proto.RegisterType((*CheckThrottlerResponse)(nil), "tabletmanagerdata.CheckThrottlerResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2108 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x06, 0x49, 0x49, 0xa6, 0x0e, 0x2f, 0x22, 0x97, 0x94, 0x48, 0xc9, 0x8d, 0x2e, 0x6b, 0xa7,
-	0x51, 0x5d, 0x94, 0x4a, 0x94, 0x34, 0x08, 0x52, 0xa4, 0xa8, 0xac, 0x8b, 0xed, 0x44, 0x89, 0x95,
-	0x95, 0x65, 0x17, 0x41, 0x81, 0xc5, 0x90, 0x3b, 0x22, 0x17, 0x5a, 0xee, 0xac, 0x67, 0x66, 0x29,
-	0xf1, 0x4f, 0xf4, 0x17, 0xf4, 0xad, 0x40, 0xfb, 0xde, 0xc7, 0xfe, 0x10, 0xf7, 0xa7, 0xf4, 0xa1,
-	0x0f, 0x2d, 0xe6, 0xb2, 0xe4, 0x2c, 0x49, 0xc9, 0x92, 0xe0, 0x02, 0x79, 0x11, 0x38, 0xdf, 0x39,
-	0x73, 0x6e, 0x73, 0x6e, 0x0b, 0x41, 0x83, 0xa3, 0x76, 0x80, 0x79, 0x1f, 0x85, 0xa8, 0x8b, 0xa9,
-	0x87, 0x38, 0x6a, 0x45, 0x94, 0x70, 0x62, 0x55, 0xa7, 0x08, 0x6b, 0x85, 0xb7, 0x31, 0xa6, 0x43,
-	0x45, 0x5f, 0x2b, 0x73, 0x12, 0x91, 0x31, 0xff, 0xda, 0x32, 0xc5, 0x51, 0xe0, 0x77, 0x10, 0xf7,
-	0x49, 0x68, 0xc0, 0xa5, 0x80, 0x74, 0x63, 0xee, 0x07, 0xea, 0x68, 0xff, 0x37, 0x03, 0x4b, 0xaf,
-	0x84, 0xe0, 0x03, 0x7c, 0xee, 0x87, 0xbe, 0x60, 0xb6, 0x2c, 0x98, 0x0b, 0x51, 0x1f, 0x37, 0x33,
-	0x9b, 0x99, 0xed, 0x45, 0x47, 0xfe, 0xb6, 0x56, 0x60, 0x81, 0x75, 0x7a, 0xb8, 0x8f, 0x9a, 0x59,
-	0x89, 0xea, 0x93, 0xd5, 0x84, 0x07, 0x1d, 0x12, 0xc4, 0xfd, 0x90, 0x35, 0x73, 0x9b, 0xb9, 0xed,
-	0x45, 0x27, 0x39, 0x5a, 0x2d, 0xa8, 0x45, 0xd4, 0xef, 0x23, 0x3a, 0x74, 0x2f, 0xf0, 0xd0, 0x4d,
-	0xb8, 0xe6, 0x24, 0x57, 0x55, 0x93, 0xbe, 0xc3, 0xc3, 0x7d, 0xcd, 0x6f, 0xc1, 0x1c, 0x1f, 0x46,
-	0xb8, 0x39, 0xaf, 0xb4, 0x8a, 0xdf, 0xd6, 0x06, 0x14, 0x84, 0xe9, 0x6e, 0x80, 0xc3, 0x2e, 0xef,
-	0x35, 0x17, 0x36, 0x33, 0xdb, 0x73, 0x0e, 0x08, 0xe8, 0x58, 0x22, 0xd6, 0x43, 0x58, 0xa4, 0xe4,
-	0xd2, 0xed, 0x90, 0x38, 0xe4, 0xcd, 0x07, 0x92, 0x9c, 0xa7, 0xe4, 0x72, 0x5f, 0x9c, 0xad, 0xc7,
-	0xb0, 0x70, 0xee, 0xe3, 0xc0, 0x63, 0xcd, 0xfc, 0x66, 0x6e, 0xbb, 0xb0, 0x5b, 0x6c, 0xa9, 0x78,
-	0x1d, 0x09, 0xd0, 0xd1, 0x34, 0xfb, 0x6f, 0x19, 0xa8, 0x9c, 0x4a, 0x67, 0x8c, 0x10, 0x7c, 0x02,
-	0x4b, 0x42, 0x4b, 0x1b, 0x31, 0xec, 0x6a, 0xbf, 0x55, 0x34, 0xca, 0x09, 0xac, 0xae, 0x58, 0x2f,
-	0x41, 0xbd, 0x8b, 0xeb, 0x8d, 0x2e, 0xb3, 0x66, 0x56, 0xaa, 0xb3, 0x5b, 0xd3, 0x4f, 0x39, 0x11,
-	0x6a, 0xa7, 0xc2, 0xd3, 0x00, 0x13, 0x01, 0x1d, 0x60, 0xca, 0x7c, 0x12, 0x36, 0x73, 0x52, 0x63,
-	0x72, 0x14, 0x86, 0x5a, 0x4a, 0xeb, 0x7e, 0x0f, 0x85, 0x5d, 0xec, 0x60, 0x16, 0x07, 0xdc, 0x7a,
-	0x0e, 0xa5, 0x36, 0x3e, 0x27, 0x34, 0x65, 0x68, 0x61, 0xf7, 0xd1, 0x0c, 0xed, 0x93, 0x6e, 0x3a,
-	0x45, 0x75, 0x53, 0xfb, 0x72, 0x04, 0x45, 0x74, 0xce, 0x31, 0x75, 0x8d, 0x97, 0xbe, 0xa5, 0xa0,
-	0x82, 0xbc, 0xa8, 0x60, 0xfb, 0xdf, 0x19, 0x28, 0x9f, 0x31, 0x4c, 0x4f, 0x30, 0xed, 0xfb, 0x8c,
-	0xe9, 0x94, 0xea, 0x11, 0xc6, 0x93, 0x94, 0x12, 0xbf, 0x05, 0x16, 0x33, 0x4c, 0x75, 0x42, 0xc9,
-	0xdf, 0xd6, 0xaf, 0xa1, 0x1a, 0x21, 0xc6, 0x2e, 0x09, 0xf5, 0xdc, 0x4e, 0x0f, 0x77, 0x2e, 0x58,
-	0xdc, 0x97, 0x71, 0x98, 0x73, 0x2a, 0x09, 0x61, 0x5f, 0xe3, 0xd6, 0x8f, 0x00, 0x11, 0xf5, 0x07,
-	0x7e, 0x80, 0xbb, 0x58, 0x25, 0x56, 0x61, 0xf7, 0xb3, 0x19, 0xd6, 0xa6, 0x6d, 0x69, 0x9d, 0x8c,
-	0xee, 0x1c, 0x86, 0x9c, 0x0e, 0x1d, 0x43, 0xc8, 0xda, 0x37, 0xb0, 0x34, 0x41, 0xb6, 0x2a, 0x90,
-	0xbb, 0xc0, 0x43, 0x6d, 0xb9, 0xf8, 0x69, 0xd5, 0x61, 0x7e, 0x80, 0x82, 0x18, 0x6b, 0xcb, 0xd5,
-	0xe1, 0xeb, 0xec, 0x57, 0x19, 0xfb, 0x5d, 0x06, 0x8a, 0x07, 0xed, 0xf7, 0xf8, 0x5d, 0x86, 0xac,
-	0xd7, 0xd6, 0x77, 0xb3, 0x5e, 0x7b, 0x14, 0x87, 0x9c, 0x11, 0x87, 0x97, 0x33, 0x5c, 0xdb, 0x99,
-	0xe1, 0x9a, 0xa9, 0xec, 0xff, 0xe9, 0xd8, 0x5f, 0x33, 0x50, 0x18, 0x6b, 0x62, 0xd6, 0x31, 0x54,
-	0x84, 0x9d, 0x6e, 0x34, 0xc6, 0x9a, 0x19, 0x69, 0xe5, 0xd6, 0x7b, 0x1f, 0xc0, 0x59, 0x8a, 0x53,
-	0x67, 0x66, 0x1d, 0x41, 0xd9, 0x6b, 0xa7, 0x64, 0xa9, 0x0a, 0xda, 0x78, 0x8f, 0xc7, 0x4e, 0xc9,
-	0x33, 0x4e, 0xcc, 0xfe, 0x04, 0x0a, 0x27, 0x7e, 0xd8, 0x75, 0xf0, 0xdb, 0x18, 0x33, 0x2e, 0x4a,
-	0x29, 0x42, 0xc3, 0x80, 0x20, 0x4f, 0x3b, 0x99, 0x1c, 0xed, 0x6d, 0x28, 0x2a, 0x46, 0x16, 0x91,
-	0x90, 0xe1, 0x1b, 0x38, 0x9f, 0x40, 0xf1, 0x34, 0xc0, 0x38, 0x4a, 0x64, 0xae, 0x41, 0xde, 0x8b,
-	0xa9, 0x6c, 0xaa, 0x92, 0x35, 0xe7, 0x8c, 0xce, 0xf6, 0x12, 0x94, 0x34, 0xaf, 0x12, 0x6b, 0xff,
-	0x2b, 0x03, 0xd6, 0xe1, 0x15, 0xee, 0xc4, 0x1c, 0x3f, 0x27, 0xe4, 0x22, 0x91, 0x31, 0xab, 0xbf,
-	0xae, 0x03, 0x44, 0x88, 0xa2, 0x3e, 0xe6, 0x98, 0x2a, 0xf7, 0x17, 0x1d, 0x03, 0xb1, 0x4e, 0x60,
-	0x11, 0x5f, 0x71, 0x8a, 0x5c, 0x1c, 0x0e, 0x64, 0xa7, 0x2d, 0xec, 0x7e, 0x3e, 0x23, 0x3a, 0xd3,
-	0xda, 0x5a, 0x87, 0xe2, 0xda, 0x61, 0x38, 0x50, 0x39, 0x91, 0xc7, 0xfa, 0xb8, 0xf6, 0x3b, 0x28,
-	0xa5, 0x48, 0x77, 0xca, 0x87, 0x73, 0xa8, 0xa5, 0x54, 0xe9, 0x38, 0x6e, 0x40, 0x01, 0x5f, 0xf9,
-	0xdc, 0x65, 0x1c, 0xf1, 0x98, 0xe9, 0x00, 0x81, 0x80, 0x4e, 0x25, 0x22, 0xc7, 0x08, 0xf7, 0x48,
-	0xcc, 0x47, 0x63, 0x44, 0x9e, 0x34, 0x8e, 0x69, 0x52, 0x05, 0xfa, 0x64, 0x0f, 0xa0, 0xf2, 0x0c,
-	0x73, 0xd5, 0x57, 0x92, 0xf0, 0xad, 0xc0, 0x82, 0x74, 0x5c, 0x65, 0xdc, 0xa2, 0xa3, 0x4f, 0xd6,
-	0x23, 0x28, 0xf9, 0x61, 0x27, 0x88, 0x3d, 0xec, 0x0e, 0x7c, 0x7c, 0xc9, 0xa4, 0x8a, 0xbc, 0x53,
-	0xd4, 0xe0, 0x6b, 0x81, 0x59, 0x1f, 0x43, 0x19, 0x5f, 0x29, 0x26, 0x2d, 0x44, 0x8d, 0xad, 0x92,
-	0x46, 0x65, 0x83, 0x66, 0x36, 0x86, 0xaa, 0xa1, 0x57, 0x7b, 0x77, 0x02, 0x55, 0xd5, 0x19, 0x8d,
-	0x66, 0x7f, 0x97, 0x6e, 0x5b, 0x61, 0x13, 0x88, 0xdd, 0x80, 0xe5, 0x67, 0x98, 0x1b, 0x29, 0xac,
-	0x7d, 0xb4, 0x7f, 0x82, 0x95, 0x49, 0x82, 0x36, 0xe2, 0x0f, 0x50, 0x48, 0x17, 0x9d, 0x50, 0xbf,
-	0x3e, 0x43, 0xbd, 0x79, 0xd9, 0xbc, 0x62, 0xd7, 0xc1, 0x3a, 0xc5, 0xdc, 0xc1, 0xc8, 0x7b, 0x19,
-	0x06, 0xc3, 0x44, 0xe3, 0x32, 0xd4, 0x52, 0xa8, 0x4e, 0xe1, 0x31, 0xfc, 0x86, 0xfa, 0x1c, 0x27,
-	0xdc, 0x2b, 0x50, 0x4f, 0xc3, 0x9a, 0xfd, 0x5b, 0xa8, 0xaa, 0xe1, 0xf4, 0x6a, 0x18, 0x25, 0xcc,
-	0xd6, 0x6f, 0xa1, 0xa0, 0xcc, 0x73, 0xe5, 0x80, 0x17, 0x26, 0x97, 0x77, 0xeb, 0xad, 0xd1, 0xbe,
-	0x22, 0x63, 0xce, 0xe5, 0x0d, 0xe0, 0xa3, 0xdf, 0xc2, 0x4e, 0x53, 0xd6, 0xd8, 0x20, 0x07, 0x9f,
-	0x53, 0xcc, 0x7a, 0x22, 0xa5, 0x4c, 0x83, 0xd2, 0xb0, 0x66, 0x6f, 0xc0, 0xb2, 0x13, 0x87, 0xcf,
-	0x31, 0x0a, 0x78, 0x4f, 0x0e, 0x8e, 0xe4, 0x42, 0x13, 0x56, 0x26, 0x09, 0xfa, 0xca, 0x17, 0xd0,
-	0x7c, 0xd1, 0x0d, 0x09, 0xc5, 0x8a, 0x78, 0x48, 0x29, 0xa1, 0xa9, 0x96, 0xc2, 0x39, 0xa6, 0xe1,
-	0xb8, 0x51, 0xc8, 0xa3, 0xfd, 0x10, 0x56, 0x67, 0xdc, 0xd2, 0x22, 0xbf, 0x16, 0x46, 0x8b, 0x7e,
-	0x92, 0xce, 0xe4, 0x47, 0x50, 0xba, 0x44, 0x3e, 0x77, 0x23, 0xc2, 0xc6, 0xc9, 0xb4, 0xe8, 0x14,
-	0x05, 0x78, 0xa2, 0x31, 0xe5, 0x99, 0x79, 0x57, 0xcb, 0xdc, 0x85, 0x95, 0x13, 0x8a, 0xcf, 0x03,
-	0xbf, 0xdb, 0x9b, 0x28, 0x10, 0xb1, 0x93, 0xc9, 0xc0, 0x25, 0x15, 0x92, 0x1c, 0xed, 0x2e, 0x34,
-	0xa6, 0xee, 0xe8, 0xbc, 0x3a, 0x86, 0xb2, 0xe2, 0x72, 0xa9, 0xdc, 0x2b, 0x92, 0x7e, 0xfe, 0xf1,
-	0xb5, 0x99, 0x6d, 0x6e, 0x21, 0x4e, 0xa9, 0x63, 0x9c, 0x98, 0xfd, 0x9f, 0x0c, 0x58, 0x7b, 0x51,
-	0x14, 0x0c, 0xd3, 0x96, 0x55, 0x20, 0xc7, 0xde, 0x06, 0x49, 0x8b, 0x61, 0x6f, 0x03, 0xd1, 0x62,
-	0xce, 0x09, 0xed, 0x60, 0x5d, 0xac, 0xea, 0x20, 0xd6, 0x00, 0x14, 0x04, 0xe4, 0xd2, 0x35, 0x76,
-	0x58, 0xd9, 0x19, 0xf2, 0x4e, 0x45, 0x12, 0x9c, 0x31, 0x3e, 0xbd, 0x00, 0xcd, 0x7d, 0xa8, 0x05,
-	0x68, 0xfe, 0x9e, 0x0b, 0xd0, 0xdf, 0x33, 0x50, 0x4b, 0x79, 0xaf, 0x63, 0xfc, 0xf3, 0x5b, 0xd5,
-	0x6a, 0x50, 0x3d, 0x26, 0x9d, 0x0b, 0xd5, 0xf5, 0x92, 0xd2, 0xa8, 0x83, 0x65, 0x82, 0xe3, 0xc2,
-	0x3b, 0x0b, 0x83, 0x29, 0xe6, 0x15, 0xa8, 0xa7, 0x61, 0xcd, 0xfe, 0x8f, 0x0c, 0x34, 0xf5, 0x88,
-	0x38, 0xc2, 0xbc, 0xd3, 0xdb, 0x63, 0x07, 0xed, 0x51, 0x1e, 0xd4, 0x61, 0x5e, 0xae, 0xe2, 0x32,
-	0x00, 0x45, 0x47, 0x1d, 0xac, 0x06, 0x3c, 0xf0, 0xda, 0xae, 0x1c, 0x8d, 0x7a, 0x3a, 0x78, 0xed,
-	0x1f, 0xc4, 0x70, 0x5c, 0x85, 0x7c, 0x1f, 0x5d, 0xb9, 0x94, 0x5c, 0x32, 0xbd, 0x0c, 0x3e, 0xe8,
-	0xa3, 0x2b, 0x87, 0x5c, 0x32, 0xb9, 0xa8, 0xfb, 0x4c, 0x6e, 0xe0, 0x6d, 0x3f, 0x0c, 0x48, 0x97,
-	0xc9, 0xe7, 0xcf, 0x3b, 0x65, 0x0d, 0x3f, 0x55, 0xa8, 0xa8, 0x35, 0x2a, 0xcb, 0xc8, 0x7c, 0xdc,
-	0xbc, 0x53, 0xa4, 0x46, 0x6d, 0xd9, 0xcf, 0x60, 0x75, 0x86, 0xcd, 0xfa, 0xf5, 0x9e, 0xc0, 0x82,
-	0x2a, 0x0d, 0xfd, 0x6c, 0x96, 0xfe, 0x9c, 0xf8, 0x51, 0xfc, 0xd5, 0x65, 0xa0, 0x39, 0xec, 0x3f,
-	0x67, 0xe0, 0xa3, 0xb4, 0xa4, 0xbd, 0x20, 0x10, 0x0b, 0x18, 0xfb, 0xf0, 0x21, 0x98, 0xf2, 0x6c,
-	0x6e, 0x86, 0x67, 0xc7, 0xb0, 0x7e, 0x9d, 0x3d, 0xf7, 0x70, 0xef, 0xbb, 0xc9, 0xb7, 0xdd, 0x8b,
-	0xa2, 0x9b, 0x1d, 0x33, 0xed, 0xcf, 0xa6, 0xec, 0x9f, 0x0e, 0xba, 0x14, 0x76, 0x0f, 0xab, 0xc4,
-	0x60, 0x0b, 0xd0, 0x00, 0xab, 0x5d, 0x23, 0x49, 0xd0, 0x23, 0xa8, 0xa5, 0x50, 0x2d, 0x78, 0x47,
-	0x6c, 0x1c, 0xa3, 0x2d, 0xa5, 0xb0, 0xdb, 0x68, 0x4d, 0x7e, 0x2f, 0xeb, 0x0b, 0x9a, 0x4d, 0x4c,
-	0x92, 0xef, 0x11, 0xe3, 0x98, 0x26, 0x9d, 0x39, 0x51, 0xf0, 0x05, 0xac, 0x4c, 0x12, 0xb4, 0x8e,
-	0x35, 0xc8, 0x4f, 0xb4, 0xf6, 0xd1, 0x59, 0xdc, 0x7a, 0x83, 0x7c, 0x7e, 0x44, 0x26, 0xe5, 0xdd,
-	0x78, 0x6b, 0x15, 0x1a, 0x53, 0xb7, 0x74, 0xc1, 0x59, 0x50, 0x39, 0xe5, 0x24, 0x92, 0xbe, 0x26,
-	0xa6, 0xd5, 0xa0, 0x6a, 0x60, 0x9a, 0xf1, 0x8f, 0xd0, 0x18, 0x81, 0xdf, 0xfb, 0xa1, 0xdf, 0x8f,
-	0xfb, 0xb7, 0x50, 0x6d, 0x6d, 0x81, 0x9c, 0x4b, 0x2e, 0xf7, 0xfb, 0x38, 0x59, 0xe0, 0x72, 0x4e,
-	0x41, 0x60, 0xaf, 0x14, 0x64, 0x7f, 0x09, 0xcd, 0x69, 0xc9, 0xb7, 0x88, 0x85, 0x34, 0x13, 0x51,
-	0x9e, 0xb2, 0x5d, 0xbc, 0xa6, 0x01, 0x6a, 0xe3, 0xff, 0x04, 0x0f, 0xc7, 0xe8, 0x59, 0xc8, 0xfd,
-	0x60, 0x4f, 0xb4, 0xb3, 0x0f, 0xe4, 0xc0, 0x3a, 0xfc, 0x62, 0xb6, 0x74, 0xad, 0xfd, 0x00, 0xb6,
-	0xd4, 0xb2, 0x72, 0x78, 0x25, 0x86, 0x3e, 0x0a, 0xc4, 0xa6, 0x14, 0x21, 0x8a, 0x43, 0x8e, 0xbd,
-	0xc4, 0x06, 0xb9, 0x04, 0x2b, 0xb2, 0xeb, 0x27, 0x1f, 0x14, 0x90, 0x40, 0x2f, 0x3c, 0xfb, 0x31,
-	0xd8, 0x37, 0x49, 0xd1, 0xba, 0x36, 0x61, 0x7d, 0x92, 0xeb, 0x30, 0xc0, 0x9d, 0xb1, 0x22, 0x7b,
-	0x0b, 0x36, 0xae, 0xe5, 0x18, 0x27, 0x85, 0xd8, 0x63, 0x85, 0x3b, 0xa3, 0x82, 0xf8, 0x95, 0xda,
-	0x6d, 0x35, 0xa6, 0x9f, 0xa7, 0x0e, 0xf3, 0xc8, 0xf3, 0x68, 0xb2, 0x31, 0xa8, 0x83, 0x48, 0x37,
-	0x07, 0x33, 0xb1, 0xe8, 0x8d, 0x4a, 0x23, 0x91, 0xb2, 0x06, 0xcd, 0x69, 0x92, 0xd6, 0xba, 0x03,
-	0x8d, 0xd7, 0x06, 0x2e, 0xaa, 0x7b, 0x66, 0x77, 0x58, 0xd4, 0xdd, 0xc1, 0x3e, 0x82, 0xe6, 0xf4,
-	0x85, 0x7b, 0xf5, 0xa5, 0x8f, 0x4c, 0x39, 0xe3, 0x52, 0x49, 0xd4, 0x97, 0x21, 0xab, 0x9f, 0x24,
-	0xe7, 0x64, 0x7d, 0x2f, 0x95, 0x2f, 0xd9, 0x89, 0xac, 0xdc, 0x84, 0xf5, 0xeb, 0x84, 0x69, 0x3f,
-	0x6b, 0x50, 0x7d, 0x11, 0xfa, 0x5c, 0x55, 0x7f, 0x12, 0x98, 0x4f, 0xc1, 0x32, 0xc1, 0x5b, 0xa4,
-	0xff, 0xbb, 0x0c, 0xac, 0x9f, 0x90, 0x28, 0x0e, 0xe4, 0xe2, 0xaa, 0x12, 0xe1, 0x5b, 0x12, 0x8b,
-	0x17, 0x4d, 0xec, 0xfe, 0x25, 0x2c, 0x89, 0xb4, 0x75, 0x3b, 0x14, 0x23, 0x8e, 0x3d, 0x37, 0x4c,
-	0x3e, 0xae, 0x4a, 0x02, 0xde, 0x57, 0xe8, 0x0f, 0x4c, 0xe4, 0x1e, 0xea, 0x08, 0xa1, 0xe6, 0x0c,
-	0x01, 0x05, 0xc9, 0x39, 0xf2, 0x15, 0x14, 0xfb, 0xd2, 0x32, 0x17, 0x05, 0x3e, 0x52, 0xb3, 0xa4,
-	0xb0, 0xbb, 0x3c, 0xb9, 0x8c, 0xef, 0x09, 0xa2, 0x53, 0x50, 0xac, 0xf2, 0x60, 0x7d, 0x06, 0x75,
-	0xa3, 0x43, 0x8e, 0x77, 0xd6, 0x39, 0xa9, 0xa3, 0x66, 0xd0, 0x46, 0xab, 0xeb, 0x16, 0x6c, 0x5c,
-	0xeb, 0x97, 0x0e, 0xe1, 0x5f, 0x32, 0x50, 0x11, 0xe1, 0x32, 0x4b, 0xdf, 0xfa, 0x0d, 0x2c, 0x28,
-	0x6e, 0xfd, 0xe4, 0xd7, 0x98, 0xa7, 0x99, 0xae, 0xb5, 0x2c, 0x7b, 0xad, 0x65, 0xb3, 0xe2, 0x99,
-	0x9b, 0x11, 0xcf, 0xe4, 0x85, 0xd3, 0x3d, 0x68, 0x19, 0x6a, 0x07, 0xb8, 0x4f, 0x38, 0x4e, 0x3f,
-	0xfc, 0x2e, 0xd4, 0xd3, 0xf0, 0x2d, 0x9e, 0x7e, 0x15, 0x1a, 0x67, 0xa1, 0x47, 0x66, 0x89, 0x5b,
-	0x83, 0xe6, 0x34, 0x49, 0x5b, 0xf0, 0x0d, 0x6c, 0x9c, 0x50, 0x22, 0x08, 0xd2, 0xb2, 0x37, 0x3d,
-	0x1c, 0xee, 0xa3, 0xb8, 0xdb, 0xe3, 0x67, 0xd1, 0x6d, 0xa6, 0xc8, 0xef, 0x61, 0xf3, 0xfa, 0xeb,
-	0xb7, 0xb3, 0x5a, 0x5d, 0x44, 0x4c, 0xcb, 0xf1, 0x0c, 0xab, 0xa7, 0x49, 0xda, 0xea, 0x7f, 0x66,
-	0xa0, 0x72, 0x8a, 0xd3, 0xe5, 0x72, 0xd7, 0xb7, 0x9e, 0xf1, 0x70, 0xd9, 0x59, 0x85, 0x30, 0xf5,
-	0x69, 0x35, 0x37, 0xfd, 0x69, 0x65, 0x3d, 0x81, 0xaa, 0xfc, 0xde, 0x70, 0x99, 0x68, 0xfa, 0x2e,
-	0x13, 0x86, 0xeb, 0xcf, 0x8c, 0x25, 0x49, 0x18, 0x0f, 0x03, 0x39, 0xa3, 0xf0, 0x44, 0x55, 0xdb,
-	0x2f, 0xc6, 0xde, 0x3a, 0x58, 0x0a, 0x19, 0x8f, 0x81, 0xbb, 0x39, 0x26, 0xbe, 0x1f, 0x67, 0x88,
-	0xd2, 0x7a, 0x1e, 0x83, 0x2d, 0x06, 0xab, 0xd1, 0x8d, 0xf6, 0x42, 0x4f, 0x34, 0xf1, 0xd4, 0xa6,
-	0xf3, 0x1a, 0x1e, 0xdd, 0xc8, 0x75, 0xdf, 0xcd, 0x67, 0x19, 0x6a, 0x66, 0xba, 0x18, 0xf9, 0x9e,
-	0x86, 0x6f, 0x91, 0x39, 0xa7, 0x50, 0x7a, 0x8a, 0x3a, 0x17, 0xf1, 0x28, 0x4d, 0x37, 0xa1, 0xd0,
-	0x21, 0x61, 0x27, 0xa6, 0x14, 0x87, 0x9d, 0xa1, 0x6e, 0x6a, 0x26, 0x24, 0x38, 0xe4, 0x27, 0x9f,
-	0x0a, 0xbd, 0xfe, 0x4e, 0x34, 0x21, 0xfb, 0x4b, 0x28, 0x27, 0x42, 0xb5, 0x09, 0x8f, 0x61, 0x1e,
-	0x0f, 0xc6, 0xa1, 0x2f, 0xb7, 0x92, 0x7f, 0x7a, 0x1c, 0x0a, 0xd4, 0x51, 0x44, 0x3d, 0xc2, 0x38,
-	0xa1, 0xf8, 0x88, 0x92, 0x7e, 0xca, 0x2e, 0x7b, 0x0f, 0x56, 0x67, 0xd0, 0xee, 0x22, 0xfe, 0xe9,
-	0xa7, 0x3f, 0xb5, 0x06, 0x3e, 0xc7, 0x8c, 0xb5, 0x7c, 0xb2, 0xa3, 0x7e, 0xed, 0x74, 0xc9, 0xce,
-	0x80, 0xef, 0xc8, 0x7f, 0xbd, 0xec, 0x4c, 0x7d, 0xab, 0xb5, 0x17, 0x24, 0xe1, 0xf3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x80, 0x1f, 0x06, 0x04, 0x1a, 0x00, 0x00,
+	// 2186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0x23, 0xc7,
+	0x11, 0xc6, 0x90, 0x92, 0x56, 0x2a, 0x3e, 0x44, 0x0e, 0xf5, 0xa0, 0x64, 0x5b, 0xd2, 0xce, 0xae,
+	0xe3, 0xb5, 0x83, 0x50, 0xb6, 0xec, 0x18, 0x86, 0x03, 0x07, 0xd1, 0xea, 0xb1, 0xbb, 0xf6, 0xda,
+	0x2b, 0x8f, 0xf6, 0x11, 0x18, 0x01, 0x06, 0x4d, 0x4e, 0x89, 0x1c, 0x68, 0x38, 0x3d, 0xdb, 0xdd,
+	0x43, 0x89, 0xe7, 0xdc, 0xf3, 0x0b, 0x72, 0x0b, 0x90, 0xdc, 0x73, 0xcc, 0x0f, 0x71, 0x7e, 0x4a,
+	0x0e, 0x39, 0x24, 0xe8, 0xc7, 0x90, 0x33, 0x24, 0xa5, 0xd5, 0x0a, 0x8b, 0x20, 0x17, 0x61, 0xfa,
+	0xab, 0xea, 0x7a, 0x75, 0x55, 0x75, 0x35, 0x05, 0xeb, 0x82, 0xb4, 0x43, 0x14, 0x7d, 0x12, 0x91,
+	0x2e, 0x32, 0x9f, 0x08, 0xd2, 0x8a, 0x19, 0x15, 0xd4, 0xae, 0x4f, 0x11, 0x36, 0x4b, 0xaf, 0x13,
+	0x64, 0x43, 0x4d, 0xdf, 0xac, 0x0a, 0x1a, 0xd3, 0x31, 0xff, 0xe6, 0x2a, 0xc3, 0x38, 0x0c, 0x3a,
+	0x44, 0x04, 0x34, 0xca, 0xc0, 0x95, 0x90, 0x76, 0x13, 0x11, 0x84, 0x7a, 0xe9, 0xfc, 0xc7, 0x82,
+	0xe5, 0xe7, 0x52, 0xf0, 0x21, 0x9e, 0x05, 0x51, 0x20, 0x99, 0x6d, 0x1b, 0xe6, 0x22, 0xd2, 0xc7,
+	0xa6, 0xb5, 0x63, 0x3d, 0x58, 0x72, 0xd5, 0xb7, 0xbd, 0x06, 0x0b, 0xbc, 0xd3, 0xc3, 0x3e, 0x69,
+	0x16, 0x14, 0x6a, 0x56, 0x76, 0x13, 0xee, 0x74, 0x68, 0x98, 0xf4, 0x23, 0xde, 0x2c, 0xee, 0x14,
+	0x1f, 0x2c, 0xb9, 0xe9, 0xd2, 0x6e, 0x41, 0x23, 0x66, 0x41, 0x9f, 0xb0, 0xa1, 0x77, 0x8e, 0x43,
+	0x2f, 0xe5, 0x9a, 0x53, 0x5c, 0x75, 0x43, 0xfa, 0x0e, 0x87, 0x07, 0x86, 0xdf, 0x86, 0x39, 0x31,
+	0x8c, 0xb1, 0x39, 0xaf, 0xb5, 0xca, 0x6f, 0x7b, 0x1b, 0x4a, 0xd2, 0x74, 0x2f, 0xc4, 0xa8, 0x2b,
+	0x7a, 0xcd, 0x85, 0x1d, 0xeb, 0xc1, 0x9c, 0x0b, 0x12, 0x7a, 0xaa, 0x10, 0xfb, 0x3d, 0x58, 0x62,
+	0xf4, 0xc2, 0xeb, 0xd0, 0x24, 0x12, 0xcd, 0x3b, 0x8a, 0xbc, 0xc8, 0xe8, 0xc5, 0x81, 0x5c, 0xdb,
+	0xf7, 0x61, 0xe1, 0x2c, 0xc0, 0xd0, 0xe7, 0xcd, 0xc5, 0x9d, 0xe2, 0x83, 0xd2, 0x5e, 0xb9, 0xa5,
+	0xe3, 0x75, 0x2c, 0x41, 0xd7, 0xd0, 0x9c, 0xbf, 0x5a, 0x50, 0x3b, 0x55, 0xce, 0x64, 0x42, 0xf0,
+	0x11, 0x2c, 0x4b, 0x2d, 0x6d, 0xc2, 0xd1, 0x33, 0x7e, 0xeb, 0x68, 0x54, 0x53, 0x58, 0x6f, 0xb1,
+	0x9f, 0x81, 0x3e, 0x17, 0xcf, 0x1f, 0x6d, 0xe6, 0xcd, 0x82, 0x52, 0xe7, 0xb4, 0xa6, 0x8f, 0x72,
+	0x22, 0xd4, 0x6e, 0x4d, 0xe4, 0x01, 0x2e, 0x03, 0x3a, 0x40, 0xc6, 0x03, 0x1a, 0x35, 0x8b, 0x4a,
+	0x63, 0xba, 0x94, 0x86, 0xda, 0x5a, 0xeb, 0x41, 0x8f, 0x44, 0x5d, 0x74, 0x91, 0x27, 0xa1, 0xb0,
+	0x1f, 0x43, 0xa5, 0x8d, 0x67, 0x94, 0xe5, 0x0c, 0x2d, 0xed, 0xdd, 0x9b, 0xa1, 0x7d, 0xd2, 0x4d,
+	0xb7, 0xac, 0x77, 0x1a, 0x5f, 0x8e, 0xa1, 0x4c, 0xce, 0x04, 0x32, 0x2f, 0x73, 0xd2, 0x37, 0x14,
+	0x54, 0x52, 0x1b, 0x35, 0xec, 0xfc, 0xcb, 0x82, 0xea, 0x0b, 0x8e, 0xec, 0x04, 0x59, 0x3f, 0xe0,
+	0xdc, 0xa4, 0x54, 0x8f, 0x72, 0x91, 0xa6, 0x94, 0xfc, 0x96, 0x58, 0xc2, 0x91, 0x99, 0x84, 0x52,
+	0xdf, 0xf6, 0x2f, 0xa1, 0x1e, 0x13, 0xce, 0x2f, 0x28, 0xf3, 0xbd, 0x4e, 0x0f, 0x3b, 0xe7, 0x3c,
+	0xe9, 0xab, 0x38, 0xcc, 0xb9, 0xb5, 0x94, 0x70, 0x60, 0x70, 0xfb, 0x47, 0x80, 0x98, 0x05, 0x83,
+	0x20, 0xc4, 0x2e, 0xea, 0xc4, 0x2a, 0xed, 0x7d, 0x36, 0xc3, 0xda, 0xbc, 0x2d, 0xad, 0x93, 0xd1,
+	0x9e, 0xa3, 0x48, 0xb0, 0xa1, 0x9b, 0x11, 0xb2, 0xf9, 0x0d, 0x2c, 0x4f, 0x90, 0xed, 0x1a, 0x14,
+	0xcf, 0x71, 0x68, 0x2c, 0x97, 0x9f, 0xf6, 0x0a, 0xcc, 0x0f, 0x48, 0x98, 0xa0, 0xb1, 0x5c, 0x2f,
+	0xbe, 0x2e, 0x7c, 0x65, 0x39, 0x3f, 0x5b, 0x50, 0x3e, 0x6c, 0xbf, 0xc1, 0xef, 0x2a, 0x14, 0xfc,
+	0xb6, 0xd9, 0x5b, 0xf0, 0xdb, 0xa3, 0x38, 0x14, 0x33, 0x71, 0x78, 0x36, 0xc3, 0xb5, 0xdd, 0x19,
+	0xae, 0x1d, 0xb6, 0xff, 0x37, 0x8e, 0xfd, 0xc5, 0x82, 0xd2, 0x58, 0x13, 0xb7, 0x9f, 0x42, 0x4d,
+	0xda, 0xe9, 0xc5, 0x63, 0xac, 0x69, 0x29, 0x2b, 0xef, 0xbe, 0xf1, 0x00, 0xdc, 0xe5, 0x24, 0xb7,
+	0xe6, 0xf6, 0x31, 0x54, 0xfd, 0x76, 0x4e, 0x96, 0xae, 0xa0, 0xed, 0x37, 0x78, 0xec, 0x56, 0xfc,
+	0xcc, 0x8a, 0x3b, 0x1f, 0x41, 0xe9, 0x24, 0x88, 0xba, 0x2e, 0xbe, 0x4e, 0x90, 0x0b, 0x59, 0x4a,
+	0x31, 0x19, 0x86, 0x94, 0xf8, 0xc6, 0xc9, 0x74, 0xe9, 0x3c, 0x80, 0xb2, 0x66, 0xe4, 0x31, 0x8d,
+	0x38, 0x5e, 0xc3, 0xf9, 0x09, 0x94, 0x4f, 0x43, 0xc4, 0x38, 0x95, 0xb9, 0x09, 0x8b, 0x7e, 0xc2,
+	0x54, 0x53, 0x55, 0xac, 0x45, 0x77, 0xb4, 0x76, 0x96, 0xa1, 0x62, 0x78, 0xb5, 0x58, 0xe7, 0x9f,
+	0x16, 0xd8, 0x47, 0x97, 0xd8, 0x49, 0x04, 0x3e, 0xa6, 0xf4, 0x3c, 0x95, 0x31, 0xab, 0xbf, 0x6e,
+	0x01, 0xc4, 0x84, 0x91, 0x3e, 0x0a, 0x64, 0xda, 0xfd, 0x25, 0x37, 0x83, 0xd8, 0x27, 0xb0, 0x84,
+	0x97, 0x82, 0x11, 0x0f, 0xa3, 0x81, 0xea, 0xb4, 0xa5, 0xbd, 0xcf, 0x67, 0x44, 0x67, 0x5a, 0x5b,
+	0xeb, 0x48, 0x6e, 0x3b, 0x8a, 0x06, 0x3a, 0x27, 0x16, 0xd1, 0x2c, 0x37, 0x7f, 0x03, 0x95, 0x1c,
+	0xe9, 0xad, 0xf2, 0xe1, 0x0c, 0x1a, 0x39, 0x55, 0x26, 0x8e, 0xdb, 0x50, 0xc2, 0xcb, 0x40, 0x78,
+	0x5c, 0x10, 0x91, 0x70, 0x13, 0x20, 0x90, 0xd0, 0xa9, 0x42, 0xd4, 0x35, 0x22, 0x7c, 0x9a, 0x88,
+	0xd1, 0x35, 0xa2, 0x56, 0x06, 0x47, 0x96, 0x56, 0x81, 0x59, 0x39, 0x03, 0xa8, 0x3d, 0x42, 0xa1,
+	0xfb, 0x4a, 0x1a, 0xbe, 0x35, 0x58, 0x50, 0x8e, 0xeb, 0x8c, 0x5b, 0x72, 0xcd, 0xca, 0xbe, 0x07,
+	0x95, 0x20, 0xea, 0x84, 0x89, 0x8f, 0xde, 0x20, 0xc0, 0x0b, 0xae, 0x54, 0x2c, 0xba, 0x65, 0x03,
+	0xbe, 0x94, 0x98, 0xfd, 0x21, 0x54, 0xf1, 0x52, 0x33, 0x19, 0x21, 0xfa, 0xda, 0xaa, 0x18, 0x54,
+	0x35, 0x68, 0xee, 0x20, 0xd4, 0x33, 0x7a, 0x8d, 0x77, 0x27, 0x50, 0xd7, 0x9d, 0x31, 0xd3, 0xec,
+	0xdf, 0xa6, 0xdb, 0xd6, 0xf8, 0x04, 0xe2, 0xac, 0xc3, 0xea, 0x23, 0x14, 0x99, 0x14, 0x36, 0x3e,
+	0x3a, 0x3f, 0xc1, 0xda, 0x24, 0xc1, 0x18, 0xf1, 0x3b, 0x28, 0xe5, 0x8b, 0x4e, 0xaa, 0xdf, 0x9a,
+	0xa1, 0x3e, 0xbb, 0x39, 0xbb, 0xc5, 0x59, 0x01, 0xfb, 0x14, 0x85, 0x8b, 0xc4, 0x7f, 0x16, 0x85,
+	0xc3, 0x54, 0xe3, 0x2a, 0x34, 0x72, 0xa8, 0x49, 0xe1, 0x31, 0xfc, 0x8a, 0x05, 0x02, 0x53, 0xee,
+	0x35, 0x58, 0xc9, 0xc3, 0x86, 0xfd, 0x5b, 0xa8, 0xeb, 0xcb, 0xe9, 0xf9, 0x30, 0x4e, 0x99, 0xed,
+	0x5f, 0x43, 0x49, 0x9b, 0xe7, 0xa9, 0x0b, 0x5e, 0x9a, 0x5c, 0xdd, 0x5b, 0x69, 0x8d, 0xe6, 0x15,
+	0x15, 0x73, 0xa1, 0x76, 0x80, 0x18, 0x7d, 0x4b, 0x3b, 0xb3, 0xb2, 0xc6, 0x06, 0xb9, 0x78, 0xc6,
+	0x90, 0xf7, 0x64, 0x4a, 0x65, 0x0d, 0xca, 0xc3, 0x86, 0x7d, 0x1d, 0x56, 0xdd, 0x24, 0x7a, 0x8c,
+	0x24, 0x14, 0x3d, 0x75, 0x71, 0xa4, 0x1b, 0x9a, 0xb0, 0x36, 0x49, 0x30, 0x5b, 0xbe, 0x80, 0xe6,
+	0x93, 0x6e, 0x44, 0x19, 0x6a, 0xe2, 0x11, 0x63, 0x94, 0xe5, 0x5a, 0x8a, 0x10, 0xc8, 0xa2, 0x71,
+	0xa3, 0x50, 0x4b, 0xe7, 0x3d, 0xd8, 0x98, 0xb1, 0xcb, 0x88, 0xfc, 0x5a, 0x1a, 0x2d, 0xfb, 0x49,
+	0x3e, 0x93, 0xef, 0x41, 0xe5, 0x82, 0x04, 0xc2, 0x8b, 0x29, 0x1f, 0x27, 0xd3, 0x92, 0x5b, 0x96,
+	0xe0, 0x89, 0xc1, 0xb4, 0x67, 0xd9, 0xbd, 0x46, 0xe6, 0x1e, 0xac, 0x9d, 0x30, 0x3c, 0x0b, 0x83,
+	0x6e, 0x6f, 0xa2, 0x40, 0xe4, 0x4c, 0xa6, 0x02, 0x97, 0x56, 0x48, 0xba, 0x74, 0xba, 0xb0, 0x3e,
+	0xb5, 0xc7, 0xe4, 0xd5, 0x53, 0xa8, 0x6a, 0x2e, 0x8f, 0xa9, 0xb9, 0x22, 0xed, 0xe7, 0x1f, 0x5e,
+	0x99, 0xd9, 0xd9, 0x29, 0xc4, 0xad, 0x74, 0x32, 0x2b, 0xee, 0xfc, 0xdb, 0x02, 0x7b, 0x3f, 0x8e,
+	0xc3, 0x61, 0xde, 0xb2, 0x1a, 0x14, 0xf9, 0xeb, 0x30, 0x6d, 0x31, 0xfc, 0x75, 0x28, 0x5b, 0xcc,
+	0x19, 0x65, 0x1d, 0x34, 0xc5, 0xaa, 0x17, 0x72, 0x0c, 0x20, 0x61, 0x48, 0x2f, 0xbc, 0xcc, 0x0c,
+	0xab, 0x3a, 0xc3, 0xa2, 0x5b, 0x53, 0x04, 0x77, 0x8c, 0x4f, 0x0f, 0x40, 0x73, 0xef, 0x6a, 0x00,
+	0x9a, 0xbf, 0xe5, 0x00, 0xf4, 0x37, 0x0b, 0x1a, 0x39, 0xef, 0x4d, 0x8c, 0xff, 0xff, 0x46, 0xb5,
+	0x06, 0xd4, 0x9f, 0xd2, 0xce, 0xb9, 0xee, 0x7a, 0x69, 0x69, 0xac, 0x80, 0x9d, 0x05, 0xc7, 0x85,
+	0xf7, 0x22, 0x0a, 0xa7, 0x98, 0xd7, 0x60, 0x25, 0x0f, 0x1b, 0xf6, 0xbf, 0x5b, 0xd0, 0x34, 0x57,
+	0xc4, 0x31, 0x8a, 0x4e, 0x6f, 0x9f, 0x1f, 0xb6, 0x47, 0x79, 0xb0, 0x02, 0xf3, 0x6a, 0x14, 0x57,
+	0x01, 0x28, 0xbb, 0x7a, 0x61, 0xaf, 0xc3, 0x1d, 0xbf, 0xed, 0xa9, 0xab, 0xd1, 0xdc, 0x0e, 0x7e,
+	0xfb, 0x07, 0x79, 0x39, 0x6e, 0xc0, 0x62, 0x9f, 0x5c, 0x7a, 0x8c, 0x5e, 0x70, 0x33, 0x0c, 0xde,
+	0xe9, 0x93, 0x4b, 0x97, 0x5e, 0x70, 0x35, 0xa8, 0x07, 0x5c, 0x4d, 0xe0, 0xed, 0x20, 0x0a, 0x69,
+	0x97, 0xab, 0xe3, 0x5f, 0x74, 0xab, 0x06, 0x7e, 0xa8, 0x51, 0x59, 0x6b, 0x4c, 0x95, 0x51, 0xf6,
+	0x70, 0x17, 0xdd, 0x32, 0xcb, 0xd4, 0x96, 0xf3, 0x08, 0x36, 0x66, 0xd8, 0x6c, 0x4e, 0xef, 0x13,
+	0x58, 0xd0, 0xa5, 0x61, 0x8e, 0xcd, 0x36, 0xcf, 0x89, 0x1f, 0xe5, 0x5f, 0x53, 0x06, 0x86, 0xc3,
+	0xf9, 0x93, 0x05, 0x1f, 0xe4, 0x25, 0xed, 0x87, 0xa1, 0x1c, 0xc0, 0xf8, 0xbb, 0x0f, 0xc1, 0x94,
+	0x67, 0x73, 0x33, 0x3c, 0x7b, 0x0a, 0x5b, 0x57, 0xd9, 0x73, 0x0b, 0xf7, 0xbe, 0x9b, 0x3c, 0xdb,
+	0xfd, 0x38, 0xbe, 0xde, 0xb1, 0xac, 0xfd, 0x85, 0x9c, 0xfd, 0xd3, 0x41, 0x57, 0xc2, 0x6e, 0x61,
+	0x95, 0xbc, 0xd8, 0x42, 0x32, 0x40, 0x3d, 0x6b, 0xa4, 0x09, 0x7a, 0x0c, 0x8d, 0x1c, 0x6a, 0x04,
+	0xef, 0xca, 0x89, 0x63, 0x34, 0xa5, 0x94, 0xf6, 0xd6, 0x5b, 0x93, 0xef, 0x65, 0xb3, 0xc1, 0xb0,
+	0xc9, 0x9b, 0xe4, 0x7b, 0xc2, 0x05, 0xb2, 0xb4, 0x33, 0xa7, 0x0a, 0xbe, 0x80, 0xb5, 0x49, 0x82,
+	0xd1, 0xb1, 0x09, 0x8b, 0x13, 0xad, 0x7d, 0xb4, 0x96, 0xbb, 0x5e, 0x91, 0x40, 0x1c, 0xd3, 0x49,
+	0x79, 0xd7, 0xee, 0xda, 0x80, 0xf5, 0xa9, 0x5d, 0xa6, 0xe0, 0x6c, 0xa8, 0x9d, 0x0a, 0x1a, 0x2b,
+	0x5f, 0x53, 0xd3, 0x1a, 0x50, 0xcf, 0x60, 0x86, 0xf1, 0xf7, 0xb0, 0x3e, 0x02, 0xbf, 0x0f, 0xa2,
+	0xa0, 0x9f, 0xf4, 0x6f, 0xa0, 0xda, 0xbe, 0x0b, 0xea, 0x5e, 0xf2, 0x44, 0xd0, 0xc7, 0x74, 0x80,
+	0x2b, 0xba, 0x25, 0x89, 0x3d, 0xd7, 0x90, 0xf3, 0x25, 0x34, 0xa7, 0x25, 0xdf, 0x20, 0x16, 0xca,
+	0x4c, 0xc2, 0x44, 0xce, 0x76, 0x79, 0x9a, 0x19, 0xd0, 0x18, 0xff, 0x07, 0x78, 0x6f, 0x8c, 0xbe,
+	0x88, 0x44, 0x10, 0xee, 0xcb, 0x76, 0xf6, 0x8e, 0x1c, 0xd8, 0x82, 0xf7, 0x67, 0x4b, 0x37, 0xda,
+	0x0f, 0xe1, 0xae, 0x1e, 0x56, 0x8e, 0x2e, 0xe5, 0xa5, 0x4f, 0x42, 0x39, 0x29, 0xc5, 0x84, 0x61,
+	0x24, 0xd0, 0x4f, 0x6d, 0x50, 0x43, 0xb0, 0x26, 0x7b, 0x41, 0xfa, 0xa0, 0x80, 0x14, 0x7a, 0xe2,
+	0x3b, 0xf7, 0xc1, 0xb9, 0x4e, 0x8a, 0xd1, 0xb5, 0x03, 0x5b, 0x93, 0x5c, 0x47, 0x21, 0x76, 0xc6,
+	0x8a, 0x9c, 0xbb, 0xb0, 0x7d, 0x25, 0xc7, 0x38, 0x29, 0x1e, 0xa1, 0x76, 0x67, 0x54, 0x10, 0x1f,
+	0x43, 0x3d, 0x83, 0x99, 0xe3, 0x59, 0x81, 0x79, 0xe2, 0xfb, 0x2c, 0x9d, 0x18, 0xf4, 0x42, 0xa6,
+	0x9b, 0x8b, 0x1c, 0x45, 0xe6, 0xba, 0x4d, 0xa5, 0x6c, 0x42, 0x73, 0x9a, 0x64, 0xb4, 0xee, 0xc2,
+	0xfa, 0xcb, 0x0c, 0x2e, 0xab, 0x7b, 0x66, 0x77, 0x58, 0x32, 0xdd, 0xc1, 0x39, 0x86, 0xe6, 0xf4,
+	0x86, 0x5b, 0xf5, 0xa5, 0x0f, 0xb2, 0x72, 0xc6, 0xa5, 0x92, 0xaa, 0xaf, 0x42, 0xc1, 0x1c, 0x49,
+	0xd1, 0x2d, 0x04, 0x7e, 0x2e, 0x5f, 0x0a, 0x13, 0x59, 0xb9, 0x03, 0x5b, 0x57, 0x09, 0x33, 0x7e,
+	0x36, 0xa0, 0xfe, 0x24, 0x0a, 0x84, 0xae, 0xfe, 0x34, 0x30, 0x9f, 0x82, 0x9d, 0x05, 0x6f, 0x90,
+	0xfe, 0x3f, 0x5b, 0xb0, 0x75, 0x42, 0xe3, 0x24, 0x54, 0x83, 0xab, 0x4e, 0x84, 0x6f, 0x69, 0x22,
+	0x4f, 0x34, 0xb5, 0xfb, 0x17, 0xb0, 0x2c, 0xd3, 0xd6, 0xeb, 0x30, 0x24, 0x02, 0x7d, 0x2f, 0x4a,
+	0x1f, 0x57, 0x15, 0x09, 0x1f, 0x68, 0xf4, 0x07, 0x2e, 0x73, 0x8f, 0x74, 0xa4, 0xd0, 0xec, 0x1d,
+	0x02, 0x1a, 0x52, 0xf7, 0xc8, 0x57, 0x50, 0xee, 0x2b, 0xcb, 0x3c, 0x12, 0x06, 0x44, 0xdf, 0x25,
+	0xa5, 0xbd, 0xd5, 0xc9, 0x61, 0x7c, 0x5f, 0x12, 0xdd, 0x92, 0x66, 0x55, 0x0b, 0xfb, 0x33, 0x58,
+	0xc9, 0x74, 0xc8, 0xf1, 0xcc, 0x3a, 0xa7, 0x74, 0x34, 0x32, 0xb4, 0xd1, 0xe8, 0x7a, 0x17, 0xb6,
+	0xaf, 0xf4, 0xcb, 0x84, 0xf0, 0xcf, 0x16, 0xd4, 0x64, 0xb8, 0xb2, 0xa5, 0x6f, 0xff, 0x0a, 0x16,
+	0x34, 0x77, 0xd3, 0xba, 0xce, 0x3c, 0xc3, 0x74, 0xa5, 0x65, 0x85, 0x2b, 0x2d, 0x9b, 0x15, 0xcf,
+	0xe2, 0x8c, 0x78, 0xa6, 0x27, 0x9c, 0xef, 0x41, 0xab, 0xd0, 0x38, 0xc4, 0x3e, 0x15, 0x98, 0x3f,
+	0xf8, 0x3d, 0x58, 0xc9, 0xc3, 0x37, 0x38, 0xfa, 0x0d, 0x58, 0x7f, 0x11, 0xf9, 0x74, 0x96, 0xb8,
+	0x4d, 0x68, 0x4e, 0x93, 0x8c, 0x05, 0xdf, 0xc0, 0xf6, 0x09, 0xa3, 0x92, 0xa0, 0x2c, 0x7b, 0xd5,
+	0xc3, 0xe8, 0x80, 0x24, 0xdd, 0x9e, 0x78, 0x11, 0xdf, 0xe4, 0x16, 0xf9, 0x2d, 0xec, 0x5c, 0xbd,
+	0xfd, 0x66, 0x56, 0xeb, 0x8d, 0x84, 0x1b, 0x39, 0x7e, 0xc6, 0xea, 0x69, 0x92, 0xb1, 0xfa, 0x1f,
+	0xf2, 0x97, 0x56, 0xcc, 0x97, 0xcb, 0xdb, 0x9e, 0xf5, 0x8c, 0x83, 0x2b, 0xcc, 0x2a, 0x84, 0xa9,
+	0xa7, 0xd5, 0xdc, 0xf4, 0xd3, 0xca, 0xfe, 0x04, 0xea, 0xea, 0xbd, 0x21, 0x7f, 0xaf, 0x60, 0xc2,
+	0xe3, 0xd2, 0x70, 0xf3, 0xcc, 0x58, 0x56, 0x84, 0xf1, 0x65, 0xa0, 0xee, 0x28, 0x9c, 0xa8, 0x6a,
+	0xe7, 0xc9, 0xd8, 0x5b, 0x17, 0x95, 0x10, 0xf4, 0x6f, 0xe7, 0x98, 0x7c, 0x3f, 0xce, 0x10, 0x65,
+	0xf4, 0xdc, 0x07, 0x47, 0x5e, 0xac, 0x99, 0x6e, 0xb4, 0x1f, 0xf9, 0xb2, 0x89, 0xe7, 0x26, 0x9d,
+	0x97, 0x70, 0xef, 0x5a, 0xae, 0xdb, 0x4e, 0x3e, 0xab, 0xd0, 0xc8, 0xa6, 0x4b, 0x26, 0xdf, 0xf3,
+	0xf0, 0x0d, 0x32, 0xe7, 0x14, 0x2a, 0x0f, 0x49, 0xe7, 0x3c, 0x19, 0xa5, 0xe9, 0x0e, 0x94, 0x3a,
+	0x34, 0xea, 0x24, 0x8c, 0x61, 0xd4, 0x19, 0x9a, 0xa6, 0x96, 0x85, 0x24, 0x87, 0x7a, 0xf2, 0xe9,
+	0xd0, 0x9b, 0x77, 0x62, 0x16, 0x72, 0xbe, 0x84, 0x6a, 0x2a, 0xd4, 0x98, 0x70, 0x1f, 0xe6, 0x71,
+	0x30, 0x0e, 0x7d, 0xb5, 0x95, 0xfe, 0xd3, 0xe3, 0x48, 0xa2, 0xae, 0x26, 0x9a, 0x2b, 0x4c, 0x50,
+	0x86, 0xc7, 0x8c, 0xf6, 0x73, 0x76, 0x39, 0xfb, 0xb0, 0x31, 0x83, 0xf6, 0x56, 0xe2, 0x3f, 0x86,
+	0x55, 0xf5, 0xc3, 0xc2, 0xf3, 0x1e, 0xa3, 0x42, 0x84, 0xe3, 0x94, 0xaf, 0x41, 0x91, 0xc4, 0x71,
+	0xfa, 0x0a, 0x26, 0x71, 0xec, 0xfc, 0xd1, 0x82, 0xb5, 0x49, 0xde, 0xf1, 0x4f, 0x6a, 0xfa, 0x18,
+	0xbc, 0x0e, 0xf5, 0xf5, 0x8f, 0x27, 0xf3, 0x2e, 0x68, 0xe8, 0x80, 0xfa, 0x28, 0xa5, 0x85, 0xa4,
+	0xab, 0xe2, 0x62, 0xb9, 0xf2, 0xd3, 0x7e, 0x1f, 0x96, 0x44, 0x8f, 0x21, 0xef, 0xd1, 0xd0, 0x57,
+	0xe9, 0x6c, 0xb9, 0x63, 0x40, 0xfe, 0x3a, 0xd0, 0x47, 0xce, 0x49, 0x17, 0x4d, 0x4d, 0xa4, 0xcb,
+	0x87, 0x9f, 0xfe, 0xd4, 0x1a, 0x04, 0x02, 0x39, 0x6f, 0x05, 0x74, 0x57, 0x7f, 0xed, 0x76, 0xe9,
+	0xee, 0x40, 0xec, 0xaa, 0xff, 0x15, 0xed, 0x4e, 0x3d, 0x2e, 0xdb, 0x0b, 0x8a, 0xf0, 0xf9, 0x7f,
+	0x07, 0x00, 0x45, 0xff, 0xd8, 0x63, 0xb5, 0x1a, 0x00, 0x00,
 }
@@ -29,72 +29,73 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0xb1, 0x04, 0x95, 0x58, 0x1e, 0xbb, 0xaa, 0x28, 0x0a, 0x12, 0x4f, 0x6d, 0x78, 0x48,
-	0x51, 0xdc, 0x34, 0x94, 0xf7, 0x6e, 0x9a, 0xb4, 0x41, 0x8d, 0x30, 0x76, 0x43, 0x10, 0x48, 0x48,
-	0x1b, 0x7b, 0x62, 0x1f, 0x39, 0xef, 0x1e, 0xbb, 0x7b, 0x56, 0xf3, 0x0a, 0x09, 0x89, 0x57, 0x48,
-	0x7c, 0x11, 0xbe, 0x24, 0xba, 0x87, 0xdd, 0x9b, 0x3d, 0xcf, 0xad, 0xed, 0x77, 0x91, 0xff, 0xbf,
-	0x99, 0xd9, 0x87, 0x99, 0xd9, 0xc9, 0xb1, 0x1d, 0x2b, 0x2e, 0x53, 0xb0, 0x0b, 0x21, 0xc5, 0x0c,
-	0xb4, 0x01, 0xbd, 0x4c, 0x26, 0xb0, 0x9f, 0x69, 0x65, 0x15, 0xbf, 0x43, 0x69, 0x3b, 0x77, 0x83,
-	0x5f, 0xa7, 0xc2, 0x8a, 0x0a, 0x7f, 0xf4, 0xdf, 0x2e, 0x7b, 0xe7, 0x65, 0xa9, 0x9d, 0x55, 0x1a,
-	0x3f, 0x65, 0xaf, 0x0f, 0x13, 0x39, 0xe3, 0x1f, 0xef, 0xaf, 0xda, 0x14, 0xc2, 0x08, 0xfe, 0xc8,
-	0xc1, 0xd8, 0x9d, 0x4f, 0x3a, 0x75, 0x93, 0x29, 0x69, 0xe0, 0xf3, 0xd7, 0xf8, 0x0b, 0xf6, 0xc6,
-	0x38, 0x05, 0xc8, 0x38, 0xc5, 0x96, 0x8a, 0x73, 0xf6, 0x69, 0x37, 0xe0, 0xbd, 0xfd, 0xc6, 0xde,
-	0x3a, 0x7e, 0x05, 0x93, 0xdc, 0xc2, 0x73, 0xa5, 0xae, 0xf9, 0x2e, 0x61, 0x82, 0x74, 0xe7, 0xf9,
-	0x8b, 0x75, 0x98, 0xf7, 0xff, 0x33, 0x7b, 0xf3, 0x19, 0xd8, 0xf1, 0x64, 0x0e, 0x0b, 0xc1, 0xef,
-	0x11, 0x66, 0x5e, 0x75, 0xbe, 0xef, 0xc7, 0x21, 0xef, 0x79, 0xc6, 0xde, 0x7d, 0x06, 0x76, 0x08,
-	0x7a, 0x91, 0x18, 0x93, 0x28, 0x69, 0xf8, 0x57, 0xb4, 0x25, 0x42, 0x5c, 0x8c, 0xaf, 0x37, 0x20,
-	0xf1, 0x11, 0x8d, 0xc1, 0x8e, 0x40, 0x4c, 0x7f, 0x90, 0xe9, 0x0d, 0x79, 0x44, 0x48, 0x8f, 0x1d,
-	0x51, 0x80, 0x79, 0xff, 0x82, 0xbd, 0x5d, 0x0b, 0x17, 0x3a, 0xb1, 0xc0, 0x23, 0x96, 0x25, 0xe0,
-	0x22, 0x7c, 0xb9, 0x96, 0xf3, 0x21, 0x7e, 0x65, 0xec, 0x68, 0x2e, 0xe4, 0x0c, 0x5e, 0xde, 0x64,
-	0xc0, 0xa9, 0x13, 0x6e, 0x64, 0xe7, 0x7e, 0x77, 0x0d, 0x85, 0xd7, 0x3f, 0x82, 0x2b, 0x0d, 0x66,
-	0x3e, 0xb6, 0xa2, 0x63, 0xfd, 0x18, 0x88, 0xad, 0x3f, 0xe4, 0xf0, 0x5d, 0x8f, 0x72, 0xf9, 0x1c,
-	0x44, 0x6a, 0xe7, 0x47, 0x73, 0x98, 0x5c, 0x93, 0x77, 0x1d, 0x22, 0xb1, 0xbb, 0x6e, 0x93, 0x3e,
-	0x50, 0xc6, 0x6e, 0x9f, 0xce, 0xa4, 0xd2, 0x50, 0xc9, 0xc7, 0x5a, 0x2b, 0xcd, 0x1f, 0x10, 0x1e,
-	0x56, 0x28, 0x17, 0xee, 0x9b, 0xcd, 0xe0, 0xf0, 0xf4, 0x52, 0x25, 0xa6, 0x75, 0x8d, 0xd0, 0xa7,
-	0xd7, 0x00, 0xf1, 0xd3, 0xc3, 0x9c, 0x0f, 0xf1, 0x3b, 0x7b, 0x6f, 0xa8, 0xe1, 0x2a, 0x4d, 0x66,
-	0x73, 0x57, 0x89, 0xd4, 0xa1, 0xb4, 0x18, 0x17, 0x68, 0x6f, 0x13, 0x14, 0x17, 0xcb, 0x20, 0xcb,
-	0xd2, 0x9b, 0x3a, 0x0e, 0x95, 0x44, 0x48, 0x8f, 0x15, 0x4b, 0x80, 0xe1, 0x4c, 0x7e, 0xa1, 0x26,
-	0xd7, 0x65, 0x77, 0x35, 0x64, 0x26, 0x37, 0x72, 0x2c, 0x93, 0x31, 0x85, 0xef, 0xe2, 0x5c, 0xa6,
-	0x8d, 0x7b, 0x6a, 0x59, 0x18, 0x88, 0xdd, 0x45, 0xc8, 0xe1, 0x04, 0xab, 0x1b, 0xe5, 0x09, 0xd8,
-	0xc9, 0x7c, 0x60, 0x9e, 0x5e, 0x0a, 0x32, 0xc1, 0x56, 0xa8, 0x58, 0x82, 0x11, 0xb0, 0x8f, 0xf8,
-	0x27, 0xfb, 0x20, 0x94, 0x07, 0x69, 0x3a, 0xd4, 0xc9, 0xd2, 0xf0, 0x87, 0x6b, 0x3d, 0x39, 0xd4,
-	0xc5, 0x3e, 0xd8, 0xc2, 0xa2, 0x7b, 0xcb, 0x83, 0x2c, 0xdb, 0x60, 0xcb, 0x83, 0x2c, 0xdb, 0x7c,
-	0xcb, 0x25, 0x1c, 0x74, 0xec, 0x54, 0x2c, 0xa1, 0x68, 0x23, 0xb9, 0xa1, 0x3b, 0x76, 0xa3, 0x47,
-	0x3b, 0x36, 0xc6, 0x70, 0x3b, 0x3a, 0x13, 0xc6, 0x82, 0x1e, 0x2a, 0x93, 0xd8, 0x44, 0x49, 0xb2,
-	0x1d, 0x85, 0x48, 0xac, 0x1d, 0xb5, 0x49, 0x5c, 0xb9, 0x17, 0x22, 0xb1, 0x27, 0xaa, 0x89, 0x44,
-	0xd9, 0xb7, 0x98, 0x58, 0xe5, 0xae, 0xa0, 0xf8, 0xa5, 0x1e, 0x5b, 0x95, 0x95, 0x3b, 0x26, 0x5f,
-	0x6a, 0xaf, 0xc6, 0x5e, 0x6a, 0x04, 0x79, 0xcf, 0x0b, 0xf6, 0xbe, 0xff, 0xf9, 0x2c, 0x91, 0xc9,
-	0x22, 0x5f, 0xf0, 0xbd, 0x98, 0x6d, 0x0d, 0xb9, 0x38, 0x0f, 0x36, 0x62, 0x71, 0x8b, 0x18, 0x5b,
-	0xa1, 0x6d, 0xb5, 0x13, 0x7a, 0x91, 0x4e, 0x8e, 0xb5, 0x08, 0x4c, 0x79, 0xe7, 0x37, 0xec, 0x4e,
-	0xf3, 0xfb, 0xb9, 0xb4, 0x49, 0x3a, 0xb8, 0xb2, 0xa0, 0xf9, 0x7e, 0xd4, 0x41, 0x03, 0xba, 0x80,
-	0xfd, 0x8d, 0x79, 0x1f, 0xfa, 0x9f, 0x1e, 0xdb, 0xa9, 0xa6, 0xca, 0xe3, 0x57, 0x16, 0xb4, 0x14,
-	0x69, 0x31, 0x46, 0x64, 0x42, 0x83, 0xb4, 0x30, 0xe5, 0xdf, 0x12, 0x1e, 0xbb, 0x71, 0xb7, 0x8e,
-	0xc7, 0x5b, 0x5a, 0xf9, 0xd5, 0xfc, 0xd5, 0x63, 0x77, 0xdb, 0xe0, 0x71, 0x0a, 0x93, 0x62, 0x29,
-	0x07, 0x1b, 0x38, 0xad, 0x59, 0xb7, 0x8e, 0x47, 0xdb, 0x98, 0xb4, 0xa7, 0xcb, 0xe2, 0xc8, 0x4c,
-	0xe7, 0x74, 0x59, 0xaa, 0xeb, 0xa6, 0xcb, 0x1a, 0xc2, 0x39, 0xfb, 0xd3, 0x08, 0xb2, 0x34, 0x99,
-	0x88, 0xa2, 0x4e, 0x8a, 0x6e, 0x43, 0xe6, 0x6c, 0x1b, 0x8a, 0xe5, 0xec, 0x2a, 0x8b, 0x9b, 0x34,
-	0x56, 0x9b, 0x2a, 0x25, 0x9b, 0x34, 0x8d, 0xc6, 0x9a, 0x74, 0x97, 0x05, 0xde, 0xef, 0x08, 0x4c,
-	0x31, 0x3d, 0x7a, 0x8e, 0xdc, 0x6f, 0x1b, 0x8a, 0xed, 0x77, 0x95, 0xc5, 0x35, 0x7a, 0x2a, 0x13,
-	0x5b, 0x35, 0x3e, 0xb2, 0x46, 0x1b, 0x39, 0x56, 0xa3, 0x98, 0x0a, 0x52, 0x73, 0xa8, 0xb2, 0x3c,
-	0x2d, 0x87, 0xc8, 0x2a, 0x77, 0xbf, 0x57, 0x79, 0x91, 0x44, 0x64, 0x6a, 0x76, 0xb0, 0xb1, 0xd4,
-	0xec, 0x34, 0xc1, 0xa9, 0x59, 0x2c, 0xae, 0xbb, 0x9d, 0x7a, 0x35, 0x96, 0x9a, 0x08, 0xc2, 0x53,
-	0xca, 0x53, 0x58, 0x28, 0x0b, 0xf5, 0xe9, 0x51, 0xef, 0x16, 0x06, 0x62, 0x53, 0x4a, 0xc8, 0xe1,
-	0x6c, 0x38, 0x97, 0x53, 0x15, 0x84, 0xd9, 0x23, 0x87, 0x9c, 0x10, 0x8a, 0x65, 0xc3, 0x2a, 0xeb,
-	0xc3, 0xfd, 0xdd, 0x63, 0x1f, 0x0e, 0xb5, 0x2a, 0xb4, 0x72, 0xb3, 0x17, 0x73, 0x90, 0x47, 0x22,
-	0x9f, 0xcd, 0xed, 0x79, 0xc6, 0xc9, 0xe3, 0xef, 0x80, 0x5d, 0xfc, 0xc3, 0xad, 0x6c, 0x82, 0x87,
-	0xaa, 0x94, 0x85, 0xa9, 0xe9, 0x29, 0xfd, 0x50, 0xb5, 0xa0, 0xe8, 0x43, 0xb5, 0xc2, 0x06, 0x2f,
-	0x2e, 0xb8, 0x1a, 0xb8, 0x47, 0xff, 0x37, 0x17, 0x9e, 0xeb, 0xfd, 0x38, 0x84, 0x47, 0x2e, 0x17,
-	0x77, 0x04, 0xa6, 0x78, 0x56, 0x60, 0xca, 0x63, 0xab, 0xf3, 0x54, 0x6c, 0xe4, 0x22, 0x60, 0x1f,
-	0xf1, 0xdf, 0x1e, 0xfb, 0xa8, 0x78, 0x93, 0x51, 0xb9, 0x0f, 0xe4, 0xb4, 0xe8, 0xac, 0xd5, 0x0c,
-	0xf6, 0xb8, 0xe3, 0x0d, 0xef, 0xe0, 0xdd, 0x32, 0xbe, 0xdb, 0xd6, 0x0c, 0x57, 0x09, 0xbe, 0x71,
-	0xb2, 0x4a, 0x30, 0x10, 0xab, 0x92, 0x90, 0xf3, 0x21, 0x7e, 0x64, 0xb7, 0x9e, 0x88, 0xc9, 0x75,
-	0x9e, 0x71, 0xea, 0x4b, 0x4b, 0x25, 0x39, 0xb7, 0x9f, 0x45, 0x08, 0xe7, 0xf0, 0x61, 0x8f, 0x6b,
-	0x76, 0xbb, 0x38, 0x5d, 0xa5, 0xe1, 0x44, 0xab, 0x45, 0xed, 0xbd, 0xa3, 0xb7, 0x86, 0x54, 0xec,
-	0xe2, 0x08, 0xb8, 0x89, 0xf9, 0xe4, 0xf0, 0x97, 0x83, 0x65, 0x62, 0xc1, 0x98, 0xfd, 0x44, 0xf5,
-	0xab, 0xbf, 0xfa, 0x33, 0xd5, 0x5f, 0xda, 0x7e, 0xf9, 0x35, 0xab, 0x4f, 0x7d, 0xfb, 0xba, 0xbc,
-	0x55, 0x6a, 0x87, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xa2, 0x81, 0x89, 0x36, 0x13, 0x00,
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdf, 0x6f, 0x1b, 0x45,
+	0x10, 0xc7, 0x89, 0x04, 0x95, 0x58, 0xa0, 0xd0, 0x55, 0x45, 0x51, 0x90, 0xf8, 0xd9, 0x16, 0x48,
+	0x50, 0xdc, 0x34, 0x94, 0x77, 0x37, 0x4d, 0xda, 0xa0, 0x46, 0x18, 0x3b, 0x21, 0x08, 0x24, 0xa4,
+	0x8d, 0x3d, 0xf1, 0x1d, 0x39, 0xef, 0x1e, 0xbb, 0x6b, 0xab, 0x79, 0x42, 0x42, 0xe2, 0x09, 0x89,
+	0x27, 0xfe, 0xe0, 0xea, 0xce, 0xb7, 0x7b, 0xb3, 0xe7, 0xb9, 0xf5, 0xf9, 0x2d, 0xf2, 0xf7, 0x33,
+	0x33, 0xfb, 0x63, 0x66, 0x76, 0x72, 0x6c, 0xdb, 0x8a, 0xcb, 0x0c, 0xec, 0x4c, 0x48, 0x31, 0x05,
+	0x6d, 0x40, 0x2f, 0xd2, 0x31, 0xec, 0xe5, 0x5a, 0x59, 0xc5, 0xef, 0x52, 0xda, 0xf6, 0xbd, 0xe0,
+	0xd7, 0x89, 0xb0, 0x62, 0x89, 0x3f, 0xfe, 0xff, 0x21, 0x7b, 0xef, 0xac, 0xd4, 0x4e, 0x97, 0x1a,
+	0x3f, 0x61, 0x6f, 0x0e, 0x52, 0x39, 0xe5, 0x9f, 0xec, 0xad, 0xda, 0x14, 0xc2, 0x10, 0xfe, 0x9c,
+	0x83, 0xb1, 0xdb, 0x9f, 0xb6, 0xea, 0x26, 0x57, 0xd2, 0xc0, 0x17, 0x6f, 0xf0, 0x97, 0xec, 0xad,
+	0x51, 0x06, 0x90, 0x73, 0x8a, 0x2d, 0x15, 0xe7, 0xec, 0xb3, 0x76, 0xc0, 0x7b, 0xfb, 0x9d, 0xbd,
+	0x73, 0xf4, 0x0a, 0xc6, 0x73, 0x0b, 0x2f, 0x94, 0xba, 0xe6, 0x0f, 0x08, 0x13, 0xa4, 0x3b, 0xcf,
+	0x0f, 0xd7, 0x61, 0xde, 0xff, 0x2f, 0xec, 0xed, 0xe7, 0x60, 0x47, 0xe3, 0x04, 0x66, 0x82, 0x7f,
+	0x49, 0x98, 0x79, 0xd5, 0xf9, 0xbe, 0x1f, 0x87, 0xbc, 0xe7, 0x29, 0xbb, 0xfd, 0x1c, 0xec, 0x00,
+	0xf4, 0x2c, 0x35, 0x26, 0x55, 0xd2, 0xf0, 0xaf, 0x69, 0x4b, 0x84, 0xb8, 0x18, 0xdf, 0x74, 0x20,
+	0xf1, 0x11, 0x8d, 0xc0, 0x0e, 0x41, 0x4c, 0x7e, 0x94, 0xd9, 0x0d, 0x79, 0x44, 0x48, 0x8f, 0x1d,
+	0x51, 0x80, 0x79, 0xff, 0x82, 0xbd, 0x5b, 0x09, 0x17, 0x3a, 0xb5, 0xc0, 0x23, 0x96, 0x25, 0xe0,
+	0x22, 0x7c, 0xb5, 0x96, 0xf3, 0x21, 0x7e, 0x63, 0xec, 0x30, 0x11, 0x72, 0x0a, 0x67, 0x37, 0x39,
+	0x70, 0xea, 0x84, 0x6b, 0xd9, 0xb9, 0x7f, 0xb0, 0x86, 0xc2, 0xeb, 0x1f, 0xc2, 0x95, 0x06, 0x93,
+	0x8c, 0xac, 0x68, 0x59, 0x3f, 0x06, 0x62, 0xeb, 0x0f, 0x39, 0x7c, 0xd7, 0xc3, 0xb9, 0x7c, 0x01,
+	0x22, 0xb3, 0xc9, 0x61, 0x02, 0xe3, 0x6b, 0xf2, 0xae, 0x43, 0x24, 0x76, 0xd7, 0x4d, 0xd2, 0x07,
+	0xca, 0xd9, 0x9d, 0x93, 0xa9, 0x54, 0x1a, 0x96, 0xf2, 0x91, 0xd6, 0x4a, 0xf3, 0x5d, 0xc2, 0xc3,
+	0x0a, 0xe5, 0xc2, 0x7d, 0xdb, 0x0d, 0x0e, 0x4f, 0x2f, 0x53, 0x62, 0x52, 0xd5, 0x08, 0x7d, 0x7a,
+	0x35, 0x10, 0x3f, 0x3d, 0xcc, 0xf9, 0x10, 0x7f, 0xb0, 0xf7, 0x07, 0x1a, 0xae, 0xb2, 0x74, 0x9a,
+	0xb8, 0x4a, 0xa4, 0x0e, 0xa5, 0xc1, 0xb8, 0x40, 0x3b, 0x5d, 0x50, 0x5c, 0x2c, 0xfd, 0x3c, 0xcf,
+	0x6e, 0xaa, 0x38, 0x54, 0x12, 0x21, 0x3d, 0x56, 0x2c, 0x01, 0x86, 0x33, 0xf9, 0xa5, 0x1a, 0x5f,
+	0x97, 0xdd, 0xd5, 0x90, 0x99, 0x5c, 0xcb, 0xb1, 0x4c, 0xc6, 0x14, 0xbe, 0x8b, 0x73, 0x99, 0xd5,
+	0xee, 0xa9, 0x65, 0x61, 0x20, 0x76, 0x17, 0x21, 0x87, 0x13, 0xac, 0x6a, 0x94, 0xc7, 0x60, 0xc7,
+	0x49, 0xdf, 0x3c, 0xbb, 0x14, 0x64, 0x82, 0xad, 0x50, 0xb1, 0x04, 0x23, 0x60, 0x1f, 0xf1, 0x2f,
+	0xf6, 0x61, 0x28, 0xf7, 0xb3, 0x6c, 0xa0, 0xd3, 0x85, 0xe1, 0x8f, 0xd6, 0x7a, 0x72, 0xa8, 0x8b,
+	0xbd, 0xbf, 0x81, 0x45, 0xfb, 0x96, 0xfb, 0x79, 0xde, 0x61, 0xcb, 0xfd, 0x3c, 0xef, 0xbe, 0xe5,
+	0x12, 0x0e, 0x3a, 0x76, 0x26, 0x16, 0x30, 0xb2, 0xc2, 0xce, 0x0d, 0xdd, 0xb1, 0x6b, 0x3d, 0xda,
+	0xb1, 0x31, 0x86, 0xdb, 0xd1, 0xa9, 0x30, 0x16, 0xf4, 0x40, 0x99, 0xd4, 0xa6, 0x4a, 0x92, 0xed,
+	0x28, 0x44, 0x62, 0xed, 0xa8, 0x49, 0xe2, 0xca, 0xbd, 0x10, 0xa9, 0x3d, 0x56, 0x75, 0x24, 0xca,
+	0xbe, 0xc1, 0xc4, 0x2a, 0x77, 0x05, 0xc5, 0x2f, 0xf5, 0xc8, 0xaa, 0xbc, 0xdc, 0x31, 0xf9, 0x52,
+	0x7b, 0x35, 0xf6, 0x52, 0x23, 0xc8, 0x7b, 0x9e, 0xb1, 0x0f, 0xfc, 0xcf, 0xa7, 0xa9, 0x4c, 0x67,
+	0xf3, 0x19, 0xdf, 0x89, 0xd9, 0x56, 0x90, 0x8b, 0xb3, 0xdb, 0x89, 0xc5, 0x2d, 0x62, 0x64, 0x85,
+	0xb6, 0xcb, 0x9d, 0xd0, 0x8b, 0x74, 0x72, 0xac, 0x45, 0x60, 0xca, 0x3b, 0xbf, 0x61, 0x77, 0xeb,
+	0xdf, 0xcf, 0xa5, 0x4d, 0xb3, 0xfe, 0x95, 0x05, 0xcd, 0xf7, 0xa2, 0x0e, 0x6a, 0xd0, 0x05, 0xec,
+	0x75, 0xe6, 0x7d, 0xe8, 0x7f, 0xb7, 0xd8, 0xf6, 0x72, 0xaa, 0x3c, 0x7a, 0x65, 0x41, 0x4b, 0x91,
+	0x15, 0x63, 0x44, 0x2e, 0x34, 0x48, 0x0b, 0x13, 0xfe, 0x1d, 0xe1, 0xb1, 0x1d, 0x77, 0xeb, 0x78,
+	0xb2, 0xa1, 0x95, 0x5f, 0xcd, 0xdf, 0x5b, 0xec, 0x5e, 0x13, 0x3c, 0xca, 0x60, 0x5c, 0x2c, 0x65,
+	0xbf, 0x83, 0xd3, 0x8a, 0x75, 0xeb, 0x78, 0xbc, 0x89, 0x49, 0x73, 0xba, 0x2c, 0x8e, 0xcc, 0xb4,
+	0x4e, 0x97, 0xa5, 0xba, 0x6e, 0xba, 0xac, 0x20, 0x9c, 0xb3, 0x3f, 0x0f, 0x21, 0xcf, 0xd2, 0xb1,
+	0x28, 0xea, 0xa4, 0xe8, 0x36, 0x64, 0xce, 0x36, 0xa1, 0x58, 0xce, 0xae, 0xb2, 0xb8, 0x49, 0x63,
+	0xb5, 0xae, 0x52, 0xb2, 0x49, 0xd3, 0x68, 0xac, 0x49, 0xb7, 0x59, 0xe0, 0xfd, 0x0e, 0xc1, 0x80,
+	0x45, 0x1c, 0xb9, 0xdf, 0x26, 0x14, 0xdb, 0xef, 0x2a, 0x8b, 0x6b, 0xf4, 0x44, 0xa6, 0x76, 0xd9,
+	0xf8, 0xc8, 0x1a, 0xad, 0xe5, 0x58, 0x8d, 0x62, 0x2a, 0x48, 0xcd, 0x81, 0xca, 0xe7, 0x99, 0xb0,
+	0xe0, 0x72, 0xf7, 0x07, 0x35, 0x2f, 0x92, 0x88, 0x4c, 0xcd, 0x16, 0x36, 0x96, 0x9a, 0xad, 0x26,
+	0x38, 0x35, 0x8b, 0xc5, 0xb5, 0xb7, 0x53, 0xaf, 0xc6, 0x52, 0x13, 0x41, 0x78, 0x4a, 0x79, 0x06,
+	0x33, 0x65, 0xa1, 0x3a, 0x3d, 0xea, 0xdd, 0xc2, 0x40, 0x6c, 0x4a, 0x09, 0x39, 0x9c, 0x0d, 0xe7,
+	0x72, 0xa2, 0x82, 0x30, 0x3b, 0xe4, 0x90, 0x33, 0x51, 0x54, 0xa8, 0xdd, 0x4e, 0xac, 0x0f, 0xf7,
+	0xcf, 0x16, 0xfb, 0x68, 0xa0, 0x55, 0xa1, 0x95, 0x9b, 0xbd, 0x48, 0x40, 0x1e, 0x8a, 0xf9, 0x34,
+	0xb1, 0xe7, 0x39, 0x27, 0x8f, 0xbf, 0x05, 0x76, 0xf1, 0x0f, 0x36, 0xb2, 0x09, 0x1e, 0xaa, 0x52,
+	0x16, 0xa6, 0xa2, 0x27, 0xf4, 0x43, 0xd5, 0x80, 0xa2, 0x0f, 0xd5, 0x0a, 0x1b, 0xbc, 0xb8, 0xe0,
+	0x6a, 0x80, 0x7c, 0x71, 0xa1, 0x51, 0x02, 0xf7, 0xe3, 0x10, 0x1e, 0xb9, 0x5c, 0xdc, 0x21, 0x18,
+	0x2b, 0x74, 0xb1, 0x93, 0xd8, 0xea, 0x3c, 0x15, 0x1b, 0xb9, 0x08, 0xd8, 0x47, 0xfc, 0x6f, 0x8b,
+	0x7d, 0x5c, 0xbc, 0xc9, 0xa8, 0xdc, 0xfb, 0x72, 0x52, 0x74, 0xd6, 0xe5, 0x0c, 0xf6, 0xa4, 0xe5,
+	0x0d, 0x6f, 0xe1, 0xdd, 0x32, 0xbe, 0xdf, 0xd4, 0x0c, 0x57, 0x09, 0xbe, 0x71, 0xb2, 0x4a, 0x30,
+	0x10, 0xab, 0x92, 0x90, 0xf3, 0x21, 0x7e, 0x62, 0xb7, 0x9e, 0x8a, 0xf1, 0xf5, 0x3c, 0xe7, 0xd4,
+	0x97, 0x96, 0xa5, 0xe4, 0xdc, 0x7e, 0x1e, 0x21, 0x9c, 0xc3, 0x47, 0x5b, 0x5c, 0xb3, 0x3b, 0xc5,
+	0xe9, 0x2a, 0x0d, 0xc7, 0x5a, 0xcd, 0x2a, 0xef, 0x2d, 0xbd, 0x35, 0xa4, 0x62, 0x17, 0x47, 0xc0,
+	0x28, 0xe6, 0x94, 0xdd, 0x2e, 0xff, 0x0d, 0x3e, 0x4b, 0xb4, 0xb2, 0x36, 0x03, 0x4d, 0x4e, 0xb3,
+	0x21, 0x12, 0x9b, 0x66, 0x9b, 0xa4, 0x0b, 0xf5, 0xf4, 0xe0, 0xd7, 0xfd, 0x45, 0x6a, 0xc1, 0x98,
+	0xbd, 0x54, 0xf5, 0x96, 0x7f, 0xf5, 0xa6, 0xaa, 0xb7, 0xb0, 0xbd, 0xf2, 0xb3, 0x59, 0x8f, 0xfa,
+	0xc8, 0x76, 0x79, 0xab, 0xd4, 0x0e, 0x5e, 0x0f, 0x00, 0xf6, 0x0f, 0x80, 0xaa, 0x9f, 0x13, 0x00,
 	0x00,
 }
 
@@ -212,6 +213,9 @@ type TabletManagerClient interface {
 	Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error)
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
+	// CheckThrottler asks the lag throttler of the master whether an app
+	// may write to the shard now.
+	CheckThrottler(ctx context.Context, in *tabletmanagerdata.CheckThrottlerRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckThrottlerResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -673,6 +677,15 @@ func (x *tabletManagerRestoreFromBackupClient) Recv() (*tabletmanagerdata.Restor
 	return m, nil
 }
 
+func (c *tabletManagerClient) CheckThrottler(ctx context.Context, in *tabletmanagerdata.CheckThrottlerRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckThrottlerResponse, error) {
+	out := new(tabletmanagerdata.CheckThrottlerResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckThrottler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -777,6 +790,9 @@ type TabletManagerServer interface {
 	Backup(*tabletmanagerdata.BackupRequest, TabletManager_BackupServer) error
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
+	// CheckThrottler asks the lag throttler of the master whether an app
+	// may write to the shard now.
+	CheckThrottler(context.Context, *tabletmanagerdata.CheckThrottlerRequest) (*tabletmanagerdata.CheckThrottlerResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -918,6 +934,9 @@ func (*UnimplementedTabletManagerServer) Backup(req *tabletmanagerdata.BackupReq
 func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdata.RestoreFromBackupRequest, srv TabletManager_RestoreFromBackupServer) error {
 	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
 }
+func (*UnimplementedTabletManagerServer) CheckThrottler(ctx context.Context, req *tabletmanagerdata.CheckThrottlerRequest) (*tabletmanagerdata.CheckThrottlerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckThrottler not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -1739,6 +1758,24 @@ func (x *tabletManagerRestoreFromBackupServer) Send(m *tabletmanagerdata.Restore
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TabletManager_CheckThrottler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckThrottlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CheckThrottler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckThrottler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CheckThrottler(ctx, req.(*tabletmanagerdata.CheckThrottlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1915,6 +1952,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PromoteSlave",
 			Handler:    _TabletManager_PromoteSlave_Handler,
 		},
+		{
+			MethodName: "CheckThrottler",
+			Handler:    _TabletManager_CheckThrottler_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -265,10 +265,16 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// unique_keys lists the primary key and the unique keys
+	// of the table. The primary key, if any, must come first.
+	// They identify the rows that are replaced or updated by
+	// REPLACE and INSERT ... ON DUPLICATE KEY UPDATE statements,
+	// and the rows affected by multi-shard DMLs with a LIMIT.
+	UniqueKeys           []*UniqueKey `protobuf:"bytes,7,rep,name=unique_keys,json=uniqueKeys,proto3" json:"unique_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -338,6 +344,13 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetUniqueKeys() []*UniqueKey {
+	if m != nil {
+		return m.UniqueKeys
+	}
+	return nil
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -494,6 +507,46 @@ func (m *Column) GetType() query.Type {
 	return query.Type_NULL_TYPE
 }
 
+// UniqueKey describes the primary key or a unique key of a table.
+type UniqueKey struct {
+	Columns              []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UniqueKey) Reset()         { *m = UniqueKey{} }
+func (m *UniqueKey) String() string { return proto.CompactTextString(m) }
+func (*UniqueKey) ProtoMessage()    {}
+func (*UniqueKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{8}
+}
+
+func (m *UniqueKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UniqueKey.Unmarshal(m, b)
+}
+func (m *UniqueKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UniqueKey.Marshal(b, m, deterministic)
+}
+func (m *UniqueKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniqueKey.Merge(m, src)
+}
+func (m *UniqueKey) XXX_Size() int {
+	return xxx_messageInfo_UniqueKey.Size(m)
+}
+func (m *UniqueKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniqueKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniqueKey proto.InternalMessageInfo
+
+func (m *UniqueKey) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
 // SrvVSchema is the roll-up of all the Keyspace schema for a cell.
 type SrvVSchema struct {
 	// keyspaces is a map of keyspace name -> Keyspace object.
@@ -508,7 +561,7 @@ func (m *SrvVSchema) Reset()         { *m = SrvVSchema{} }
 func (m *SrvVSchema) String() string { return proto.CompactTextString(m) }
 func (*SrvVSchema) ProtoMessage()    {}
 func (*SrvVSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{8}
+	return fileDescriptor_3f6849254fea3e77, []int{9}
 }
 
 func (m *SrvVSchema) XXX_Unmarshal(b []byte) error {
@@ -555,6 +608,7 @@ func init() {
 	proto.RegisterType((*ColumnVindex)(nil), "vschema.ColumnVindex")
 	proto.RegisterType((*AutoIncrement)(nil), "vschema.AutoIncrement")
 	proto.RegisterType((*Column)(nil), "vschema.Column")
+	proto.RegisterType((*UniqueKey)(nil), "vschema.UniqueKey")
 	proto.RegisterType((*SrvVSchema)(nil), "vschema.SrvVSchema")
 	proto.RegisterMapType((map[string]*Keyspace)(nil), "vschema.SrvVSchema.KeyspacesEntry")
 }
@@ -562,48 +616,50 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x56, 0x5a, 0xfa, 0x93, 0x13, 0x5a, 0x36, 0x0b, 0x58, 0x56, 0x84, 0xa8, 0x22, 0xd8, 0xba,
+	0x5d, 0xb4, 0x52, 0xd1, 0x24, 0xd6, 0x89, 0x69, 0x0c, 0x71, 0x81, 0x40, 0xda, 0x14, 0x18, 0x17,
+	0xbb, 0x89, 0x42, 0xea, 0x81, 0x45, 0x9b, 0xa4, 0xb6, 0x93, 0x91, 0xd7, 0xd9, 0x03, 0xed, 0x05,
+	0xf6, 0x08, 0x7b, 0x89, 0x29, 0xb6, 0x13, 0x1c, 0xe8, 0xee, 0x7c, 0x7c, 0xce, 0xf7, 0xf9, 0xf3,
+	0x67, 0x9f, 0x03, 0x9d, 0x94, 0x05, 0xb7, 0x78, 0xee, 0x0f, 0x63, 0x1a, 0xf1, 0x08, 0xb5, 0x54,
+	0xd8, 0xb3, 0x16, 0x09, 0xa6, 0x99, 0xdc, 0x75, 0x26, 0xb0, 0xea, 0x46, 0x09, 0x27, 0xe1, 0x8d,
+	0x9b, 0xcc, 0x30, 0x43, 0x6f, 0xa1, 0x41, 0xf3, 0x85, 0x6d, 0xf4, 0xeb, 0x03, 0x6b, 0xbc, 0x3e,
+	0x2c, 0x48, 0xb4, 0x2a, 0x57, 0x96, 0x38, 0xa7, 0x60, 0x69, 0xbb, 0x68, 0x1b, 0xe0, 0x07, 0x8d,
+	0xe6, 0x1e, 0xf7, 0xaf, 0x67, 0xd8, 0x36, 0xfa, 0xc6, 0xc0, 0x74, 0xcd, 0x7c, 0xe7, 0x32, 0xdf,
+	0x40, 0x5b, 0x60, 0xf2, 0x48, 0x26, 0x99, 0x5d, 0xeb, 0xd7, 0x07, 0xa6, 0xdb, 0xe6, 0x91, 0xc8,
+	0x31, 0xe7, 0x6f, 0x0d, 0xda, 0x67, 0x38, 0x63, 0xb1, 0x1f, 0x60, 0x64, 0x43, 0x8b, 0xdd, 0xfa,
+	0x74, 0x8a, 0xa7, 0x82, 0xa5, 0xed, 0x16, 0x21, 0xfa, 0x00, 0xed, 0x94, 0x84, 0x53, 0x7c, 0xaf,
+	0x28, 0xac, 0xf1, 0x4e, 0x29, 0xb0, 0x80, 0x0f, 0xaf, 0x54, 0xc5, 0x49, 0xc8, 0x69, 0xe6, 0x96,
+	0x00, 0xf4, 0x0e, 0x9a, 0xea, 0xf4, 0xba, 0x80, 0x6e, 0x3f, 0x85, 0x4a, 0x35, 0x12, 0xa8, 0x8a,
+	0xd1, 0x01, 0xd8, 0x14, 0x2f, 0x12, 0x42, 0xb1, 0x87, 0xef, 0xe3, 0x19, 0x09, 0x08, 0xf7, 0xa8,
+	0xbc, 0xb6, 0xbd, 0x22, 0xe4, 0x6d, 0xaa, 0xfc, 0x89, 0x4a, 0x2b, 0x53, 0x7a, 0xe7, 0xd0, 0xa9,
+	0x68, 0x41, 0xcf, 0xa0, 0x7e, 0x87, 0x33, 0x65, 0x4d, 0xbe, 0x44, 0x7b, 0xd0, 0x48, 0xfd, 0x59,
+	0x82, 0xed, 0x5a, 0xdf, 0x18, 0x58, 0xe3, 0xb5, 0x52, 0x92, 0x04, 0xba, 0x32, 0x3b, 0xa9, 0x1d,
+	0x18, 0xbd, 0x53, 0xb0, 0x34, 0x79, 0x4b, 0xb8, 0x76, 0xab, 0x5c, 0xdd, 0x92, 0x4b, 0xc0, 0x34,
+	0x2a, 0xe7, 0x97, 0x01, 0x4d, 0x79, 0x00, 0x42, 0xb0, 0xc2, 0xb3, 0xb8, 0x78, 0x2e, 0xb1, 0x46,
+	0xfb, 0xd0, 0x8c, 0x7d, 0xea, 0xcf, 0x0b, 0x8f, 0xb7, 0x1e, 0xa9, 0x1a, 0x7e, 0x15, 0x59, 0x65,
+	0x93, 0x2c, 0x45, 0xeb, 0xd0, 0x88, 0x7e, 0x86, 0x98, 0xda, 0x75, 0xc1, 0x24, 0x83, 0xde, 0x7b,
+	0xb0, 0xb4, 0xe2, 0x25, 0xa2, 0xd7, 0x75, 0xd1, 0xa6, 0x2e, 0xf2, 0x77, 0x0d, 0x1a, 0xf2, 0xe7,
+	0x2c, 0xd3, 0xf8, 0x11, 0xd6, 0x82, 0x68, 0x96, 0xcc, 0x43, 0xef, 0xd1, 0x87, 0xd8, 0x28, 0xc5,
+	0x1e, 0x8b, 0xbc, 0x32, 0xb2, 0x1b, 0x68, 0x11, 0x66, 0xe8, 0x10, 0xba, 0x7e, 0xc2, 0x23, 0x8f,
+	0x84, 0x01, 0xc5, 0x73, 0x1c, 0x72, 0xa1, 0xdb, 0x1a, 0x6f, 0x96, 0xf0, 0xa3, 0x84, 0x47, 0xa7,
+	0x45, 0xd6, 0xed, 0xf8, 0x7a, 0x88, 0xde, 0x40, 0x4b, 0x12, 0x32, 0x7b, 0xa5, 0x5f, 0xaf, 0xbc,
+	0x9c, 0x3c, 0xd6, 0x2d, 0xf2, 0x68, 0x13, 0x9a, 0x31, 0x09, 0x43, 0x3c, 0xb5, 0x1b, 0x42, 0xbf,
+	0x8a, 0xd0, 0x04, 0x5e, 0xaa, 0x1b, 0xcc, 0x08, 0xe3, 0x9e, 0x9f, 0xf0, 0xdb, 0x88, 0x12, 0xee,
+	0x73, 0x92, 0x62, 0xbb, 0x29, 0x3e, 0xd6, 0x0b, 0x59, 0x70, 0x4e, 0x18, 0x3f, 0xd2, 0xd3, 0x68,
+	0x1f, 0xac, 0x24, 0x24, 0x8b, 0x04, 0x7b, 0x77, 0x38, 0x63, 0x76, 0x4b, 0x48, 0x40, 0xa5, 0x84,
+	0x6f, 0x22, 0x77, 0x86, 0x33, 0x17, 0x92, 0x62, 0xc9, 0x9c, 0x4b, 0x58, 0xd5, 0x2d, 0xc9, 0x85,
+	0x49, 0x7e, 0x65, 0xac, 0x8a, 0x72, 0xbb, 0x43, 0x7f, 0x5e, 0xbc, 0x88, 0x58, 0xe7, 0x2d, 0x59,
+	0xdc, 0xb7, 0x2e, 0x5a, 0xb7, 0x08, 0x9d, 0x63, 0xe8, 0x54, 0x9c, 0xfa, 0x2f, 0x6d, 0x0f, 0xda,
+	0x0c, 0x2f, 0x12, 0x1c, 0x06, 0x05, 0x75, 0x19, 0x3b, 0x87, 0xd0, 0x3c, 0xae, 0x1e, 0x6e, 0x68,
+	0x87, 0xef, 0xa8, 0xf7, 0xcf, 0x51, 0xdd, 0xb1, 0x35, 0x94, 0xf3, 0xeb, 0x32, 0x8b, 0xb1, 0xfc,
+	0x0c, 0xce, 0x1e, 0x98, 0xe5, 0x95, 0x75, 0xa9, 0x46, 0x55, 0xea, 0x1f, 0x03, 0xe0, 0x82, 0xa6,
+	0x57, 0x17, 0xc2, 0x25, 0xf4, 0x09, 0xcc, 0x3b, 0xd5, 0xf8, 0xc5, 0xb8, 0x73, 0x4a, 0x0b, 0x1f,
+	0xea, 0xca, 0xe9, 0xa0, 0x3e, 0xfc, 0x03, 0x08, 0x4d, 0xa0, 0xa3, 0x26, 0x81, 0x27, 0x87, 0xa6,
+	0xec, 0xbc, 0x8d, 0x65, 0x43, 0x93, 0xb9, 0xab, 0x54, 0x8b, 0x7a, 0x5f, 0xa0, 0x5b, 0x25, 0x5e,
+	0xd2, 0x1c, 0xaf, 0xab, 0x1d, 0xfd, 0xfc, 0xc9, 0xc0, 0xd2, 0xfa, 0xe5, 0xf3, 0xab, 0xef, 0xbb,
+	0x29, 0xe1, 0x98, 0xb1, 0x21, 0x89, 0x46, 0x72, 0x35, 0xba, 0x89, 0x46, 0x29, 0x1f, 0x89, 0x49,
+	0x3f, 0x52, 0xd8, 0xeb, 0xa6, 0x08, 0xf7, 0xff, 0x0d, 0x00, 0xfa, 0xe5, 0xb5, 0x3a, 0x1f, 0x06,
+	0x00, 0x00,
 }
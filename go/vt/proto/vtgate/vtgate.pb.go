@@ -137,7 +137,23 @@ type Session struct {
 	// post_sessions contains sessions that have to be committed last.
 	PostSessions []*Session_ShardSession `protobuf:"bytes,10,rep,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
 	// last_insert_id keeps track of the last seen insert_id for this session
-	LastInsertId         uint64   `protobuf:"varint,11,opt,name=last_insert_id,json=lastInsertId,proto3" json:"last_insert_id,omitempty"`
+	LastInsertId uint64 `protobuf:"varint,11,opt,name=last_insert_id,json=lastInsertId,proto3" json:"last_insert_id,omitempty"`
+	// system_variables keeps track of the MySQL system variables set by the
+	// session, as sql expressions. They're applied to reserved connections.
+	SystemVariables map[string]string `protobuf:"bytes,12,rep,name=system_variables,json=systemVariables,proto3" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// savepoints are the savepoint statements of the current transaction.
+	// They're replayed on the shards that join the transaction later.
+	Savepoints []string `protobuf:"bytes,13,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	// lock_session is the shard session holding the reserved connection
+	// on which the advisory lock functions of the session are executed.
+	LockSession *Session_ShardSession `protobuf:"bytes,14,opt,name=lock_session,json=lockSession,proto3" json:"lock_session,omitempty"`
+	// found_rows keeps track of the number of rows returned by the last
+	// select, or the number of rows it would have returned without a limit
+	// if it used SQL_CALC_FOUND_ROWS.
+	FoundRows uint64 `protobuf:"varint,15,opt,name=found_rows,json=foundRows,proto3" json:"found_rows,omitempty"`
+	// temporary_tables are the temporary tables created by the session,
+	// as keyspace-qualified names. They live on the reserved connections.
+	TemporaryTables      []string `protobuf:"bytes,16,rep,name=temporary_tables,json=temporaryTables,proto3" json:"temporary_tables,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -245,12 +261,51 @@ func (m *Session) GetLastInsertId() uint64 {
 	return 0
 }
 
+func (m *Session) GetSystemVariables() map[string]string {
+	if m != nil {
+		return m.SystemVariables
+	}
+	return nil
+}
+
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetLockSession() *Session_ShardSession {
+	if m != nil {
+		return m.LockSession
+	}
+	return nil
+}
+
+func (m *Session) GetFoundRows() uint64 {
+	if m != nil {
+		return m.FoundRows
+	}
+	return 0
+}
+
+func (m *Session) GetTemporaryTables() []string {
+	if m != nil {
+		return m.TemporaryTables
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
-	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Target        *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	TransactionId int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// reserved_id is set if the connection carries the
+	// system_variables of the session. If the shard is
+	// in a transaction, transaction_id is the same.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Session_ShardSession) Reset()         { *m = Session_ShardSession{} }
@@ -292,6 +347,13 @@ func (m *Session_ShardSession) GetTransactionId() int64 {
 	return 0
 }
 
+func (m *Session_ShardSession) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -3639,6 +3701,7 @@ func init() {
 	proto.RegisterEnum("vtgate.TransactionMode", TransactionMode_name, TransactionMode_value)
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
@@ -3695,135 +3758,144 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 2067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
-	0x15, 0x4f, 0x77, 0xfb, 0xf3, 0xf9, 0x73, 0x6b, 0xbc, 0xbb, 0x8e, 0x33, 0xec, 0x38, 0x9d, 0x8c,
-	0xd6, 0xd9, 0xac, 0x3c, 0xc4, 0x81, 0x80, 0x50, 0x50, 0x98, 0xf1, 0x4e, 0x56, 0x56, 0x76, 0x3e,
-	0x28, 0x7b, 0x67, 0x01, 0x11, 0xb5, 0x7a, 0xec, 0xc2, 0xdb, 0x8c, 0xdd, 0xed, 0x74, 0x95, 0xbd,
-	0x0c, 0x07, 0x94, 0x0b, 0xe7, 0x88, 0x03, 0x12, 0x8a, 0x90, 0x10, 0x12, 0x12, 0x27, 0xae, 0x48,
-	0xc0, 0x85, 0x1b, 0x12, 0x17, 0xc4, 0x89, 0x3b, 0xff, 0x00, 0x12, 0x7f, 0x41, 0xd4, 0x55, 0xd5,
-	0x1f, 0xf6, 0x7c, 0x79, 0x3c, 0x3b, 0x23, 0xef, 0xc5, 0xea, 0xaa, 0xf7, 0xea, 0xd5, 0xab, 0xdf,
-	0xfb, 0xd5, 0xab, 0xd7, 0xe5, 0x86, 0xec, 0x84, 0xf5, 0x4d, 0x46, 0xea, 0x23, 0xd7, 0x61, 0x0e,
-	0x4a, 0x88, 0x56, 0xa5, 0x78, 0x68, 0xd9, 0x03, 0xa7, 0xdf, 0x33, 0x99, 0x29, 0x24, 0x95, 0xcc,
-	0x67, 0x63, 0xe2, 0x1e, 0xcb, 0x46, 0x9e, 0x39, 0x23, 0x27, 0x2a, 0x9c, 0x30, 0x77, 0xd4, 0x15,
-	0x0d, 0xfd, 0x97, 0x71, 0x48, 0xb6, 0x09, 0xa5, 0x96, 0x63, 0xa3, 0x75, 0xc8, 0x5b, 0xb6, 0xc1,
-	0x5c, 0xd3, 0xa6, 0x66, 0x97, 0x59, 0x8e, 0x5d, 0x56, 0xaa, 0x4a, 0x2d, 0x85, 0x73, 0x96, 0xdd,
-	0x09, 0x3b, 0x51, 0x13, 0xf2, 0xf4, 0xb9, 0xe9, 0xf6, 0x0c, 0x2a, 0xc6, 0xd1, 0xb2, 0x5a, 0xd5,
-	0x6a, 0x99, 0xc6, 0x6a, 0x5d, 0x7a, 0x27, 0xed, 0xd5, 0xdb, 0x9e, 0x96, 0x6c, 0xe0, 0x1c, 0x8d,
-	0xb4, 0x28, 0x7a, 0x03, 0xd2, 0xd4, 0xb2, 0xfb, 0x03, 0x62, 0xf4, 0x0e, 0xcb, 0x1a, 0x9f, 0x26,
-	0x25, 0x3a, 0x1e, 0x1d, 0xa2, 0x7b, 0x00, 0xe6, 0x98, 0x39, 0x5d, 0x67, 0x38, 0xb4, 0x58, 0x39,
-	0xc6, 0xa5, 0x91, 0x1e, 0xf4, 0x16, 0xe4, 0x98, 0xe9, 0xf6, 0x09, 0x33, 0x28, 0x73, 0x2d, 0xbb,
-	0x5f, 0x8e, 0x57, 0x95, 0x5a, 0x1a, 0x67, 0x45, 0x67, 0x9b, 0xf7, 0xa1, 0x0d, 0x48, 0x3a, 0x23,
-	0xc6, 0xfd, 0x4b, 0x54, 0x95, 0x5a, 0xa6, 0x71, 0xbb, 0x2e, 0x50, 0xd9, 0xfe, 0x19, 0xe9, 0x8e,
-	0x19, 0xd9, 0x13, 0x42, 0xec, 0x6b, 0xa1, 0x2d, 0x28, 0x46, 0xd6, 0x6e, 0x0c, 0x9d, 0x1e, 0x29,
-	0x27, 0xab, 0x4a, 0x2d, 0xdf, 0xb8, 0xeb, 0xaf, 0x2c, 0x02, 0xc3, 0x8e, 0xd3, 0x23, 0xb8, 0xc0,
-	0xa6, 0x3b, 0xd0, 0x06, 0xa4, 0x5e, 0x98, 0xae, 0x6d, 0xd9, 0x7d, 0x5a, 0x4e, 0x71, 0x54, 0x56,
-	0xe4, 0xac, 0xdf, 0xf7, 0x7e, 0x9f, 0x09, 0x19, 0x0e, 0x94, 0xd0, 0x47, 0x90, 0x1d, 0xb9, 0x24,
-	0x84, 0x32, 0x3d, 0x07, 0x94, 0x99, 0x91, 0x4b, 0x02, 0x20, 0x37, 0x21, 0x37, 0x72, 0x28, 0x0b,
-	0x2d, 0xc0, 0x1c, 0x16, 0xb2, 0xde, 0x90, 0xc0, 0xc4, 0xdb, 0x90, 0x1f, 0x98, 0x94, 0x19, 0x96,
-	0x4d, 0x89, 0xcb, 0x0c, 0xab, 0x57, 0xce, 0x54, 0x95, 0x5a, 0x0c, 0x67, 0xbd, 0xde, 0x16, 0xef,
-	0x6c, 0xf5, 0x2a, 0x3f, 0x86, 0x6c, 0xd4, 0x06, 0x5a, 0x87, 0x84, 0xc0, 0x9b, 0xb3, 0x24, 0xd3,
-	0xc8, 0xc9, 0x85, 0x76, 0x78, 0x27, 0x96, 0x42, 0x8f, 0x54, 0x51, 0x54, 0xad, 0x5e, 0x59, 0xad,
-	0x2a, 0x35, 0x0d, 0xe7, 0x22, 0xbd, 0xad, 0x9e, 0xfe, 0x2f, 0x15, 0xf2, 0x32, 0x30, 0x98, 0x7c,
-	0x36, 0x26, 0x94, 0xa1, 0x87, 0x90, 0xee, 0x9a, 0x83, 0x01, 0x71, 0xbd, 0x41, 0x62, 0x8e, 0x42,
-	0x5d, 0x70, 0xb7, 0xc9, 0xfb, 0x5b, 0x8f, 0x70, 0x4a, 0x68, 0xb4, 0x7a, 0xe8, 0x1d, 0x48, 0x4a,
-	0x08, 0xf8, 0x04, 0x42, 0x37, 0x8a, 0x00, 0xf6, 0xe5, 0xe8, 0x3e, 0xc4, 0xb9, 0xab, 0x9c, 0x77,
-	0x99, 0xc6, 0x2d, 0xe9, 0xf8, 0x96, 0x33, 0xb6, 0x7b, 0x3c, 0x4c, 0x58, 0xc8, 0xd1, 0x37, 0x21,
-	0xc3, 0xcc, 0xc3, 0x01, 0x61, 0x06, 0x3b, 0x1e, 0x11, 0x4e, 0xc4, 0x7c, 0xa3, 0x54, 0x0f, 0xf6,
-	0x53, 0x87, 0x0b, 0x3b, 0xc7, 0x23, 0x82, 0x81, 0x05, 0xcf, 0xe8, 0x21, 0x20, 0xdb, 0xf1, 0xe0,
-	0x9c, 0xda, 0x4b, 0x71, 0x4e, 0xe3, 0xa2, 0xed, 0xb0, 0xd6, 0xd4, 0x76, 0x5a, 0x87, 0xfc, 0x11,
-	0x39, 0xa6, 0x23, 0xb3, 0x4b, 0x0c, 0xbe, 0x47, 0x38, 0x5d, 0xd3, 0x38, 0xe7, 0xf7, 0x72, 0xd4,
-	0xa3, 0x74, 0x4e, 0xce, 0x43, 0x67, 0xfd, 0x0b, 0x05, 0x0a, 0x01, 0xa2, 0x74, 0xe4, 0xd8, 0x94,
-	0xa0, 0x75, 0x88, 0x13, 0xd7, 0x75, 0xdc, 0x19, 0x38, 0xf1, 0x7e, 0x73, 0xdb, 0xeb, 0xc6, 0x42,
-	0x7a, 0x19, 0x2c, 0x1f, 0x40, 0xc2, 0x25, 0x74, 0x3c, 0x60, 0x12, 0x4c, 0x14, 0xa5, 0x3b, 0xe6,
-	0x12, 0x2c, 0x35, 0xf4, 0xff, 0xaa, 0x50, 0x92, 0x1e, 0xf1, 0x35, 0xd1, 0xe5, 0x89, 0x74, 0x05,
-	0x52, 0x3e, 0xdc, 0x3c, 0xcc, 0x69, 0x1c, 0xb4, 0xd1, 0x1d, 0x48, 0xf0, 0xb8, 0xd0, 0x72, 0xbc,
-	0xaa, 0xd5, 0xd2, 0x58, 0xb6, 0x66, 0xd9, 0x91, 0xb8, 0x12, 0x3b, 0x92, 0x67, 0xb0, 0x23, 0x12,
-	0xf6, 0xd4, 0x5c, 0x61, 0xff, 0xb5, 0x02, 0xb7, 0x67, 0x40, 0x5e, 0x8a, 0xe0, 0xff, 0x5f, 0x85,
-	0xd7, 0xa5, 0x5f, 0x9f, 0x48, 0x64, 0x5b, 0xaf, 0x0a, 0x03, 0xde, 0x84, 0x6c, 0xb0, 0x45, 0x2d,
-	0xc9, 0x83, 0x2c, 0xce, 0x1c, 0x85, 0xeb, 0x58, 0x52, 0x32, 0x7c, 0xa9, 0x40, 0xe5, 0x34, 0xd0,
-	0x97, 0x82, 0x11, 0x9f, 0x6b, 0x70, 0x37, 0x74, 0x0e, 0x9b, 0x76, 0x9f, 0xbc, 0x22, 0x7c, 0x78,
-	0x0f, 0xe0, 0x88, 0x1c, 0x1b, 0x2e, 0x77, 0x99, 0xb3, 0xc1, 0x5b, 0x69, 0x10, 0x6b, 0x7f, 0x35,
-	0x38, 0x7d, 0xe4, 0xaf, 0x6b, 0x49, 0xf9, 0xf1, 0x1b, 0x05, 0xca, 0x27, 0x43, 0xb0, 0x14, 0xec,
-	0xf8, 0x4b, 0x2c, 0x60, 0xc7, 0xb6, 0xcd, 0x2c, 0x76, 0xfc, 0xca, 0x64, 0x8b, 0x87, 0x80, 0x08,
-	0xf7, 0xd8, 0xe8, 0x3a, 0x83, 0xf1, 0xd0, 0x36, 0x6c, 0x73, 0x48, 0x64, 0x89, 0x5a, 0x14, 0x92,
-	0x26, 0x17, 0xec, 0x9a, 0x43, 0x82, 0x7e, 0x00, 0x2b, 0x52, 0x7b, 0x2a, 0xc5, 0x24, 0x38, 0xa9,
-	0x6a, 0xbe, 0xa7, 0x67, 0x20, 0x51, 0xf7, 0x3b, 0xf0, 0x2d, 0x61, 0xe4, 0x93, 0xb3, 0x53, 0x52,
-	0xf2, 0x4a, 0x94, 0x4b, 0x5d, 0x4c, 0xb9, 0xf4, 0x3c, 0x94, 0xab, 0x1c, 0x42, 0xca, 0x77, 0x1a,
-	0xad, 0x41, 0x8c, 0xbb, 0xa6, 0x70, 0xd7, 0x32, 0x7e, 0x01, 0xe9, 0x79, 0xc4, 0x05, 0xa8, 0x04,
-	0xf1, 0x89, 0x39, 0x18, 0x13, 0x1e, 0xb8, 0x2c, 0x16, 0x0d, 0xb4, 0x06, 0x99, 0x08, 0x56, 0x3c,
-	0x56, 0x59, 0x0c, 0x61, 0x36, 0x8e, 0xd2, 0x3a, 0x82, 0xd8, 0x52, 0xd0, 0xfa, 0xdf, 0x2a, 0xac,
-	0x48, 0xd7, 0xb6, 0x4c, 0xd6, 0x7d, 0x7e, 0xed, 0x94, 0x7e, 0x17, 0x92, 0x9e, 0x37, 0x16, 0xa1,
-	0x65, 0x8d, 0x73, 0xea, 0x14, 0x52, 0xfb, 0x1a, 0x8b, 0x16, 0xbc, 0xeb, 0x90, 0x37, 0xe9, 0x29,
-	0xc5, 0x6e, 0xce, 0xa4, 0x37, 0x51, 0xe9, 0x7e, 0xa9, 0x04, 0x75, 0xa5, 0xc4, 0xf4, 0xda, 0x42,
-	0xfd, 0x75, 0x48, 0x8a, 0x40, 0xfa, 0x68, 0xde, 0x91, 0xbe, 0x89, 0x30, 0x3f, 0xb3, 0xd8, 0x73,
-	0x61, 0xda, 0x57, 0xd3, 0x6d, 0x28, 0x70, 0xa4, 0xf9, 0xda, 0x38, 0xdc, 0x61, 0x96, 0x51, 0x2e,
-	0x91, 0x65, 0xd4, 0x33, 0xab, 0x52, 0x2d, 0x5a, 0x95, 0xea, 0x7f, 0x0e, 0xeb, 0x2c, 0x0e, 0xc6,
-	0x0d, 0x55, 0xda, 0xef, 0xcd, 0xd2, 0x2c, 0x78, 0x67, 0x9e, 0x59, 0xfd, 0x4d, 0x91, 0xed, 0xb2,
-	0xaf, 0xff, 0xfa, 0x6f, 0xc3, 0x5a, 0x69, 0x0a, 0xb8, 0x6b, 0xe3, 0xd2, 0xc3, 0x59, 0x2e, 0x9d,
-	0x96, 0x37, 0x02, 0x1e, 0xfd, 0x02, 0x4a, 0x1c, 0xc9, 0x30, 0xc3, 0xbf, 0x44, 0x32, 0xcd, 0x16,
-	0xb8, 0xda, 0x89, 0x02, 0x57, 0xff, 0xbb, 0x0a, 0xf7, 0xa2, 0xf0, 0xdc, 0x64, 0x11, 0xff, 0xc1,
-	0x2c, 0xb9, 0x56, 0xa7, 0xc8, 0x35, 0x03, 0xc9, 0xd2, 0x32, 0xec, 0xf7, 0x0a, 0xac, 0x9d, 0x09,
-	0xe1, 0x92, 0xd0, 0xec, 0x8f, 0x2a, 0x94, 0xda, 0xcc, 0x25, 0xe6, 0xf0, 0x4a, 0xb7, 0x31, 0x01,
-	0x2b, 0xd5, 0xcb, 0x5d, 0xb1, 0x68, 0xf3, 0x87, 0x68, 0xe6, 0x28, 0x89, 0x5d, 0x70, 0x94, 0xc4,
-	0xe7, 0xba, 0x03, 0x8c, 0xe0, 0x9a, 0x38, 0x1f, 0x57, 0xbd, 0x09, 0xb7, 0x67, 0x80, 0x92, 0x21,
-	0x0c, 0xcb, 0x01, 0xe5, 0xc2, 0x72, 0xe0, 0x0b, 0x15, 0x2a, 0x53, 0x56, 0xae, 0x92, 0xae, 0xe7,
-	0x06, 0x3d, 0x9a, 0x0a, 0xb4, 0x33, 0xcf, 0x95, 0xd8, 0x79, 0xb7, 0x1d, 0xf1, 0x39, 0x03, 0x75,
-	0xe9, 0x4d, 0xd2, 0x82, 0x37, 0x4e, 0x05, 0x64, 0x01, 0x70, 0x7f, 0xa7, 0xc2, 0xda, 0x94, 0xad,
-	0x2b, 0xe7, 0xac, 0x97, 0x82, 0xf0, 0x6c, 0xb2, 0x8d, 0x5d, 0x78, 0x9b, 0x70, 0x6d, 0x60, 0xef,
-	0x42, 0xf5, 0x6c, 0x80, 0x16, 0x40, 0xfc, 0x4f, 0x2a, 0x7c, 0x6d, 0xd6, 0xe0, 0x55, 0x5e, 0xec,
-	0x5f, 0x0a, 0xde, 0xd3, 0x6f, 0xeb, 0xb1, 0x05, 0xde, 0xd6, 0xaf, 0x0d, 0xff, 0x27, 0x70, 0xef,
-	0x2c, 0xb8, 0x16, 0x40, 0xff, 0x87, 0x90, 0xdd, 0x22, 0x7d, 0xcb, 0x5e, 0x0c, 0xeb, 0xa9, 0x7f,
-	0x64, 0xd4, 0xe9, 0x7f, 0x64, 0xf4, 0xef, 0x40, 0x4e, 0x9a, 0x96, 0x7e, 0x45, 0x12, 0xa5, 0x72,
-	0x41, 0xa2, 0xfc, 0x5c, 0x81, 0x5c, 0x93, 0xff, 0x71, 0x73, 0xed, 0x85, 0xc2, 0x1d, 0x48, 0x98,
-	0xcc, 0x19, 0x5a, 0x5d, 0xf9, 0x97, 0x92, 0x6c, 0xe9, 0x45, 0xc8, 0xfb, 0x1e, 0x08, 0xff, 0xf5,
-	0x9f, 0x42, 0x01, 0x3b, 0x83, 0xc1, 0xa1, 0xd9, 0x3d, 0xba, 0x6e, 0xaf, 0x74, 0x04, 0xc5, 0x70,
-	0x2e, 0x39, 0xff, 0xa7, 0xf0, 0x3a, 0x26, 0xd4, 0x19, 0x4c, 0x48, 0xa4, 0xa4, 0x58, 0xcc, 0x13,
-	0x04, 0xb1, 0x1e, 0x93, 0xff, 0xab, 0xa4, 0x31, 0x7f, 0xd6, 0xff, 0xa6, 0x40, 0x69, 0x87, 0x50,
-	0x6a, 0xf6, 0x89, 0x20, 0xd8, 0x62, 0xa6, 0xcf, 0xab, 0x19, 0x4b, 0x10, 0x17, 0x27, 0xaf, 0xd8,
-	0x6f, 0xa2, 0x81, 0x36, 0x20, 0x1d, 0x6c, 0x36, 0x7e, 0x26, 0x9f, 0xbe, 0xd7, 0x52, 0xfe, 0x5e,
-	0xf3, 0xbc, 0x8f, 0xdc, 0x8f, 0xf0, 0x67, 0xfd, 0x57, 0x0a, 0xdc, 0x92, 0xde, 0x6f, 0x2e, 0x1a,
-	0x9f, 0xf3, 0x5c, 0xf7, 0xe7, 0xd4, 0xc2, 0x39, 0xd1, 0x3d, 0xd0, 0xfc, 0x64, 0x9c, 0x69, 0x64,
-	0xe5, 0x2e, 0x3b, 0x30, 0x07, 0x63, 0x82, 0x3d, 0x81, 0xbe, 0x03, 0xd9, 0x56, 0xa4, 0xd2, 0x44,
-	0xab, 0xa0, 0x06, 0x6e, 0x4c, 0xab, 0xab, 0x56, 0x6f, 0xf6, 0x8a, 0x42, 0x3d, 0x71, 0x45, 0xf1,
-	0x57, 0x05, 0x56, 0xc3, 0x25, 0x5e, 0xf9, 0x60, 0xba, 0xec, 0x6a, 0x3f, 0x84, 0x82, 0xd5, 0x33,
-	0x4e, 0x1c, 0x43, 0x99, 0x46, 0xc9, 0x67, 0x71, 0x74, 0xb1, 0x38, 0x67, 0x45, 0x5a, 0x54, 0x5f,
-	0x85, 0xca, 0x69, 0xe4, 0x95, 0xd4, 0xfe, 0x9f, 0x0a, 0xb7, 0xda, 0xa3, 0x81, 0xc5, 0x64, 0x8e,
-	0x7a, 0xd9, 0xeb, 0x99, 0xfb, 0x92, 0xee, 0x4d, 0xc8, 0x52, 0xcf, 0x0f, 0x79, 0x0f, 0x27, 0x0b,
-	0x9a, 0x0c, 0xef, 0x13, 0x37, 0x70, 0x5e, 0x9c, 0x7c, 0x95, 0xb1, 0xcd, 0x38, 0x09, 0x35, 0x0c,
-	0x52, 0x63, 0x6c, 0x33, 0xf4, 0x0d, 0xb8, 0x6b, 0x8f, 0x87, 0x86, 0xeb, 0xbc, 0xa0, 0xc6, 0x88,
-	0xb8, 0x06, 0xb7, 0x6c, 0x8c, 0x4c, 0x97, 0xf1, 0x14, 0xaf, 0xe1, 0x15, 0x7b, 0x3c, 0xc4, 0xce,
-	0x0b, 0xba, 0x4f, 0x5c, 0x3e, 0xf9, 0xbe, 0xe9, 0x32, 0xf4, 0x3d, 0x48, 0x9b, 0x83, 0xbe, 0xe3,
-	0x5a, 0xec, 0xf9, 0x50, 0x5e, 0xbc, 0xe9, 0xd2, 0xcd, 0x13, 0xc8, 0xd4, 0x37, 0x7d, 0x4d, 0x1c,
-	0x0e, 0x42, 0xef, 0x02, 0x1a, 0x53, 0x62, 0x08, 0xe7, 0xc4, 0xa4, 0x93, 0x86, 0xbc, 0x85, 0x2b,
-	0x8c, 0x29, 0x09, 0xcd, 0x1c, 0x34, 0xf4, 0x7f, 0x68, 0x80, 0xa2, 0x76, 0x65, 0x8e, 0xfe, 0x16,
-	0x24, 0xf8, 0x78, 0x5a, 0x56, 0x78, 0x6c, 0xd7, 0x82, 0x0c, 0x75, 0x42, 0xb7, 0xee, 0xb9, 0x8d,
-	0xa5, 0x7a, 0xe5, 0x53, 0xc8, 0xfa, 0x3b, 0x95, 0x2f, 0x27, 0x1a, 0x0d, 0xe5, 0xdc, 0xd3, 0x55,
-	0x9d, 0xe3, 0x74, 0xad, 0x7c, 0x04, 0x69, 0x5e, 0xd5, 0x5d, 0x68, 0x3b, 0xac, 0x45, 0xd5, 0x68,
-	0x2d, 0x5a, 0xf9, 0x8f, 0x02, 0x31, 0x3e, 0x78, 0xee, 0x97, 0xdf, 0x1d, 0xfe, 0xbe, 0x20, 0xbc,
-	0x14, 0xd1, 0x13, 0x49, 0xfb, 0xfe, 0x39, 0x90, 0x44, 0x21, 0xc0, 0xd9, 0xa3, 0x28, 0x20, 0x4d,
-	0x00, 0xf1, 0x09, 0x04, 0x37, 0x25, 0x78, 0xf8, 0xf6, 0x39, 0xa6, 0x82, 0xe5, 0xe2, 0x34, 0x0d,
-	0x56, 0x8e, 0x20, 0x46, 0xad, 0x9f, 0x8b, 0x2c, 0xa9, 0x61, 0xfe, 0xac, 0xbf, 0x0f, 0xb7, 0x1f,
-	0x13, 0xd6, 0x76, 0x27, 0xfe, 0x76, 0xf3, 0xb7, 0xcf, 0x39, 0x30, 0xe9, 0x18, 0xee, 0xcc, 0x0e,
-	0x92, 0x0c, 0xf8, 0x36, 0x64, 0xa9, 0x3b, 0x31, 0xa6, 0x46, 0x7a, 0x55, 0x49, 0x10, 0x9e, 0xe8,
-	0xa0, 0x0c, 0x0d, 0x1b, 0xfa, 0x3f, 0x15, 0xc8, 0x1f, 0x5c, 0xe5, 0xe8, 0x98, 0x29, 0xa1, 0xd4,
-	0x39, 0x4b, 0xa8, 0xfb, 0x10, 0x9f, 0xf4, 0x99, 0xbc, 0xd5, 0xf5, 0x22, 0x1a, 0xf9, 0xb6, 0xe5,
-	0xe0, 0x31, 0xb3, 0x7a, 0x58, 0xc8, 0xbd, 0xc2, 0xe8, 0x27, 0xd6, 0x80, 0x11, 0x37, 0x38, 0x65,
-	0x22, 0x9a, 0x1f, 0x73, 0x09, 0x96, 0x1a, 0xfa, 0x77, 0xa1, 0x10, 0xac, 0x25, 0xac, 0xab, 0xc8,
-	0x84, 0xd8, 0xc1, 0xde, 0x98, 0x1a, 0x7e, 0xb0, 0xed, 0x89, 0xb0, 0xd4, 0xd0, 0xff, 0xa0, 0xc2,
-	0xca, 0xd3, 0x51, 0xcf, 0x64, 0xcb, 0x7e, 0x96, 0x2e, 0x58, 0xb6, 0xae, 0x42, 0x9a, 0x59, 0x43,
-	0x42, 0x99, 0x39, 0x1c, 0xc9, 0xac, 0x16, 0x76, 0x78, 0x11, 0xe1, 0x38, 0xc8, 0xcb, 0x58, 0x7f,
-	0x8f, 0x71, 0x88, 0x3a, 0xce, 0x11, 0xb1, 0xb1, 0x90, 0xeb, 0x47, 0x50, 0x9a, 0x46, 0x49, 0x42,
-	0x5d, 0xf3, 0x0d, 0x4c, 0x57, 0xb0, 0xb2, 0xf0, 0xe5, 0x48, 0x0b, 0x05, 0xf4, 0x0e, 0x14, 0xbd,
-	0x52, 0x76, 0x48, 0x8c, 0xd0, 0x1f, 0xf1, 0xb5, 0x48, 0x41, 0xf4, 0x77, 0xfc, 0xee, 0x07, 0x8f,
-	0xa0, 0x30, 0xf3, 0x31, 0x0e, 0x2a, 0x40, 0xe6, 0xe9, 0x6e, 0x7b, 0x7f, 0xbb, 0xd9, 0xfa, 0xb8,
-	0xb5, 0xfd, 0xa8, 0xf8, 0x1a, 0x02, 0x48, 0xb4, 0x5b, 0xbb, 0x8f, 0x9f, 0x6c, 0x17, 0x15, 0x94,
-	0x86, 0xf8, 0xce, 0xd3, 0x27, 0x9d, 0x56, 0x51, 0xf5, 0x1e, 0x3b, 0xcf, 0xf6, 0xf6, 0x9b, 0x45,
-	0xed, 0xc1, 0x87, 0x90, 0x11, 0x75, 0xe1, 0x9e, 0xdb, 0x23, 0xae, 0x37, 0x60, 0x77, 0x0f, 0xef,
-	0x6c, 0x3e, 0x29, 0xbe, 0x86, 0x92, 0xa0, 0xed, 0x63, 0x6f, 0x64, 0x0a, 0x62, 0xfb, 0x7b, 0xed,
-	0x4e, 0x51, 0x45, 0x79, 0x80, 0xcd, 0xa7, 0x9d, 0xbd, 0xe6, 0xde, 0xce, 0x4e, 0xab, 0x53, 0xd4,
-	0xb6, 0x3e, 0x80, 0x82, 0xe5, 0xd4, 0x27, 0x16, 0x23, 0x94, 0x8a, 0xcf, 0xa9, 0x7e, 0xf4, 0x96,
-	0x6c, 0x59, 0xce, 0x86, 0x78, 0xda, 0xe8, 0x3b, 0x1b, 0x13, 0xb6, 0xc1, 0xa5, 0x1b, 0x22, 0x41,
-	0x1c, 0x26, 0x78, 0xeb, 0xfd, 0xaf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x03, 0xdd, 0xba, 0xce,
-	0x25, 0x00, 0x00,
+	// 2212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x8f, 0x1b, 0xc7,
+	0xf1, 0xf7, 0xcc, 0xf0, 0x59, 0x7c, 0xaa, 0x45, 0x49, 0x34, 0xbd, 0xd6, 0xd2, 0x63, 0x2d, 0x44,
+	0xcb, 0x02, 0xf7, 0x6f, 0xfa, 0x1f, 0xc7, 0x30, 0x1c, 0x38, 0xbb, 0xd4, 0x5a, 0x20, 0xac, 0x7d,
+	0xa4, 0x97, 0x5a, 0x25, 0x01, 0x8c, 0xc1, 0x2c, 0xd9, 0xa6, 0x26, 0x24, 0x67, 0xe8, 0xe9, 0x26,
+	0x15, 0x06, 0x48, 0xe0, 0x6f, 0x60, 0xe4, 0x10, 0x20, 0x30, 0x02, 0x04, 0x01, 0x02, 0xe4, 0x94,
+	0x6b, 0x80, 0x24, 0x97, 0xdc, 0x02, 0xe4, 0x12, 0xe4, 0x94, 0x7b, 0x0e, 0xb9, 0x06, 0xc8, 0x27,
+	0x08, 0xa6, 0xbb, 0xe7, 0x41, 0xee, 0x8b, 0xfb, 0x12, 0xa8, 0x0b, 0x31, 0x5d, 0x5d, 0xdd, 0x5d,
+	0xf5, 0xab, 0x5f, 0x57, 0x17, 0x7b, 0x06, 0xb2, 0x13, 0xd6, 0x33, 0x19, 0xa9, 0x8f, 0x5c, 0x87,
+	0x39, 0x28, 0x21, 0x5a, 0x95, 0xe2, 0xa1, 0x65, 0x0f, 0x9c, 0x5e, 0xd7, 0x64, 0xa6, 0xe8, 0xa9,
+	0x64, 0xbe, 0x1c, 0x13, 0x77, 0x2a, 0x1b, 0x79, 0xe6, 0x8c, 0x9c, 0x68, 0xe7, 0x84, 0xb9, 0xa3,
+	0x8e, 0x68, 0xe8, 0xff, 0x4e, 0x42, 0x72, 0x9f, 0x50, 0x6a, 0x39, 0x36, 0x5a, 0x83, 0xbc, 0x65,
+	0x1b, 0xcc, 0x35, 0x6d, 0x6a, 0x76, 0x98, 0xe5, 0xd8, 0x65, 0xa5, 0xaa, 0xd4, 0x52, 0x38, 0x67,
+	0xd9, 0xed, 0x50, 0x88, 0x9a, 0x90, 0xa7, 0xcf, 0x4d, 0xb7, 0x6b, 0x50, 0x31, 0x8e, 0x96, 0xd5,
+	0xaa, 0x56, 0xcb, 0x34, 0x56, 0xea, 0xd2, 0x3a, 0x39, 0x5f, 0x7d, 0xdf, 0xd3, 0x92, 0x0d, 0x9c,
+	0xa3, 0x91, 0x16, 0x45, 0x6f, 0x40, 0x9a, 0x5a, 0x76, 0x6f, 0x40, 0x8c, 0xee, 0x61, 0x59, 0xe3,
+	0xcb, 0xa4, 0x84, 0xe0, 0xd1, 0x21, 0xba, 0x0b, 0x60, 0x8e, 0x99, 0xd3, 0x71, 0x86, 0x43, 0x8b,
+	0x95, 0x63, 0xbc, 0x37, 0x22, 0x41, 0x6f, 0x43, 0x8e, 0x99, 0x6e, 0x8f, 0x30, 0x83, 0x32, 0xd7,
+	0xb2, 0x7b, 0xe5, 0x78, 0x55, 0xa9, 0xa5, 0x71, 0x56, 0x08, 0xf7, 0xb9, 0x0c, 0xad, 0x43, 0xd2,
+	0x19, 0x31, 0x6e, 0x5f, 0xa2, 0xaa, 0xd4, 0x32, 0x8d, 0x5b, 0x75, 0x81, 0xca, 0xd6, 0x8f, 0x49,
+	0x67, 0xcc, 0xc8, 0xae, 0xe8, 0xc4, 0xbe, 0x16, 0xda, 0x84, 0x62, 0xc4, 0x77, 0x63, 0xe8, 0x74,
+	0x49, 0x39, 0x59, 0x55, 0x6a, 0xf9, 0xc6, 0x1d, 0xdf, 0xb3, 0x08, 0x0c, 0xdb, 0x4e, 0x97, 0xe0,
+	0x02, 0x9b, 0x15, 0xa0, 0x75, 0x48, 0xbd, 0x30, 0x5d, 0xdb, 0xb2, 0x7b, 0xb4, 0x9c, 0xe2, 0xa8,
+	0xdc, 0x94, 0xab, 0x7e, 0xcf, 0xfb, 0x7d, 0x26, 0xfa, 0x70, 0xa0, 0x84, 0x3e, 0x81, 0xec, 0xc8,
+	0x25, 0x21, 0x94, 0xe9, 0x05, 0xa0, 0xcc, 0x8c, 0x5c, 0x12, 0x00, 0xb9, 0x01, 0xb9, 0x91, 0x43,
+	0x59, 0x38, 0x03, 0x2c, 0x30, 0x43, 0xd6, 0x1b, 0x12, 0x4c, 0x71, 0x0f, 0xf2, 0x03, 0x93, 0x32,
+	0xc3, 0xb2, 0x29, 0x71, 0x99, 0x61, 0x75, 0xcb, 0x99, 0xaa, 0x52, 0x8b, 0xe1, 0xac, 0x27, 0x6d,
+	0x71, 0x61, 0xab, 0x8b, 0x76, 0xa1, 0x48, 0xa7, 0x94, 0x91, 0xa1, 0x31, 0x31, 0x5d, 0xcb, 0x3c,
+	0x1c, 0x10, 0x5a, 0xce, 0xf2, 0xb5, 0xee, 0x1d, 0x59, 0x8b, 0xeb, 0x1d, 0xf8, 0x6a, 0x5b, 0x36,
+	0x73, 0xa7, 0xb8, 0x40, 0x67, 0xa5, 0x5e, 0x94, 0xa9, 0x39, 0x21, 0x23, 0xc7, 0xb2, 0x19, 0x2d,
+	0xe7, 0xaa, 0x5a, 0x2d, 0x8d, 0x23, 0x12, 0x0f, 0x9a, 0x81, 0xd3, 0xe9, 0xfb, 0x9e, 0x95, 0xf3,
+	0x55, 0xe5, 0x4c, 0xc7, 0x32, 0xde, 0x08, 0xd9, 0x40, 0x6f, 0x02, 0x7c, 0xe1, 0x8c, 0xed, 0xae,
+	0xe1, 0x3a, 0x2f, 0x68, 0xb9, 0xc0, 0x7d, 0x4a, 0x73, 0x09, 0x76, 0x5e, 0x50, 0xf4, 0x0e, 0x14,
+	0x19, 0x19, 0x8e, 0x1c, 0xd7, 0x74, 0xa7, 0x06, 0x13, 0x0e, 0x15, 0xb9, 0x15, 0x85, 0x40, 0xde,
+	0xe6, 0xe2, 0xca, 0x4f, 0x21, 0x1b, 0x5d, 0x06, 0xad, 0x41, 0x42, 0x70, 0x8d, 0xef, 0x90, 0x4c,
+	0x23, 0x27, 0x83, 0xdc, 0xe6, 0x42, 0x2c, 0x3b, 0xbd, 0x0d, 0x15, 0x65, 0x94, 0xd5, 0x2d, 0xab,
+	0x55, 0xa5, 0xa6, 0xe1, 0x5c, 0x44, 0xda, 0xea, 0xa2, 0x55, 0xc8, 0xb8, 0x84, 0x12, 0x77, 0x42,
+	0xba, 0x9e, 0x8e, 0xc6, 0x75, 0xc0, 0x17, 0xb5, 0xba, 0x95, 0x4d, 0x28, 0x1d, 0x07, 0x29, 0x2a,
+	0x82, 0xd6, 0x27, 0x53, 0x6e, 0x43, 0x1a, 0x7b, 0x8f, 0xa8, 0x04, 0xf1, 0x89, 0x39, 0x18, 0x13,
+	0xbe, 0x50, 0x1a, 0x8b, 0xc6, 0x47, 0xea, 0x87, 0x8a, 0xfe, 0x77, 0x15, 0xf2, 0x92, 0xf9, 0x98,
+	0x7c, 0x39, 0x26, 0x94, 0xa1, 0x87, 0x90, 0xee, 0x98, 0x83, 0x01, 0x71, 0xbd, 0x55, 0x85, 0x23,
+	0x85, 0xba, 0x48, 0x0e, 0x4d, 0x2e, 0x6f, 0x3d, 0xc2, 0x29, 0xa1, 0xd1, 0xea, 0xa2, 0x77, 0x20,
+	0xe9, 0x47, 0x42, 0x0d, 0x74, 0xa3, 0x91, 0xc0, 0x7e, 0x3f, 0xba, 0x0f, 0x71, 0x8e, 0x07, 0x77,
+	0x25, 0xd3, 0xb8, 0x21, 0xd1, 0xd9, 0xf4, 0xa0, 0xe7, 0xfb, 0x00, 0x8b, 0x7e, 0xf4, 0x2d, 0xc8,
+	0x70, 0xe0, 0x99, 0xc1, 0xa6, 0x23, 0xc2, 0x77, 0x7a, 0xbe, 0x51, 0xaa, 0x07, 0x09, 0x8b, 0xc3,
+	0xcf, 0xda, 0xd3, 0x11, 0xc1, 0xc0, 0x82, 0x67, 0xf4, 0x10, 0x90, 0xed, 0x30, 0x63, 0x2e, 0x59,
+	0xc5, 0x79, 0x9e, 0x28, 0xda, 0x0e, 0x6b, 0xcd, 0xe4, 0xab, 0x35, 0xc8, 0xf7, 0xc9, 0x94, 0x8e,
+	0xcc, 0x0e, 0x31, 0x78, 0x12, 0xe2, 0xf9, 0x20, 0x8d, 0x73, 0xbe, 0x94, 0x87, 0x36, 0x9a, 0x2f,
+	0x92, 0x8b, 0xe4, 0x0b, 0xfd, 0x6b, 0x05, 0x0a, 0x01, 0xa2, 0x74, 0xe4, 0xd8, 0x94, 0xa0, 0x35,
+	0x88, 0x13, 0xd7, 0x75, 0xdc, 0x39, 0x38, 0xf1, 0x5e, 0x73, 0xcb, 0x13, 0x63, 0xd1, 0x7b, 0x1e,
+	0x2c, 0x1f, 0x40, 0xc2, 0x25, 0x74, 0x3c, 0x60, 0x12, 0x4c, 0x14, 0xcd, 0x27, 0x98, 0xf7, 0x60,
+	0xa9, 0xa1, 0xff, 0x4b, 0x85, 0x92, 0xb4, 0x88, 0xfb, 0x44, 0x97, 0x27, 0xd2, 0x15, 0x48, 0xf9,
+	0x70, 0xf3, 0x30, 0xa7, 0x71, 0xd0, 0x46, 0xb7, 0x21, 0xc1, 0xe3, 0x42, 0xcb, 0x71, 0xbe, 0xfd,
+	0x64, 0x6b, 0x9e, 0x1d, 0x89, 0x4b, 0xb1, 0x23, 0x79, 0x02, 0x3b, 0x22, 0x61, 0x4f, 0x2d, 0x14,
+	0xf6, 0x5f, 0x28, 0x70, 0x6b, 0x0e, 0xe4, 0xa5, 0x08, 0xfe, 0x7f, 0x55, 0x78, 0x5d, 0xda, 0xf5,
+	0x99, 0x44, 0xb6, 0xf5, 0xaa, 0x30, 0xe0, 0x2d, 0xc8, 0x06, 0x5b, 0xd4, 0x92, 0x3c, 0xc8, 0xe2,
+	0x4c, 0x3f, 0xf4, 0x63, 0x49, 0xc9, 0xf0, 0x8d, 0x02, 0x95, 0xe3, 0x40, 0x5f, 0x0a, 0x46, 0x7c,
+	0xa5, 0xc1, 0x9d, 0xd0, 0x38, 0x6c, 0xda, 0x3d, 0xf2, 0x8a, 0xf0, 0xe1, 0x3d, 0x80, 0x3e, 0x99,
+	0x1a, 0x2e, 0x37, 0x99, 0xb3, 0xc1, 0xf3, 0x34, 0x88, 0xb5, 0xef, 0x0d, 0x4e, 0xf7, 0xe5, 0xd3,
+	0xb2, 0xf2, 0xe3, 0x97, 0x0a, 0x94, 0x8f, 0x86, 0x60, 0x29, 0xd8, 0xf1, 0xc7, 0x58, 0xc0, 0x8e,
+	0x2d, 0x9b, 0x59, 0x6c, 0xfa, 0xca, 0x64, 0x8b, 0x87, 0x80, 0x08, 0xb7, 0xd8, 0xe8, 0x38, 0x83,
+	0xf1, 0xd0, 0x36, 0x6c, 0x73, 0x48, 0xe4, 0x7f, 0x80, 0xa2, 0xe8, 0x69, 0xf2, 0x8e, 0x1d, 0x73,
+	0x48, 0xd0, 0xf7, 0xe1, 0xa6, 0xd4, 0x9e, 0x49, 0x31, 0x09, 0x4e, 0xaa, 0x9a, 0x6f, 0xe9, 0x09,
+	0x48, 0xd4, 0x7d, 0x01, 0xbe, 0x21, 0x26, 0xf9, 0xec, 0xe4, 0x94, 0x94, 0xbc, 0x14, 0xe5, 0x52,
+	0x67, 0x53, 0x2e, 0xbd, 0x08, 0xe5, 0x2a, 0x87, 0x90, 0xf2, 0x8d, 0x46, 0xab, 0x10, 0xe3, 0xa6,
+	0x29, 0xdc, 0xb4, 0x8c, 0x5f, 0xa5, 0x7a, 0x16, 0xf1, 0x8e, 0xd9, 0x7a, 0x31, 0x2b, 0xeb, 0x45,
+	0xaf, 0x20, 0x8d, 0x60, 0xc5, 0x63, 0x95, 0xc5, 0x10, 0x66, 0xe3, 0x28, 0xad, 0x23, 0x88, 0x2d,
+	0x05, 0xad, 0xff, 0xa1, 0xc2, 0x4d, 0x69, 0xda, 0xa6, 0xc9, 0x3a, 0xcf, 0xaf, 0x9d, 0xd2, 0xef,
+	0x42, 0xd2, 0xb3, 0xc6, 0x22, 0xb4, 0xac, 0x55, 0xb5, 0xe3, 0x49, 0xed, 0x6b, 0x5c, 0xb4, 0xe0,
+	0x5d, 0x83, 0xbc, 0x49, 0x8f, 0x29, 0x76, 0x73, 0x26, 0x7d, 0x19, 0x95, 0xee, 0x37, 0x0a, 0x94,
+	0x66, 0x31, 0xbd, 0xb6, 0x50, 0xff, 0x1f, 0x24, 0x45, 0x20, 0x7d, 0x34, 0x6f, 0x4b, 0xdb, 0x44,
+	0x98, 0x9f, 0x59, 0xec, 0xb9, 0x98, 0xda, 0x57, 0xd3, 0x6d, 0x28, 0x70, 0xa4, 0xb9, 0x6f, 0x1c,
+	0xee, 0x30, 0xcb, 0x28, 0xe7, 0xc8, 0x32, 0xea, 0x89, 0x55, 0xa9, 0x16, 0xad, 0x4a, 0xf5, 0x3f,
+	0x84, 0x75, 0x16, 0x07, 0xe3, 0x25, 0x55, 0xda, 0xef, 0xcd, 0xd3, 0x2c, 0xb8, 0x94, 0x98, 0xf3,
+	0xfe, 0x65, 0x91, 0xed, 0xbc, 0xf7, 0x2b, 0xfa, 0xaf, 0xc2, 0x5a, 0x69, 0x06, 0xb8, 0x6b, 0xe3,
+	0xd2, 0xc3, 0x79, 0x2e, 0x1d, 0x97, 0x37, 0x02, 0x1e, 0xfd, 0x0c, 0x4a, 0x1c, 0xc9, 0x30, 0xc3,
+	0x5f, 0x21, 0x99, 0xe6, 0x0b, 0x5c, 0xed, 0x48, 0x81, 0xab, 0xff, 0x45, 0x85, 0xbb, 0x51, 0x78,
+	0x5e, 0x66, 0x11, 0xff, 0xc1, 0x3c, 0xb9, 0x56, 0x66, 0xc8, 0x35, 0x07, 0xc9, 0xd2, 0x32, 0xec,
+	0x37, 0x0a, 0xac, 0x9e, 0x08, 0xe1, 0x92, 0xd0, 0xec, 0x77, 0x2a, 0x94, 0xf6, 0x99, 0x4b, 0xcc,
+	0xe1, 0xa5, 0x6e, 0x63, 0x02, 0x56, 0xaa, 0xe7, 0xbb, 0x62, 0xd1, 0x16, 0x0f, 0xd1, 0xdc, 0x51,
+	0x12, 0x3b, 0xe3, 0x28, 0x89, 0x2f, 0x74, 0xc9, 0x1a, 0xc1, 0x35, 0x71, 0x3a, 0xae, 0x7a, 0x13,
+	0x6e, 0xcd, 0x01, 0x25, 0x43, 0x18, 0x96, 0x03, 0xca, 0x99, 0xe5, 0xc0, 0xd7, 0x2a, 0x54, 0x66,
+	0x66, 0xb9, 0x4c, 0xba, 0x5e, 0x18, 0xf4, 0x68, 0x2a, 0xd0, 0x4e, 0x3c, 0x57, 0x62, 0xa7, 0xdd,
+	0x76, 0xc4, 0x17, 0x0c, 0xd4, 0xb9, 0x37, 0x49, 0x0b, 0xde, 0x38, 0x16, 0x90, 0x0b, 0x80, 0xfb,
+	0x6b, 0x15, 0x56, 0x67, 0xe6, 0xba, 0x74, 0xce, 0xba, 0x12, 0x84, 0xe7, 0x93, 0x6d, 0xec, 0xcc,
+	0xdb, 0x84, 0x6b, 0x03, 0x7b, 0x07, 0xaa, 0x27, 0x03, 0x74, 0x01, 0xc4, 0x7f, 0xaf, 0xc2, 0x9b,
+	0xf3, 0x13, 0x5e, 0xe6, 0x8f, 0xfd, 0x95, 0xe0, 0x3d, 0xfb, 0x6f, 0x3d, 0x76, 0x81, 0x7f, 0xeb,
+	0xd7, 0x86, 0xff, 0x13, 0xb8, 0x7b, 0x12, 0x5c, 0x17, 0x40, 0xff, 0x07, 0x90, 0xdd, 0x24, 0x3d,
+	0xcb, 0xbe, 0x18, 0xd6, 0x33, 0xaf, 0xbc, 0xd4, 0xd9, 0x57, 0x5e, 0xfa, 0x47, 0x90, 0x93, 0x53,
+	0x4b, 0xbb, 0x22, 0x89, 0x52, 0x39, 0x23, 0x51, 0x7e, 0xa5, 0x40, 0xae, 0xc9, 0xdf, 0x8c, 0x5d,
+	0x7b, 0xa1, 0x70, 0x1b, 0x12, 0x26, 0x73, 0x86, 0x56, 0x47, 0xbe, 0xb3, 0x93, 0x2d, 0xbd, 0x08,
+	0x79, 0xdf, 0x02, 0x61, 0xbf, 0xfe, 0x23, 0x28, 0x60, 0x67, 0x30, 0x38, 0x34, 0x3b, 0xfd, 0xeb,
+	0xb6, 0x4a, 0x47, 0x50, 0x0c, 0xd7, 0x92, 0xeb, 0x7f, 0x0e, 0xaf, 0x63, 0x42, 0x9d, 0xc1, 0x84,
+	0x44, 0x4a, 0x8a, 0x8b, 0x59, 0x82, 0x20, 0xd6, 0x65, 0xf2, 0xe5, 0x4d, 0x1a, 0xf3, 0x67, 0xfd,
+	0xcf, 0x0a, 0x94, 0xb6, 0x09, 0xa5, 0x66, 0x8f, 0x08, 0x82, 0x5d, 0x6c, 0xea, 0xd3, 0x6a, 0xc6,
+	0x12, 0xc4, 0xc5, 0xc9, 0x2b, 0xf6, 0x9b, 0x68, 0xa0, 0x75, 0x48, 0x07, 0x9b, 0xad, 0x1c, 0x93,
+	0x94, 0x3d, 0xba, 0xd7, 0x52, 0xfe, 0x5e, 0xf3, 0xac, 0x8f, 0xdc, 0x8f, 0xf0, 0x67, 0xfd, 0xe7,
+	0x0a, 0xdc, 0x90, 0xd6, 0x6f, 0x74, 0xfa, 0x57, 0x6f, 0xba, 0xbf, 0xa6, 0x16, 0xae, 0x89, 0xee,
+	0x82, 0xe6, 0x27, 0xe3, 0x4c, 0x23, 0x2b, 0x77, 0xd9, 0x81, 0x39, 0x18, 0x13, 0xec, 0x75, 0xe8,
+	0xdb, 0x90, 0x6d, 0x45, 0x2a, 0x4d, 0xb4, 0x02, 0x6a, 0x60, 0xc6, 0xac, 0xba, 0x6a, 0x75, 0xe7,
+	0xaf, 0x28, 0xd4, 0x23, 0x57, 0x14, 0x7f, 0x52, 0x60, 0x25, 0x74, 0xf1, 0xd2, 0x07, 0xd3, 0x79,
+	0xbd, 0xfd, 0x18, 0x0a, 0x56, 0xd7, 0x38, 0x72, 0x0c, 0x65, 0x1a, 0x25, 0x9f, 0xc5, 0x51, 0x67,
+	0x71, 0xce, 0x8a, 0xb4, 0xa8, 0xbe, 0x02, 0x95, 0xe3, 0xc8, 0x2b, 0xa9, 0xfd, 0x1f, 0x15, 0x6e,
+	0xec, 0x8f, 0x06, 0x16, 0x93, 0x39, 0xea, 0xaa, 0xfd, 0x59, 0xf8, 0x92, 0xee, 0x2d, 0xc8, 0x52,
+	0xcf, 0x0e, 0x79, 0x0f, 0x27, 0x0b, 0x9a, 0x0c, 0x97, 0x89, 0x1b, 0x38, 0x2f, 0x4e, 0xbe, 0xca,
+	0xd8, 0x66, 0x9c, 0x84, 0x1a, 0x06, 0xa9, 0x31, 0xb6, 0x19, 0xfa, 0x7f, 0xb8, 0x63, 0x8f, 0x87,
+	0xfc, 0x15, 0xad, 0x31, 0x22, 0xae, 0xc1, 0x67, 0x36, 0x46, 0xa6, 0xcb, 0x78, 0x8a, 0xd7, 0xf0,
+	0x4d, 0x7b, 0x3c, 0xf4, 0xde, 0xd7, 0xee, 0x11, 0x97, 0x2f, 0xbe, 0x67, 0xba, 0x0c, 0x7d, 0x17,
+	0xd2, 0xe6, 0xa0, 0xe7, 0xb8, 0x16, 0x7b, 0x3e, 0x94, 0x17, 0x6f, 0xba, 0x34, 0xf3, 0x08, 0x32,
+	0xf5, 0x0d, 0x5f, 0x13, 0x87, 0x83, 0xd0, 0xbb, 0x80, 0xc6, 0x94, 0x18, 0xc2, 0x38, 0xb1, 0xe8,
+	0xa4, 0x21, 0x6f, 0xe1, 0x0a, 0x63, 0x4a, 0xc2, 0x69, 0x0e, 0x1a, 0xfa, 0x5f, 0x35, 0x40, 0xd1,
+	0x79, 0x65, 0x8e, 0xfe, 0x36, 0x24, 0xf8, 0x78, 0x5a, 0x56, 0x78, 0x6c, 0x57, 0x83, 0x0c, 0x75,
+	0x44, 0xb7, 0xee, 0x99, 0x8d, 0xa5, 0x7a, 0xe5, 0x73, 0xc8, 0xfa, 0x3b, 0x95, 0xbb, 0x13, 0x8d,
+	0x86, 0x72, 0xea, 0xe9, 0xaa, 0x2e, 0x70, 0xba, 0x56, 0x3e, 0x81, 0x34, 0xaf, 0xea, 0xce, 0x9c,
+	0x3b, 0xac, 0x45, 0xd5, 0x68, 0x2d, 0x5a, 0xf9, 0xa7, 0x02, 0x31, 0x3e, 0x78, 0xe1, 0x3f, 0xbf,
+	0xdb, 0x90, 0x0f, 0xac, 0x14, 0xd1, 0x13, 0x49, 0xfb, 0xfe, 0x29, 0x90, 0x44, 0x21, 0xc0, 0xd9,
+	0x7e, 0xa4, 0x85, 0x9a, 0x00, 0xe2, 0x1b, 0x13, 0x3e, 0x95, 0xe0, 0xe1, 0xbd, 0x53, 0xa6, 0x0a,
+	0xdc, 0xc5, 0x69, 0x1a, 0x78, 0x8e, 0x20, 0x46, 0xad, 0x9f, 0x88, 0x2c, 0xa9, 0x61, 0xfe, 0xac,
+	0xbf, 0x0f, 0xb7, 0x1e, 0x13, 0xb6, 0xef, 0x4e, 0xfc, 0xed, 0xe6, 0x6f, 0x9f, 0x53, 0x60, 0xd2,
+	0x31, 0xdc, 0x9e, 0x1f, 0x24, 0x19, 0xf0, 0x21, 0x64, 0xa9, 0x3b, 0x31, 0x66, 0x46, 0x7a, 0x55,
+	0x49, 0x10, 0x9e, 0xe8, 0xa0, 0x0c, 0x0d, 0x1b, 0xfa, 0xdf, 0x14, 0xc8, 0x1f, 0x5c, 0xe6, 0xe8,
+	0x98, 0x2b, 0xa1, 0xd4, 0x05, 0x4b, 0xa8, 0xfb, 0x10, 0x9f, 0xf4, 0x98, 0xbc, 0xd5, 0xf5, 0x22,
+	0x1a, 0xf9, 0x78, 0xe8, 0xe0, 0x31, 0xb3, 0xba, 0x58, 0xf4, 0x7b, 0x85, 0xd1, 0x17, 0xd6, 0x80,
+	0x11, 0x37, 0x38, 0x65, 0x22, 0x9a, 0x9f, 0xf2, 0x1e, 0x2c, 0x35, 0xf4, 0xef, 0x40, 0x21, 0xf0,
+	0x25, 0xac, 0xab, 0xc8, 0x84, 0xd8, 0xc1, 0xde, 0x98, 0x19, 0x7e, 0xb0, 0xe5, 0x75, 0x61, 0xa9,
+	0xa1, 0xff, 0x56, 0x85, 0x9b, 0x4f, 0x47, 0x5d, 0x93, 0x2d, 0xfb, 0x59, 0x7a, 0xc1, 0xb2, 0x75,
+	0x05, 0xd2, 0xcc, 0x1a, 0x12, 0xca, 0xcc, 0xe1, 0x48, 0x66, 0xb5, 0x50, 0xe0, 0x45, 0x84, 0xe3,
+	0x50, 0x4e, 0xce, 0xec, 0x31, 0x0e, 0x51, 0xdb, 0xe9, 0x13, 0x1b, 0x8b, 0x7e, 0xbd, 0x0f, 0xa5,
+	0x59, 0x94, 0x24, 0xd4, 0x35, 0x7f, 0x82, 0xd9, 0x0a, 0x56, 0x16, 0xbe, 0x1c, 0x69, 0xa1, 0xe0,
+	0x7d, 0xf2, 0xe2, 0x95, 0xb2, 0x43, 0x62, 0x84, 0xf6, 0x88, 0x4f, 0x52, 0x0a, 0x42, 0xde, 0xf6,
+	0xc5, 0x0f, 0x1e, 0x41, 0x61, 0xee, 0x6b, 0x27, 0x54, 0x80, 0xcc, 0xd3, 0x9d, 0xfd, 0xbd, 0xad,
+	0x66, 0xeb, 0xd3, 0xd6, 0xd6, 0xa3, 0xe2, 0x6b, 0x08, 0x20, 0xb1, 0xdf, 0xda, 0x79, 0xfc, 0x64,
+	0xab, 0xa8, 0xa0, 0x34, 0xc4, 0xb7, 0x9f, 0x3e, 0x69, 0xb7, 0x8a, 0xaa, 0xf7, 0xd8, 0x7e, 0xb6,
+	0xbb, 0xd7, 0x2c, 0x6a, 0x0f, 0x3e, 0x86, 0x8c, 0xa8, 0x0b, 0x77, 0xdd, 0x2e, 0x71, 0xbd, 0x01,
+	0x3b, 0xbb, 0x78, 0x7b, 0xe3, 0x49, 0xf1, 0x35, 0x94, 0x04, 0x6d, 0x0f, 0x7b, 0x23, 0x53, 0x10,
+	0xdb, 0xdb, 0xdd, 0x6f, 0x17, 0x55, 0x94, 0x07, 0xd8, 0x78, 0xda, 0xde, 0x6d, 0xee, 0x6e, 0x6f,
+	0xb7, 0xda, 0x45, 0x6d, 0xf3, 0x03, 0x28, 0x58, 0x4e, 0x7d, 0x62, 0x31, 0x42, 0xa9, 0xf8, 0x5e,
+	0xed, 0x87, 0x6f, 0xcb, 0x96, 0xe5, 0xac, 0x8b, 0xa7, 0xf5, 0x9e, 0xb3, 0x3e, 0x61, 0xeb, 0xbc,
+	0x77, 0x5d, 0x24, 0x88, 0xc3, 0x04, 0x6f, 0xbd, 0xff, 0xbf, 0x01, 0x00, 0xf5, 0x2b, 0x2f, 0x48,
+	0x2f, 0x27, 0x00, 0x00,
 }
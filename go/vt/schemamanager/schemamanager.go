@@ -74,6 +74,9 @@ type ExecuteResult struct {
 	Sqls           []string
 	ExecutorErr    string
 	TotalTimeSpent time.Duration
+	// Migrations are the UUIDs of the schema migrations recorded
+	// for Sqls, in the same order.
+	Migrations []string `json:",omitempty"`
 }
 
 // ShardWithError contains information why a shard failed to execute given sql
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -245,6 +246,13 @@ type fakeTabletManagerClient struct {
 	EnableExecuteFetchAsDbaError bool
 	preflightSchemas             map[string]*tabletmanagerdatapb.SchemaChangeResult
 	schemaDefinitions            map[string]*tabletmanagerdatapb.SchemaDefinition
+
+	// CancelSchemaMigrations makes the schema migrations look cancelled
+	// when they're started.
+	CancelSchemaMigrations bool
+
+	mu              sync.Mutex
+	executedQueries []string
 }
 
 func (client *fakeTabletManagerClient) AddSchemaChange(sql string, schemaResult *tabletmanagerdatapb.SchemaChangeResult) {
@@ -281,6 +289,12 @@ func (client *fakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, ta
 	if client.EnableExecuteFetchAsDbaError {
 		return nil, fmt.Errorf("ExecuteFetchAsDba occur an unknown error")
 	}
+	client.mu.Lock()
+	client.executedQueries = append(client.executedQueries, string(query))
+	client.mu.Unlock()
+	if strings.HasPrefix(string(query), "update _vt.schema_migrations set status='running'") && !client.CancelSchemaMigrations {
+		return &querypb.QueryResult{RowsAffected: 1}, nil
+	}
 	return client.TabletManagerClient.ExecuteFetchAsDba(ctx, tablet, usePool, query, maxRows, disableBinlogs, reloadSchema)
 }
 
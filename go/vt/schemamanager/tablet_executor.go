@@ -30,6 +30,15 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
+const (
+	// DDLStrategyDirect runs the schema changes directly on every master.
+	DDLStrategyDirect = wrangler.SchemaMigrationStrategyDirect
+	// DDLStrategyOnline runs ALTER TABLE statements as online schema
+	// migrations, which don't lock the table. The other statements
+	// still run directly.
+	DDLStrategyOnline = wrangler.SchemaMigrationStrategyOnline
+)
+
 // TabletExecutor applies schema changes to all tablets.
 type TabletExecutor struct {
 	wr                   *wrangler.Wrangler
@@ -38,6 +47,7 @@ type TabletExecutor struct {
 	allowBigSchemaChange bool
 	keyspace             string
 	waitSlaveTimeout     time.Duration
+	ddlStrategy          string
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
@@ -47,9 +57,21 @@ func NewTabletExecutor(wr *wrangler.Wrangler, waitSlaveTimeout time.Duration) *T
 		isClosed:             true,
 		allowBigSchemaChange: false,
 		waitSlaveTimeout:     waitSlaveTimeout,
+		ddlStrategy:          DDLStrategyDirect,
 	}
 }
 
+// SetDDLStrategy changes how TabletExecutor applies ALTER TABLE statements.
+func (exec *TabletExecutor) SetDDLStrategy(ddlStrategy string) error {
+	switch ddlStrategy {
+	case DDLStrategyDirect, DDLStrategyOnline:
+	default:
+		return fmt.Errorf("unknown ddl strategy: %s", ddlStrategy)
+	}
+	exec.ddlStrategy = ddlStrategy
+	return nil
+}
+
 // AllowBigSchemaChange changes TabletExecutor such that big schema changes
 // will no longer be rejected.
 func (exec *TabletExecutor) AllowBigSchemaChange() {
@@ -101,6 +123,18 @@ func (exec *TabletExecutor) Validate(ctx context.Context, sqls []string) error {
 		return fmt.Errorf("executor is closed")
 	}
 
+	if exec.ddlStrategy == DDLStrategyOnline {
+		// Online schema migrations don't lock the table,
+		// so they can't be big schema changes.
+		directSQLs := make([]string, 0, len(sqls))
+		for _, sql := range sqls {
+			if !wrangler.IsOnlineDDL(sql) {
+				directSQLs = append(directSQLs, sql)
+			}
+		}
+		sqls = directSQLs
+	}
+
 	// We ignore DATABASE-level DDLs here because detectBigSchemaChanges doesn't
 	// look at them anyway.
 	parsedDDLs, _, err := exec.parseDDLs(sqls)
@@ -215,17 +249,48 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		return &execResult
 	}
 
+	// Record all the schema changes before running them, so that the
+	// ones after a failure stay queued and can be retried later.
+	strategies := make([]string, len(sqls))
+	for index, sql := range sqls {
+		strategies[index] = DDLStrategyDirect
+		if exec.ddlStrategy == DDLStrategyOnline && wrangler.IsOnlineDDL(sql) {
+			strategies[index] = DDLStrategyOnline
+		}
+		migration := wrangler.NewSchemaMigrationUUID()
+		if err := exec.wr.QueueSchemaMigration(ctx, exec.keyspace, migration, strategies[index], sql); err != nil {
+			execResult.ExecutorErr = err.Error()
+			return &execResult
+		}
+		exec.wr.Logger().Printf("Queued schema migration %v.%v for: %v\n", exec.keyspace, migration, sql)
+		execResult.Migrations = append(execResult.Migrations, migration)
+	}
+
 	for index, sql := range sqls {
 		execResult.CurSQLIndex = index
-		exec.executeOnAllTablets(ctx, &execResult, sql)
-		if len(execResult.FailedShards) > 0 {
+		if strategies[index] == DDLStrategyOnline {
+			exec.executeOnlineDDL(ctx, &execResult, execResult.Migrations[index], sql)
+		} else {
+			exec.executeOnAllTablets(ctx, &execResult, execResult.Migrations[index], sql)
+		}
+		if len(execResult.FailedShards) > 0 || execResult.ExecutorErr != "" {
 			break
 		}
 	}
 	return &execResult
 }
 
-func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string) {
+// executeOnlineDDL starts an online schema migration on all shards.
+// The migration is then managed with the OnlineDDL vtctl command.
+func (exec *TabletExecutor) executeOnlineDDL(ctx context.Context, execResult *ExecuteResult, migration, sql string) {
+	if err := exec.wr.RunSchemaMigration(ctx, exec.keyspace, migration); err != nil {
+		execResult.ExecutorErr = err.Error()
+		return
+	}
+	exec.wr.Logger().Printf("Started online schema migration %v.%v for: %v\n", exec.keyspace, migration, sql)
+}
+
+func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, migration, sql string) {
 	var wg sync.WaitGroup
 	numOfMasterTablets := len(exec.tablets)
 	wg.Add(numOfMasterTablets)
@@ -234,7 +299,7 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 	for _, tablet := range exec.tablets {
 		go func(tablet *topodatapb.Tablet) {
 			defer wg.Done()
-			exec.executeOneTablet(ctx, tablet, sql, errChan, successChan)
+			exec.executeOneTablet(ctx, tablet, migration, sql, errChan, successChan)
 		}(tablet)
 	}
 	wg.Wait()
@@ -272,10 +337,22 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 func (exec *TabletExecutor) executeOneTablet(
 	ctx context.Context,
 	tablet *topodatapb.Tablet,
+	migration string,
 	sql string,
 	errChan chan ShardWithError,
 	successChan chan ShardResult) {
+	if err := exec.wr.StartSchemaMigration(ctx, tablet, migration, wrangler.SchemaMigrationStatusQueued); err != nil {
+		errChan <- ShardWithError{Shard: tablet.Shard, Err: fmt.Sprintf("couldn't start migration %v: %v", migration, err)}
+		return
+	}
 	result, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
+	status, message := wrangler.SchemaMigrationStatusComplete, ""
+	if err != nil {
+		status, message = wrangler.SchemaMigrationStatusFailed, err.Error()
+	}
+	if updateErr := exec.wr.UpdateSchemaMigration(ctx, tablet, migration, status, message); updateErr != nil {
+		exec.wr.Logger().Warningf("Could not update migration %v on %v/%v to %v: %v", migration, tablet.Keyspace, tablet.Shard, status, updateErr)
+	}
 	if err != nil {
 		errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
 		return
@@ -17,6 +17,7 @@ limitations under the License.
 package schemamanager
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -165,6 +166,51 @@ func TestTabletExecutorValidate(t *testing.T) {
 	}
 }
 
+func TestTabletExecutorDDLStrategy(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:     "test_table_04",
+				Schema:   "table schema",
+				Type:     tmutils.TableBaseTable,
+				RowCount: 3000000,
+			},
+		},
+	})
+
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	ctx := context.Background()
+
+	executor.Open(ctx, "test_keyspace")
+	defer executor.Close()
+
+	if err := executor.SetDDLStrategy("unknown"); err == nil || err.Error() != "unknown ddl strategy: unknown" {
+		t.Fatalf("SetDDLStrategy(unknown) = %v, want error", err)
+	}
+	if err := executor.SetDDLStrategy(DDLStrategyOnline); err != nil {
+		t.Fatalf("SetDDLStrategy(online) failed: %v", err)
+	}
+
+	// big ALTERs run as online schema migrations
+	if err := executor.Validate(ctx, []string{
+		"ALTER TABLE test_table_04 ADD COLUMN new_id bigint(20)",
+	}); err != nil {
+		t.Fatalf("executor.Validate should succeed, online schema migrations don't lock the table: %v", err)
+	}
+
+	// other big changes still run directly
+	if err := executor.Validate(ctx, []string{
+		"RENAME TABLE test_table_04 TO test_table_05",
+		"CREATE INDEX idx ON test_table_04 (id)",
+	}); err == nil {
+		t.Fatalf("executor.Validate should fail, change a table with more than 2,000,000 rows")
+	}
+}
+
 func TestTabletExecutorDML(t *testing.T) {
 	fakeTmc := newFakeTabletManagerClient()
 
@@ -216,3 +262,79 @@ func TestTabletExecutorExecute(t *testing.T) {
 		t.Fatalf("execute should fail, call execute.Open first")
 	}
 }
+
+func TestTabletExecutorSchemaMigrations(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	ctx := context.Background()
+
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open failed: %v", err)
+	}
+	defer executor.Close()
+
+	sqls := []string{"create table t1(id int)", "create table t2(id int)"}
+	result := executor.Execute(ctx, sqls)
+	if result.ExecutorErr != "" || len(result.FailedShards) != 0 {
+		t.Fatalf("execute failed: %v %v", result.ExecutorErr, result.FailedShards)
+	}
+	if len(result.Migrations) != len(sqls) {
+		t.Fatalf("got migrations %v, want one for each of %v", result.Migrations, sqls)
+	}
+
+	// Every shard records each statement as queued, then running, then complete.
+	for _, migration := range result.Migrations {
+		counts := make(map[string]int)
+		for _, query := range fakeTmc.executedQueries {
+			if !strings.Contains(query, "'"+migration+"'") {
+				continue
+			}
+			if strings.HasPrefix(query, "insert") && strings.Contains(query, "'queued'") {
+				counts["queued"]++
+			}
+			for _, status := range []string{"running", "complete"} {
+				if strings.Contains(query, "set status='"+status+"'") {
+					counts[status]++
+				}
+			}
+		}
+		want := map[string]int{"queued": 3, "running": 3, "complete": 3}
+		if !reflect.DeepEqual(counts, want) {
+			t.Errorf("migration %v: got %v status changes, want %v", migration, counts, want)
+		}
+	}
+}
+
+func TestTabletExecutorSchemaMigrationsCancelled(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{})
+	fakeTmc.CancelSchemaMigrations = true
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	ctx := context.Background()
+
+	if err := executor.SetDDLStrategy(DDLStrategyOnline); err != nil {
+		t.Fatalf("executor.SetDDLStrategy failed: %v", err)
+	}
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open failed: %v", err)
+	}
+	defer executor.Close()
+
+	sql := "create table t1(id int)"
+	result := executor.Execute(ctx, []string{sql})
+	if len(result.FailedShards) != 3 {
+		t.Fatalf("got failed shards %v, want all of them", result.FailedShards)
+	}
+	if want := "is no longer queued"; !strings.Contains(result.FailedShards[0].Err, want) {
+		t.Errorf("got error %v, want it to contain %v", result.FailedShards[0].Err, want)
+	}
+	// A cancelled migration is neither run nor overwritten.
+	for _, query := range fakeTmc.executedQueries {
+		if query == sql || strings.Contains(query, "'complete'") || strings.Contains(query, "'failed'") {
+			t.Errorf("unexpected query: %v", query)
+		}
+	}
+}
@@ -53,6 +53,10 @@ const (
 	StmtUnknown
 	StmtComment
 	StmtPriv
+	StmtSRollback
+	StmtSavepoint
+	StmtRelease
+	StmtKill
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -73,7 +77,7 @@ func Preview(sql string) StatementType {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "with":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -113,6 +117,14 @@ func Preview(sql string) StatementType {
 		return StmtOther
 	case "grant", "revoke":
 		return StmtPriv
+	case "rollback":
+		return StmtSRollback
+	case "savepoint":
+		return StmtSavepoint
+	case "release":
+		return StmtRelease
+	case "kill":
+		return StmtKill
 	}
 	return StmtUnknown
 }
@@ -139,6 +151,14 @@ func (s StatementType) String() string {
 		return "COMMIT"
 	case StmtRollback:
 		return "ROLLBACK"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtRelease:
+		return "RELEASE"
+	case StmtKill:
+		return "KILL"
 	case StmtSet:
 		return "SET"
 	case StmtShow:
@@ -367,6 +387,12 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 					return nil, "", err
 				}
 				result[setKey] = num
+			case FloatVal:
+				num, err := strconv.ParseFloat(string(expr.Val), 64)
+				if err != nil {
+					return nil, "", err
+				}
+				result[setKey] = num
 			default:
 				return nil, "", fmt.Errorf("invalid value type: %v", String(expr))
 			}
@@ -33,6 +33,7 @@ func TestPreview(t *testing.T) {
 		{"select ...", StmtSelect},
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
+		{"with t as (select ...) select ...", StmtSelect},
 		{"( select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
@@ -58,6 +59,10 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback to a", StmtSRollback},
+		{"savepoint a", StmtSavepoint},
+		{"release savepoint a", StmtRelease},
+		{"kill query 12", StmtKill},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -506,6 +511,9 @@ func TestExtractSetValues(t *testing.T) {
 	}, {
 		sql: "SET foo = 0x1234",
 		err: "invalid value type: 0x1234",
+	}, {
+		sql: "SET long_query_time = 1.5",
+		out: map[SetKey]interface{}{{Key: "long_query_time", Scope: ImplicitStr}: 1.5},
 	}, {
 		sql: "SET names utf8",
 		out: map[SetKey]interface{}{{Key: "names", Scope: ImplicitStr}: "utf8"},
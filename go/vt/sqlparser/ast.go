@@ -55,22 +55,26 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
-		Cache       string
-		Comments    Comments
-		Distinct    string
-		Hints       string
-		SelectExprs SelectExprs
-		From        TableExprs
-		Where       *Where
-		GroupBy     GroupBy
-		Having      *Where
-		OrderBy     OrderBy
-		Limit       *Limit
-		Lock        string
+		With             *With
+		Cache            string
+		Comments         Comments
+		Distinct         string
+		Hints            string
+		SQLCalcFoundRows bool
+		SelectExprs      SelectExprs
+		From             TableExprs
+		Where            *Where
+		GroupBy          GroupBy
+		Having           *Where
+		Windows          NamedWindows
+		OrderBy          OrderBy
+		Limit            *Limit
+		Lock             string
 	}
 
 	// Union represents a UNION statement.
 	Union struct {
+		With        *With
 		Type        string
 		Left, Right SelectStatement
 		OrderBy     OrderBy
@@ -157,6 +161,10 @@ type (
 		// Table is set if Action is other than RenameStr or DropStr.
 		Table TableName
 
+		// Temporary is set if the table of a CREATE or DROP
+		// statement is a temporary table.
+		Temporary bool
+
 		// The following fields are set if a DDL was fully analyzed.
 		IfExists      bool
 		TableSpec     *TableSpec
@@ -202,6 +210,28 @@ type (
 	// Rollback represents a Rollback statement.
 	Rollback struct{}
 
+	// SRollback represents a rollback to savepoint statement.
+	SRollback struct {
+		Name ColIdent
+	}
+
+	// Savepoint represents a savepoint statement.
+	Savepoint struct {
+		Name ColIdent
+	}
+
+	// Release represents a release savepoint statement.
+	Release struct {
+		Name ColIdent
+	}
+
+	// Kill represents a KILL [CONNECTION | QUERY] statement.
+	// Type is KillConnectionStr or KillQueryStr.
+	Kill struct {
+		Type         string
+		ConnectionID *SQLVal
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -228,6 +258,10 @@ func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
 func (*Commit) iStatement()            {}
 func (*Rollback) iStatement()          {}
+func (*SRollback) iStatement()         {}
+func (*Savepoint) iStatement()         {}
+func (*Release) iStatement()           {}
+func (*Kill) iStatement()              {}
 func (*OtherRead) iStatement()         {}
 func (*OtherAdmin) iStatement()        {}
 func (*Select) iSelectStatement()      {}
@@ -625,11 +659,13 @@ type (
 	}
 
 	// FuncExpr represents a function call.
+	// If Over is set, the function is a window function.
 	FuncExpr struct {
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -770,6 +806,60 @@ type Limit struct {
 	Offset, Rowcount Expr
 }
 
+// OverClause represents the OVER clause of a window function.
+// It either references a named window, or specifies the window inline.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the specification of a window.
+// Name can reference a named window that this specification extends.
+type WindowSpecification struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil if the frame was specified without BETWEEN.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FramePoint represents a boundary of a window frame.
+// Expr is only set for the 'expr preceding' and 'expr following' types.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// With represents a WITH clause.
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// CommonTableExpr represents a single common table expression
+// of a WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// NamedWindows represents a WINDOW clause.
+type NamedWindows []*NamedWindow
+
+// NamedWindow represents a single window definition of a WINDOW clause.
+type NamedWindow struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
 // Values represents a VALUES clause.
 type Values []ValTuple
 
@@ -814,10 +904,14 @@ type TableIdent struct {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	calcFoundRows := ""
+	if node.SQLCalcFoundRows {
+		calcFoundRows = SQLCalcFoundRowsStr
+	}
+	buf.Myprintf("%vselect %v%s%s%s%s%v from %v%v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, calcFoundRows, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -828,7 +922,7 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -889,19 +983,27 @@ func (node *DBDDL) Format(buf *TrackedBuffer) {
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
+		temp := ""
+		if node.Temporary {
+			temp = " temporary"
+		}
 		if node.OptLike != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.OptLike)
+			buf.Myprintf("%s%s table %v %v", node.Action, temp, node.Table, node.OptLike)
 		} else if node.TableSpec != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.TableSpec)
+			buf.Myprintf("%s%s table %v %v", node.Action, temp, node.Table, node.TableSpec)
 		} else {
-			buf.Myprintf("%s table %v", node.Action, node.Table)
+			buf.Myprintf("%s%s table %v", node.Action, temp, node.Table)
 		}
 	case DropStr:
+		temp := ""
+		if node.Temporary {
+			temp = " temporary"
+		}
 		exists := ""
 		if node.IfExists {
 			exists = " if exists"
 		}
-		buf.Myprintf("%s table%s %v", node.Action, exists, node.FromTables)
+		buf.Myprintf("%s%s table%s %v", node.Action, temp, exists, node.FromTables)
 	case RenameStr:
 		buf.Myprintf("%s table %v to %v", node.Action, node.FromTables[0], node.ToTables[0])
 		for i := 1; i < len(node.FromTables); i++ {
@@ -1236,6 +1338,26 @@ func (node *Rollback) Format(buf *TrackedBuffer) {
 	buf.WriteString("rollback")
 }
 
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.Myprintf("kill %s %v", node.Type, node.ConnectionID)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -1546,7 +1668,7 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.Myprintf("%s(%s%v)%v", node.Name.String(), distinct, node.Exprs, node.Over)
 }
 
 // Format formats the node
@@ -1679,6 +1801,99 @@ func (node *Limit) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v", node.Rowcount)
 }
 
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.Myprintf(" over %v", node.WindowName)
+		return
+	}
+	buf.Myprintf(" over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	var prefix string
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionBy) != 0 {
+		buf.Myprintf("%spartition by %v", prefix, node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) != 0 {
+		buf.Myprintf("%sorder by ", prefix)
+		for i, n := range node.OrderBy {
+			if i != 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", n)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", prefix, node.Frame)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr == nil {
+		buf.Myprintf("%s", node.Type)
+		return
+	}
+	buf.Myprintf("%v %s", node.Expr, node.Type)
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf("with ")
+	if node.Recursive {
+		buf.Myprintf("recursive ")
+	}
+	for i, n := range node.CTEs {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", n)
+	}
+	buf.Myprintf(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as (%v)", node.Name, node.WindowSpec)
+}
+
 // Format formats the node.
 func (node Values) Format(buf *TrackedBuffer) {
 	prefix := "values "
@@ -522,8 +522,10 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregate functions used as window functions are not considered
+// aggregates because they don't group the rows.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
 }
 
 // NewColIdent makes a new ColIdent.
@@ -609,6 +611,11 @@ func (node TableIdent) String() string {
 	return node.v
 }
 
+// EqualString performs a case-insensitive compare with str.
+func (node TableIdent) EqualString(str string) bool {
+	return strings.EqualFold(node.v, str)
+}
+
 // CompliantName returns a compliant id name
 // that can be used for a bind var.
 func (node TableIdent) CompliantName() string {
@@ -28,7 +28,8 @@ const (
 	DirectiveMultiShardAutocommit = "MULTI_SHARD_AUTOCOMMIT"
 	// DirectiveSkipQueryPlanCache skips query plan cache when set.
 	DirectiveSkipQueryPlanCache = "SKIP_QUERY_PLAN_CACHE"
-	// DirectiveQueryTimeout sets a query timeout in vtgate. Only supported for SELECTS.
+	// DirectiveQueryTimeout sets a timeout for the query in milliseconds,
+	// which bounds its execution in vtgate and in vttablet.
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
@@ -317,3 +318,32 @@ func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	}
 	return false
 }
+
+// QueryTimeoutDirective returns the value of the query timeout directive
+// of the statement in milliseconds, or 0 if it's not set.
+func QueryTimeoutDirective(stmt Statement) int {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Union:
+		// The directive is in the comments of the first select.
+		return QueryTimeoutDirective(stmt.Left)
+	case *ParenSelect:
+		return QueryTimeoutDirective(stmt.Select)
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return 0
+	}
+	directives := ExtractCommentDirectives(comments)
+	timeout, ok := directives[DirectiveQueryTimeout].(int)
+	if !ok || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
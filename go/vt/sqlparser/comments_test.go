@@ -452,3 +452,30 @@ func TestSkipQueryPlanCacheDirective(t *testing.T) {
 		t.Errorf("d.SkipQueryPlanCacheDirective(stmt) should be true")
 	}
 }
+
+func TestQueryTimeoutDirective(t *testing.T) {
+	testCases := []struct {
+		query string
+		want  int
+	}{
+		{"select /*vt+ QUERY_TIMEOUT_MS=500 */ * from users", 500},
+		{"select * from users", 0},
+		{"select /*vt+ QUERY_TIMEOUT_MS=abc */ * from users", 0},
+		{"select /*vt+ QUERY_TIMEOUT_MS=-1 */ * from users", 0},
+		{"select /*vt+ QUERY_TIMEOUT_MS=100 */ * from a union select * from b", 100},
+		{"(select /*vt+ QUERY_TIMEOUT_MS=100 */ * from a) union select * from b", 100},
+		{"insert /*vt+ QUERY_TIMEOUT_MS=200 */ into users(id) values (1)", 200},
+		{"update /*vt+ QUERY_TIMEOUT_MS=300 */ users set name=1", 300},
+		{"delete /*vt+ QUERY_TIMEOUT_MS=400 */ from users", 400},
+		{"set /*vt+ QUERY_TIMEOUT_MS=400 */ a = 1", 0},
+	}
+	for _, tc := range testCases {
+		stmt, err := Parse(tc.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := QueryTimeoutDirective(stmt); got != tc.want {
+			t.Errorf("QueryTimeoutDirective(%s): %d, want %d", tc.query, got, tc.want)
+		}
+	}
+}
@@ -29,6 +29,9 @@ const (
 	SQLCacheStr   = "sql_cache "
 	SQLNoCacheStr = "sql_no_cache "
 
+	// Select.SQLCalcFoundRows
+	SQLCalcFoundRowsStr = "sql_calc_found_rows "
+
 	// Union.Type
 	UnionStr         = "union"
 	UnionAllStr      = "union all"
@@ -38,6 +41,10 @@ const (
 	InsertStr  = "insert"
 	ReplaceStr = "replace"
 
+	// Kill.Type
+	KillConnectionStr = "connection"
+	KillQueryStr      = "query"
+
 	// Set.Scope or Show.Scope
 	SessionStr        = "session"
 	GlobalStr         = "global"
@@ -80,6 +87,17 @@ const (
 	IgnoreStr = "ignore "
 	ForceStr  = "force "
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// Where.Type
 	WhereStr  = "where"
 	HavingStr = "having"
@@ -39,6 +39,7 @@ func RewriteAST(in Statement) (*RewriteASTResult, error) {
 		AST:              in,
 		NeedLastInsertID: er.lastInsertID,
 		NeedDatabase:     er.database,
+		NeedFoundRows:    er.foundRows,
 	}, nil
 }
 
@@ -66,10 +67,12 @@ type RewriteASTResult struct {
 	AST              Statement
 	NeedLastInsertID bool
 	NeedDatabase     bool
+	NeedFoundRows    bool
 }
 
 type expressionRewriter struct {
 	lastInsertID, database    bool
+	foundRows                 bool
 	shouldRewriteDatabaseFunc bool
 	err                       error
 }
@@ -79,6 +82,11 @@ const (
 	LastInsertIDName = "__lastInsertId"
 	//DBVarName is a reserved bind var name for database()
 	DBVarName = "__vtdbname"
+	//FoundRowsName is a reserved bind var name for found_rows()
+	FoundRowsName = "__vtfrows"
+	//SchemaNameVarName is a reserved bind var name for the keyspace a query
+	//on the system tables is about. Tablets replace it with their database name.
+	SchemaNameVarName = "__vtschemaname"
 )
 
 func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
@@ -98,6 +106,7 @@ func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
 			node.Expr = newExpr
 			er.database = er.database || inner.database
 			er.lastInsertID = er.lastInsertID || inner.lastInsertID
+			er.foundRows = er.foundRows || inner.foundRows
 			if inner.didAnythingChange() {
 				node.As = NewColIdent(buf.String())
 			}
@@ -113,6 +122,13 @@ func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
 				cursor.Replace(bindVarExpression(LastInsertIDName))
 				er.lastInsertID = true
 			}
+		case node.Name.EqualString("found_rows"):
+			if len(node.Exprs) > 0 {
+				er.err = vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "Syntax error. FOUND_ROWS() takes no arguments")
+			} else {
+				cursor.Replace(bindVarExpression(FoundRowsName))
+				er.foundRows = true
+			}
 		case node.Name.EqualString("database") && er.shouldRewriteDatabaseFunc:
 			if len(node.Exprs) > 0 {
 				er.err = vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "Syntax error. DATABASE() takes no arguments")
@@ -126,7 +142,7 @@ func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
 }
 
 func (er *expressionRewriter) didAnythingChange() bool {
-	return er.database || er.lastInsertID
+	return er.database || er.lastInsertID || er.foundRows
 }
 
 func bindVarExpression(name string) *SQLVal {
@@ -23,8 +23,8 @@ import (
 )
 
 type myTestCase struct {
-	in, expected string
-	liid, db     bool
+	in, expected    string
+	liid, db, frows bool
 }
 
 func TestRewrites(in *testing.T) {
@@ -74,6 +74,16 @@ func TestRewrites(in *testing.T) {
 			expected: "select (select :__vtdbname as `database()` from dual) as `(select database() from dual)` from dual",
 			db:       true, liid: false,
 		},
+		{
+			in:       "SELECT found_rows()",
+			expected: "SELECT :__vtfrows as `found_rows()`",
+			frows:    true,
+		},
+		{
+			in:       "SELECT found_rows() + last_insert_id() from dual",
+			expected: "SELECT :__vtfrows + :__lastInsertId as `found_rows() + last_insert_id()` from dual",
+			liid:     true, frows: true,
+		},
 		{
 			in:       "select id from user where database()",
 			expected: "select id from user where database()",
@@ -101,6 +111,7 @@ func TestRewrites(in *testing.T) {
 			require.Equal(t, s, String(result.AST))
 			require.Equal(t, tc.liid, result.NeedLastInsertID, "should need last insert id")
 			require.Equal(t, tc.db, result.NeedDatabase, "should need database name")
+			require.Equal(t, tc.frows, result.NeedFoundRows, "should need found rows")
 		})
 	}
 }
@@ -117,6 +117,23 @@ var (
 		output: "select /* union order by limit lock */ 1 from t union select 1 from t order by a asc limit 1 for update",
 	}, {
 		input: "select /* union with limit on lhs */ 1 from t limit 1 union select 1 from t",
+	}, {
+		input: "with t as (select a from b) select * from t",
+	}, {
+		input: "with t(x, y) as (select a, b from c), u as (select x from t) select t.y from t join u on t.x = u.x",
+	}, {
+		input: "with recursive t(n) as (select 1 from dual union all select n + 1 from t where n < 10) select n from t",
+	}, {
+		input: "with t as (select a from b) select a from t union select a from t order by a asc limit 1",
+	}, {
+		input: "select * from (with t as (select a from b) select a from t) as s",
+	}, {
+		input: "select * from s where a in (with t as (select a from b) select a from t)",
+	}, {
+		input: "insert into s(a) with t as (select a from b) select a from t",
+	}, {
+		input:  "select `with` from t",
+		output: "select `with` from t",
 	}, {
 		input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
 		output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
@@ -135,6 +152,10 @@ var (
 		input: "select /* distinct */ distinct 1 from t",
 	}, {
 		input: "select /* straight_join */ straight_join 1 from t",
+	}, {
+		input: "select /* sql_calc_found_rows */ sql_calc_found_rows 1 from t limit 10",
+	}, {
+		input: "select /* sql_calc_found_rows with modifiers */ sql_no_cache distinct sql_calc_found_rows a from t",
 	}, {
 		input: "select /* for update */ 1 from t for update",
 	}, {
@@ -1007,6 +1028,11 @@ var (
 	}, {
 		input:  "create table a (a int, b char, c garbage)",
 		output: "create table a",
+	}, {
+		input: "create temporary table a (\n\tid bigint\n)",
+	}, {
+		input:  "create temporary table if not exists a like b",
+		output: "create temporary table a like b",
 	}, {
 		input:  "create table a (b1 bool not null primary key, b2 boolean not null)",
 		output: "create table a (\n\tb1 bool not null primary key,\n\tb2 boolean not null\n)",
@@ -1118,6 +1144,10 @@ var (
 	}, {
 		input:  "drop table if exists a",
 		output: "drop table if exists a",
+	}, {
+		input: "drop temporary table a, b",
+	}, {
+		input: "drop temporary table if exists a",
 	}, {
 		input:  "drop view if exists a",
 		output: "drop table if exists a",
@@ -1461,6 +1491,33 @@ var (
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 1) from t group by name",
 	}, {
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
+	}, {
+		input: "select id, row_number() over (partition by a order by b desc) from t",
+	}, {
+		input: "select id, rank() over (order by a asc, b desc) from t",
+	}, {
+		input: "select id, lag(a, 1) over (partition by b order by c asc) from t",
+	}, {
+		input: "select sum(a) over () from t",
+	}, {
+		input: "select count(distinct a) over (partition by b) from t",
+	}, {
+		input: "select sum(a) over (order by b asc rows unbounded preceding) from t",
+	}, {
+		input: "select sum(a) over (order by b asc rows between 1 preceding and current row) from t",
+	}, {
+		input: "select sum(a) over (order by b asc rows between current row and unbounded following) from t",
+	}, {
+		input: "select sum(a) over (order by b asc range between interval 1 day preceding and :c following) from t",
+	}, {
+		input: "select sum(a) over w from t window w as (partition by b)",
+	}, {
+		input: "select sum(a) over (w order by c asc), max(a) over v from t window w as (partition by b), v as (w rows 2 preceding)",
+	}, {
+		input: "select a from t group by a having count(*) > 1 window w as (order by a asc) order by a asc limit 1",
+	}, {
+		input:  "select row, rows, `current` from t",
+		output: "select `row`, `rows`, `current` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1495,6 +1552,36 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint a",
+	}, {
+		input: "savepoint `@@@;a`",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input: "rollback to a",
+	}, {
+		input:  "rollback to savepoint savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input: "release savepoint a",
+	}, {
+		input:  "kill 12",
+		output: "kill connection 12",
+	}, {
+		input: "kill connection 12",
+	}, {
+		input: "kill query 12",
+	}, {
+		input:  "KILL QUERY 12",
+		output: "kill query 12",
+	}, {
+		input:  "select connection from t",
+		output: "select `connection` from t",
+	}, {
+		input:  "select temporary from t",
+		output: "select `temporary` from t",
 	}, {
 		input: "create database test_db",
 	}, {
@@ -1896,6 +1983,12 @@ func TestConvert(t *testing.T) {
 	}, {
 		input:  "set transaction isolation level 12345",
 		output: "syntax error at position 38 near '12345'",
+	}, {
+		input:  "select sum(a) over (rows a preceding) from t",
+		output: "syntax error at position 27 near 'a'",
+	}, {
+		input:  "with t select 1 from dual",
+		output: "syntax error at position 14 near 'select'",
 	}}
 
 	for _, tcase := range invalidSQL {
@@ -2481,6 +2574,9 @@ var (
 	}{{
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
+	}, {
+		input:  "kill query a",
+		output: "syntax error at position 13 near 'a'",
 	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
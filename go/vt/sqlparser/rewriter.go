@@ -144,6 +144,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -292,6 +304,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -300,6 +324,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -398,6 +426,10 @@ func replaceJoinTableExprRightExpr(newNode, parent SQLNode) {
 	parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
 }
 
+func replaceKillConnectionID(newNode, parent SQLNode) {
+	parent.(*Kill).ConnectionID = newNode.(*SQLVal)
+}
+
 func replaceLimitOffset(newNode, parent SQLNode) {
 	parent.(*Limit).Offset = newNode.(Expr)
 }
@@ -414,6 +446,24 @@ func replaceMatchExprExpr(newNode, parent SQLNode) {
 	parent.(*MatchExpr).Expr = newNode.(Expr)
 }
 
+func replaceNamedWindowName(newNode, parent SQLNode) {
+	parent.(*NamedWindow).Name = newNode.(ColIdent)
+}
+
+func replaceNamedWindowWindowSpec(newNode, parent SQLNode) {
+	parent.(*NamedWindow).WindowSpec = newNode.(*WindowSpecification)
+}
+
+type replaceNamedWindowsItems int
+
+func (r *replaceNamedWindowsItems) replace(newNode, container SQLNode) {
+	container.(NamedWindows)[int(*r)] = newNode.(*NamedWindow)
+}
+
+func (r *replaceNamedWindowsItems) inc() {
+	*r++
+}
+
 func replaceNextvalExpr(newNode, parent SQLNode) {
 	tmp := parent.(Nextval)
 	tmp.Expr = newNode.(Expr)
@@ -459,6 +509,14 @@ func (r *replaceOrderByItems) inc() {
 	*r++
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+}
+
 func replaceParenExprExpr(newNode, parent SQLNode) {
 	parent.(*ParenExpr).Expr = newNode.(Expr)
 }
@@ -515,6 +573,18 @@ func replaceRangeCondTo(newNode, parent SQLNode) {
 	parent.(*RangeCond).To = newNode.(Expr)
 }
 
+func replaceReleaseName(newNode, parent SQLNode) {
+	parent.(*Release).Name = newNode.(ColIdent)
+}
+
+func replaceSRollbackName(newNode, parent SQLNode) {
+	parent.(*SRollback).Name = newNode.(ColIdent)
+}
+
+func replaceSavepointName(newNode, parent SQLNode) {
+	parent.(*Savepoint).Name = newNode.(ColIdent)
+}
+
 func replaceSelectComments(newNode, parent SQLNode) {
 	parent.(*Select).Comments = newNode.(Comments)
 }
@@ -547,6 +617,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(NamedWindows)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -719,6 +797,10 @@ func replaceUnionRight(newNode, parent SQLNode) {
 	parent.(*Union).Right = newNode.(SelectStatement)
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUpdateComments(newNode, parent SQLNode) {
 	parent.(*Update).Comments = newNode.(Comments)
 }
@@ -824,6 +906,32 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowSpecificationFrame(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Frame = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecificationName(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecificationOrderBy(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).OrderBy = newNode.(OrderBy)
+}
+
+func replaceWindowSpecificationPartitionBy(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).PartitionBy = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 // apply is where the visiting happens. Here is where we keep the big switch-case that will be used
 // to do the actual visiting of SQLNodes
 func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
@@ -917,6 +1025,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -987,9 +1100,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1042,6 +1163,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.LeftExpr, replaceJoinTableExprLeftExpr)
 		a.apply(node, n.RightExpr, replaceJoinTableExprRightExpr)
 
+	case *Kill:
+		a.apply(node, n.ConnectionID, replaceKillConnectionID)
+
 	case *Limit:
 		a.apply(node, n.Offset, replaceLimitOffset)
 		a.apply(node, n.Rowcount, replaceLimitRowcount)
@@ -1052,6 +1176,18 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Columns, replaceMatchExprColumns)
 		a.apply(node, n.Expr, replaceMatchExprExpr)
 
+	case *NamedWindow:
+		a.apply(node, n.Name, replaceNamedWindowName)
+		a.apply(node, n.WindowSpec, replaceNamedWindowWindowSpec)
+
+	case NamedWindows:
+		replacer := replaceNamedWindowsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
 	case Nextval:
 		a.apply(node, n.Expr, replaceNextvalExpr)
 
@@ -1090,6 +1226,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenExpr:
 		a.apply(node, n.Expr, replaceParenExprExpr)
 
@@ -1127,10 +1267,19 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case ReferenceAction:
 
+	case *Release:
+		a.apply(node, n.Name, replaceReleaseName)
+
 	case *Rollback:
 
 	case *SQLVal:
 
+	case *SRollback:
+		a.apply(node, n.Name, replaceSRollbackName)
+
+	case *Savepoint:
+		a.apply(node, n.Name, replaceSavepointName)
+
 	case *Select:
 		a.apply(node, n.Comments, replaceSelectComments)
 		a.apply(node, n.From, replaceSelectFrom)
@@ -1140,6 +1289,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1243,6 +1394,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Limit, replaceUnionLimit)
 		a.apply(node, n.OrderBy, replaceUnionOrderBy)
 		a.apply(node, n.Right, replaceUnionRight)
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *Update:
 		a.apply(node, n.Comments, replaceUpdateComments)
@@ -1306,6 +1458,20 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowSpecification:
+		a.apply(node, n.Frame, replaceWindowSpecificationFrame)
+		a.apply(node, n.Name, replaceWindowSpecificationName)
+		a.apply(node, n.OrderBy, replaceWindowSpecificationOrderBy)
+		a.apply(node, n.PartitionBy, replaceWindowSpecificationPartitionBy)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	default:
 		panic("unknown ast type " + reflect.TypeOf(node).String())
 	}
@@ -75,6 +75,15 @@ type yySymType struct {
 	orderBy              OrderBy
 	order                *Order
 	limit                *Limit
+	with                 *With
+	cte                  *CommonTableExpr
+	ctes                 []*CommonTableExpr
+	overClause           *OverClause
+	windowSpec           *WindowSpecification
+	frameClause          *FrameClause
+	framePoint           *FramePoint
+	namedWindows         NamedWindows
+	namedWindow          *NamedWindow
 	updateExprs          UpdateExprs
 	setExprs             SetExprs
 	updateExpr           *UpdateExpr
@@ -146,294 +155,304 @@ const SHARE = 57381
 const MODE = 57382
 const SQL_NO_CACHE = 57383
 const SQL_CACHE = 57384
-const JOIN = 57385
-const STRAIGHT_JOIN = 57386
-const LEFT = 57387
-const RIGHT = 57388
-const INNER = 57389
-const OUTER = 57390
-const CROSS = 57391
-const NATURAL = 57392
-const USE = 57393
-const FORCE = 57394
-const ON = 57395
-const USING = 57396
-const ID = 57397
-const HEX = 57398
-const STRING = 57399
-const INTEGRAL = 57400
-const FLOAT = 57401
-const HEXNUM = 57402
-const VALUE_ARG = 57403
-const LIST_ARG = 57404
-const COMMENT = 57405
-const COMMENT_KEYWORD = 57406
-const BIT_LITERAL = 57407
-const NULL = 57408
-const TRUE = 57409
-const FALSE = 57410
-const OFF = 57411
-const OR = 57412
-const AND = 57413
-const NOT = 57414
-const BETWEEN = 57415
-const CASE = 57416
-const WHEN = 57417
-const THEN = 57418
-const ELSE = 57419
-const END = 57420
-const LE = 57421
-const GE = 57422
-const NE = 57423
-const NULL_SAFE_EQUAL = 57424
-const IS = 57425
-const LIKE = 57426
-const REGEXP = 57427
-const IN = 57428
-const SHIFT_LEFT = 57429
-const SHIFT_RIGHT = 57430
-const DIV = 57431
-const MOD = 57432
-const UNARY = 57433
-const COLLATE = 57434
-const BINARY = 57435
-const UNDERSCORE_BINARY = 57436
-const UNDERSCORE_UTF8MB4 = 57437
-const INTERVAL = 57438
-const JSON_EXTRACT_OP = 57439
-const JSON_UNQUOTE_EXTRACT_OP = 57440
-const CREATE = 57441
-const ALTER = 57442
-const DROP = 57443
-const RENAME = 57444
-const ANALYZE = 57445
-const ADD = 57446
-const FLUSH = 57447
-const SCHEMA = 57448
-const TABLE = 57449
-const INDEX = 57450
-const VIEW = 57451
-const TO = 57452
-const IGNORE = 57453
-const IF = 57454
-const UNIQUE = 57455
-const PRIMARY = 57456
-const COLUMN = 57457
-const SPATIAL = 57458
-const FULLTEXT = 57459
-const KEY_BLOCK_SIZE = 57460
-const CHECK = 57461
-const ACTION = 57462
-const CASCADE = 57463
-const CONSTRAINT = 57464
-const FOREIGN = 57465
-const NO = 57466
-const REFERENCES = 57467
-const RESTRICT = 57468
-const SHOW = 57469
-const DESCRIBE = 57470
-const EXPLAIN = 57471
-const DATE = 57472
-const ESCAPE = 57473
-const REPAIR = 57474
-const OPTIMIZE = 57475
-const TRUNCATE = 57476
-const MAXVALUE = 57477
-const PARTITION = 57478
-const REORGANIZE = 57479
-const LESS = 57480
-const THAN = 57481
-const PROCEDURE = 57482
-const TRIGGER = 57483
-const VINDEX = 57484
-const VINDEXES = 57485
-const STATUS = 57486
-const VARIABLES = 57487
-const WARNINGS = 57488
-const SEQUENCE = 57489
-const BEGIN = 57490
-const START = 57491
-const TRANSACTION = 57492
-const COMMIT = 57493
-const ROLLBACK = 57494
-const BIT = 57495
-const TINYINT = 57496
-const SMALLINT = 57497
-const MEDIUMINT = 57498
-const INT = 57499
-const INTEGER = 57500
-const BIGINT = 57501
-const INTNUM = 57502
-const REAL = 57503
-const DOUBLE = 57504
-const FLOAT_TYPE = 57505
-const DECIMAL = 57506
-const NUMERIC = 57507
-const TIME = 57508
-const TIMESTAMP = 57509
-const DATETIME = 57510
-const YEAR = 57511
-const CHAR = 57512
-const VARCHAR = 57513
-const BOOL = 57514
-const CHARACTER = 57515
-const VARBINARY = 57516
-const NCHAR = 57517
-const TEXT = 57518
-const TINYTEXT = 57519
-const MEDIUMTEXT = 57520
-const LONGTEXT = 57521
-const BLOB = 57522
-const TINYBLOB = 57523
-const MEDIUMBLOB = 57524
-const LONGBLOB = 57525
-const JSON = 57526
-const ENUM = 57527
-const GEOMETRY = 57528
-const POINT = 57529
-const LINESTRING = 57530
-const POLYGON = 57531
-const GEOMETRYCOLLECTION = 57532
-const MULTIPOINT = 57533
-const MULTILINESTRING = 57534
-const MULTIPOLYGON = 57535
-const NULLX = 57536
-const AUTO_INCREMENT = 57537
-const APPROXNUM = 57538
-const SIGNED = 57539
-const UNSIGNED = 57540
-const ZEROFILL = 57541
-const COLLATION = 57542
-const DATABASES = 57543
-const TABLES = 57544
-const VITESS_METADATA = 57545
-const VSCHEMA = 57546
-const FULL = 57547
-const PROCESSLIST = 57548
-const COLUMNS = 57549
-const FIELDS = 57550
-const ENGINES = 57551
-const PLUGINS = 57552
-const NAMES = 57553
-const CHARSET = 57554
-const GLOBAL = 57555
-const SESSION = 57556
-const ISOLATION = 57557
-const LEVEL = 57558
-const READ = 57559
-const WRITE = 57560
-const ONLY = 57561
-const REPEATABLE = 57562
-const COMMITTED = 57563
-const UNCOMMITTED = 57564
-const SERIALIZABLE = 57565
-const CURRENT_TIMESTAMP = 57566
-const DATABASE = 57567
-const CURRENT_DATE = 57568
-const CURRENT_TIME = 57569
-const LOCALTIME = 57570
-const LOCALTIMESTAMP = 57571
-const UTC_DATE = 57572
-const UTC_TIME = 57573
-const UTC_TIMESTAMP = 57574
-const REPLACE = 57575
-const CONVERT = 57576
-const CAST = 57577
-const SUBSTR = 57578
-const SUBSTRING = 57579
-const GROUP_CONCAT = 57580
-const SEPARATOR = 57581
-const TIMESTAMPADD = 57582
-const TIMESTAMPDIFF = 57583
-const MATCH = 57584
-const AGAINST = 57585
-const BOOLEAN = 57586
-const LANGUAGE = 57587
-const WITH = 57588
-const QUERY = 57589
-const EXPANSION = 57590
-const UNUSED = 57591
-const ARRAY = 57592
-const CUME_DIST = 57593
-const DESCRIPTION = 57594
-const DENSE_RANK = 57595
-const EMPTY = 57596
-const EXCEPT = 57597
-const FIRST_VALUE = 57598
-const GROUPING = 57599
-const GROUPS = 57600
-const JSON_TABLE = 57601
-const LAG = 57602
-const LAST_VALUE = 57603
-const LATERAL = 57604
-const LEAD = 57605
-const MEMBER = 57606
-const NTH_VALUE = 57607
-const NTILE = 57608
-const OF = 57609
-const OVER = 57610
-const PERCENT_RANK = 57611
-const RANK = 57612
-const RECURSIVE = 57613
-const ROW_NUMBER = 57614
-const SYSTEM = 57615
-const WINDOW = 57616
-const ACTIVE = 57617
-const ADMIN = 57618
-const BUCKETS = 57619
-const CLONE = 57620
-const COMPONENT = 57621
-const DEFINITION = 57622
-const ENFORCED = 57623
-const EXCLUDE = 57624
-const FOLLOWING = 57625
-const GEOMCOLLECTION = 57626
-const GET_MASTER_PUBLIC_KEY = 57627
-const HISTOGRAM = 57628
-const HISTORY = 57629
-const INACTIVE = 57630
-const INVISIBLE = 57631
-const LOCKED = 57632
-const MASTER_COMPRESSION_ALGORITHMS = 57633
-const MASTER_PUBLIC_KEY_PATH = 57634
-const MASTER_TLS_CIPHERSUITES = 57635
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57636
-const NESTED = 57637
-const NETWORK_NAMESPACE = 57638
-const NOWAIT = 57639
-const NULLS = 57640
-const OJ = 57641
-const OLD = 57642
-const OPTIONAL = 57643
-const ORDINALITY = 57644
-const ORGANIZATION = 57645
-const OTHERS = 57646
-const PATH = 57647
-const PERSIST = 57648
-const PERSIST_ONLY = 57649
-const PRECEDING = 57650
-const PRIVILEGE_CHECKS_USER = 57651
-const PROCESS = 57652
-const RANDOM = 57653
-const REFERENCE = 57654
-const REQUIRE_ROW_FORMAT = 57655
-const RESOURCE = 57656
-const RESPECT = 57657
-const RESTART = 57658
-const RETAIN = 57659
-const REUSE = 57660
-const ROLE = 57661
-const SECONDARY = 57662
-const SECONDARY_ENGINE = 57663
-const SECONDARY_LOAD = 57664
-const SECONDARY_UNLOAD = 57665
-const SKIP = 57666
-const SRID = 57667
-const THREAD_PRIORITY = 57668
-const TIES = 57669
-const UNBOUNDED = 57670
-const VCPU = 57671
-const VISIBLE = 57672
+const SQL_CALC_FOUND_ROWS = 57385
+const JOIN = 57386
+const STRAIGHT_JOIN = 57387
+const LEFT = 57388
+const RIGHT = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const ID = 57398
+const HEX = 57399
+const STRING = 57400
+const INTEGRAL = 57401
+const FLOAT = 57402
+const HEXNUM = 57403
+const VALUE_ARG = 57404
+const LIST_ARG = 57405
+const COMMENT = 57406
+const COMMENT_KEYWORD = 57407
+const BIT_LITERAL = 57408
+const NULL = 57409
+const TRUE = 57410
+const FALSE = 57411
+const OFF = 57412
+const OR = 57413
+const AND = 57414
+const NOT = 57415
+const BETWEEN = 57416
+const CASE = 57417
+const WHEN = 57418
+const THEN = 57419
+const ELSE = 57420
+const END = 57421
+const LE = 57422
+const GE = 57423
+const NE = 57424
+const NULL_SAFE_EQUAL = 57425
+const IS = 57426
+const LIKE = 57427
+const REGEXP = 57428
+const IN = 57429
+const SHIFT_LEFT = 57430
+const SHIFT_RIGHT = 57431
+const DIV = 57432
+const MOD = 57433
+const UNARY = 57434
+const COLLATE = 57435
+const BINARY = 57436
+const UNDERSCORE_BINARY = 57437
+const UNDERSCORE_UTF8MB4 = 57438
+const INTERVAL = 57439
+const JSON_EXTRACT_OP = 57440
+const JSON_UNQUOTE_EXTRACT_OP = 57441
+const CREATE = 57442
+const ALTER = 57443
+const DROP = 57444
+const RENAME = 57445
+const ANALYZE = 57446
+const ADD = 57447
+const FLUSH = 57448
+const SCHEMA = 57449
+const TABLE = 57450
+const INDEX = 57451
+const VIEW = 57452
+const TO = 57453
+const IGNORE = 57454
+const IF = 57455
+const UNIQUE = 57456
+const PRIMARY = 57457
+const COLUMN = 57458
+const SPATIAL = 57459
+const FULLTEXT = 57460
+const KEY_BLOCK_SIZE = 57461
+const CHECK = 57462
+const ACTION = 57463
+const CASCADE = 57464
+const CONSTRAINT = 57465
+const FOREIGN = 57466
+const NO = 57467
+const REFERENCES = 57468
+const RESTRICT = 57469
+const SHOW = 57470
+const DESCRIBE = 57471
+const EXPLAIN = 57472
+const DATE = 57473
+const ESCAPE = 57474
+const REPAIR = 57475
+const OPTIMIZE = 57476
+const TRUNCATE = 57477
+const MAXVALUE = 57478
+const PARTITION = 57479
+const REORGANIZE = 57480
+const LESS = 57481
+const THAN = 57482
+const PROCEDURE = 57483
+const TRIGGER = 57484
+const VINDEX = 57485
+const VINDEXES = 57486
+const TEMPORARY = 57487
+const STATUS = 57488
+const VARIABLES = 57489
+const WARNINGS = 57490
+const SEQUENCE = 57491
+const BEGIN = 57492
+const START = 57493
+const TRANSACTION = 57494
+const COMMIT = 57495
+const ROLLBACK = 57496
+const SAVEPOINT = 57497
+const RELEASE = 57498
+const KILL = 57499
+const CONNECTION = 57500
+const BIT = 57501
+const TINYINT = 57502
+const SMALLINT = 57503
+const MEDIUMINT = 57504
+const INT = 57505
+const INTEGER = 57506
+const BIGINT = 57507
+const INTNUM = 57508
+const REAL = 57509
+const DOUBLE = 57510
+const FLOAT_TYPE = 57511
+const DECIMAL = 57512
+const NUMERIC = 57513
+const TIME = 57514
+const TIMESTAMP = 57515
+const DATETIME = 57516
+const YEAR = 57517
+const CHAR = 57518
+const VARCHAR = 57519
+const BOOL = 57520
+const CHARACTER = 57521
+const VARBINARY = 57522
+const NCHAR = 57523
+const TEXT = 57524
+const TINYTEXT = 57525
+const MEDIUMTEXT = 57526
+const LONGTEXT = 57527
+const BLOB = 57528
+const TINYBLOB = 57529
+const MEDIUMBLOB = 57530
+const LONGBLOB = 57531
+const JSON = 57532
+const ENUM = 57533
+const GEOMETRY = 57534
+const POINT = 57535
+const LINESTRING = 57536
+const POLYGON = 57537
+const GEOMETRYCOLLECTION = 57538
+const MULTIPOINT = 57539
+const MULTILINESTRING = 57540
+const MULTIPOLYGON = 57541
+const NULLX = 57542
+const AUTO_INCREMENT = 57543
+const APPROXNUM = 57544
+const SIGNED = 57545
+const UNSIGNED = 57546
+const ZEROFILL = 57547
+const COLLATION = 57548
+const DATABASES = 57549
+const TABLES = 57550
+const VITESS_METADATA = 57551
+const VSCHEMA = 57552
+const FULL = 57553
+const PROCESSLIST = 57554
+const COLUMNS = 57555
+const FIELDS = 57556
+const ENGINES = 57557
+const PLUGINS = 57558
+const NAMES = 57559
+const CHARSET = 57560
+const GLOBAL = 57561
+const SESSION = 57562
+const ISOLATION = 57563
+const LEVEL = 57564
+const READ = 57565
+const WRITE = 57566
+const ONLY = 57567
+const REPEATABLE = 57568
+const COMMITTED = 57569
+const UNCOMMITTED = 57570
+const SERIALIZABLE = 57571
+const CURRENT_TIMESTAMP = 57572
+const DATABASE = 57573
+const CURRENT_DATE = 57574
+const CURRENT_TIME = 57575
+const LOCALTIME = 57576
+const LOCALTIMESTAMP = 57577
+const UTC_DATE = 57578
+const UTC_TIME = 57579
+const UTC_TIMESTAMP = 57580
+const REPLACE = 57581
+const CONVERT = 57582
+const CAST = 57583
+const SUBSTR = 57584
+const SUBSTRING = 57585
+const GROUP_CONCAT = 57586
+const SEPARATOR = 57587
+const TIMESTAMPADD = 57588
+const TIMESTAMPDIFF = 57589
+const MATCH = 57590
+const AGAINST = 57591
+const BOOLEAN = 57592
+const LANGUAGE = 57593
+const WITH = 57594
+const QUERY = 57595
+const EXPANSION = 57596
+const UNUSED = 57597
+const ARRAY = 57598
+const CUME_DIST = 57599
+const DESCRIPTION = 57600
+const DENSE_RANK = 57601
+const EMPTY = 57602
+const EXCEPT = 57603
+const FIRST_VALUE = 57604
+const GROUPING = 57605
+const GROUPS = 57606
+const JSON_TABLE = 57607
+const LAG = 57608
+const LAST_VALUE = 57609
+const LATERAL = 57610
+const LEAD = 57611
+const MEMBER = 57612
+const NTH_VALUE = 57613
+const NTILE = 57614
+const OF = 57615
+const PERCENT_RANK = 57616
+const RANK = 57617
+const RECURSIVE = 57618
+const ROW_NUMBER = 57619
+const SYSTEM = 57620
+const ACTIVE = 57621
+const ADMIN = 57622
+const BUCKETS = 57623
+const CLONE = 57624
+const COMPONENT = 57625
+const DEFINITION = 57626
+const ENFORCED = 57627
+const EXCLUDE = 57628
+const GEOMCOLLECTION = 57629
+const GET_MASTER_PUBLIC_KEY = 57630
+const HISTOGRAM = 57631
+const HISTORY = 57632
+const INACTIVE = 57633
+const INVISIBLE = 57634
+const LOCKED = 57635
+const MASTER_COMPRESSION_ALGORITHMS = 57636
+const MASTER_PUBLIC_KEY_PATH = 57637
+const MASTER_TLS_CIPHERSUITES = 57638
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57639
+const NESTED = 57640
+const NETWORK_NAMESPACE = 57641
+const NOWAIT = 57642
+const NULLS = 57643
+const OJ = 57644
+const OLD = 57645
+const OPTIONAL = 57646
+const ORDINALITY = 57647
+const ORGANIZATION = 57648
+const OTHERS = 57649
+const PATH = 57650
+const PERSIST = 57651
+const PERSIST_ONLY = 57652
+const PRIVILEGE_CHECKS_USER = 57653
+const PROCESS = 57654
+const RANDOM = 57655
+const REFERENCE = 57656
+const REQUIRE_ROW_FORMAT = 57657
+const RESOURCE = 57658
+const RESPECT = 57659
+const RESTART = 57660
+const RETAIN = 57661
+const REUSE = 57662
+const ROLE = 57663
+const SECONDARY = 57664
+const SECONDARY_ENGINE = 57665
+const SECONDARY_LOAD = 57666
+const SECONDARY_UNLOAD = 57667
+const SKIP = 57668
+const SRID = 57669
+const THREAD_PRIORITY = 57670
+const TIES = 57671
+const VCPU = 57672
+const VISIBLE = 57673
+const OVER = 57674
+const WINDOW = 57675
+const ROWS = 57676
+const RANGE = 57677
+const CURRENT = 57678
+const ROW = 57679
+const UNBOUNDED = 57680
+const PRECEDING = 57681
+const FOLLOWING = 57682
 
 var yyToknames = [...]string{
 	"$end",
@@ -478,6 +497,7 @@ var yyToknames = [...]string{
 	"MODE",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
+	"SQL_CALC_FOUND_ROWS",
 	"JOIN",
 	"STRAIGHT_JOIN",
 	"LEFT",
@@ -596,6 +616,7 @@ var yyToknames = [...]string{
 	"TRIGGER",
 	"VINDEX",
 	"VINDEXES",
+	"TEMPORARY",
 	"STATUS",
 	"VARIABLES",
 	"WARNINGS",
@@ -605,6 +626,10 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
+	"KILL",
+	"CONNECTION",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -720,13 +745,11 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
 	"RECURSIVE",
 	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -735,7 +758,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -760,7 +782,6 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
@@ -780,9 +801,17 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"RANGE",
+	"CURRENT",
+	"ROW",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"';'",
 }
 var yyStatenames = [...]string{}
@@ -796,1795 +825,1947 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 3,
-	5, 29,
-	-2, 4,
-	-1, 37,
-	161, 302,
-	162, 302,
-	-2, 290,
-	-1, 322,
-	113, 645,
-	-2, 641,
-	-1, 323,
-	113, 646,
-	-2, 642,
-	-1, 391,
-	83, 894,
-	-2, 63,
-	-1, 392,
-	83, 812,
-	-2, 64,
-	-1, 397,
-	83, 781,
-	-2, 607,
-	-1, 399,
-	83, 842,
-	-2, 609,
-	-1, 697,
-	1, 355,
-	5, 355,
-	12, 355,
-	13, 355,
-	14, 355,
-	15, 355,
-	17, 355,
-	19, 355,
-	30, 355,
-	31, 355,
-	43, 355,
-	44, 355,
-	45, 355,
-	46, 355,
-	47, 355,
-	49, 355,
-	50, 355,
-	53, 355,
-	54, 355,
-	56, 355,
-	57, 355,
-	348, 355,
-	-2, 373,
-	-1, 700,
-	54, 44,
-	56, 44,
-	-2, 48,
-	-1, 852,
-	113, 648,
-	-2, 644,
-	-1, 1081,
-	5, 30,
-	-2, 441,
-	-1, 1111,
-	5, 29,
-	-2, 581,
-	-1, 1355,
-	5, 30,
-	-2, 582,
-	-1, 1408,
-	5, 29,
-	-2, 584,
-	-1, 1488,
-	5, 30,
-	-2, 585,
+	-1, 25,
+	5, 41,
+	-2, 27,
+	-1, 39,
+	163, 316,
+	164, 316,
+	-2, 304,
+	-1, 66,
+	5, 41,
+	-2, 28,
+	-1, 336,
+	114, 695,
+	-2, 691,
+	-1, 337,
+	114, 696,
+	-2, 692,
+	-1, 406,
+	84, 951,
+	-2, 75,
+	-1, 407,
+	84, 866,
+	-2, 76,
+	-1, 412,
+	84, 833,
+	-2, 657,
+	-1, 414,
+	84, 896,
+	-2, 659,
+	-1, 722,
+	1, 379,
+	5, 379,
+	12, 379,
+	13, 379,
+	14, 379,
+	15, 379,
+	17, 379,
+	19, 379,
+	30, 379,
+	31, 379,
+	44, 379,
+	45, 379,
+	46, 379,
+	47, 379,
+	48, 379,
+	50, 379,
+	51, 379,
+	54, 379,
+	55, 379,
+	57, 379,
+	58, 379,
+	350, 379,
+	358, 379,
+	-2, 397,
+	-1, 725,
+	55, 56,
+	57, 56,
+	-2, 60,
+	-1, 880,
+	114, 698,
+	-2, 694,
+	-1, 1121,
+	5, 42,
+	-2, 465,
+	-1, 1411,
+	5, 42,
+	-2, 632,
+	-1, 1548,
+	5, 42,
+	-2, 635,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16982
+const yyLast = 18512
 
 var yyAct = [...]int{
 
-	323, 1522, 1512, 1317, 1476, 653, 327, 1114, 1206, 1375,
-	1388, 966, 1132, 1421, 1257, 340, 1291, 939, 1258, 551,
-	57, 962, 353, 1115, 1254, 1009, 652, 3, 975, 1270,
-	1264, 995, 81, 965, 301, 1229, 266, 396, 1159, 266,
-	877, 888, 799, 884, 329, 815, 1138, 1185, 1072, 1176,
-	713, 979, 941, 854, 584, 926, 1005, 520, 385, 590,
-	937, 354, 51, 712, 390, 596, 310, 266, 81, 325,
-	989, 919, 266, 694, 266, 702, 605, 387, 300, 667,
-	56, 1515, 382, 1499, 1510, 1028, 906, 668, 1486, 693,
-	61, 540, 1507, 1318, 1498, 1246, 1485, 1347, 525, 1027,
-	261, 257, 258, 259, 956, 314, 555, 276, 1286, 1287,
-	957, 958, 292, 51, 562, 1285, 63, 64, 65, 66,
-	67, 306, 253, 1147, 298, 251, 1146, 255, 1032, 1148,
-	573, 714, 286, 715, 574, 571, 572, 1026, 1450, 618,
-	617, 627, 628, 620, 621, 622, 623, 624, 625, 626,
-	619, 578, 297, 629, 1167, 988, 1230, 293, 294, 295,
-	296, 1208, 365, 299, 371, 372, 369, 370, 368, 367,
-	366, 1378, 557, 996, 559, 1338, 1336, 1395, 373, 374,
-	291, 788, 393, 269, 566, 567, 576, 1023, 1020, 1021,
-	272, 1019, 787, 1210, 1232, 785, 1509, 1506, 280, 275,
-	1469, 980, 1477, 1205, 920, 556, 558, 541, 1530, 1430,
-	577, 527, 1422, 255, 1209, 1211, 982, 982, 792, 260,
-	254, 789, 887, 1030, 1033, 1424, 776, 786, 1234, 1202,
-	1238, 278, 1233, 1526, 1231, 1204, 1280, 285, 1279, 1236,
-	1278, 252, 982, 523, 530, 268, 1160, 521, 1235, 1133,
-	1135, 256, 266, 1193, 641, 642, 1040, 266, 316, 1039,
-	1025, 1237, 1239, 266, 270, 1458, 1358, 1090, 1215, 266,
-	1087, 963, 1143, 1100, 81, 1066, 81, 81, 826, 81,
-	708, 81, 1024, 1191, 609, 547, 629, 81, 823, 604,
-	554, 282, 273, 1423, 283, 284, 289, 952, 1303, 619,
-	274, 277, 629, 271, 288, 287, 816, 1484, 1451, 996,
-	820, 1431, 1429, 981, 981, 1467, 1439, 81, 553, 978,
-	976, 1029, 977, 907, 1268, 592, 1134, 521, 974, 980,
-	70, 537, 1203, 593, 1201, 561, 1031, 561, 561, 981,
-	561, 1524, 561, 1085, 1525, 1084, 1523, 716, 561, 1304,
-	1192, 543, 544, 545, 1248, 1197, 1194, 1187, 1195, 1190,
-	519, 1186, 603, 602, 1188, 1189, 71, 778, 51, 639,
-	641, 642, 1165, 641, 642, 907, 861, 1097, 1196, 604,
-	266, 266, 266, 638, 1472, 599, 640, 1490, 817, 81,
-	859, 860, 858, 1086, 534, 81, 535, 552, 594, 536,
-	627, 628, 620, 621, 622, 623, 624, 625, 626, 619,
-	580, 581, 629, 1531, 651, 1384, 655, 656, 657, 658,
-	659, 660, 661, 662, 663, 697, 666, 669, 669, 669,
-	675, 669, 669, 675, 669, 683, 684, 685, 686, 687,
-	688, 692, 698, 1383, 603, 602, 670, 672, 674, 676,
-	678, 680, 681, 1532, 671, 673, 1180, 677, 679, 706,
-	682, 604, 603, 602, 701, 1179, 710, 617, 627, 628,
-	620, 621, 622, 623, 624, 625, 626, 619, 352, 604,
-	629, 618, 617, 627, 628, 620, 621, 622, 623, 624,
-	625, 626, 619, 1168, 583, 629, 622, 623, 624, 625,
-	626, 619, 526, 393, 629, 1063, 1064, 1065, 602, 825,
-	79, 620, 621, 622, 623, 624, 625, 626, 619, 54,
-	266, 629, 829, 830, 604, 81, 250, 1492, 1468, 857,
-	266, 266, 81, 81, 81, 1073, 603, 602, 266, 985,
-	878, 266, 879, 1250, 266, 986, 395, 824, 266, 1402,
-	81, 1465, 1149, 604, 1150, 81, 81, 81, 266, 81,
-	81, 587, 591, 1381, 603, 602, 1177, 81, 81, 1049,
-	803, 603, 602, 804, 22, 1427, 1508, 1436, 610, 528,
-	529, 604, 1494, 583, 1435, 801, 561, 1320, 604, 1427,
-	1480, 379, 380, 561, 561, 561, 81, 844, 846, 847,
-	1160, 266, 1155, 845, 1427, 583, 1300, 81, 1427, 1459,
-	983, 561, 880, 654, 1427, 1426, 561, 561, 561, 798,
-	561, 561, 665, 793, 1373, 1372, 320, 831, 561, 561,
-	797, 911, 1360, 583, 305, 855, 343, 342, 345, 346,
-	347, 348, 1357, 583, 1267, 344, 349, 852, 1310, 1309,
-	890, 81, 850, 1306, 1307, 1306, 1305, 856, 1079, 583,
-	1353, 833, 923, 583, 890, 583, 24, 897, 900, 779,
-	777, 774, 549, 908, 723, 722, 704, 24, 542, 533,
-	532, 1438, 892, 848, 81, 81, 928, 931, 932, 933,
-	929, 266, 930, 934, 583, 1407, 1271, 1272, 923, 266,
-	266, 1109, 51, 266, 266, 704, 1110, 266, 266, 266,
-	81, 881, 882, 1308, 1139, 54, 54, 655, 1139, 705,
-	58, 707, 1255, 81, 851, 1267, 54, 922, 1218, 904,
-	916, 618, 617, 627, 628, 620, 621, 622, 623, 624,
-	625, 626, 619, 697, 1151, 629, 801, 697, 705, 24,
-	703, 697, 395, 923, 395, 395, 955, 395, 923, 395,
-	938, 1079, 1267, 947, 698, 395, 950, 949, 698, 997,
-	998, 999, 945, 1079, 953, 954, 1103, 266, 81, 1102,
-	81, 970, 946, 1079, 703, 703, 266, 266, 266, 266,
-	266, 709, 266, 266, 827, 607, 266, 81, 54, 1011,
-	791, 1500, 1390, 991, 992, 993, 994, 1350, 307, 990,
-	1365, 1010, 805, 1344, 1296, 266, 1154, 266, 266, 1002,
-	1003, 1004, 266, 1006, 393, 1271, 1272, 1007, 1008, 1001,
-	1000, 1207, 1391, 1013, 818, 1046, 1517, 967, 1513, 561,
-	1298, 561, 1274, 1255, 1181, 618, 617, 627, 628, 620,
-	621, 622, 623, 624, 625, 626, 619, 54, 561, 629,
-	821, 841, 842, 795, 852, 1126, 1124, 395, 839, 1054,
-	1127, 1125, 1128, 718, 932, 933, 1277, 1276, 1123, 893,
-	894, 855, 1122, 899, 902, 903, 1056, 1055, 618, 617,
-	627, 628, 620, 621, 622, 623, 624, 625, 626, 619,
-	311, 312, 629, 856, 1504, 1497, 1214, 1051, 915, 1502,
-	917, 918, 1068, 1067, 654, 1061, 1060, 895, 896, 1172,
-	597, 597, 721, 585, 266, 266, 266, 266, 266, 1116,
-	1474, 550, 582, 598, 598, 586, 266, 595, 1164, 266,
-	1473, 851, 1405, 266, 1162, 1156, 1111, 266, 928, 931,
-	932, 933, 929, 1351, 930, 934, 1386, 643, 644, 645,
-	646, 647, 648, 649, 650, 892, 81, 1016, 697, 697,
-	697, 697, 697, 794, 936, 961, 1059, 1152, 308, 309,
-	302, 1112, 1113, 697, 1058, 698, 698, 698, 698, 698,
-	1129, 697, 1096, 1444, 1141, 1137, 1142, 303, 1118, 1119,
-	938, 1121, 1136, 395, 58, 1443, 1144, 1140, 698, 1393,
-	395, 395, 395, 1117, 81, 81, 1120, 1171, 1161, 1173,
-	1174, 1175, 1139, 1169, 1170, 575, 1519, 1518, 395, 1157,
-	1158, 1091, 1088, 395, 395, 395, 814, 395, 395, 600,
-	1519, 1455, 1379, 822, 81, 395, 395, 60, 62, 55,
-	1, 1178, 1511, 1319, 1349, 1387, 1022, 1475, 266, 1420,
-	1290, 1062, 973, 964, 69, 518, 68, 81, 1198, 1466,
-	972, 971, 1428, 1377, 835, 984, 561, 1166, 987, 1297,
-	967, 1163, 1471, 1052, 1053, 607, 591, 729, 395, 727,
-	728, 1213, 618, 617, 627, 628, 620, 621, 622, 623,
-	624, 625, 626, 619, 726, 561, 629, 731, 1077, 1078,
-	730, 1221, 725, 81, 81, 1247, 1116, 1256, 1222, 279,
-	388, 1228, 1241, 935, 717, 1012, 1240, 1094, 601, 883,
-	72, 1184, 1200, 1259, 1199, 1018, 819, 81, 852, 1261,
-	569, 570, 281, 1054, 637, 909, 1057, 1145, 394, 1080,
-	1275, 1262, 81, 828, 81, 81, 589, 1442, 1392, 1282,
-	1095, 1266, 913, 914, 664, 1289, 1098, 1281, 905, 328,
-	843, 341, 1260, 338, 51, 339, 834, 1108, 611, 326,
-	318, 1220, 266, 696, 1293, 1288, 689, 1284, 395, 927,
-	925, 1301, 1302, 924, 383, 1273, 1294, 1295, 1269, 695,
-	266, 395, 1217, 1346, 699, 1449, 81, 838, 26, 81,
-	81, 81, 266, 59, 313, 1251, 81, 19, 18, 266,
-	17, 20, 16, 15, 14, 832, 560, 538, 30, 21,
-	13, 12, 11, 1325, 10, 9, 8, 7, 853, 6,
-	263, 862, 863, 864, 865, 866, 867, 868, 869, 870,
-	871, 872, 873, 874, 875, 876, 395, 5, 395, 4,
-	1334, 304, 23, 697, 1326, 2, 967, 0, 967, 0,
-	0, 384, 0, 0, 1116, 395, 522, 0, 524, 1327,
-	698, 1352, 0, 0, 889, 891, 0, 1361, 0, 81,
-	0, 1362, 0, 0, 0, 0, 912, 81, 1312, 0,
-	1152, 1331, 1332, 0, 1333, 395, 0, 1335, 1345, 1337,
-	0, 1313, 81, 1315, 0, 0, 0, 1371, 0, 81,
-	0, 0, 0, 1223, 0, 0, 0, 0, 0, 0,
-	1220, 0, 0, 0, 0, 0, 0, 1380, 0, 1382,
-	1367, 1368, 1369, 618, 617, 627, 628, 620, 621, 622,
-	623, 624, 625, 626, 619, 0, 1249, 629, 81, 81,
-	0, 81, 0, 1374, 1394, 0, 81, 0, 81, 81,
-	81, 266, 1406, 561, 81, 1414, 1259, 1415, 1417, 1418,
-	0, 1401, 0, 1408, 0, 0, 0, 0, 1419, 0,
-	1425, 81, 266, 0, 0, 1432, 1413, 0, 1283, 0,
-	1440, 909, 1433, 967, 1434, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1260, 0, 0, 1409, 0,
-	0, 0, 1456, 0, 0, 0, 0, 0, 81, 0,
-	1259, 0, 1463, 1389, 1464, 0, 1457, 0, 0, 81,
-	81, 0, 0, 0, 395, 0, 0, 0, 1437, 1478,
-	1482, 1479, 0, 0, 0, 0, 531, 0, 0, 81,
-	0, 539, 1116, 1487, 0, 0, 0, 546, 0, 1260,
-	266, 51, 0, 548, 0, 0, 0, 0, 81, 0,
-	0, 1343, 0, 0, 0, 0, 1069, 1070, 1071, 1496,
-	0, 0, 1182, 395, 746, 0, 0, 0, 0, 1501,
-	1503, 81, 563, 564, 0, 565, 0, 568, 0, 1348,
-	1505, 0, 1075, 579, 1516, 0, 1076, 0, 1342, 654,
-	0, 1527, 395, 0, 1081, 1082, 1083, 1363, 0, 0,
-	1364, 1089, 0, 1366, 1092, 1093, 0, 0, 0, 0,
-	1099, 0, 0, 0, 1101, 395, 588, 1104, 1105, 1106,
-	1107, 0, 0, 1389, 967, 0, 618, 617, 627, 628,
-	620, 621, 622, 623, 624, 625, 626, 619, 0, 1131,
-	629, 1514, 0, 734, 0, 0, 0, 0, 0, 395,
-	0, 0, 264, 0, 691, 290, 700, 0, 909, 0,
-	0, 1263, 1265, 618, 617, 627, 628, 620, 621, 622,
-	623, 624, 625, 626, 619, 0, 0, 629, 0, 0,
-	317, 747, 0, 386, 0, 1265, 0, 0, 264, 0,
-	264, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	395, 0, 395, 1292, 760, 763, 764, 765, 766, 767,
-	768, 0, 769, 770, 771, 772, 773, 748, 749, 750,
-	751, 732, 733, 761, 0, 735, 0, 736, 737, 738,
-	739, 740, 741, 742, 743, 744, 745, 752, 753, 754,
-	755, 756, 757, 758, 759, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1316, 0, 0, 1321, 1322, 1323,
-	0, 0, 0, 0, 395, 0, 0, 0, 0, 0,
-	1224, 1225, 1481, 654, 0, 0, 0, 0, 0, 1226,
-	1227, 0, 0, 0, 1242, 1243, 0, 1244, 1245, 0,
-	0, 0, 0, 0, 724, 762, 0, 0, 0, 1252,
-	1253, 0, 0, 0, 780, 781, 0, 0, 0, 0,
-	0, 1341, 790, 0, 0, 384, 909, 0, 796, 0,
-	0, 775, 0, 0, 0, 0, 0, 0, 782, 783,
-	784, 0, 809, 0, 0, 0, 0, 395, 0, 0,
-	0, 0, 0, 0, 0, 1376, 802, 0, 0, 0,
-	0, 806, 807, 808, 0, 810, 811, 0, 0, 0,
-	395, 1299, 0, 812, 813, 0, 0, 395, 264, 0,
-	0, 0, 0, 264, 0, 840, 0, 0, 0, 264,
-	0, 0, 0, 0, 0, 264, 618, 617, 627, 628,
-	620, 621, 622, 623, 624, 625, 626, 619, 0, 0,
-	629, 0, 0, 0, 0, 0, 1410, 1411, 0, 1412,
-	0, 0, 0, 0, 1376, 0, 1376, 1376, 1376, 0,
-	1329, 0, 1292, 1328, 1074, 0, 0, 0, 0, 0,
-	0, 1330, 0, 0, 0, 0, 0, 0, 0, 1376,
-	0, 0, 1339, 1340, 618, 617, 627, 628, 620, 621,
-	622, 623, 624, 625, 626, 619, 0, 0, 629, 0,
-	0, 0, 1354, 1355, 1356, 921, 1359, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1470, 0, 948, 0,
-	0, 0, 0, 1370, 0, 0, 0, 395, 395, 0,
-	0, 0, 0, 0, 0, 0, 264, 264, 264, 0,
-	0, 0, 0, 0, 909, 0, 0, 1489, 618, 617,
-	627, 628, 620, 621, 622, 623, 624, 625, 626, 619,
-	0, 0, 629, 0, 0, 0, 1495, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1396, 1397,
-	1398, 1399, 1400, 0, 0, 0, 1403, 1404, 0, 1376,
-	0, 1014, 0, 0, 0, 0, 0, 0, 0, 0,
-	1034, 1035, 1036, 1037, 1038, 0, 1041, 1042, 0, 1416,
-	1043, 0, 0, 0, 1015, 0, 1017, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1045,
-	0, 0, 0, 1044, 0, 0, 1050, 0, 1445, 1446,
-	1447, 1448, 0, 1452, 0, 1453, 1454, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1460, 0, 1461,
-	1462, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 264, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 264, 264, 0, 0,
-	0, 1483, 0, 0, 264, 0, 0, 264, 0, 1488,
-	264, 0, 0, 0, 800, 0, 0, 0, 0, 0,
-	613, 0, 616, 0, 264, 0, 0, 1493, 630, 631,
-	632, 633, 634, 635, 636, 0, 614, 615, 612, 618,
-	617, 627, 628, 620, 621, 622, 623, 624, 625, 626,
-	619, 0, 0, 629, 0, 0, 0, 0, 0, 0,
-	0, 1520, 0, 0, 0, 0, 0, 264, 0, 0,
-	0, 0, 1528, 1529, 0, 0, 800, 0, 0, 0,
-	0, 0, 0, 0, 0, 24, 25, 52, 27, 28,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 43, 0, 0, 0, 0, 29,
-	48, 49, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 317, 317, 0, 0, 317, 317, 317,
-	38, 0, 0, 910, 54, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 317, 317, 317, 0, 264, 0, 0,
-	0, 1183, 0, 0, 0, 264, 943, 0, 0, 264,
-	264, 0, 0, 264, 951, 800, 0, 0, 0, 0,
-	0, 0, 1216, 0, 0, 0, 0, 0, 0, 0,
-	1212, 0, 0, 0, 0, 31, 32, 34, 33, 36,
-	0, 50, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 37, 44, 45, 0, 0, 46, 47,
-	35, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 264, 39, 40, 0, 41, 42, 0,
-	0, 0, 264, 264, 264, 264, 264, 0, 264, 264,
-	0, 0, 264, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 264, 0, 1047, 1048, 0, 0, 0, 264, 0,
-	0, 0, 0, 800, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 1311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1314, 0, 0, 0, 0, 53,
-	0, 0, 0, 0, 0, 0, 1324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 910,
-	264, 264, 264, 264, 264, 0, 0, 0, 0, 0,
-	0, 0, 1130, 0, 0, 264, 0, 0, 0, 943,
-	0, 0, 0, 264, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1385, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1441, 0, 0, 0,
-	0, 0, 0, 0, 264, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 800, 0, 0,
-	0, 0, 0, 0, 0, 0, 910, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1491, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 264, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 264, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 264, 0,
-	0, 0, 0, 0, 0, 264, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 910, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 943, 0, 0,
-	0, 0, 0, 505, 493, 0, 450, 508, 423, 440,
-	516, 441, 444, 481, 408, 463, 165, 438, 264, 427,
-	403, 434, 404, 425, 452, 111, 456, 422, 495, 466,
-	507, 137, 428, 514, 139, 472, 0, 211, 153, 0,
-	0, 454, 497, 461, 490, 449, 482, 413, 471, 509,
-	439, 479, 510, 0, 0, 0, 80, 0, 968, 969,
-	0, 0, 0, 0, 0, 101, 0, 476, 504, 436,
-	478, 480, 402, 473, 0, 406, 409, 515, 500, 431,
-	432, 1153, 910, 0, 0, 0, 0, 0, 453, 462,
-	487, 447, 0, 0, 0, 0, 264, 0, 0, 0,
-	429, 0, 470, 0, 0, 0, 410, 407, 0, 0,
-	451, 0, 0, 0, 412, 0, 430, 488, 0, 400,
-	119, 492, 499, 448, 267, 503, 446, 445, 506, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 496, 426, 435, 105, 433, 193, 172, 231,
-	469, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	405, 0, 212, 234, 249, 99, 421, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 417,
-	420, 415, 416, 464, 465, 511, 512, 513, 489, 411,
-	0, 418, 419, 0, 494, 501, 502, 468, 82, 91,
-	138, 246, 186, 116, 235, 401, 414, 109, 424, 0,
-	0, 437, 442, 443, 455, 457, 458, 459, 460, 467,
-	474, 475, 477, 483, 484, 485, 486, 491, 498, 517,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 505, 493, 0, 450,
-	508, 423, 440, 516, 441, 444, 481, 408, 463, 165,
-	438, 0, 427, 403, 434, 404, 425, 452, 111, 456,
-	422, 495, 466, 507, 137, 428, 514, 139, 472, 0,
-	211, 153, 0, 0, 454, 497, 461, 490, 449, 482,
-	413, 471, 509, 439, 479, 510, 0, 0, 0, 80,
-	0, 968, 969, 0, 0, 0, 0, 0, 101, 0,
-	476, 504, 436, 478, 480, 402, 473, 0, 406, 409,
-	515, 500, 431, 432, 0, 0, 0, 0, 0, 0,
-	0, 453, 462, 487, 447, 0, 0, 0, 0, 0,
-	0, 0, 0, 429, 0, 470, 0, 0, 0, 410,
-	407, 0, 0, 451, 0, 0, 0, 412, 0, 430,
-	488, 0, 400, 119, 492, 499, 448, 267, 503, 446,
-	445, 506, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 496, 426, 435, 105, 433,
-	193, 172, 231, 469, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 405, 0, 212, 234, 249, 99, 421,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 417, 420, 415, 416, 464, 465, 511, 512,
-	513, 489, 411, 0, 418, 419, 0, 494, 501, 502,
-	468, 82, 91, 138, 246, 186, 116, 235, 401, 414,
-	109, 424, 0, 0, 437, 442, 443, 455, 457, 458,
-	459, 460, 467, 474, 475, 477, 483, 484, 485, 486,
-	491, 498, 517, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 505,
-	493, 0, 450, 508, 423, 440, 516, 441, 444, 481,
-	408, 463, 165, 438, 0, 427, 403, 434, 404, 425,
-	452, 111, 456, 422, 495, 466, 507, 137, 428, 514,
-	139, 472, 0, 211, 153, 0, 0, 454, 497, 461,
-	490, 449, 482, 413, 471, 509, 439, 479, 510, 54,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 476, 504, 436, 478, 480, 402, 473,
-	0, 406, 409, 515, 500, 431, 432, 0, 0, 0,
-	0, 0, 0, 0, 453, 462, 487, 447, 0, 0,
-	0, 0, 0, 0, 0, 0, 429, 0, 470, 0,
-	0, 0, 410, 407, 0, 0, 451, 0, 0, 0,
-	412, 0, 430, 488, 0, 400, 119, 492, 499, 448,
-	267, 503, 446, 445, 506, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 496, 426,
-	435, 105, 433, 193, 172, 231, 469, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 405, 0, 212, 234,
-	249, 99, 421, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 417, 420, 415, 416, 464,
-	465, 511, 512, 513, 489, 411, 0, 418, 419, 0,
-	494, 501, 502, 468, 82, 91, 138, 246, 186, 116,
-	235, 401, 414, 109, 424, 0, 0, 437, 442, 443,
-	455, 457, 458, 459, 460, 467, 474, 475, 477, 483,
-	484, 485, 486, 491, 498, 517, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 505, 493, 0, 450, 508, 423, 440, 516,
-	441, 444, 481, 408, 463, 165, 438, 0, 427, 403,
-	434, 404, 425, 452, 111, 456, 422, 495, 466, 507,
-	137, 428, 514, 139, 472, 0, 211, 153, 0, 0,
-	454, 497, 461, 490, 449, 482, 413, 471, 509, 439,
-	479, 510, 0, 0, 0, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 476, 504, 436, 478,
-	480, 402, 473, 0, 406, 409, 515, 500, 431, 432,
-	0, 0, 0, 0, 0, 0, 0, 453, 462, 487,
-	447, 0, 0, 0, 0, 0, 0, 1219, 0, 429,
-	0, 470, 0, 0, 0, 410, 407, 0, 0, 451,
-	0, 0, 0, 412, 0, 430, 488, 0, 400, 119,
-	492, 499, 448, 267, 503, 446, 445, 506, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 496, 426, 435, 105, 433, 193, 172, 231, 469,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 405,
-	0, 212, 234, 249, 99, 421, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 417, 420,
-	415, 416, 464, 465, 511, 512, 513, 489, 411, 0,
-	418, 419, 0, 494, 501, 502, 468, 82, 91, 138,
-	246, 186, 116, 235, 401, 414, 109, 424, 0, 0,
-	437, 442, 443, 455, 457, 458, 459, 460, 467, 474,
-	475, 477, 483, 484, 485, 486, 491, 498, 517, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 505, 493, 0, 450, 508,
-	423, 440, 516, 441, 444, 481, 408, 463, 165, 438,
-	0, 427, 403, 434, 404, 425, 452, 111, 456, 422,
-	495, 466, 507, 137, 428, 514, 139, 472, 0, 211,
-	153, 0, 0, 454, 497, 461, 490, 449, 482, 413,
-	471, 509, 439, 479, 510, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 476,
-	504, 436, 478, 480, 402, 473, 0, 406, 409, 515,
-	500, 431, 432, 0, 0, 0, 0, 0, 0, 0,
-	453, 462, 487, 447, 0, 0, 0, 0, 0, 0,
-	952, 0, 429, 0, 470, 0, 0, 0, 410, 407,
-	0, 0, 451, 0, 0, 0, 412, 0, 430, 488,
-	0, 400, 119, 492, 499, 448, 267, 503, 446, 445,
-	506, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 496, 426, 435, 105, 433, 193,
-	172, 231, 469, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 405, 0, 212, 234, 249, 99, 421, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 417, 420, 415, 416, 464, 465, 511, 512, 513,
-	489, 411, 0, 418, 419, 0, 494, 501, 502, 468,
-	82, 91, 138, 246, 186, 116, 235, 401, 414, 109,
-	424, 0, 0, 437, 442, 443, 455, 457, 458, 459,
-	460, 467, 474, 475, 477, 483, 484, 485, 486, 491,
-	498, 517, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 505, 493,
-	0, 450, 508, 423, 440, 516, 441, 444, 481, 408,
-	463, 165, 438, 0, 427, 403, 434, 404, 425, 452,
-	111, 456, 422, 495, 466, 507, 137, 428, 514, 139,
-	472, 0, 211, 153, 0, 0, 454, 497, 461, 490,
-	449, 482, 413, 471, 509, 439, 479, 510, 0, 0,
-	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 476, 504, 436, 478, 480, 402, 473, 0,
-	406, 409, 515, 500, 431, 432, 0, 0, 0, 0,
-	0, 0, 0, 453, 462, 487, 447, 0, 0, 0,
-	0, 0, 0, 849, 0, 429, 0, 470, 0, 0,
-	0, 410, 407, 0, 0, 451, 0, 0, 0, 412,
-	0, 430, 488, 0, 400, 119, 492, 499, 448, 267,
-	503, 446, 445, 506, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 496, 426, 435,
-	105, 433, 193, 172, 231, 469, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 405, 0, 212, 234, 249,
-	99, 421, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 417, 420, 415, 416, 464, 465,
-	511, 512, 513, 489, 411, 0, 418, 419, 0, 494,
-	501, 502, 468, 82, 91, 138, 246, 186, 116, 235,
-	401, 414, 109, 424, 0, 0, 437, 442, 443, 455,
-	457, 458, 459, 460, 467, 474, 475, 477, 483, 484,
-	485, 486, 491, 498, 517, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 505, 493, 0, 450, 508, 423, 440, 516, 441,
-	444, 481, 408, 463, 165, 438, 0, 427, 403, 434,
-	404, 425, 452, 111, 456, 422, 495, 466, 507, 137,
-	428, 514, 139, 472, 0, 211, 153, 0, 0, 454,
-	497, 461, 490, 449, 482, 413, 471, 509, 439, 479,
-	510, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 476, 504, 436, 478, 480,
-	402, 473, 0, 406, 409, 515, 500, 431, 432, 0,
-	0, 0, 0, 0, 0, 0, 453, 462, 487, 447,
-	0, 0, 0, 0, 0, 0, 0, 0, 429, 0,
-	470, 0, 0, 0, 410, 407, 0, 0, 451, 0,
-	0, 0, 412, 0, 430, 488, 0, 400, 119, 492,
-	499, 448, 267, 503, 446, 445, 506, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	496, 426, 435, 105, 433, 193, 172, 231, 469, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 405, 0,
-	212, 234, 249, 99, 421, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 417, 420, 415,
-	416, 464, 465, 511, 512, 513, 489, 411, 0, 418,
-	419, 0, 494, 501, 502, 468, 82, 91, 138, 246,
-	186, 116, 235, 401, 414, 109, 424, 0, 0, 437,
-	442, 443, 455, 457, 458, 459, 460, 467, 474, 475,
-	477, 483, 484, 485, 486, 491, 498, 517, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 505, 493, 0, 450, 508, 423,
-	440, 516, 441, 444, 481, 408, 463, 165, 438, 0,
-	427, 403, 434, 404, 425, 452, 111, 456, 422, 495,
-	466, 507, 137, 428, 514, 139, 472, 0, 211, 153,
-	0, 0, 454, 497, 461, 490, 449, 482, 413, 471,
-	509, 439, 479, 510, 0, 0, 0, 322, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 476, 504,
-	436, 478, 480, 402, 473, 0, 406, 409, 515, 500,
-	431, 432, 0, 0, 0, 0, 0, 0, 0, 453,
-	462, 487, 447, 0, 0, 0, 0, 0, 0, 0,
-	0, 429, 0, 470, 0, 0, 0, 410, 407, 0,
-	0, 451, 0, 0, 0, 412, 0, 430, 488, 0,
-	400, 119, 492, 499, 448, 267, 503, 446, 445, 506,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 496, 426, 435, 105, 433, 193, 172,
-	231, 469, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 405, 0, 212, 234, 249, 99, 421, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	417, 420, 415, 416, 464, 465, 511, 512, 513, 489,
-	411, 0, 418, 419, 0, 494, 501, 502, 468, 82,
-	91, 138, 246, 186, 116, 235, 401, 414, 109, 424,
-	0, 0, 437, 442, 443, 455, 457, 458, 459, 460,
-	467, 474, 475, 477, 483, 484, 485, 486, 491, 498,
-	517, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 505, 493, 0,
-	450, 508, 423, 440, 516, 441, 444, 481, 408, 463,
-	165, 438, 0, 427, 403, 434, 404, 425, 452, 111,
-	456, 422, 495, 466, 507, 137, 428, 514, 139, 472,
-	0, 211, 153, 0, 0, 454, 497, 461, 490, 449,
-	482, 413, 471, 509, 439, 479, 510, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 476, 504, 436, 478, 480, 402, 473, 0, 406,
-	409, 515, 500, 431, 432, 0, 0, 0, 0, 0,
-	0, 0, 453, 462, 487, 447, 0, 0, 0, 0,
-	0, 0, 0, 0, 429, 0, 470, 0, 0, 0,
-	410, 407, 0, 0, 451, 0, 0, 0, 412, 0,
-	430, 488, 0, 400, 119, 492, 499, 448, 267, 503,
-	446, 445, 506, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 496, 426, 435, 105,
-	433, 193, 172, 231, 469, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 398, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 405, 0, 212, 234, 249, 99,
-	421, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	399, 397, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 417, 420, 415, 416, 464, 465, 511,
-	512, 513, 489, 411, 0, 418, 419, 0, 494, 501,
-	502, 468, 82, 91, 138, 246, 186, 116, 235, 401,
-	414, 109, 424, 0, 0, 437, 442, 443, 455, 457,
-	458, 459, 460, 467, 474, 475, 477, 483, 484, 485,
-	486, 491, 498, 517, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	505, 493, 0, 450, 508, 423, 440, 516, 441, 444,
-	481, 408, 463, 165, 438, 0, 427, 403, 434, 404,
-	425, 452, 111, 456, 422, 495, 466, 507, 137, 428,
-	514, 139, 472, 0, 211, 153, 0, 0, 454, 497,
-	461, 490, 449, 482, 413, 471, 509, 439, 479, 510,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 0, 476, 504, 436, 478, 480, 402,
-	473, 0, 406, 409, 515, 500, 431, 432, 0, 0,
-	0, 0, 0, 0, 0, 453, 462, 487, 447, 0,
-	0, 0, 0, 0, 0, 0, 0, 429, 0, 470,
-	0, 0, 0, 410, 407, 0, 0, 451, 0, 0,
-	0, 412, 0, 430, 488, 0, 400, 119, 492, 499,
-	448, 267, 503, 446, 445, 506, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 496,
-	426, 435, 105, 433, 193, 172, 231, 469, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 405, 0, 212,
-	234, 249, 99, 421, 219, 243, 244, 0, 0, 100,
-	118, 113, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 417, 420, 415, 416,
-	464, 465, 511, 512, 513, 489, 411, 0, 418, 419,
-	0, 494, 501, 502, 468, 82, 91, 138, 246, 186,
-	116, 235, 401, 414, 109, 424, 0, 0, 437, 442,
-	443, 455, 457, 458, 459, 460, 467, 474, 475, 477,
-	483, 484, 485, 486, 491, 498, 517, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 505, 493, 0, 450, 508, 423, 440,
-	516, 441, 444, 481, 408, 463, 165, 438, 0, 427,
-	403, 434, 404, 425, 452, 111, 456, 422, 495, 466,
-	507, 137, 428, 514, 139, 472, 0, 211, 153, 0,
-	0, 454, 497, 461, 490, 449, 482, 413, 471, 509,
-	439, 479, 510, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 476, 504, 436,
-	478, 480, 402, 473, 0, 406, 409, 515, 500, 431,
-	432, 0, 0, 0, 0, 0, 0, 0, 453, 462,
-	487, 447, 0, 0, 0, 0, 0, 0, 0, 0,
-	429, 0, 470, 0, 0, 0, 410, 407, 0, 0,
-	451, 0, 0, 0, 412, 0, 430, 488, 0, 400,
-	119, 492, 499, 448, 267, 503, 446, 445, 506, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 496, 426, 435, 105, 433, 193, 172, 231,
-	469, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 711, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 398, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	405, 0, 212, 234, 249, 99, 421, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 399, 397, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 417,
-	420, 415, 416, 464, 465, 511, 512, 513, 489, 411,
-	0, 418, 419, 0, 494, 501, 502, 468, 82, 91,
-	138, 246, 186, 116, 235, 401, 414, 109, 424, 0,
-	0, 437, 442, 443, 455, 457, 458, 459, 460, 467,
-	474, 475, 477, 483, 484, 485, 486, 491, 498, 517,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 505, 493, 0, 450,
-	508, 423, 440, 516, 441, 444, 481, 408, 463, 165,
-	438, 0, 427, 403, 434, 404, 425, 452, 111, 456,
-	422, 495, 466, 507, 137, 428, 514, 139, 472, 0,
-	211, 153, 0, 0, 454, 497, 461, 490, 449, 482,
-	413, 471, 509, 439, 479, 510, 0, 0, 0, 80,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
-	476, 504, 436, 478, 480, 402, 473, 0, 406, 409,
-	515, 500, 431, 432, 0, 0, 0, 0, 0, 0,
-	0, 453, 462, 487, 447, 0, 0, 0, 0, 0,
-	0, 0, 0, 429, 0, 470, 0, 0, 0, 410,
-	407, 0, 0, 451, 0, 0, 0, 412, 0, 430,
-	488, 0, 400, 119, 492, 499, 448, 267, 503, 446,
-	445, 506, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 496, 426, 435, 105, 433,
-	193, 172, 231, 469, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 389, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 398, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 405, 0, 212, 234, 249, 99, 421,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 399,
-	397, 392, 391, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 417, 420, 415, 416, 464, 465, 511, 512,
-	513, 489, 411, 0, 418, 419, 0, 494, 501, 502,
-	468, 82, 91, 138, 246, 186, 116, 235, 401, 414,
-	109, 424, 0, 0, 437, 442, 443, 455, 457, 458,
-	459, 460, 467, 474, 475, 477, 483, 484, 485, 486,
-	491, 498, 517, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 885, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 886, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 322,
-	343, 342, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 0, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 315, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 0, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 959, 0, 54, 0, 0, 322,
-	343, 342, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 960, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 0, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 0, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 583, 322,
-	343, 342, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 0, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 0, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 0, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 322,
-	343, 342, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 0, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 315, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 0, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 322,
-	343, 901, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 0, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 315, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 324, 0, 0, 0, 111, 0,
-	321, 0, 0, 0, 137, 0, 364, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 355, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 322,
-	343, 898, 345, 346, 347, 348, 0, 0, 101, 344,
-	349, 350, 351, 0, 0, 0, 319, 336, 0, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 333,
-	334, 315, 0, 0, 0, 377, 0, 335, 0, 0,
-	330, 331, 332, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 267, 0, 0,
-	375, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 365, 376, 371, 372, 369, 370, 368, 367,
-	366, 378, 357, 358, 359, 360, 362, 0, 373, 374,
-	361, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 24,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 324, 0, 0, 0,
-	111, 0, 321, 0, 0, 0, 137, 0, 364, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 355, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 322, 343, 342, 345, 346, 347, 348, 0, 0,
-	101, 344, 349, 350, 351, 0, 0, 0, 319, 336,
-	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
-	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 375, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 365, 376, 371, 372, 369, 370,
-	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
-	373, 374, 361, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 324, 0, 0, 0,
-	111, 0, 321, 0, 0, 0, 137, 0, 364, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 355, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 322, 343, 342, 345, 346, 347, 348, 0, 0,
-	101, 344, 349, 350, 351, 0, 0, 0, 319, 336,
-	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
-	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 375, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 365, 376, 371, 372, 369, 370,
-	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
-	373, 374, 361, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 364, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 355, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 322, 343, 342, 345, 346, 347, 348, 0, 0,
-	101, 344, 349, 350, 351, 0, 0, 0, 0, 336,
-	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
-	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 375, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 1521, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 365, 376, 371, 372, 369, 370,
-	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
-	373, 374, 361, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 364, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 355, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	583, 322, 343, 342, 345, 346, 347, 348, 0, 0,
-	101, 344, 349, 350, 351, 0, 0, 0, 0, 336,
-	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
-	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 375, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 365, 376, 371, 372, 369, 370,
-	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
-	373, 374, 361, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 364, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 355, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 322, 343, 342, 345, 346, 347, 348, 0, 0,
-	101, 344, 349, 350, 351, 0, 0, 0, 0, 336,
-	0, 363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 377, 0, 335,
-	0, 0, 330, 331, 332, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 375, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 365, 376, 371, 372, 369, 370,
-	368, 367, 366, 378, 357, 358, 359, 360, 362, 0,
-	373, 374, 361, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 618, 617, 627,
-	628, 620, 621, 622, 623, 624, 625, 626, 619, 0,
-	0, 629, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 606, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 608, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 603, 602, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 604, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 74, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 76, 77, 0, 73,
-	0, 0, 0, 78, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 75, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 942, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 944, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 267,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 24, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 165, 0, 0, 0, 0, 0, 0,
-	0, 0, 111, 0, 0, 0, 0, 0, 137, 0,
-	0, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 80, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 267, 0, 0, 0, 0, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 0,
-	0, 0, 105, 0, 193, 172, 231, 0, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 0, 0, 212,
-	234, 249, 99, 0, 219, 243, 244, 0, 0, 100,
-	118, 113, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 24, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 942,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 944, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	940, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 0, 836, 0,
-	0, 837, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 720, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 719, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 583, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 944, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 608, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 690, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 381, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	267, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 262, 0,
-	267, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	267, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	267, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 322, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	267, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242,
+	337, 1620, 1449, 1606, 1588, 677, 1368, 866, 1246, 1534,
+	1154, 1443, 995, 367, 1576, 1172, 1308, 354, 1342, 341,
+	1479, 1305, 966, 968, 1309, 1275, 570, 1155, 1038, 1004,
+	579, 1315, 318, 411, 87, 581, 1178, 1321, 277, 826,
+	994, 277, 906, 1199, 1280, 917, 87, 1112, 913, 850,
+	310, 605, 855, 1225, 1008, 842, 738, 1216, 955, 935,
+	277, 883, 991, 970, 940, 676, 3, 614, 1034, 719,
+	861, 537, 277, 87, 737, 405, 718, 277, 948, 277,
+	397, 628, 325, 558, 400, 339, 67, 543, 402, 727,
+	1581, 1581, 65, 1582, 1582, 1594, 1595, 691, 1592, 1593,
+	311, 312, 313, 314, 1591, 1573, 317, 1577, 408, 692,
+	1562, 1563, 1276, 328, 56, 69, 70, 71, 72, 323,
+	1612, 1567, 58, 308, 1604, 1546, 1597, 1369, 327, 1566,
+	1545, 1297, 1058, 1403, 542, 263, 58, 58, 261, 985,
+	265, 1583, 1583, 1337, 1338, 322, 1057, 1509, 642, 641,
+	651, 652, 644, 645, 646, 647, 648, 649, 650, 643,
+	1149, 739, 653, 740, 574, 1150, 1465, 986, 987, 545,
+	546, 1336, 63, 1281, 316, 379, 1062, 385, 386, 383,
+	384, 382, 381, 380, 592, 1056, 63, 63, 593, 590,
+	591, 387, 388, 272, 267, 269, 270, 58, 27, 60,
+	29, 30, 1187, 315, 597, 1186, 1207, 1017, 1188, 1433,
+	1453, 1283, 1025, 1394, 1392, 1248, 48, 306, 309, 303,
+	595, 31, 53, 54, 814, 585, 586, 1250, 811, 1599,
+	576, 268, 578, 1586, 813, 1053, 1050, 1051, 264, 1049,
+	1535, 1503, 1245, 40, 949, 1528, 1629, 63, 1009, 559,
+	1285, 544, 1289, 1024, 1284, 265, 1282, 1251, 560, 262,
+	818, 1287, 277, 575, 577, 815, 1624, 277, 596, 812,
+	1286, 1060, 1063, 1249, 277, 1173, 1175, 304, 1011, 801,
+	1331, 277, 1330, 1288, 1290, 1329, 87, 540, 87, 87,
+	1487, 87, 548, 87, 547, 280, 266, 1070, 1130, 87,
+	1069, 665, 666, 1011, 1517, 87, 1414, 87, 33, 34,
+	36, 35, 38, 1055, 55, 1270, 1183, 271, 582, 583,
+	1140, 584, 1510, 587, 1105, 277, 881, 1480, 733, 598,
+	632, 87, 1127, 566, 538, 1054, 39, 49, 50, 1242,
+	1482, 51, 52, 37, 992, 1244, 1544, 1025, 1011, 555,
+	1018, 653, 1174, 573, 562, 563, 564, 981, 41, 42,
+	1233, 43, 44, 45, 46, 47, 603, 604, 343, 643,
+	1083, 847, 653, 627, 1059, 1622, 1010, 1526, 1623, 1200,
+	1621, 1578, 1578, 1579, 1579, 59, 538, 1354, 843, 1061,
+	572, 1231, 621, 1488, 1486, 277, 277, 277, 1496, 59,
+	59, 1010, 665, 666, 87, 1299, 1007, 1005, 1481, 1006,
+	87, 936, 552, 1319, 553, 1003, 1009, 554, 717, 536,
+	642, 641, 651, 652, 644, 645, 646, 647, 648, 649,
+	650, 643, 75, 741, 653, 609, 665, 666, 1355, 408,
+	626, 625, 1602, 1243, 618, 1241, 1010, 61, 936, 916,
+	1137, 646, 647, 648, 649, 650, 643, 627, 1232, 653,
+	59, 803, 1205, 1237, 1234, 1227, 1235, 1230, 76, 1226,
+	571, 844, 1228, 1229, 1113, 713, 1630, 726, 694, 696,
+	698, 700, 702, 704, 705, 890, 1236, 625, 731, 1014,
+	695, 697, 735, 701, 703, 1015, 706, 1530, 1550, 888,
+	889, 887, 637, 627, 640, 1439, 1438, 368, 62, 1552,
+	654, 655, 656, 657, 658, 659, 660, 1631, 638, 639,
+	636, 642, 641, 651, 652, 644, 645, 646, 647, 648,
+	649, 650, 643, 63, 62, 653, 1220, 277, 626, 625,
+	1219, 1208, 87, 886, 907, 1301, 908, 277, 602, 277,
+	87, 87, 87, 1527, 1460, 627, 277, 1436, 260, 277,
+	1088, 1089, 1217, 277, 1102, 1103, 1104, 277, 1080, 87,
+	62, 831, 800, 1126, 87, 87, 87, 277, 87, 87,
+	808, 809, 810, 1125, 1451, 1124, 87, 87, 644, 645,
+	646, 647, 648, 649, 650, 643, 830, 1524, 653, 829,
+	828, 87, 626, 625, 833, 834, 835, 1371, 837, 838,
+	626, 625, 1200, 1189, 622, 1190, 839, 840, 277, 627,
+	622, 87, 1101, 1598, 277, 626, 625, 627, 394, 395,
+	87, 872, 874, 875, 1195, 806, 909, 873, 825, 819,
+	1406, 857, 627, 824, 821, 1554, 622, 1101, 1538, 1493,
+	884, 642, 641, 651, 652, 644, 645, 646, 647, 648,
+	649, 650, 643, 1085, 804, 653, 880, 802, 879, 1101,
+	622, 1492, 878, 1101, 1518, 87, 858, 799, 642, 641,
+	651, 652, 644, 645, 646, 647, 648, 649, 650, 643,
+	1101, 1484, 653, 1429, 1428, 1400, 1416, 622, 859, 568,
+	864, 561, 1084, 729, 926, 929, 551, 663, 87, 87,
+	937, 876, 1413, 622, 1361, 1360, 277, 1357, 1358, 626,
+	625, 1357, 1356, 1448, 277, 277, 1119, 622, 277, 277,
+	952, 622, 277, 277, 277, 87, 627, 919, 622, 748,
+	747, 918, 920, 729, 550, 921, 1351, 730, 87, 732,
+	976, 910, 911, 1306, 978, 319, 1318, 1600, 933, 945,
+	1012, 951, 919, 1179, 722, 1318, 975, 828, 728, 1119,
+	408, 642, 641, 651, 652, 644, 645, 646, 647, 648,
+	649, 650, 643, 996, 1179, 653, 1409, 730, 952, 728,
+	952, 1495, 1359, 580, 728, 580, 580, 1119, 580, 1191,
+	580, 984, 277, 87, 974, 87, 580, 277, 952, 1143,
+	982, 983, 277, 277, 277, 277, 277, 979, 277, 277,
+	999, 1142, 277, 277, 87, 1119, 1086, 1040, 610, 1318,
+	734, 617, 817, 1044, 620, 1046, 63, 1568, 1445, 1019,
+	1421, 1039, 277, 662, 277, 277, 664, 1347, 1194, 277,
+	1322, 1323, 1614, 1035, 1075, 1030, 1399, 1029, 1247, 1446,
+	1042, 87, 611, 58, 1036, 1037, 1607, 1349, 1325, 1077,
+	1306, 1221, 848, 822, 675, 1098, 679, 680, 681, 682,
+	683, 684, 685, 686, 687, 1328, 690, 693, 693, 693,
+	699, 693, 693, 699, 693, 707, 708, 709, 710, 711,
+	712, 884, 1073, 723, 957, 960, 961, 962, 958, 1090,
+	959, 963, 63, 63, 1166, 880, 366, 879, 1327, 1167,
+	1164, 1107, 1163, 1091, 1162, 1165, 1168, 1092, 961, 962,
+	1265, 1100, 642, 641, 651, 652, 644, 645, 646, 647,
+	648, 649, 650, 643, 615, 616, 653, 1584, 1565, 1108,
+	85, 1377, 1254, 277, 277, 277, 277, 277, 1570, 1263,
+	1262, 851, 305, 1212, 862, 277, 862, 746, 277, 569,
+	1204, 1156, 277, 852, 1115, 1532, 277, 863, 1116, 863,
+	860, 1531, 1463, 1202, 1196, 1407, 1121, 1122, 1123, 410,
+	1441, 1045, 25, 1129, 1136, 87, 1132, 1133, 1180, 820,
+	1601, 965, 1139, 865, 606, 885, 1141, 1192, 1559, 1144,
+	1145, 1146, 1147, 1181, 1151, 1182, 1026, 1027, 1028, 66,
+	612, 613, 1169, 1158, 1159, 1542, 1161, 607, 1261, 1157,
+	996, 1171, 1160, 921, 319, 1177, 1260, 1558, 1540, 1179,
+	594, 1184, 1131, 87, 87, 1616, 1615, 1616, 1128, 580,
+	841, 623, 1201, 1514, 1434, 1082, 321, 580, 580, 580,
+	68, 1197, 1198, 64, 1, 1605, 330, 1370, 357, 356,
+	359, 360, 361, 362, 1223, 87, 580, 358, 363, 1442,
+	1052, 580, 580, 580, 1533, 580, 580, 1211, 1218, 1213,
+	1214, 1215, 722, 580, 580, 1478, 722, 1341, 1002, 1224,
+	722, 993, 87, 74, 1238, 1252, 87, 535, 1020, 1021,
+	1022, 1023, 73, 1525, 1001, 1000, 1485, 1432, 62, 1013,
+	1264, 1206, 1016, 1348, 1031, 1032, 1033, 1203, 1529, 754,
+	922, 923, 1253, 752, 928, 931, 932, 753, 751, 1257,
+	1258, 1268, 756, 755, 750, 291, 403, 964, 742, 1041,
+	624, 77, 1240, 87, 87, 1269, 1239, 1271, 1048, 944,
+	1307, 946, 947, 846, 1310, 1279, 588, 1298, 1291, 1156,
+	589, 1292, 62, 293, 880, 661, 1302, 87, 1259, 1185,
+	1107, 409, 1277, 1278, 1313, 1587, 1572, 679, 1580, 1561,
+	1560, 1317, 87, 1502, 87, 87, 1450, 1087, 1326, 854,
+	1557, 1539, 410, 1135, 410, 410, 1340, 410, 1332, 410,
+	688, 1333, 934, 342, 871, 410, 355, 1335, 1312, 352,
+	353, 599, 277, 601, 1093, 1344, 1148, 996, 635, 996,
+	340, 967, 1339, 332, 1099, 723, 721, 714, 334, 723,
+	277, 1345, 1346, 956, 954, 953, 87, 630, 398, 87,
+	87, 87, 277, 1324, 1320, 720, 885, 1447, 1402, 1508,
+	277, 1097, 28, 320, 393, 307, 22, 21, 20, 19,
+	18, 87, 1352, 1353, 1209, 1210, 1363, 87, 17, 23,
+	1376, 16, 15, 14, 556, 32, 24, 13, 12, 1364,
+	11, 1366, 641, 651, 652, 644, 645, 646, 647, 648,
+	649, 650, 643, 1384, 1385, 653, 1268, 10, 9, 8,
+	580, 7, 580, 6, 1390, 5, 4, 619, 26, 608,
+	410, 722, 722, 722, 722, 722, 743, 57, 2, 1408,
+	0, 580, 0, 0, 1380, 0, 722, 0, 1156, 0,
+	87, 0, 0, 0, 722, 1386, 1418, 0, 87, 0,
+	1417, 0, 1192, 0, 0, 1431, 1395, 1396, 0, 0,
+	0, 0, 0, 87, 1117, 1118, 0, 0, 0, 0,
+	87, 0, 0, 664, 0, 996, 1410, 1411, 1412, 0,
+	1415, 0, 0, 1134, 0, 1427, 0, 1106, 957, 960,
+	961, 962, 958, 1440, 959, 963, 0, 1426, 1322, 1323,
+	633, 0, 0, 0, 0, 1444, 0, 0, 0, 0,
+	0, 0, 0, 0, 87, 87, 0, 87, 0, 0,
+	0, 0, 87, 1310, 87, 87, 87, 277, 0, 1472,
+	87, 1473, 1475, 1476, 1464, 678, 0, 0, 0, 0,
+	0, 0, 0, 0, 689, 0, 87, 277, 1483, 277,
+	1477, 0, 1489, 1497, 1459, 0, 1152, 1153, 410, 0,
+	723, 723, 723, 723, 723, 0, 410, 410, 410, 1471,
+	0, 1500, 0, 0, 0, 967, 0, 1176, 1466, 1310,
+	0, 0, 1515, 723, 1499, 410, 0, 0, 1474, 0,
+	410, 410, 410, 1522, 410, 410, 87, 87, 1490, 1523,
+	1491, 0, 410, 410, 0, 0, 0, 1536, 0, 1537,
+	0, 0, 0, 0, 1501, 0, 0, 849, 87, 1504,
+	1505, 1506, 1507, 0, 1511, 1547, 1512, 1513, 0, 277,
+	0, 1444, 996, 1516, 1156, 1541, 87, 867, 1519, 0,
+	1520, 1521, 0, 0, 0, 0, 630, 1556, 1564, 410,
+	0, 580, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1571, 1569, 1575, 0, 0, 0, 87,
+	288, 0, 0, 1543, 87, 0, 1585, 0, 0, 0,
+	0, 1548, 580, 667, 668, 669, 670, 671, 672, 673,
+	674, 912, 1590, 0, 0, 0, 298, 87, 1553, 0,
+	0, 87, 0, 0, 0, 1609, 0, 938, 0, 1613,
+	1435, 0, 1437, 1619, 0, 0, 0, 1611, 1625, 0,
+	0, 0, 0, 0, 942, 943, 0, 0, 0, 0,
+	0, 0, 1387, 1388, 0, 1389, 0, 0, 1391, 832,
+	1393, 0, 1452, 0, 0, 0, 0, 281, 0, 0,
+	0, 410, 0, 0, 284, 0, 0, 0, 1311, 0,
+	62, 845, 292, 287, 410, 0, 0, 0, 0, 0,
+	0, 0, 0, 853, 856, 0, 0, 0, 0, 0,
+	0, 0, 1626, 1627, 1628, 0, 0, 0, 0, 0,
+	0, 0, 869, 870, 1430, 290, 0, 0, 0, 0,
+	0, 0, 297, 651, 652, 644, 645, 646, 647, 648,
+	649, 650, 643, 0, 1379, 653, 0, 0, 0, 410,
+	0, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	410, 0, 1398, 0, 0, 0, 678, 1405, 0, 924,
+	925, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	294, 285, 0, 295, 296, 301, 1397, 0, 0, 286,
+	289, 0, 283, 300, 299, 0, 0, 1094, 0, 0,
+	0, 0, 0, 0, 1383, 642, 641, 651, 652, 644,
+	645, 646, 647, 648, 649, 650, 643, 0, 410, 653,
+	0, 0, 0, 0, 0, 1401, 0, 0, 990, 0,
+	0, 0, 0, 0, 0, 0, 0, 722, 642, 641,
+	651, 652, 644, 645, 646, 647, 648, 649, 650, 643,
+	0, 0, 653, 0, 0, 0, 0, 1423, 1424, 1425,
+	0, 0, 642, 641, 651, 652, 644, 645, 646, 647,
+	648, 649, 650, 643, 0, 0, 653, 0, 634, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 938, 0,
+	580, 0, 0, 0, 882, 0, 0, 891, 892, 893,
+	894, 895, 896, 897, 898, 899, 900, 901, 902, 903,
+	904, 905, 0, 0, 0, 0, 275, 0, 0, 302,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 410, 0, 0, 0, 0, 0, 1311, 326, 0,
+	1467, 0, 0, 0, 0, 0, 331, 0, 0, 0,
+	401, 0, 941, 0, 0, 275, 0, 275, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1494,
+	0, 0, 0, 0, 0, 0, 723, 0, 0, 1222,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1311, 0, 62, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1272, 1120, 0, 0, 0,
+	0, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1138, 1114, 642, 641, 651, 652, 644,
+	645, 646, 647, 648, 649, 650, 643, 0, 1266, 653,
+	0, 0, 410, 0, 642, 641, 651, 652, 644, 645,
+	646, 647, 648, 649, 650, 643, 0, 0, 653, 642,
+	641, 651, 652, 644, 645, 646, 647, 648, 649, 650,
+	643, 0, 0, 653, 0, 0, 0, 410, 0, 0,
+	0, 0, 0, 0, 0, 0, 938, 0, 0, 1314,
+	1316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1608, 410, 1610,
+	410, 1343, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 0, 0, 275, 0, 1109, 1110, 1111,
+	0, 0, 275, 0, 0, 0, 0, 0, 0, 275,
+	0, 0, 771, 0, 0, 0, 0, 0, 0, 0,
+	0, 1255, 1256, 856, 0, 0, 0, 0, 0, 0,
+	0, 0, 1367, 0, 0, 1372, 1373, 1374, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 0, 0, 0, 410, 0, 0,
+	0, 0, 0, 1382, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1300, 724, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 759, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 938, 0, 0, 0, 0,
+	0, 0, 0, 274, 0, 0, 1334, 0, 0, 0,
+	0, 0, 0, 275, 275, 275, 410, 0, 0, 0,
+	772, 0, 0, 0, 867, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 399, 0, 410,
+	0, 0, 539, 0, 541, 0, 410, 0, 785, 788,
+	789, 790, 791, 792, 793, 0, 794, 795, 796, 797,
+	798, 773, 774, 775, 776, 757, 758, 786, 0, 760,
+	0, 761, 762, 763, 764, 765, 766, 767, 768, 769,
+	770, 777, 778, 779, 780, 781, 782, 783, 784, 0,
+	1468, 1469, 0, 1470, 0, 0, 0, 0, 867, 0,
+	867, 867, 867, 0, 0, 0, 1343, 0, 0, 0,
+	0, 0, 1273, 1274, 0, 0, 0, 0, 0, 0,
+	0, 0, 867, 0, 0, 0, 1293, 1294, 1404, 1295,
+	1296, 0, 0, 0, 0, 0, 0, 0, 678, 787,
+	0, 1303, 1304, 0, 0, 0, 1419, 0, 0, 1420,
+	0, 0, 1422, 0, 0, 275, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 275, 0, 275, 0, 0,
+	0, 0, 410, 410, 275, 0, 0, 275, 0, 0,
+	0, 275, 0, 0, 0, 827, 0, 0, 0, 0,
+	0, 938, 0, 0, 1549, 275, 0, 0, 0, 0,
+	0, 0, 0, 1350, 0, 0, 0, 0, 0, 0,
+	0, 0, 1555, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 549, 0, 0,
+	0, 0, 557, 0, 0, 0, 326, 0, 0, 565,
+	0, 0, 275, 0, 0, 867, 567, 0, 0, 0,
+	1589, 827, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1381, 0, 1603, 0, 0, 0, 1589, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 331, 0, 0, 0, 0, 331,
+	331, 0, 0, 331, 331, 331, 0, 0, 0, 939,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 331, 331,
+	331, 331, 0, 0, 275, 0, 0, 0, 0, 0,
+	0, 0, 275, 972, 0, 0, 275, 275, 0, 0,
+	275, 980, 827, 0, 0, 0, 0, 0, 0, 0,
+	716, 0, 725, 0, 0, 0, 0, 0, 0, 678,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1574, 678, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1454, 1455, 1456, 1457,
+	1458, 0, 0, 0, 1461, 1462, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 0, 0, 275, 0, 0, 0, 0,
+	275, 275, 275, 275, 275, 0, 275, 275, 0, 0,
+	275, 275, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 1078, 1079, 0, 0, 0, 275, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	827, 0, 749, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 805, 0, 807, 0, 0, 0, 0, 0,
+	0, 816, 0, 0, 399, 0, 0, 0, 823, 0,
+	0, 0, 0, 331, 331, 0, 0, 0, 0, 0,
+	0, 0, 836, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	939, 275, 275, 275, 275, 275, 0, 0, 0, 0,
+	0, 0, 1596, 1170, 0, 0, 275, 0, 0, 868,
+	972, 0, 0, 0, 275, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1617, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 950, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 977, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 827,
+	0, 0, 0, 0, 0, 0, 0, 1043, 939, 0,
+	0, 0, 1047, 0, 0, 0, 0, 1064, 1065, 1066,
+	1067, 1068, 0, 1071, 1072, 0, 0, 399, 1074, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 173, 0, 0, 0, 971, 1076, 0, 0,
+	0, 119, 0, 0, 1081, 0, 0, 145, 0, 0,
+	147, 0, 0, 222, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 276, 0, 973, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 275, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 0, 0, 0, 0, 0, 0, 275, 0,
+	0, 0, 0, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 127, 0, 0,
+	0, 279, 0, 0, 0, 0, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 0,
+	0, 0, 113, 0, 201, 180, 242, 939, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 0, 0, 223, 245, 259, 105, 0, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 972, 0, 0, 0, 0,
+	88, 97, 146, 0, 194, 124, 246, 0, 0, 117,
+	0, 0, 0, 0, 0, 275, 0, 275, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 939, 0, 213, 0, 112, 212, 243,
+	186, 128, 0, 0, 0, 0, 0, 275, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1362, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1365, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1375, 0, 0,
+	0, 0, 0, 0, 0, 1378, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 521, 509, 0, 465,
+	524, 438, 455, 532, 456, 459, 496, 423, 478, 173,
+	453, 0, 442, 418, 449, 419, 440, 467, 119, 471,
+	437, 511, 481, 523, 145, 443, 530, 147, 487, 0,
+	222, 161, 0, 0, 0, 469, 513, 476, 506, 464,
+	497, 428, 486, 525, 454, 494, 526, 0, 0, 0,
+	86, 0, 997, 998, 0, 0, 0, 0, 0, 107,
+	0, 491, 520, 451, 493, 495, 417, 488, 0, 421,
+	424, 531, 516, 446, 447, 1193, 0, 0, 0, 0,
+	0, 0, 468, 477, 503, 462, 0, 0, 0, 0,
+	0, 0, 1498, 0, 444, 0, 485, 0, 0, 0,
+	425, 422, 0, 0, 466, 0, 0, 0, 427, 0,
+	445, 504, 0, 415, 127, 508, 515, 463, 279, 519,
+	461, 460, 522, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 512, 441, 450, 113,
+	448, 201, 180, 242, 484, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	240, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 1551, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 101, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 420,
+	0, 223, 245, 259, 105, 436, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 165, 102, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 432, 435,
+	430, 431, 479, 480, 527, 528, 529, 505, 426, 0,
+	433, 434, 0, 510, 517, 518, 483, 88, 97, 146,
+	534, 194, 124, 246, 416, 429, 117, 439, 0, 0,
+	452, 457, 458, 470, 472, 473, 474, 475, 482, 489,
+	490, 492, 499, 501, 502, 507, 514, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	498, 533, 213, 500, 112, 212, 243, 186, 128, 521,
+	509, 0, 465, 524, 438, 455, 532, 456, 459, 496,
+	423, 478, 173, 453, 0, 442, 418, 449, 419, 440,
+	467, 119, 471, 437, 511, 481, 523, 145, 443, 530,
+	147, 487, 0, 222, 161, 0, 0, 0, 469, 513,
+	476, 506, 464, 497, 428, 486, 525, 454, 494, 526,
+	0, 0, 0, 86, 0, 997, 998, 0, 0, 0,
+	0, 0, 107, 0, 491, 520, 451, 493, 495, 417,
+	488, 0, 421, 424, 531, 516, 446, 447, 0, 0,
+	0, 0, 0, 0, 0, 468, 477, 503, 462, 0,
+	0, 0, 0, 0, 0, 0, 0, 444, 0, 485,
+	0, 0, 0, 425, 422, 0, 0, 466, 0, 0,
+	0, 427, 0, 445, 504, 0, 415, 127, 508, 515,
+	463, 279, 519, 461, 460, 522, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 512,
+	441, 450, 113, 448, 201, 180, 242, 484, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 420, 0, 223, 245, 259, 105, 436, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 432, 435, 430, 431, 479, 480, 527, 528, 529,
+	505, 426, 0, 433, 434, 0, 510, 517, 518, 483,
+	88, 97, 146, 534, 194, 124, 246, 416, 429, 117,
+	439, 0, 0, 452, 457, 458, 470, 472, 473, 474,
+	475, 482, 489, 490, 492, 499, 501, 502, 507, 514,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 498, 533, 213, 500, 112, 212, 243,
+	186, 128, 521, 509, 0, 465, 524, 438, 455, 532,
+	456, 459, 496, 423, 478, 173, 453, 0, 442, 418,
+	449, 419, 440, 467, 119, 471, 437, 511, 481, 523,
+	145, 443, 530, 147, 487, 0, 222, 161, 0, 0,
+	0, 469, 513, 476, 506, 464, 497, 428, 486, 525,
+	454, 494, 526, 63, 0, 0, 86, 0, 0, 0,
+	0, 0, 0, 0, 0, 107, 0, 491, 520, 451,
+	493, 495, 417, 488, 0, 421, 424, 531, 516, 446,
+	447, 0, 0, 0, 0, 0, 0, 0, 468, 477,
+	503, 462, 0, 0, 0, 0, 0, 0, 0, 0,
+	444, 0, 485, 0, 0, 0, 425, 422, 0, 0,
+	466, 0, 0, 0, 427, 0, 445, 504, 0, 415,
+	127, 508, 515, 463, 279, 519, 461, 460, 522, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 512, 441, 450, 113, 448, 201, 180, 242,
+	484, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 420, 0, 223, 245, 259,
+	105, 436, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 432, 435, 430, 431, 479, 480,
+	527, 528, 529, 505, 426, 0, 433, 434, 0, 510,
+	517, 518, 483, 88, 97, 146, 534, 194, 124, 246,
+	416, 429, 117, 439, 0, 0, 452, 457, 458, 470,
+	472, 473, 474, 475, 482, 489, 490, 492, 499, 501,
+	502, 507, 514, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 498, 533, 213, 500,
+	112, 212, 243, 186, 128, 521, 509, 0, 465, 524,
+	438, 455, 532, 456, 459, 496, 423, 478, 173, 453,
+	0, 442, 418, 449, 419, 440, 467, 119, 471, 437,
+	511, 481, 523, 145, 443, 530, 147, 487, 0, 222,
+	161, 0, 0, 0, 469, 513, 476, 506, 464, 497,
+	428, 486, 525, 454, 494, 526, 0, 0, 0, 86,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	491, 520, 451, 493, 495, 417, 488, 0, 421, 424,
+	531, 516, 446, 447, 0, 0, 0, 0, 0, 0,
+	0, 468, 477, 503, 462, 0, 0, 0, 0, 0,
+	0, 1267, 0, 444, 0, 485, 0, 0, 0, 425,
+	422, 0, 0, 466, 0, 0, 0, 427, 0, 445,
+	504, 0, 415, 127, 508, 515, 463, 279, 519, 461,
+	460, 522, 192, 0, 226, 130, 144, 103, 89, 99,
+	0, 129, 170, 199, 203, 512, 441, 450, 113, 448,
+	201, 180, 242, 484, 182, 200, 148, 232, 193, 241,
+	251, 252, 278, 229, 249, 256, 219, 92, 228, 240,
+	108, 211, 214, 0, 0, 111, 94, 238, 225, 159,
+	139, 140, 93, 0, 197, 118, 125, 115, 172, 235,
+	236, 114, 258, 100, 248, 96, 101, 247, 166, 231,
+	239, 160, 153, 95, 237, 158, 152, 143, 122, 132,
+	190, 150, 191, 133, 163, 162, 164, 0, 420, 0,
+	223, 245, 259, 105, 436, 230, 254, 255, 0, 0,
+	106, 126, 121, 189, 165, 102, 135, 220, 142, 149,
+	196, 257, 179, 202, 109, 244, 221, 432, 435, 430,
+	431, 479, 480, 527, 528, 529, 505, 426, 0, 433,
+	434, 0, 510, 517, 518, 483, 88, 97, 146, 534,
+	194, 124, 246, 416, 429, 117, 439, 0, 0, 452,
+	457, 458, 470, 472, 473, 474, 475, 482, 489, 490,
+	492, 499, 501, 502, 507, 514, 90, 91, 98, 104,
+	110, 116, 120, 123, 131, 134, 136, 137, 138, 141,
+	151, 154, 155, 156, 157, 167, 168, 169, 171, 174,
+	175, 176, 177, 178, 181, 183, 184, 185, 187, 188,
+	195, 198, 204, 205, 206, 207, 208, 209, 210, 215,
+	216, 217, 218, 224, 227, 233, 234, 250, 253, 498,
+	533, 213, 500, 112, 212, 243, 186, 128, 521, 509,
+	0, 465, 524, 438, 455, 532, 456, 459, 496, 423,
+	478, 173, 453, 0, 442, 418, 449, 419, 440, 467,
+	119, 471, 437, 511, 481, 523, 145, 443, 530, 147,
+	487, 0, 222, 161, 0, 0, 0, 469, 513, 476,
+	506, 464, 497, 428, 486, 525, 454, 494, 526, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 491, 520, 451, 493, 495, 417, 488,
+	0, 421, 424, 531, 516, 446, 447, 0, 0, 0,
+	0, 0, 0, 0, 468, 477, 503, 462, 0, 0,
+	0, 0, 0, 0, 981, 0, 444, 0, 485, 0,
+	0, 0, 425, 422, 0, 0, 466, 0, 0, 0,
+	427, 0, 445, 504, 0, 415, 127, 508, 515, 463,
+	279, 519, 461, 460, 522, 192, 0, 226, 130, 144,
+	103, 89, 99, 0, 129, 170, 199, 203, 512, 441,
+	450, 113, 448, 201, 180, 242, 484, 182, 200, 148,
+	232, 193, 241, 251, 252, 278, 229, 249, 256, 219,
+	92, 228, 240, 108, 211, 214, 0, 0, 111, 94,
+	238, 225, 159, 139, 140, 93, 0, 197, 118, 125,
+	115, 172, 235, 236, 114, 258, 100, 248, 96, 101,
+	247, 166, 231, 239, 160, 153, 95, 237, 158, 152,
+	143, 122, 132, 190, 150, 191, 133, 163, 162, 164,
+	0, 420, 0, 223, 245, 259, 105, 436, 230, 254,
+	255, 0, 0, 106, 126, 121, 189, 165, 102, 135,
+	220, 142, 149, 196, 257, 179, 202, 109, 244, 221,
+	432, 435, 430, 431, 479, 480, 527, 528, 529, 505,
+	426, 0, 433, 434, 0, 510, 517, 518, 483, 88,
+	97, 146, 534, 194, 124, 246, 416, 429, 117, 439,
+	0, 0, 452, 457, 458, 470, 472, 473, 474, 475,
+	482, 489, 490, 492, 499, 501, 502, 507, 514, 90,
+	91, 98, 104, 110, 116, 120, 123, 131, 134, 136,
+	137, 138, 141, 151, 154, 155, 156, 157, 167, 168,
+	169, 171, 174, 175, 176, 177, 178, 181, 183, 184,
+	185, 187, 188, 195, 198, 204, 205, 206, 207, 208,
+	209, 210, 215, 216, 217, 218, 224, 227, 233, 234,
+	250, 253, 498, 533, 213, 500, 112, 212, 243, 186,
+	128, 521, 509, 0, 465, 524, 438, 455, 532, 456,
+	459, 496, 423, 478, 173, 453, 0, 442, 418, 449,
+	419, 440, 467, 119, 471, 437, 511, 481, 523, 145,
+	443, 530, 147, 487, 0, 222, 161, 0, 0, 0,
+	469, 513, 476, 506, 464, 497, 428, 486, 525, 454,
+	494, 526, 0, 0, 0, 336, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 520, 451, 493,
+	495, 417, 488, 0, 421, 424, 531, 516, 446, 447,
+	0, 0, 0, 0, 0, 0, 0, 468, 477, 503,
+	462, 0, 0, 0, 0, 0, 0, 877, 0, 444,
+	0, 485, 0, 0, 0, 425, 422, 0, 0, 466,
+	0, 0, 0, 427, 0, 445, 504, 0, 415, 127,
+	508, 515, 463, 279, 519, 461, 460, 522, 192, 0,
+	226, 130, 144, 103, 89, 99, 0, 129, 170, 199,
+	203, 512, 441, 450, 113, 448, 201, 180, 242, 484,
+	182, 200, 148, 232, 193, 241, 251, 252, 278, 229,
+	249, 256, 219, 92, 228, 240, 108, 211, 214, 0,
+	0, 111, 94, 238, 225, 159, 139, 140, 93, 0,
+	197, 118, 125, 115, 172, 235, 236, 114, 258, 100,
+	248, 96, 101, 247, 166, 231, 239, 160, 153, 95,
+	237, 158, 152, 143, 122, 132, 190, 150, 191, 133,
+	163, 162, 164, 0, 420, 0, 223, 245, 259, 105,
+	436, 230, 254, 255, 0, 0, 106, 126, 121, 189,
+	165, 102, 135, 220, 142, 149, 196, 257, 179, 202,
+	109, 244, 221, 432, 435, 430, 431, 479, 480, 527,
+	528, 529, 505, 426, 0, 433, 434, 0, 510, 517,
+	518, 483, 88, 97, 146, 534, 194, 124, 246, 416,
+	429, 117, 439, 0, 0, 452, 457, 458, 470, 472,
+	473, 474, 475, 482, 489, 490, 492, 499, 501, 502,
+	507, 514, 90, 91, 98, 104, 110, 116, 120, 123,
+	131, 134, 136, 137, 138, 141, 151, 154, 155, 156,
+	157, 167, 168, 169, 171, 174, 175, 176, 177, 178,
+	181, 183, 184, 185, 187, 188, 195, 198, 204, 205,
+	206, 207, 208, 209, 210, 215, 216, 217, 218, 224,
+	227, 233, 234, 250, 253, 498, 533, 213, 500, 112,
+	212, 243, 186, 128, 521, 509, 0, 465, 524, 438,
+	455, 532, 456, 459, 496, 423, 478, 173, 453, 0,
+	442, 418, 449, 419, 440, 467, 119, 471, 437, 511,
+	481, 523, 145, 443, 530, 147, 487, 0, 222, 161,
+	0, 0, 0, 469, 513, 476, 506, 464, 497, 428,
+	486, 525, 454, 494, 526, 0, 0, 0, 86, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 491,
+	520, 451, 493, 495, 417, 488, 0, 421, 424, 531,
+	516, 446, 447, 0, 0, 0, 0, 0, 0, 0,
+	468, 477, 503, 462, 0, 0, 0, 0, 0, 0,
+	0, 0, 444, 0, 485, 0, 0, 0, 425, 422,
+	0, 0, 466, 0, 0, 0, 427, 0, 445, 504,
+	0, 415, 127, 508, 515, 463, 279, 519, 461, 460,
+	522, 192, 0, 226, 130, 144, 103, 89, 99, 0,
+	129, 170, 199, 203, 512, 441, 450, 113, 448, 201,
+	180, 242, 484, 182, 200, 148, 232, 193, 241, 251,
+	252, 278, 229, 249, 256, 219, 92, 228, 240, 108,
+	211, 214, 0, 0, 111, 94, 238, 225, 159, 139,
+	140, 93, 0, 197, 118, 125, 115, 172, 235, 236,
+	114, 258, 100, 248, 96, 101, 247, 166, 231, 239,
+	160, 153, 95, 237, 158, 152, 143, 122, 132, 190,
+	150, 191, 133, 163, 162, 164, 0, 420, 0, 223,
+	245, 259, 105, 436, 230, 254, 255, 0, 0, 106,
+	126, 121, 189, 165, 102, 135, 220, 142, 149, 196,
+	257, 179, 202, 109, 244, 221, 432, 435, 430, 431,
+	479, 480, 527, 528, 529, 505, 426, 0, 433, 434,
+	0, 510, 517, 518, 483, 88, 97, 146, 534, 194,
+	124, 246, 416, 429, 117, 439, 0, 0, 452, 457,
+	458, 470, 472, 473, 474, 475, 482, 489, 490, 492,
+	499, 501, 502, 507, 514, 90, 91, 98, 104, 110,
+	116, 120, 123, 131, 134, 136, 137, 138, 141, 151,
+	154, 155, 156, 157, 167, 168, 169, 171, 174, 175,
+	176, 177, 178, 181, 183, 184, 185, 187, 188, 195,
+	198, 204, 205, 206, 207, 208, 209, 210, 215, 216,
+	217, 218, 224, 227, 233, 234, 250, 253, 498, 533,
+	213, 500, 112, 212, 243, 186, 128, 521, 509, 0,
+	465, 524, 438, 455, 532, 456, 459, 496, 423, 478,
+	173, 453, 0, 442, 418, 449, 419, 440, 467, 119,
+	471, 437, 511, 481, 523, 145, 443, 530, 147, 487,
+	0, 222, 161, 0, 0, 0, 469, 513, 476, 506,
+	464, 497, 428, 486, 525, 454, 494, 526, 0, 0,
+	0, 336, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 491, 520, 451, 493, 495, 417, 488, 0,
+	421, 424, 531, 516, 446, 447, 0, 0, 0, 0,
+	0, 0, 0, 468, 477, 503, 462, 0, 0, 0,
+	0, 0, 0, 0, 0, 444, 0, 485, 0, 0,
+	0, 425, 422, 0, 0, 466, 0, 0, 0, 427,
+	0, 445, 504, 0, 415, 127, 508, 515, 463, 279,
+	519, 461, 460, 522, 192, 0, 226, 130, 144, 103,
+	89, 99, 0, 129, 170, 199, 203, 512, 441, 450,
+	113, 448, 201, 180, 242, 484, 182, 200, 148, 232,
+	193, 241, 251, 252, 278, 229, 249, 256, 219, 92,
+	228, 240, 108, 211, 214, 0, 0, 111, 94, 238,
+	225, 159, 139, 140, 93, 0, 197, 118, 125, 115,
+	172, 235, 236, 114, 258, 100, 248, 96, 101, 247,
+	166, 231, 239, 160, 153, 95, 237, 158, 152, 143,
+	122, 132, 190, 150, 191, 133, 163, 162, 164, 0,
+	420, 0, 223, 245, 259, 105, 436, 230, 254, 255,
+	0, 0, 106, 126, 121, 189, 165, 102, 135, 220,
+	142, 149, 196, 257, 179, 202, 109, 244, 221, 432,
+	435, 430, 431, 479, 480, 527, 528, 529, 505, 426,
+	0, 433, 434, 0, 510, 517, 518, 483, 88, 97,
+	146, 534, 194, 124, 246, 416, 429, 117, 439, 0,
+	0, 452, 457, 458, 470, 472, 473, 474, 475, 482,
+	489, 490, 492, 499, 501, 502, 507, 514, 90, 91,
+	98, 104, 110, 116, 120, 123, 131, 134, 136, 137,
+	138, 141, 151, 154, 155, 156, 157, 167, 168, 169,
+	171, 174, 175, 176, 177, 178, 181, 183, 184, 185,
+	187, 188, 195, 198, 204, 205, 206, 207, 208, 209,
+	210, 215, 216, 217, 218, 224, 227, 233, 234, 250,
+	253, 498, 533, 213, 500, 112, 212, 243, 186, 128,
+	521, 509, 0, 465, 524, 438, 455, 532, 456, 459,
+	496, 423, 478, 173, 453, 0, 442, 418, 449, 419,
+	440, 467, 119, 471, 437, 511, 481, 523, 145, 443,
+	530, 147, 487, 0, 222, 161, 0, 0, 0, 469,
+	513, 476, 506, 464, 497, 428, 486, 525, 454, 494,
+	526, 0, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 107, 0, 491, 520, 451, 493, 495,
+	417, 488, 0, 421, 424, 531, 516, 446, 447, 0,
+	0, 0, 0, 0, 0, 0, 468, 477, 503, 462,
+	0, 0, 0, 0, 0, 0, 0, 0, 444, 0,
+	485, 0, 0, 0, 425, 422, 0, 0, 466, 0,
+	0, 0, 427, 0, 445, 504, 0, 415, 127, 508,
+	515, 463, 279, 519, 461, 460, 522, 192, 0, 226,
+	130, 144, 103, 89, 99, 0, 129, 170, 199, 203,
+	512, 441, 450, 113, 448, 201, 180, 242, 484, 182,
+	200, 148, 232, 193, 241, 251, 252, 278, 229, 249,
+	256, 219, 92, 228, 240, 108, 211, 214, 0, 0,
+	111, 94, 238, 225, 159, 139, 140, 93, 0, 197,
+	118, 125, 115, 172, 235, 236, 114, 258, 100, 248,
+	96, 413, 247, 166, 231, 239, 160, 153, 95, 237,
+	158, 152, 143, 122, 132, 190, 150, 191, 133, 163,
+	162, 164, 0, 420, 0, 223, 245, 259, 105, 436,
+	230, 254, 255, 0, 0, 106, 126, 121, 189, 414,
+	412, 135, 220, 142, 149, 196, 257, 179, 202, 109,
+	244, 221, 432, 435, 430, 431, 479, 480, 527, 528,
+	529, 505, 426, 0, 433, 434, 0, 510, 517, 518,
+	483, 88, 97, 146, 534, 194, 124, 246, 416, 429,
+	117, 439, 0, 0, 452, 457, 458, 470, 472, 473,
+	474, 475, 482, 489, 490, 492, 499, 501, 502, 507,
+	514, 90, 91, 98, 104, 110, 116, 120, 123, 131,
+	134, 136, 137, 138, 141, 151, 154, 155, 156, 157,
+	167, 168, 169, 171, 174, 175, 176, 177, 178, 181,
+	183, 184, 185, 187, 188, 195, 198, 204, 205, 206,
+	207, 208, 209, 210, 215, 216, 217, 218, 224, 227,
+	233, 234, 250, 253, 498, 533, 213, 500, 112, 212,
+	243, 186, 128, 521, 509, 0, 465, 524, 438, 455,
+	532, 456, 459, 496, 423, 478, 173, 453, 0, 442,
+	418, 449, 419, 440, 467, 119, 471, 437, 511, 481,
+	523, 145, 443, 530, 147, 487, 0, 222, 161, 0,
+	0, 0, 469, 513, 476, 506, 464, 497, 428, 486,
+	525, 454, 494, 526, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 491, 520,
+	451, 493, 495, 417, 488, 0, 421, 424, 531, 516,
+	446, 447, 0, 0, 0, 0, 0, 0, 0, 468,
+	477, 503, 462, 0, 0, 0, 0, 0, 0, 0,
+	0, 444, 0, 485, 0, 0, 0, 425, 422, 0,
+	0, 466, 0, 0, 0, 427, 0, 445, 504, 0,
+	415, 127, 508, 515, 463, 279, 519, 461, 460, 522,
+	192, 0, 226, 130, 144, 103, 89, 99, 0, 129,
+	170, 199, 203, 512, 441, 450, 113, 448, 201, 180,
+	242, 484, 182, 200, 148, 232, 193, 241, 251, 252,
+	278, 229, 249, 256, 219, 92, 228, 240, 108, 211,
+	214, 0, 0, 111, 94, 238, 225, 159, 139, 140,
+	93, 0, 197, 118, 125, 115, 172, 235, 236, 114,
+	258, 100, 248, 96, 101, 247, 166, 231, 239, 160,
+	153, 95, 237, 158, 152, 143, 122, 132, 190, 150,
+	191, 133, 163, 162, 164, 0, 420, 0, 223, 245,
+	259, 105, 436, 230, 254, 255, 0, 0, 106, 126,
+	121, 189, 165, 102, 135, 220, 142, 149, 196, 257,
+	179, 202, 109, 244, 221, 432, 435, 430, 431, 479,
+	480, 527, 528, 529, 505, 426, 0, 433, 434, 0,
+	510, 517, 518, 483, 88, 97, 146, 534, 194, 124,
+	246, 416, 429, 117, 439, 0, 0, 452, 457, 458,
+	470, 472, 473, 474, 475, 482, 489, 490, 492, 499,
+	501, 502, 507, 514, 90, 91, 98, 104, 110, 116,
+	120, 123, 131, 134, 136, 137, 138, 141, 151, 154,
+	155, 156, 157, 167, 168, 169, 171, 174, 175, 176,
+	177, 178, 181, 183, 184, 185, 187, 188, 195, 198,
+	204, 205, 206, 207, 208, 209, 210, 215, 216, 217,
+	218, 224, 227, 233, 234, 250, 253, 498, 533, 213,
+	500, 112, 212, 243, 186, 128, 521, 509, 0, 465,
+	524, 438, 455, 532, 456, 459, 496, 423, 478, 173,
+	453, 0, 442, 418, 449, 419, 440, 467, 119, 471,
+	437, 511, 481, 523, 145, 443, 530, 147, 487, 0,
+	222, 161, 0, 0, 0, 469, 513, 476, 506, 464,
+	497, 428, 486, 525, 454, 494, 526, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 491, 520, 451, 493, 495, 417, 488, 0, 421,
+	424, 531, 516, 446, 447, 0, 0, 0, 0, 0,
+	0, 0, 468, 477, 503, 462, 0, 0, 0, 0,
+	0, 0, 0, 0, 444, 0, 485, 0, 0, 0,
+	425, 422, 0, 0, 466, 0, 0, 0, 427, 0,
+	445, 504, 0, 415, 127, 508, 515, 463, 279, 519,
+	461, 460, 522, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 512, 441, 450, 113,
+	448, 201, 180, 242, 484, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	736, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 0, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 413, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 420,
+	0, 223, 245, 259, 105, 436, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 414, 412, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 432, 435,
+	430, 431, 479, 480, 527, 528, 529, 505, 426, 0,
+	433, 434, 0, 510, 517, 518, 483, 88, 97, 146,
+	534, 194, 124, 246, 416, 429, 117, 439, 0, 0,
+	452, 457, 458, 470, 472, 473, 474, 475, 482, 489,
+	490, 492, 499, 501, 502, 507, 514, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	498, 533, 213, 500, 112, 212, 243, 186, 128, 521,
+	509, 0, 465, 524, 438, 455, 532, 456, 459, 496,
+	423, 478, 173, 453, 0, 442, 418, 449, 419, 440,
+	467, 119, 471, 437, 511, 481, 523, 145, 443, 530,
+	147, 487, 0, 222, 161, 0, 0, 0, 469, 513,
+	476, 506, 464, 497, 428, 486, 525, 454, 494, 526,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 491, 520, 451, 493, 495, 417,
+	488, 0, 421, 424, 531, 516, 446, 447, 0, 0,
+	0, 0, 0, 0, 0, 468, 477, 503, 462, 0,
+	0, 0, 0, 0, 0, 0, 0, 444, 0, 485,
+	0, 0, 0, 425, 422, 0, 0, 466, 0, 0,
+	0, 427, 0, 445, 504, 0, 415, 127, 508, 515,
+	463, 279, 519, 461, 460, 522, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 512,
+	441, 450, 113, 448, 201, 180, 242, 484, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 404, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	413, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 420, 0, 223, 245, 259, 105, 436, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 414, 412,
+	407, 406, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 432, 435, 430, 431, 479, 480, 527, 528, 529,
+	505, 426, 0, 433, 434, 0, 510, 517, 518, 483,
+	88, 97, 146, 534, 194, 124, 246, 416, 429, 117,
+	439, 0, 0, 452, 457, 458, 470, 472, 473, 474,
+	475, 482, 489, 490, 492, 499, 501, 502, 507, 514,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 498, 533, 213, 500, 112, 212, 243,
+	186, 128, 173, 0, 0, 914, 0, 338, 0, 0,
+	0, 119, 0, 335, 0, 0, 0, 145, 915, 378,
+	147, 0, 0, 222, 161, 0, 0, 0, 0, 0,
+	369, 370, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 0, 0, 336, 357, 356, 359, 360, 361, 362,
+	0, 0, 107, 358, 363, 364, 365, 0, 0, 0,
+	333, 350, 0, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 348, 329, 0, 0, 0, 391,
+	0, 349, 0, 0, 344, 345, 346, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 127, 0, 0,
+	0, 279, 0, 0, 389, 0, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 0,
+	0, 0, 113, 0, 201, 180, 242, 0, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 0, 0, 223, 245, 259, 105, 0, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 379, 390, 385, 386, 383, 384, 382, 381, 380,
+	392, 371, 372, 373, 374, 376, 0, 387, 388, 375,
+	88, 97, 146, 0, 194, 124, 246, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 0, 0, 213, 0, 112, 212, 243,
+	186, 128, 173, 0, 0, 0, 0, 338, 0, 0,
+	0, 119, 0, 335, 0, 0, 0, 145, 0, 378,
+	147, 0, 0, 222, 161, 0, 0, 0, 0, 0,
+	369, 370, 0, 0, 0, 0, 0, 0, 988, 0,
+	63, 0, 0, 336, 357, 356, 359, 360, 361, 362,
+	0, 0, 107, 358, 363, 364, 365, 989, 0, 0,
+	333, 350, 0, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 348, 0, 0, 0, 0, 391,
+	0, 349, 0, 0, 344, 345, 346, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 127, 0, 0,
+	0, 279, 0, 0, 389, 0, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 0,
+	0, 0, 113, 0, 201, 180, 242, 0, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 0, 0, 223, 245, 259, 105, 0, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 379, 390, 385, 386, 383, 384, 382, 381, 380,
+	392, 371, 372, 373, 374, 376, 0, 387, 388, 375,
+	88, 97, 146, 0, 194, 124, 246, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 58, 0, 213, 0, 112, 212, 243,
+	186, 128, 0, 0, 0, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 0, 336, 357, 356, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 0, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 59, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 0,
+	112, 212, 243, 186, 128, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 622, 336, 357, 356, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 0, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 0,
+	112, 212, 243, 186, 128, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 0, 336, 357, 356, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 329, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 0,
+	112, 212, 243, 186, 128, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 0, 336, 357, 930, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 329, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 0,
+	112, 212, 243, 186, 128, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 0, 336, 357, 927, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 329, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 0,
+	112, 212, 243, 186, 128, 173, 0, 0, 0, 0,
+	338, 0, 0, 0, 119, 0, 335, 0, 0, 0,
+	145, 0, 378, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 369, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 63, 0, 0, 336, 357, 356, 359,
+	360, 361, 362, 0, 0, 107, 358, 363, 364, 365,
+	0, 0, 0, 333, 350, 0, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 348, 0, 0,
+	0, 0, 391, 0, 349, 0, 0, 344, 345, 346,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 389, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 379, 390, 385, 386, 383, 384,
+	382, 381, 380, 392, 371, 372, 373, 374, 376, 0,
+	387, 388, 375, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 173, 0, 213, 0,
+	112, 212, 243, 186, 128, 119, 0, 0, 0, 0,
+	0, 145, 0, 378, 147, 0, 0, 222, 161, 0,
+	0, 0, 0, 0, 369, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 336, 357, 356,
+	359, 360, 361, 362, 0, 0, 107, 358, 363, 364,
+	365, 0, 0, 0, 0, 350, 0, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 348, 0,
+	0, 0, 0, 391, 0, 349, 0, 0, 344, 345,
+	346, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 127, 0, 0, 0, 279, 0, 0, 389, 0,
+	192, 0, 226, 130, 144, 103, 89, 99, 0, 129,
+	170, 199, 203, 0, 0, 0, 113, 0, 201, 180,
+	242, 1618, 182, 200, 148, 232, 193, 241, 251, 252,
+	278, 229, 249, 256, 219, 92, 228, 240, 108, 211,
+	214, 0, 0, 111, 94, 238, 225, 159, 139, 140,
+	93, 0, 197, 118, 125, 115, 172, 235, 236, 114,
+	258, 100, 248, 96, 101, 247, 166, 231, 239, 160,
+	153, 95, 237, 158, 152, 143, 122, 132, 190, 150,
+	191, 133, 163, 162, 164, 0, 0, 0, 223, 245,
+	259, 105, 0, 230, 254, 255, 0, 0, 106, 126,
+	121, 189, 165, 102, 135, 220, 142, 149, 196, 257,
+	179, 202, 109, 244, 221, 379, 390, 385, 386, 383,
+	384, 382, 381, 380, 392, 371, 372, 373, 374, 376,
+	0, 387, 388, 375, 88, 97, 146, 0, 194, 124,
+	246, 0, 0, 117, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 116,
+	120, 123, 131, 134, 136, 137, 138, 141, 151, 154,
+	155, 156, 157, 167, 168, 169, 171, 174, 175, 176,
+	177, 178, 181, 183, 184, 185, 187, 188, 195, 198,
+	204, 205, 206, 207, 208, 209, 210, 215, 216, 217,
+	218, 224, 227, 233, 234, 250, 253, 173, 0, 213,
+	0, 112, 212, 243, 186, 128, 119, 0, 0, 0,
+	0, 0, 145, 0, 378, 147, 0, 0, 222, 161,
+	0, 0, 0, 0, 0, 369, 370, 0, 0, 0,
+	0, 0, 0, 0, 0, 63, 0, 622, 336, 357,
+	356, 359, 360, 361, 362, 0, 0, 107, 358, 363,
+	364, 365, 0, 0, 0, 0, 350, 0, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 348,
+	0, 0, 0, 0, 391, 0, 349, 0, 0, 344,
+	345, 346, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 127, 0, 0, 0, 279, 0, 0, 389,
+	0, 192, 0, 226, 130, 144, 103, 89, 99, 0,
+	129, 170, 199, 203, 0, 0, 0, 113, 0, 201,
+	180, 242, 0, 182, 200, 148, 232, 193, 241, 251,
+	252, 278, 229, 249, 256, 219, 92, 228, 240, 108,
+	211, 214, 0, 0, 111, 94, 238, 225, 159, 139,
+	140, 93, 0, 197, 118, 125, 115, 172, 235, 236,
+	114, 258, 100, 248, 96, 101, 247, 166, 231, 239,
+	160, 153, 95, 237, 158, 152, 143, 122, 132, 190,
+	150, 191, 133, 163, 162, 164, 0, 0, 0, 223,
+	245, 259, 105, 0, 230, 254, 255, 0, 0, 106,
+	126, 121, 189, 165, 102, 135, 220, 142, 149, 196,
+	257, 179, 202, 109, 244, 221, 379, 390, 385, 386,
+	383, 384, 382, 381, 380, 392, 371, 372, 373, 374,
+	376, 0, 387, 388, 375, 88, 97, 146, 0, 194,
+	124, 246, 0, 0, 117, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	116, 120, 123, 131, 134, 136, 137, 138, 141, 151,
+	154, 155, 156, 157, 167, 168, 169, 171, 174, 175,
+	176, 177, 178, 181, 183, 184, 185, 187, 188, 195,
+	198, 204, 205, 206, 207, 208, 209, 210, 215, 216,
+	217, 218, 224, 227, 233, 234, 250, 253, 173, 0,
+	213, 0, 112, 212, 243, 186, 128, 119, 0, 0,
+	0, 0, 0, 145, 0, 378, 147, 0, 0, 222,
+	161, 0, 0, 0, 0, 0, 369, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 0, 0, 336,
+	357, 356, 359, 360, 361, 362, 0, 0, 107, 358,
+	363, 364, 365, 0, 0, 0, 0, 350, 0, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	348, 0, 0, 0, 0, 391, 0, 349, 0, 0,
+	344, 345, 346, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 127, 0, 0, 0, 279, 0, 0,
+	389, 0, 192, 0, 226, 130, 144, 103, 89, 99,
+	0, 129, 170, 199, 203, 0, 0, 0, 113, 0,
+	201, 180, 242, 0, 182, 200, 148, 232, 193, 241,
+	251, 252, 278, 229, 249, 256, 219, 92, 228, 240,
+	108, 211, 214, 0, 0, 111, 94, 238, 225, 159,
+	139, 140, 93, 0, 197, 118, 125, 115, 172, 235,
+	236, 114, 258, 100, 248, 96, 101, 247, 166, 231,
+	239, 160, 153, 95, 237, 158, 152, 143, 122, 132,
+	190, 150, 191, 133, 163, 162, 164, 0, 0, 0,
+	223, 245, 259, 105, 0, 230, 254, 255, 0, 0,
+	106, 126, 121, 189, 165, 102, 135, 220, 142, 149,
+	196, 257, 179, 202, 109, 244, 221, 379, 390, 385,
+	386, 383, 384, 382, 381, 380, 392, 371, 372, 373,
+	374, 376, 0, 387, 388, 375, 88, 97, 146, 0,
+	194, 124, 246, 0, 0, 117, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 116, 120, 123, 131, 134, 136, 137, 138, 141,
+	151, 154, 155, 156, 157, 167, 168, 169, 171, 174,
+	175, 176, 177, 178, 181, 183, 184, 185, 187, 188,
+	195, 198, 204, 205, 206, 207, 208, 209, 210, 215,
+	216, 217, 218, 224, 227, 233, 234, 250, 253, 173,
+	0, 213, 0, 112, 212, 243, 186, 128, 119, 0,
+	0, 0, 0, 0, 145, 0, 0, 147, 0, 0,
+	222, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 642, 641, 651, 652,
+	644, 645, 646, 647, 648, 649, 650, 643, 0, 0,
+	653, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 127, 0, 0, 0, 279, 0,
+	0, 0, 0, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 0, 0, 0, 113,
+	0, 201, 180, 242, 0, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	240, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 0, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 101, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 0,
+	0, 223, 245, 259, 105, 0, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 165, 102, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 97, 146,
+	0, 194, 124, 246, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	0, 0, 213, 0, 112, 212, 243, 186, 128, 173,
+	0, 0, 0, 629, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 0, 145, 0, 0, 147, 0, 0,
+	222, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 631, 0, 0, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 0, 626, 625, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 627, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 127, 0, 0, 0, 279, 0,
+	0, 0, 0, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 0, 0, 0, 113,
+	0, 201, 180, 242, 0, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	240, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 0, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 101, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 0,
+	0, 223, 245, 259, 105, 0, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 165, 102, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 97, 146,
+	0, 194, 124, 246, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	173, 0, 213, 0, 112, 212, 243, 186, 128, 119,
+	0, 0, 0, 0, 0, 145, 0, 0, 147, 0,
+	0, 222, 161, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 127, 81, 82, 0, 78,
+	0, 0, 0, 84, 192, 0, 226, 130, 144, 103,
+	89, 99, 0, 129, 170, 199, 203, 0, 0, 0,
+	113, 0, 201, 180, 242, 0, 182, 200, 148, 232,
+	193, 241, 251, 252, 83, 229, 249, 256, 219, 92,
+	228, 240, 108, 211, 214, 0, 0, 111, 94, 238,
+	225, 159, 139, 140, 93, 0, 197, 118, 125, 115,
+	172, 235, 236, 114, 258, 100, 248, 96, 101, 247,
+	166, 231, 239, 160, 153, 95, 237, 158, 152, 143,
+	122, 132, 190, 150, 191, 133, 163, 162, 164, 0,
+	0, 0, 223, 245, 259, 105, 0, 230, 254, 255,
+	0, 0, 106, 126, 121, 189, 165, 102, 135, 220,
+	142, 149, 196, 257, 179, 202, 109, 244, 221, 0,
+	80, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 97,
+	146, 0, 194, 124, 246, 0, 0, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
+	98, 104, 110, 116, 120, 123, 131, 134, 136, 137,
+	138, 141, 151, 154, 155, 156, 157, 167, 168, 169,
+	171, 174, 175, 176, 177, 178, 181, 183, 184, 185,
+	187, 188, 195, 198, 204, 205, 206, 207, 208, 209,
+	210, 215, 216, 217, 218, 224, 227, 233, 234, 250,
+	253, 58, 0, 213, 0, 112, 212, 243, 186, 128,
+	0, 0, 0, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 0, 0, 145, 0,
+	0, 147, 0, 0, 222, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 0, 0, 86, 0, 0, 0, 0, 0,
+	0, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 127, 0,
+	0, 0, 279, 0, 0, 0, 0, 192, 0, 226,
+	130, 144, 103, 89, 99, 0, 129, 170, 199, 203,
+	0, 0, 0, 113, 0, 201, 180, 242, 0, 182,
+	200, 148, 232, 193, 241, 251, 252, 278, 229, 249,
+	256, 219, 92, 228, 240, 108, 211, 214, 0, 0,
+	111, 94, 238, 225, 159, 139, 140, 93, 0, 197,
+	118, 125, 115, 172, 235, 236, 114, 258, 100, 248,
+	96, 101, 247, 166, 231, 239, 160, 153, 95, 237,
+	158, 152, 143, 122, 132, 190, 150, 191, 133, 163,
+	162, 164, 0, 0, 0, 223, 245, 259, 105, 0,
+	230, 254, 255, 0, 0, 106, 126, 121, 189, 165,
+	102, 135, 220, 142, 149, 196, 257, 179, 202, 109,
+	244, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 97, 146, 59, 194, 124, 246, 0, 0,
+	117, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 116, 120, 123, 131,
+	134, 136, 137, 138, 141, 151, 154, 155, 156, 157,
+	167, 168, 169, 171, 174, 175, 176, 177, 178, 181,
+	183, 184, 185, 187, 188, 195, 198, 204, 205, 206,
+	207, 208, 209, 210, 215, 216, 217, 218, 224, 227,
+	233, 234, 250, 253, 58, 0, 213, 0, 112, 212,
+	243, 186, 128, 0, 0, 0, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 145, 0, 0, 147, 0, 0, 222, 161, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 127, 0, 0, 0, 279, 0, 0, 0, 0,
+	192, 0, 226, 130, 144, 103, 89, 99, 0, 129,
+	170, 199, 203, 0, 0, 0, 113, 0, 201, 180,
+	242, 0, 182, 200, 148, 232, 193, 241, 251, 252,
+	278, 229, 249, 256, 219, 92, 228, 240, 108, 211,
+	214, 0, 0, 111, 94, 238, 225, 159, 139, 140,
+	93, 0, 197, 118, 125, 115, 172, 235, 236, 114,
+	258, 100, 248, 96, 101, 247, 166, 231, 239, 160,
+	153, 95, 237, 158, 152, 143, 122, 132, 190, 150,
+	191, 133, 163, 162, 164, 0, 0, 0, 223, 245,
+	259, 105, 0, 230, 254, 255, 0, 0, 106, 126,
+	121, 189, 165, 102, 135, 220, 142, 149, 196, 257,
+	179, 202, 109, 244, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 97, 146, 59, 194, 124,
+	246, 0, 0, 117, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 116,
+	120, 123, 131, 134, 136, 137, 138, 141, 151, 154,
+	155, 156, 157, 167, 168, 169, 171, 174, 175, 176,
+	177, 178, 181, 183, 184, 185, 187, 188, 195, 198,
+	204, 205, 206, 207, 208, 209, 210, 215, 216, 217,
+	218, 224, 227, 233, 234, 250, 253, 173, 0, 213,
+	0, 112, 212, 243, 186, 128, 119, 0, 0, 0,
+	0, 0, 145, 0, 0, 147, 0, 0, 222, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 86, 0,
+	0, 1095, 0, 0, 1096, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 127, 0, 0, 0, 279, 0, 0, 0,
+	0, 192, 0, 226, 130, 144, 103, 89, 99, 0,
+	129, 170, 199, 203, 0, 0, 0, 113, 0, 201,
+	180, 242, 0, 182, 200, 148, 232, 193, 241, 251,
+	252, 278, 229, 249, 256, 219, 92, 228, 240, 108,
+	211, 214, 0, 0, 111, 94, 238, 225, 159, 139,
+	140, 93, 0, 197, 118, 125, 115, 172, 235, 236,
+	114, 258, 100, 248, 96, 101, 247, 166, 231, 239,
+	160, 153, 95, 237, 158, 152, 143, 122, 132, 190,
+	150, 191, 133, 163, 162, 164, 0, 0, 0, 223,
+	245, 259, 105, 0, 230, 254, 255, 0, 0, 106,
+	126, 121, 189, 165, 102, 135, 220, 142, 149, 196,
+	257, 179, 202, 109, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 97, 146, 0, 194,
+	124, 246, 0, 0, 117, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	116, 120, 123, 131, 134, 136, 137, 138, 141, 151,
+	154, 155, 156, 157, 167, 168, 169, 171, 174, 175,
+	176, 177, 178, 181, 183, 184, 185, 187, 188, 195,
+	198, 204, 205, 206, 207, 208, 209, 210, 215, 216,
+	217, 218, 224, 227, 233, 234, 250, 253, 0, 0,
+	213, 0, 112, 212, 243, 186, 128, 173, 0, 0,
+	0, 971, 0, 0, 0, 0, 119, 0, 0, 0,
+	0, 0, 145, 0, 0, 147, 0, 0, 222, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	973, 0, 0, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 127, 0, 0, 0, 279, 0, 0, 0,
+	0, 192, 0, 226, 130, 144, 103, 89, 99, 0,
+	129, 170, 199, 203, 0, 0, 0, 113, 0, 201,
+	180, 242, 0, 969, 200, 148, 232, 193, 241, 251,
+	252, 278, 229, 249, 256, 219, 92, 228, 240, 108,
+	211, 214, 0, 0, 111, 94, 238, 225, 159, 139,
+	140, 93, 0, 197, 118, 125, 115, 172, 235, 236,
+	114, 258, 100, 248, 96, 101, 247, 166, 231, 239,
+	160, 153, 95, 237, 158, 152, 143, 122, 132, 190,
+	150, 191, 133, 163, 162, 164, 0, 0, 0, 223,
+	245, 259, 105, 0, 230, 254, 255, 0, 0, 106,
+	126, 121, 189, 165, 102, 135, 220, 142, 149, 196,
+	257, 179, 202, 109, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 97, 146, 0, 194,
+	124, 246, 0, 0, 117, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 91, 98, 104, 110,
+	116, 120, 123, 131, 134, 136, 137, 138, 141, 151,
+	154, 155, 156, 157, 167, 168, 169, 171, 174, 175,
+	176, 177, 178, 181, 183, 184, 185, 187, 188, 195,
+	198, 204, 205, 206, 207, 208, 209, 210, 215, 216,
+	217, 218, 224, 227, 233, 234, 250, 253, 173, 0,
+	213, 0, 112, 212, 243, 186, 128, 119, 0, 745,
+	0, 0, 0, 145, 0, 0, 147, 0, 0, 222,
+	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	0, 744, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 127, 0, 0, 0, 279, 0, 0,
+	0, 0, 192, 0, 226, 130, 144, 103, 89, 99,
+	0, 129, 170, 199, 203, 0, 0, 0, 113, 0,
+	201, 180, 242, 0, 182, 200, 148, 232, 193, 241,
+	251, 252, 278, 229, 249, 256, 219, 92, 228, 240,
+	108, 211, 214, 0, 0, 111, 94, 238, 225, 159,
+	139, 140, 93, 0, 197, 118, 125, 115, 172, 235,
+	236, 114, 258, 100, 248, 96, 101, 247, 166, 231,
+	239, 160, 153, 95, 237, 158, 152, 143, 122, 132,
+	190, 150, 191, 133, 163, 162, 164, 0, 0, 0,
+	223, 245, 259, 105, 0, 230, 254, 255, 0, 0,
+	106, 126, 121, 189, 165, 102, 135, 220, 142, 149,
+	196, 257, 179, 202, 109, 244, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 97, 146, 0,
+	194, 124, 246, 0, 0, 117, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 116, 120, 123, 131, 134, 136, 137, 138, 141,
+	151, 154, 155, 156, 157, 167, 168, 169, 171, 174,
+	175, 176, 177, 178, 181, 183, 184, 185, 187, 188,
+	195, 198, 204, 205, 206, 207, 208, 209, 210, 215,
+	216, 217, 218, 224, 227, 233, 234, 250, 253, 173,
+	0, 213, 0, 112, 212, 243, 186, 128, 119, 0,
+	0, 0, 0, 0, 145, 0, 0, 147, 0, 0,
+	222, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 63, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 127, 0, 0, 0, 279, 0,
+	0, 0, 0, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 0, 0, 0, 113,
+	0, 201, 180, 242, 0, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	240, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 0, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 101, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 0,
+	0, 223, 245, 259, 105, 0, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 165, 102, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 97, 146,
+	0, 194, 124, 246, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	173, 0, 213, 0, 112, 212, 243, 186, 128, 119,
+	0, 0, 0, 0, 0, 145, 0, 0, 147, 0,
+	0, 222, 161, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	622, 86, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 127, 0, 0, 0, 279,
+	0, 0, 0, 0, 192, 0, 226, 130, 144, 103,
+	89, 99, 0, 129, 170, 199, 203, 0, 0, 0,
+	113, 0, 201, 180, 242, 0, 182, 200, 148, 232,
+	193, 241, 251, 252, 278, 229, 249, 256, 219, 92,
+	228, 240, 108, 211, 214, 0, 0, 111, 94, 238,
+	225, 159, 139, 140, 93, 0, 197, 118, 125, 115,
+	172, 235, 236, 114, 258, 100, 248, 96, 101, 247,
+	166, 231, 239, 160, 153, 95, 237, 158, 152, 143,
+	122, 132, 190, 150, 191, 133, 163, 162, 164, 0,
+	0, 0, 223, 245, 259, 105, 0, 230, 254, 255,
+	0, 0, 106, 126, 121, 189, 165, 102, 135, 220,
+	142, 149, 196, 257, 179, 202, 109, 244, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 97,
+	146, 0, 194, 124, 246, 0, 0, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
+	98, 104, 110, 116, 120, 123, 131, 134, 136, 137,
+	138, 141, 151, 154, 155, 156, 157, 167, 168, 169,
+	171, 174, 175, 176, 177, 178, 181, 183, 184, 185,
+	187, 188, 195, 198, 204, 205, 206, 207, 208, 209,
+	210, 215, 216, 217, 218, 224, 227, 233, 234, 250,
+	253, 173, 0, 213, 0, 112, 212, 243, 186, 128,
+	119, 0, 0, 0, 0, 0, 145, 0, 0, 147,
+	0, 0, 222, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
+	0, 0, 86, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 127, 0, 0, 0,
+	279, 0, 0, 0, 0, 192, 0, 226, 130, 144,
+	103, 89, 99, 0, 129, 170, 199, 203, 0, 0,
+	0, 113, 0, 201, 180, 242, 0, 182, 200, 148,
+	232, 193, 241, 251, 252, 278, 229, 249, 256, 219,
+	92, 228, 240, 108, 211, 214, 0, 0, 111, 94,
+	238, 225, 159, 139, 140, 93, 0, 197, 118, 125,
+	115, 172, 235, 236, 114, 258, 100, 248, 96, 101,
+	247, 166, 231, 239, 160, 153, 95, 237, 158, 152,
+	143, 122, 132, 190, 150, 191, 133, 163, 162, 164,
+	0, 0, 0, 223, 245, 259, 105, 0, 230, 254,
+	255, 0, 0, 106, 126, 121, 189, 165, 102, 135,
+	220, 142, 149, 196, 257, 179, 202, 109, 244, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	97, 146, 0, 194, 124, 246, 0, 0, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 98, 104, 110, 116, 120, 123, 131, 134, 136,
+	137, 138, 141, 151, 154, 155, 156, 157, 167, 168,
+	169, 171, 174, 175, 176, 177, 178, 181, 183, 184,
+	185, 187, 188, 195, 198, 204, 205, 206, 207, 208,
+	209, 210, 215, 216, 217, 218, 224, 227, 233, 234,
+	250, 253, 173, 0, 213, 0, 112, 212, 243, 186,
+	128, 119, 0, 0, 0, 0, 0, 145, 0, 0,
+	147, 0, 0, 222, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 973, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 127, 0, 0,
+	0, 279, 0, 0, 0, 0, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 0,
+	0, 0, 113, 0, 201, 180, 242, 0, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 214, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 0, 0, 223, 245, 259, 105, 0, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 97, 146, 0, 194, 124, 246, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 173, 0, 213, 0, 112, 212, 243,
+	186, 128, 119, 0, 0, 0, 0, 0, 145, 0,
+	0, 147, 0, 0, 222, 161, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 631, 0, 0, 0,
+	0, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 127, 0,
+	0, 0, 279, 0, 0, 0, 0, 192, 0, 226,
+	130, 144, 103, 89, 99, 0, 129, 170, 199, 203,
+	0, 0, 0, 113, 0, 201, 180, 242, 0, 182,
+	200, 148, 232, 193, 241, 251, 252, 278, 229, 249,
+	256, 219, 92, 228, 240, 108, 211, 214, 0, 0,
+	111, 94, 238, 225, 159, 139, 140, 93, 0, 197,
+	118, 125, 115, 172, 235, 236, 114, 258, 100, 248,
+	96, 101, 247, 166, 231, 239, 160, 153, 95, 237,
+	158, 152, 143, 122, 132, 190, 150, 191, 133, 163,
+	162, 164, 0, 0, 0, 223, 245, 259, 105, 0,
+	230, 254, 255, 0, 0, 106, 126, 121, 189, 165,
+	102, 135, 220, 142, 149, 196, 257, 179, 202, 109,
+	244, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 88, 97, 146, 0, 194, 124, 246, 0, 0,
+	117, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 91, 98, 104, 110, 116, 120, 123, 131,
+	134, 136, 137, 138, 141, 151, 154, 155, 156, 157,
+	167, 168, 169, 171, 174, 175, 176, 177, 178, 181,
+	183, 184, 185, 187, 188, 195, 198, 204, 205, 206,
+	207, 208, 209, 210, 215, 216, 217, 218, 224, 227,
+	233, 234, 250, 253, 0, 173, 213, 0, 112, 212,
+	243, 186, 128, 715, 119, 0, 0, 0, 0, 0,
+	145, 0, 0, 147, 0, 0, 222, 161, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	127, 0, 0, 0, 279, 0, 0, 0, 0, 192,
+	0, 226, 130, 144, 103, 89, 99, 0, 129, 170,
+	199, 203, 0, 0, 0, 113, 0, 201, 180, 242,
+	0, 182, 200, 148, 232, 193, 241, 251, 252, 278,
+	229, 249, 256, 219, 92, 228, 240, 108, 211, 214,
+	0, 0, 111, 94, 238, 225, 159, 139, 140, 93,
+	0, 197, 118, 125, 115, 172, 235, 236, 114, 258,
+	100, 248, 96, 101, 247, 166, 231, 239, 160, 153,
+	95, 237, 158, 152, 143, 122, 132, 190, 150, 191,
+	133, 163, 162, 164, 0, 0, 0, 223, 245, 259,
+	105, 0, 230, 254, 255, 0, 0, 106, 126, 121,
+	189, 165, 102, 135, 220, 142, 149, 196, 257, 179,
+	202, 109, 244, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 97, 146, 0, 194, 124, 246,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 91, 98, 104, 110, 116, 120,
+	123, 131, 134, 136, 137, 138, 141, 151, 154, 155,
+	156, 157, 167, 168, 169, 171, 174, 175, 176, 177,
+	178, 181, 183, 184, 185, 187, 188, 195, 198, 204,
+	205, 206, 207, 208, 209, 210, 215, 216, 217, 218,
+	224, 227, 233, 234, 250, 253, 0, 0, 213, 396,
+	112, 212, 243, 186, 128, 0, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 145, 0, 0, 147, 0, 0, 222, 161, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 127, 0, 0, 0, 279, 0, 0, 0, 0,
+	192, 0, 226, 130, 144, 103, 89, 99, 0, 129,
+	170, 199, 203, 0, 0, 0, 113, 0, 201, 180,
+	242, 0, 182, 200, 148, 232, 193, 241, 251, 252,
+	278, 229, 249, 256, 219, 92, 228, 240, 108, 211,
+	214, 0, 0, 111, 94, 238, 225, 159, 139, 140,
+	93, 0, 197, 118, 125, 115, 172, 235, 236, 114,
+	258, 100, 248, 96, 101, 247, 166, 231, 239, 160,
+	153, 95, 237, 158, 152, 143, 122, 132, 190, 150,
+	191, 133, 163, 162, 164, 0, 0, 0, 223, 245,
+	259, 105, 0, 230, 254, 255, 0, 0, 106, 126,
+	121, 189, 165, 102, 135, 220, 142, 149, 196, 257,
+	179, 202, 109, 244, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 97, 146, 0, 194, 124,
+	246, 0, 0, 117, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 98, 104, 110, 116,
+	120, 123, 131, 134, 136, 137, 138, 141, 151, 154,
+	155, 156, 157, 167, 168, 169, 171, 174, 175, 176,
+	177, 178, 181, 183, 184, 185, 187, 188, 195, 198,
+	204, 205, 206, 207, 208, 209, 210, 215, 216, 217,
+	218, 224, 227, 233, 234, 250, 253, 173, 0, 213,
+	0, 112, 212, 243, 186, 128, 119, 0, 0, 0,
+	0, 0, 145, 0, 0, 147, 0, 0, 222, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 127, 0, 0, 0, 279, 0, 0, 0,
+	0, 192, 0, 226, 130, 144, 103, 89, 99, 0,
+	129, 170, 199, 203, 0, 0, 0, 113, 0, 201,
+	180, 242, 0, 182, 200, 148, 232, 193, 241, 251,
+	252, 278, 229, 249, 256, 219, 92, 228, 240, 108,
+	211, 214, 0, 0, 111, 94, 238, 225, 159, 139,
+	140, 93, 0, 197, 118, 125, 115, 172, 235, 236,
+	114, 258, 100, 248, 96, 101, 247, 166, 231, 239,
+	160, 153, 95, 237, 158, 152, 143, 122, 132, 190,
+	150, 191, 133, 163, 162, 164, 0, 0, 0, 223,
+	245, 259, 105, 0, 230, 254, 255, 0, 0, 106,
+	126, 121, 189, 165, 102, 135, 220, 142, 149, 196,
+	257, 179, 202, 109, 244, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 97, 146, 0, 194,
+	124, 246, 0, 0, 117, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 324, 0, 0, 90, 91, 98, 104, 110,
+	116, 120, 123, 131, 134, 136, 137, 138, 141, 151,
+	154, 155, 156, 157, 167, 168, 169, 171, 174, 175,
+	176, 177, 178, 181, 183, 184, 185, 187, 188, 195,
+	198, 204, 205, 206, 207, 208, 209, 210, 215, 216,
+	217, 218, 224, 227, 233, 234, 250, 253, 173, 0,
+	213, 0, 112, 212, 243, 186, 128, 119, 0, 0,
+	0, 0, 0, 145, 0, 0, 147, 0, 0, 222,
+	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 127, 0, 273, 0, 279, 0, 0,
+	0, 0, 192, 0, 226, 130, 144, 103, 89, 99,
+	0, 129, 170, 199, 203, 0, 0, 0, 113, 0,
+	201, 180, 242, 0, 182, 200, 148, 232, 193, 241,
+	251, 252, 278, 229, 249, 256, 219, 92, 228, 240,
+	108, 211, 214, 0, 0, 111, 94, 238, 225, 159,
+	139, 140, 93, 0, 197, 118, 125, 115, 172, 235,
+	236, 114, 258, 100, 248, 96, 101, 247, 166, 231,
+	239, 160, 153, 95, 237, 158, 152, 143, 122, 132,
+	190, 150, 191, 133, 163, 162, 164, 0, 0, 0,
+	223, 245, 259, 105, 0, 230, 254, 255, 0, 0,
+	106, 126, 121, 189, 165, 102, 135, 220, 142, 149,
+	196, 257, 179, 202, 109, 244, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 88, 97, 146, 0,
+	194, 124, 246, 0, 0, 117, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 90, 91, 98, 104,
+	110, 116, 120, 123, 131, 134, 136, 137, 138, 141,
+	151, 154, 155, 156, 157, 167, 168, 169, 171, 174,
+	175, 176, 177, 178, 181, 183, 184, 185, 187, 188,
+	195, 198, 204, 205, 206, 207, 208, 209, 210, 215,
+	216, 217, 218, 224, 227, 233, 234, 250, 253, 173,
+	0, 213, 0, 112, 212, 243, 186, 128, 119, 0,
+	0, 0, 0, 0, 145, 0, 0, 147, 0, 0,
+	222, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	86, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 127, 0, 0, 0, 279, 0,
+	0, 0, 0, 192, 0, 226, 130, 144, 103, 89,
+	99, 0, 129, 170, 199, 203, 0, 0, 0, 113,
+	0, 201, 180, 242, 0, 182, 200, 148, 232, 193,
+	241, 251, 252, 278, 229, 249, 256, 219, 92, 228,
+	240, 108, 211, 214, 0, 0, 111, 94, 238, 225,
+	159, 139, 140, 93, 0, 197, 118, 125, 115, 172,
+	235, 236, 114, 258, 100, 248, 96, 101, 247, 166,
+	231, 239, 160, 153, 95, 237, 158, 152, 143, 122,
+	132, 190, 150, 191, 133, 163, 162, 164, 0, 0,
+	0, 223, 245, 259, 105, 0, 230, 254, 255, 0,
+	0, 106, 126, 121, 189, 165, 102, 135, 220, 142,
+	149, 196, 257, 179, 202, 109, 244, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 97, 146,
+	0, 194, 124, 246, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 91, 98,
+	104, 110, 116, 120, 123, 131, 134, 136, 137, 138,
+	141, 151, 154, 155, 156, 157, 167, 168, 169, 171,
+	174, 175, 176, 177, 178, 181, 183, 184, 185, 187,
+	188, 195, 198, 204, 205, 206, 207, 208, 209, 210,
+	215, 216, 217, 218, 224, 227, 233, 234, 250, 253,
+	173, 0, 213, 0, 112, 212, 243, 186, 128, 119,
+	0, 0, 0, 0, 0, 145, 0, 0, 147, 0,
+	0, 222, 161, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 127, 0, 0, 0, 279,
+	0, 0, 0, 0, 192, 0, 226, 130, 144, 103,
+	89, 99, 0, 129, 170, 199, 203, 0, 0, 0,
+	113, 0, 201, 180, 242, 0, 182, 200, 148, 232,
+	193, 241, 251, 252, 278, 229, 249, 256, 219, 92,
+	228, 240, 108, 211, 214, 0, 0, 111, 94, 238,
+	225, 159, 139, 140, 93, 0, 197, 118, 125, 115,
+	172, 235, 236, 114, 258, 100, 248, 96, 101, 247,
+	166, 231, 239, 160, 153, 95, 237, 158, 152, 143,
+	122, 132, 190, 150, 191, 133, 163, 162, 164, 0,
+	0, 0, 223, 245, 259, 105, 0, 230, 254, 255,
+	0, 0, 106, 126, 121, 189, 165, 102, 135, 220,
+	142, 149, 196, 257, 179, 202, 109, 244, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 88, 97,
+	146, 0, 194, 124, 246, 0, 0, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 91,
+	98, 104, 110, 116, 120, 123, 131, 134, 136, 137,
+	138, 141, 151, 154, 155, 156, 157, 167, 168, 169,
+	171, 174, 175, 176, 177, 178, 181, 183, 184, 185,
+	187, 188, 195, 198, 204, 205, 206, 207, 208, 209,
+	210, 215, 216, 217, 218, 224, 227, 233, 234, 250,
+	253, 173, 0, 213, 0, 112, 212, 243, 186, 128,
+	119, 0, 0, 0, 0, 0, 145, 0, 0, 147,
+	0, 0, 222, 161, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 336, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 127, 0, 0, 0,
+	279, 0, 0, 0, 0, 192, 0, 226, 130, 144,
+	103, 89, 99, 0, 129, 170, 199, 203, 0, 0,
+	0, 113, 0, 201, 180, 242, 0, 182, 200, 148,
+	232, 193, 241, 251, 252, 278, 229, 249, 256, 219,
+	92, 228, 240, 108, 211, 214, 0, 0, 111, 94,
+	238, 225, 159, 139, 140, 93, 0, 197, 118, 125,
+	115, 172, 235, 236, 114, 258, 100, 248, 96, 101,
+	247, 166, 231, 239, 160, 153, 95, 237, 158, 152,
+	143, 122, 132, 190, 150, 191, 133, 163, 162, 164,
+	0, 0, 0, 223, 245, 259, 105, 0, 230, 254,
+	255, 0, 0, 106, 126, 121, 189, 165, 102, 135,
+	220, 142, 149, 196, 257, 179, 202, 109, 244, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	97, 146, 0, 194, 124, 246, 0, 0, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 98, 104, 110, 116, 120, 123, 131, 134, 136,
+	137, 138, 141, 151, 154, 155, 156, 157, 167, 168,
+	169, 171, 174, 175, 176, 177, 178, 181, 183, 184,
+	185, 187, 188, 195, 198, 204, 205, 206, 207, 208,
+	209, 210, 215, 216, 217, 218, 224, 227, 233, 234,
+	250, 253, 173, 0, 213, 0, 112, 212, 243, 186,
+	128, 119, 0, 0, 0, 0, 0, 145, 0, 0,
+	147, 0, 0, 222, 161, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 127, 0, 0,
+	0, 279, 0, 0, 0, 0, 192, 0, 226, 130,
+	144, 103, 89, 99, 0, 129, 170, 199, 203, 0,
+	0, 0, 113, 0, 201, 180, 242, 0, 182, 200,
+	148, 232, 193, 241, 251, 252, 278, 229, 249, 256,
+	219, 92, 228, 240, 108, 211, 600, 0, 0, 111,
+	94, 238, 225, 159, 139, 140, 93, 0, 197, 118,
+	125, 115, 172, 235, 236, 114, 258, 100, 248, 96,
+	101, 247, 166, 231, 239, 160, 153, 95, 237, 158,
+	152, 143, 122, 132, 190, 150, 191, 133, 163, 162,
+	164, 0, 0, 0, 223, 245, 259, 105, 0, 230,
+	254, 255, 0, 0, 106, 126, 121, 189, 165, 102,
+	135, 220, 142, 149, 196, 257, 179, 202, 109, 244,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 97, 146, 0, 194, 124, 246, 0, 0, 117,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 91, 98, 104, 110, 116, 120, 123, 131, 134,
+	136, 137, 138, 141, 151, 154, 155, 156, 157, 167,
+	168, 169, 171, 174, 175, 176, 177, 178, 181, 183,
+	184, 185, 187, 188, 195, 198, 204, 205, 206, 207,
+	208, 209, 210, 215, 216, 217, 218, 224, 227, 233,
+	234, 250, 253, 0, 0, 213, 0, 112, 212, 243,
+	186, 128,
 }
 var yyPact = [...]int{
 
-	2159, -1000, -268, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	191, -1000, -266, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 989, 1042, -1000, -1000, -1000, -1000, -1000, -1000,
-	275, 11323, -1, 127, -23, 15644, 121, 74, 16304, -1000,
-	13, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -67, -95,
-	-1000, 743, -1000, -1000, -1000, -1000, -1000, 963, 981, 802,
-	958, 859, -1000, 8011, 85, 85, 15314, 6691, -1000, -1000,
-	269, 16304, 118, 16304, -152, 82, 82, 82, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 857, -1000, -1000, -1000,
+	-1000, -1000, 376, 12152, 11, 171, 69, 16830, 170, 1537,
+	17492, -1000, 50, -1000, 149, 17161, 45, -52, -1000, -1000,
+	-1000, -1000, -1000, -22, -51, -1000, 1019, 1051, -1000, 16499,
+	-1000, -1000, 116, -1000, -1000, -1000, -1000, 9137, -1000, 126,
+	126, 16168, 7434, -1000, -1000, 327, 17492, 161, 17492, -122,
+	121, 121, 121, 169, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2602,21 +2783,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	120, 16304, 622, 621, 278, -1000, 16304, 78, 620, 78,
-	78, 78, 16304, -1000, 172, -1000, -1000, -1000, 16304, 614,
-	901, 306, 48, 3604, -1000, 3604, 3604, -1000, 3604, 23,
-	3604, -89, 1013, 24, -9, -1000, 3604, -1000, -1000, -1000,
+	167, 17492, 685, 647, 295, -1000, 17492, 119, 133, 642,
+	119, 119, 119, 17492, -1000, 219, -1000, -1000, -1000, -1000,
+	17492, 640, 939, 378, 105, 4257, -1000, 4257, 4257, -1000,
+	4257, 62, 4257, -41, 1028, 56, 43, -1000, 4257, -1000,
+	-1000, -1000, -1000, -1000, 18154, -1000, 17161, 486, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 987, 1011,
+	856, 1000, 903, 774, 17492, -1000, 780, 562, 1040, -1000,
+	11821, 216, -1000, 10157, 426, 780, -1000, -1000, 780, -1000,
+	-1000, 186, -1000, -1000, 11150, 11150, 11150, 11150, 11150, 11150,
+	11150, 11150, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 780, -1000, 8457, 780,
+	780, 780, 780, 780, 780, 780, 780, 10157, 780, 780,
+	780, 780, 780, 780, 780, 780, 780, 780, 780, 780,
+	780, 780, 780, 408, 15827, 14171, 17492, 732, 692, -1000,
+	-1000, 214, 773, 7081, -77, -1000, -1000, -1000, 349, 13840,
+	-1000, -1000, -1000, 937, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	437, 904, 9343, 9343, 989, -1000, 743, -1000, -1000, -1000,
-	900, -1000, -1000, 319, 1028, -1000, 10993, 171, -1000, 9343,
-	2025, 661, -1000, -1000, 661, -1000, -1000, 140, -1000, -1000,
-	10333, 10333, 10333, 10333, 10333, 10333, 10333, 10333, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 661, -1000, 9013, 661, 661, 661, 661, 661,
-	661, 661, 661, 9343, 661, 661, 661, 661, 661, 661,
-	661, 661, 661, 661, 661, 661, 661, 661, 661, 14977,
-	13987, 16304, 694, 665, -1000, -1000, 167, 735, 6348, -101,
-	-1000, -1000, -1000, 264, 13327, -1000, -1000, -1000, 892, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2626,293 +2810,311 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 682, 17492, -1000, 2112, -1000,
+	618, 4257, 152, 608, 385, 605, 17492, 121, 17492, 4257,
+	4257, 4257, 68, 109, 99, 17492, 775, 132, 17492, 976,
+	119, 819, 17492, 584, 579, -1000, 6728, -1000, 4257, 378,
+	-1000, 510, 10157, 4257, 4257, 4257, 17492, 4257, 4257, -1000,
+	-1000, -1000, -1000, -1000, -1000, 4257, 4257, -1000, 1039, 377,
+	-1000, -1000, -1000, -1000, 10157, 279, -1000, 818, -1000, -1000,
+	17161, -1000, -1000, -1000, -1000, 942, 10157, 10157, 1019, -1000,
+	116, -1000, -1000, -1000, 943, -1000, -1000, 17492, 774, 981,
+	17161, -1000, -1000, 17492, -1000, 10157, 10157, 561, -1000, 15495,
+	-1000, -1000, 5316, 282, 212, 11150, 477, 407, 11150, 11150,
+	11150, 11150, 11150, 11150, 11150, 11150, 11150, 11150, 11150, 11150,
+	11150, 11150, 11150, 485, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 577, -1000, 116, 1008, 1008, 242, 242, 242,
+	242, 242, 242, 242, 11481, 7774, 562, 680, 366, 8457,
+	9137, 9137, 10157, 10157, 9817, 9477, 9137, 945, 331, 366,
+	17823, -1000, -1000, 10819, -1000, -1000, -1000, -1000, -1000, 562,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17161, 17161, 9137,
+	9137, 9137, 9137, -1000, 90, 17492, -1000, 731, 860, -1000,
+	-1000, -1000, 979, 12838, 13509, 90, 711, 14171, 17492, -1000,
+	-1000, 14171, 17492, 4963, 6375, 773, -77, 744, -1000, -100,
+	-74, 8114, 235, -1000, -1000, -1000, -1000, 3904, 275, 702,
+	419, -14, -1000, -1000, -1000, 783, -1000, 783, 783, 783,
+	783, 16, 16, 16, 16, -1000, -1000, -1000, -1000, -1000,
+	801, 799, -1000, 783, 783, 783, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 618, 16304,
-	-1000, 1464, -1000, 613, 3604, 100, 612, 292, 611, 16304,
-	16304, 3604, 3604, 3604, 36, 68, 57, 16304, 744, 91,
-	16304, 950, 810, 16304, 572, 561, -1000, 6005, -1000, 3604,
-	306, -1000, 513, 9343, 3604, 3604, 3604, 16304, 3604, 3604,
-	-1000, -1000, -1000, -1000, -1000, -1000, 3604, 3604, -1000, 1025,
-	295, -1000, -1000, -1000, -1000, 9343, 219, -1000, 807, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1034, 195, 491, 165, 738,
-	-1000, 498, 963, 437, 859, 12997, 824, -1000, -1000, -1000,
-	16304, -1000, 9343, 9343, 528, -1000, 14647, -1000, -1000, 4633,
-	199, 10333, 464, 299, 10333, 10333, 10333, 10333, 10333, 10333,
-	10333, 10333, 10333, 10333, 10333, 10333, 10333, 10333, 10333, 482,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 554, -1000,
-	743, 577, 577, 178, 178, 178, 178, 178, 178, 178,
-	10663, 7021, 437, 608, 389, 9013, 8011, 8011, 9343, 9343,
-	8671, 8341, 8011, 899, 244, 389, 16634, -1000, -1000, 10003,
-	-1000, -1000, -1000, -1000, -1000, 437, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15974, 15974, 8011, 8011, 8011, 8011, 51,
-	16304, -1000, 697, 905, -1000, -1000, -1000, 952, 12337, 12667,
-	51, 728, 13987, 16304, -1000, -1000, 13987, 16304, 4290, 5662,
-	735, -101, 700, -1000, -129, -125, 7351, 163, -1000, -1000,
-	-1000, -1000, 3261, 189, 553, 470, -60, -1000, -1000, -1000,
-	754, -1000, 754, 754, 754, 754, -17, -17, -17, -17,
-	-1000, -1000, -1000, -1000, -1000, 775, 774, -1000, 754, 754,
-	754, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 768,
-	768, 768, 756, 756, 779, -1000, 16304, 3604, 944, 3604,
-	-1000, 70, -1000, -1000, -1000, 16304, 16304, 16304, 16304, 16304,
-	138, 16304, 16304, 729, -1000, 16304, 3604, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 389, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 16304, 306, 16304, 16304, 389, -1000,
-	509, 16304, -1000, 868, 9343, 9343, 5319, 9343, -1000, -1000,
-	-1000, 904, -1000, 899, 965, -1000, 881, 880, 8011, -1000,
-	-1000, 199, 434, -1000, -1000, 436, -1000, -1000, -1000, -1000,
-	162, 661, -1000, 1844, -1000, -1000, -1000, -1000, 464, 10333,
-	10333, 10333, 387, 1844, 1780, 304, 372, 178, 396, 396,
-	194, 194, 194, 194, 194, 413, 413, -1000, -1000, -1000,
-	437, -1000, -1000, -1000, 437, 8011, 8011, 727, -1000, -1000,
-	9343, -1000, 437, 602, 602, 289, 371, 259, 1021, 602,
-	256, 1020, 602, 602, 8011, 296, -1000, 9343, 437, -1000,
-	160, -1000, 637, 723, 720, 602, 437, 602, 602, 671,
-	661, -1000, 16634, 13987, 13987, 13987, 13987, 13987, -1000, 839,
-	835, -1000, 823, 822, 829, 16304, -1000, 606, 12337, 198,
-	661, -1000, 14317, -1000, -1000, 1010, 13987, 702, -1000, 702,
-	-1000, 159, -1000, -1000, 700, -101, -111, -1000, -1000, -1000,
-	-1000, 389, -1000, 494, 688, 2918, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 761, 544, -1000, 917, 214, 188, 542,
-	916, -1000, -1000, -1000, 909, -1000, 303, -62, -1000, -1000,
-	432, -17, -17, -1000, -1000, 163, 889, 163, 163, 163,
-	506, 506, -1000, -1000, -1000, -1000, 404, -1000, -1000, -1000,
-	395, -1000, 791, 15974, 3604, -1000, -1000, -1000, -1000, 225,
-	225, 207, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 50, 777, -1000, -1000, -1000, -1000, 2,
-	34, 88, -1000, 3604, -1000, 295, -1000, -1000, -1000, -1000,
-	-1000, 866, 389, 389, 155, -1000, -1000, 16304, -1000, -1000,
-	-1000, -1000, 717, -1000, -1000, -1000, 3947, 8011, -1000, 387,
-	1844, 1249, -1000, 10333, 10333, -1000, -1000, 602, 602, 8011,
-	389, -1000, -1000, -1000, 47, 482, 47, 10333, 10333, -1000,
-	10333, 10333, -1000, -165, 705, 272, -1000, 9343, 463, -1000,
-	5319, -1000, 10333, 10333, -1000, -1000, -1000, -1000, 790, 16634,
-	661, -1000, 11995, 15974, 706, -1000, 241, 905, 772, 789,
-	643, -1000, -1000, -1000, -1000, 834, -1000, 833, -1000, -1000,
-	-1000, -1000, -1000, 115, 113, 111, 15974, -1000, 989, 9343,
-	702, -1000, -1000, 197, -1000, -1000, -119, -130, -1000, -1000,
-	-1000, 3261, -1000, 3261, 15974, 61, -1000, 542, 542, -1000,
-	-1000, -1000, 759, 787, 10333, -1000, -1000, -1000, 549, 163,
-	163, -1000, 240, -1000, -1000, -1000, 599, -1000, 597, 657,
-	592, 16304, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16304,
-	-1000, -1000, -1000, -1000, -1000, 15974, -170, 529, 15974, 15974,
-	15974, 16304, -1000, 306, -1000, 4976, -1000, 1010, 13987, -1000,
-	-1000, 437, -1000, 10333, 1844, 1844, -1000, -1000, -1000, 437,
-	754, 754, -1000, 754, 756, -1000, 754, 1, 754, 0,
-	437, 437, 1722, 1499, 1462, 794, 661, -159, -1000, 389,
-	9343, -1000, 998, 751, -1000, 926, 669, 604, -1000, -1000,
-	7681, 437, 586, 153, 576, -1000, 989, 16634, 9343, -1000,
-	-1000, 9343, 755, -1000, 9343, -1000, -1000, -1000, 661, 661,
-	661, 576, 963, 389, -1000, -1000, -1000, -1000, 2918, -1000,
-	568, -1000, 754, -1000, -1000, -1000, 15974, -41, 1033, 1844,
-	-1000, -1000, -1000, -1000, -1000, -17, 503, -17, 382, -1000,
-	354, 3604, -1000, -1000, -1000, -1000, 930, -1000, 4976, -1000,
-	-1000, 747, 778, -1000, -1000, -1000, 996, 642, -1000, 1844,
-	-1000, -1000, 119, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 10333, 10333, 10333, 10333, 10333, 963, 489, 389, 10333,
-	10333, 914, -1000, 661, -1000, -1000, 660, 15974, 15974, -1000,
-	15974, 963, -1000, 389, 389, 15974, 389, 13657, 15974, 15974,
-	11653, -1000, 158, 15974, -1000, 558, -1000, 181, -1000, -79,
-	163, -1000, 163, 527, 520, -1000, 661, 625, -1000, 233,
-	15974, 16304, 991, 977, -1000, -1000, 637, 637, 637, 637,
-	45, 437, -1000, 637, 637, 1032, -1000, 661, -1000, 743,
-	152, -1000, -1000, -1000, 552, 548, -1000, 548, 548, 198,
-	158, -1000, 493, 232, 468, -1000, 58, 15974, 317, 912,
-	-1000, 902, -1000, -1000, -1000, -1000, -1000, 49, 4976, 3261,
-	533, -1000, -1000, 9343, 9343, -1000, -1000, -1000, -1000, 437,
-	46, -176, -1000, -1000, -1000, 16634, 604, 437, 15974, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 326, -1000, -1000, 16304,
-	-1000, -1000, 467, -1000, -1000, 526, -1000, 15974, -1000, -1000,
-	777, 389, 594, -1000, 865, -168, -182, 588, -1000, -1000,
-	-1000, 746, -1000, -1000, 49, 874, -170, -1000, 864, -1000,
-	15974, -1000, 42, -1000, -171, 519, 40, -180, 785, 661,
-	-184, 783, -1000, 1017, 9673, -1000, -1000, 1031, 203, 203,
-	637, 437, -1000, -1000, -1000, 71, 384, -1000, -1000, -1000,
-	-1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 797, 797, 797, 785, 785, 805,
+	-1000, 17492, 4257, 968, 4257, -1000, 17492, 117, -1000, -1000,
+	-1000, 17492, 17492, 17492, 17492, 17492, 178, 17492, 17492, 737,
+	-1000, 17492, 17492, 4257, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 366, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 17492, 378, 17492, 17492, 366, -1000, 507, 17492, -1000,
+	-1000, 1046, 276, 645, 769, -1000, 536, 987, 562, 903,
+	13169, 830, -1000, -1000, -1000, 780, 612, -1000, -1000, 282,
+	412, -1000, -1000, 494, -1000, -1000, -1000, -1000, 210, 780,
+	-1000, 6022, 1944, -1000, -1000, -1000, -1000, 477, 11150, 11150,
+	11150, 325, 1944, 1929, 1606, 1196, 242, 350, 350, 263,
+	263, 263, 263, 263, 489, 489, -1000, -1000, -1000, 562,
+	-1000, -1000, -1000, 562, 9137, 9137, 768, -1000, -1000, 10157,
+	-1000, 562, 669, 669, 528, 551, 321, 1037, 669, 287,
+	1031, 669, 669, 9137, 368, -1000, 10157, 562, -1000, 206,
+	-1000, 556, 764, 752, 669, 562, 669, 669, 130, 780,
+	-1000, 17823, 14171, 14171, 14171, 14171, 14171, -1000, 880, 878,
+	-1000, 876, 870, 882, 17492, -1000, 673, 12838, 223, 780,
+	-1000, 15164, -1000, -1000, 1027, 14171, 751, -1000, 751, -1000,
+	202, -1000, -1000, 744, -77, -38, -1000, -1000, -1000, -1000,
+	366, -1000, 554, 742, 3551, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 792, 575, -1000, 956, 250, 320, 553, 955,
+	-1000, -1000, -1000, 941, -1000, 392, -16, -1000, -1000, 479,
+	16, 16, -1000, -1000, 235, 933, 235, 235, 235, 501,
+	501, -1000, -1000, -1000, -1000, 478, -1000, -1000, -1000, 474,
+	-1000, 817, 17161, 4257, -1000, -1000, -1000, -1000, -1000, 332,
+	332, 317, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 88, 803, -1000, -1000, -1000, -1000, 55,
+	67, 129, -1000, 737, 4257, -1000, 377, -1000, -1000, -1000,
+	-1000, -1000, -1000, 913, 10157, 10157, 10157, -1000, -1000, -1000,
+	942, -1000, 945, 1017, -1000, 925, 924, 887, -1000, -1000,
+	-1000, 17161, -1000, -1000, -1000, 4610, 9137, 201, -1000, 325,
+	1944, 1910, -1000, 11150, 11150, -1000, -237, 669, 669, 9137,
+	366, -1000, -1000, -1000, 63, 485, 63, 11150, 11150, -1000,
+	11150, 11150, -1000, -135, 740, 322, -1000, 10157, 464, -1000,
+	6022, -1000, 11150, 11150, -1000, -1000, -1000, -1000, 816, 17823,
+	780, -1000, 12495, 17161, 772, -1000, 329, 860, 796, 814,
+	1344, -1000, -1000, -1000, -1000, 874, -1000, 841, -1000, -1000,
+	-1000, -1000, -1000, 159, 156, 154, 17161, -1000, 1019, 10157,
+	751, -1000, -1000, 256, -1000, -1000, -69, -101, -1000, -1000,
+	-1000, 3904, -1000, 3904, 17161, 107, -1000, 553, 553, -1000,
+	-1000, -1000, 791, 813, 11150, -1000, -1000, -1000, 688, 235,
+	235, -1000, 328, -1000, -1000, -1000, 664, -1000, 660, 735,
+	657, 17492, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 17492,
+	-1000, -1000, -1000, -1000, -1000, 17161, -142, 548, 17161, 17161,
+	17161, 17492, -1000, 378, 911, 366, 366, -1000, -1000, 17492,
+	-1000, -1000, -1000, -1000, 9137, -1000, -1000, -1000, -1000, 562,
+	5669, -1000, 11150, 1944, 1944, -1000, 14833, -237, -237, -1000,
+	562, 783, 783, -1000, 783, 785, -1000, 783, 33, 783,
+	32, 562, 562, 1747, 1723, 837, 676, 780, -129, -1000,
+	366, 10157, -1000, 1690, 583, -1000, 958, 699, 729, -1000,
+	-1000, 8797, 562, 655, 192, 639, -1000, 1019, 17823, 10157,
+	-1000, -1000, 10157, 784, -1000, 10157, -1000, -1000, -1000, 780,
+	780, 780, 639, 987, 366, -1000, -1000, -1000, -1000, 3551,
+	-1000, 636, -1000, 783, -1000, -1000, -1000, 17161, -9, 1045,
+	1944, -1000, -1000, -1000, -1000, -1000, 16, 496, 16, 444,
+	-1000, 443, 4257, -1000, -1000, -1000, -1000, 964, -1000, 5669,
+	-1000, -1000, 782, 804, -1000, -1000, -1000, -1000, -1000, 712,
+	-1000, 1944, -1000, 525, -1000, -1000, -1000, -1000, 151, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11150, 11150, 11150,
+	11150, 11150, 987, 493, 366, 11150, 11150, 954, -1000, 780,
+	-1000, -1000, 131, 17161, 17161, -1000, 17161, 987, -1000, 366,
+	366, 17161, 366, 14502, 17161, 17161, 3024, -1000, 272, 17161,
+	-1000, 633, 262, -1000, -72, 235, -1000, 235, 613, 591,
+	-1000, 780, 734, -1000, 314, 17161, 17492, 1027, 14171, 562,
+	87, -1000, -1000, -1000, 556, 556, 556, 556, 53, 562,
+	-1000, 556, 556, 1044, -1000, 780, -1000, 116, 190, -1000,
+	-1000, -1000, 616, 612, -1000, 612, 612, 223, 272, -1000,
+	538, 293, 492, -1000, 102, 429, 953, -1000, 947, -1000,
+	-1000, -1000, -1000, -1000, 86, 5669, 3904, 590, -1000, 1025,
+	733, -1000, 1019, 1009, -1000, -1000, -1000, -1000, 562, 79,
+	-145, -1000, -1000, -1000, 17823, 729, 562, 17161, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 436, -1000, -1000, 17492, -1000,
+	448, -1000, -1000, 588, -1000, 17161, -1000, -1000, 803, 1023,
+	992, -241, 10157, -1000, 908, -139, -150, 708, -1000, -1000,
+	-1000, 781, -1000, -1000, 86, 923, -142, -245, 10157, 10157,
+	-1000, 29, -1000, -1000, 705, -1000, 907, -1000, 17161, -1000,
+	77, -1000, -1000, 17161, 366, 705, -1000, 28, -250, -258,
+	-261, -1000, -1000, 11150, -143, 565, 72, 700, -1000, 978,
+	367, -1000, -1000, -1000, -1000, -1000, 11481, -146, 812, 780,
+	17161, 780, 28, -1000, -151, 798, -1000, 1036, 10488, -1000,
+	525, -1000, -1000, -1000, 1038, 236, 236, 556, 562, 562,
+	-1000, -1000, -1000, 108, 447, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1265, 26, 574, 1262, 1261, 1259, 1257, 1239, 1237,
-	1236, 1235, 1234, 1232, 1231, 1230, 1229, 1228, 1227, 1224,
-	1223, 1222, 1221, 1220, 1218, 1217, 90, 1214, 1213, 1208,
-	65, 1207, 66, 1205, 1203, 48, 222, 43, 41, 258,
-	1202, 60, 89, 73, 1199, 29, 1198, 1195, 82, 1194,
-	1193, 55, 1190, 1189, 1204, 1186, 58, 1183, 12, 46,
-	1180, 1179, 1178, 1177, 69, 626, 1176, 1175, 15, 1173,
-	1171, 87, 1170, 53, 5, 14, 22, 18, 1169, 44,
-	6, 1168, 86, 1164, 1160, 1158, 1157, 20, 1156, 59,
-	1153, 34, 54, 1151, 9, 71, 30, 24, 7, 77,
-	63, 1148, 23, 64, 50, 1147, 1146, 526, 1144, 1142,
-	45, 1141, 1140, 19, 1136, 91, 502, 1135, 1134, 1132,
-	1130, 37, 0, 478, 114, 76, 1128, 1125, 1124, 1546,
-	42, 52, 17, 1123, 112, 1226, 40, 1120, 1119, 35,
-	1112, 1110, 1107, 1104, 1090, 1089, 1087, 70, 1082, 1081,
-	1079, 31, 21, 1078, 1077, 56, 25, 1075, 1073, 1072,
-	49, 57, 1071, 1070, 51, 38, 1069, 1066, 1065, 1064,
-	1063, 33, 11, 1062, 16, 1060, 13, 1059, 28, 1057,
-	4, 1056, 10, 1055, 3, 1053, 8, 47, 1, 1052,
-	2, 1050, 1049, 61, 631, 75, 1048, 79,
+	0, 1328, 65, 992, 114, 1327, 1319, 1318, 119, 82,
+	1317, 1316, 1315, 1313, 1311, 1309, 1308, 1307, 1290, 1288,
+	1287, 1286, 1285, 1284, 1283, 1282, 1281, 1279, 1278, 1270,
+	1269, 1268, 1267, 1266, 1265, 86, 1264, 1263, 1262, 70,
+	1261, 67, 1259, 1258, 47, 449, 48, 45, 1066, 1257,
+	22, 76, 69, 1255, 37, 1254, 1253, 80, 1248, 1245,
+	58, 1244, 1243, 2205, 1237, 84, 1236, 15, 36, 1233,
+	1230, 1228, 1226, 85, 1238, 1224, 1220, 17, 1219, 1216,
+	109, 1214, 61, 5, 16, 13, 24, 1213, 368, 19,
+	1212, 59, 1210, 1203, 1201, 1200, 32, 1199, 52, 1197,
+	51, 25, 2, 1196, 1193, 1190, 1189, 14, 1188, 1186,
+	1185, 4, 49, 1184, 7, 78, 31, 21, 10, 88,
+	74, 1181, 27, 75, 56, 1179, 1178, 558, 1175, 1173,
+	55, 1170, 1166, 26, 1163, 83, 87, 1158, 1156, 1152,
+	1151, 33, 0, 916, 35, 81, 1150, 1149, 1148, 1858,
+	39, 63, 23, 1147, 50, 30, 42, 1146, 1145, 44,
+	1144, 1143, 1142, 1138, 1137, 1133, 1129, 350, 1128, 1127,
+	1123, 253, 62, 1122, 1121, 1120, 68, 28, 1119, 1117,
+	1116, 57, 71, 1115, 1114, 54, 43, 1113, 1112, 1107,
+	1103, 1101, 40, 12, 1098, 18, 1097, 20, 1095, 29,
+	1084, 9, 1080, 11, 1079, 6, 1067, 8, 53, 1,
+	1065, 3, 1064, 1063, 507, 64, 89, 1060, 97,
 }
 var yyR1 = [...]int{
 
-	0, 191, 192, 192, 1, 1, 1, 1, 1, 1,
+	0, 212, 213, 213, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
-	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 9, 195, 195, 48, 48, 49, 49, 95, 95,
-	10, 10, 10, 10, 100, 100, 104, 104, 104, 105,
-	105, 105, 105, 137, 137, 11, 11, 11, 11, 11,
-	11, 11, 186, 186, 185, 184, 184, 183, 183, 182,
-	17, 167, 169, 169, 168, 168, 168, 168, 161, 140,
-	140, 140, 140, 143, 143, 141, 141, 141, 141, 141,
-	141, 141, 141, 141, 142, 142, 142, 142, 142, 144,
-	144, 144, 144, 144, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 146,
-	146, 146, 146, 146, 146, 146, 146, 160, 160, 147,
-	147, 155, 155, 156, 156, 156, 153, 153, 154, 154,
-	157, 157, 157, 149, 149, 150, 150, 158, 158, 151,
-	151, 151, 152, 152, 152, 159, 159, 159, 159, 159,
-	148, 148, 162, 162, 177, 177, 176, 176, 176, 166,
-	166, 173, 173, 173, 173, 173, 164, 164, 165, 165,
-	175, 175, 174, 163, 163, 178, 178, 178, 178, 189,
-	190, 188, 188, 188, 188, 188, 170, 170, 170, 171,
-	171, 171, 172, 172, 172, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 181, 179, 179, 180, 180, 13,
-	18, 18, 14, 14, 14, 14, 14, 15, 15, 19,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 111, 111,
-	109, 109, 112, 112, 110, 110, 110, 113, 113, 113,
-	114, 114, 138, 138, 138, 21, 21, 23, 23, 24,
-	25, 22, 22, 22, 22, 22, 22, 22, 16, 196,
-	26, 27, 27, 28, 28, 28, 32, 32, 32, 30,
-	30, 30, 31, 31, 37, 37, 36, 36, 38, 38,
-	38, 38, 126, 126, 126, 125, 125, 40, 40, 41,
-	41, 42, 42, 43, 43, 43, 43, 57, 57, 94,
-	94, 96, 96, 44, 44, 44, 44, 45, 45, 46,
-	46, 47, 47, 133, 133, 132, 132, 132, 131, 131,
-	50, 50, 50, 52, 51, 51, 51, 51, 53, 53,
-	55, 55, 54, 54, 56, 58, 58, 58, 58, 58,
-	59, 59, 39, 39, 39, 39, 39, 39, 39, 108,
-	108, 61, 61, 60, 60, 60, 60, 60, 60, 60,
-	60, 60, 60, 72, 72, 72, 72, 72, 72, 62,
-	62, 62, 62, 62, 62, 62, 35, 35, 73, 73,
-	73, 79, 74, 74, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 69, 69, 69, 69,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 197,
-	197, 71, 70, 70, 70, 70, 70, 70, 33, 33,
-	33, 33, 33, 136, 136, 139, 139, 139, 139, 139,
-	139, 139, 139, 139, 139, 139, 139, 139, 83, 83,
-	34, 34, 81, 81, 82, 84, 84, 80, 80, 80,
-	64, 64, 64, 64, 64, 64, 64, 64, 66, 66,
-	66, 85, 85, 86, 86, 87, 87, 88, 88, 89,
-	90, 90, 90, 91, 91, 91, 91, 92, 92, 92,
-	63, 63, 63, 63, 63, 63, 93, 93, 93, 93,
-	97, 97, 75, 75, 77, 77, 76, 78, 98, 98,
-	102, 99, 99, 103, 103, 103, 103, 101, 101, 101,
-	128, 128, 128, 106, 106, 115, 115, 116, 116, 107,
-	107, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 118, 118, 118, 119, 119, 120, 120, 120, 127,
-	127, 123, 123, 124, 124, 129, 129, 130, 130, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 193, 194, 134, 135,
-	135, 135,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 3,
+	3, 3, 7, 7, 8, 8, 9, 10, 10, 11,
+	4, 5, 5, 6, 6, 12, 12, 38, 38, 13,
+	14, 14, 14, 14, 216, 216, 57, 57, 58, 58,
+	115, 115, 15, 15, 15, 15, 120, 120, 124, 124,
+	124, 125, 125, 125, 125, 157, 157, 16, 16, 16,
+	16, 16, 16, 16, 207, 207, 206, 205, 205, 204,
+	204, 203, 22, 22, 188, 190, 190, 189, 189, 189,
+	189, 182, 160, 160, 160, 160, 163, 163, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 162, 162, 162,
+	162, 162, 164, 164, 164, 164, 164, 165, 165, 165,
+	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 165, 166, 166, 166, 166, 166, 166, 166, 166,
+	181, 181, 167, 167, 176, 176, 177, 177, 177, 173,
+	173, 174, 174, 178, 178, 178, 169, 169, 170, 170,
+	179, 179, 171, 171, 171, 172, 172, 172, 180, 180,
+	180, 180, 180, 168, 168, 183, 183, 198, 198, 197,
+	197, 197, 187, 187, 194, 194, 194, 194, 194, 185,
+	185, 186, 186, 196, 196, 195, 184, 184, 199, 199,
+	199, 199, 210, 211, 209, 209, 209, 209, 209, 191,
+	191, 191, 192, 192, 192, 193, 193, 193, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 208, 208, 208, 208, 208,
+	208, 208, 208, 208, 208, 208, 208, 202, 200, 200,
+	201, 201, 18, 23, 23, 19, 19, 19, 19, 19,
+	19, 20, 20, 24, 25, 25, 25, 25, 25, 25,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	25, 25, 131, 131, 129, 129, 132, 132, 130, 130,
+	130, 133, 133, 133, 134, 134, 158, 158, 158, 26,
+	26, 28, 28, 29, 30, 30, 30, 31, 32, 33,
+	34, 34, 34, 27, 27, 27, 27, 27, 27, 27,
+	21, 217, 35, 36, 36, 37, 37, 37, 41, 41,
+	41, 39, 39, 39, 40, 40, 175, 175, 46, 46,
+	45, 45, 47, 47, 47, 47, 146, 146, 146, 145,
+	145, 49, 49, 50, 50, 51, 51, 52, 52, 52,
+	52, 66, 66, 114, 114, 116, 116, 53, 53, 53,
+	53, 54, 54, 55, 55, 56, 56, 153, 153, 152,
+	152, 152, 151, 151, 59, 59, 59, 61, 60, 60,
+	60, 60, 62, 62, 64, 64, 63, 63, 65, 67,
+	67, 67, 67, 67, 68, 68, 48, 48, 48, 48,
+	48, 48, 48, 128, 128, 70, 70, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 81, 81, 81,
+	81, 81, 81, 71, 71, 71, 71, 71, 71, 71,
+	44, 44, 82, 82, 82, 88, 83, 83, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	78, 78, 78, 78, 76, 76, 76, 76, 76, 76,
+	76, 76, 76, 76, 76, 76, 76, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 218, 218, 80, 79, 79, 79, 79,
+	79, 79, 42, 42, 42, 42, 42, 156, 156, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 92, 92, 43, 43, 90, 90, 91, 93,
+	93, 89, 89, 89, 73, 73, 73, 73, 73, 73,
+	73, 73, 75, 75, 75, 94, 94, 95, 95, 96,
+	96, 97, 97, 98, 99, 99, 99, 100, 100, 100,
+	100, 101, 101, 101, 102, 103, 103, 104, 104, 105,
+	105, 105, 106, 106, 107, 107, 107, 107, 107, 108,
+	108, 108, 109, 109, 110, 110, 111, 112, 112, 112,
+	72, 72, 72, 72, 72, 72, 113, 113, 113, 113,
+	117, 117, 84, 84, 86, 86, 85, 87, 118, 118,
+	122, 119, 119, 123, 123, 123, 123, 121, 121, 121,
+	148, 148, 148, 126, 126, 135, 135, 136, 136, 127,
+	127, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 138, 138, 138, 139, 139, 140, 140, 140, 147,
+	147, 143, 143, 144, 144, 149, 149, 150, 150, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 214, 215, 154, 155, 155, 155,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 6, 7, 5, 10, 1,
-	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
-	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
-	3, 4, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 8, 4, 6,
-	5, 5, 0, 2, 1, 0, 2, 1, 3, 3,
-	4, 4, 2, 4, 1, 3, 3, 3, 8, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
-	0, 1, 2, 0, 2, 0, 3, 0, 1, 0,
-	3, 3, 0, 2, 2, 0, 2, 1, 2, 1,
-	0, 2, 5, 4, 1, 2, 2, 3, 2, 0,
-	1, 2, 3, 3, 2, 2, 1, 1, 0, 1,
-	1, 3, 2, 3, 1, 10, 11, 11, 12, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 3, 1,
-	2, 3, 1, 1, 1, 6, 7, 7, 7, 7,
-	4, 5, 4, 4, 7, 5, 5, 5, 12, 7,
-	5, 9, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 4, 3, 3, 5, 2, 3, 1, 1,
-	0, 1, 1, 1, 0, 2, 2, 0, 2, 2,
-	0, 2, 0, 1, 1, 2, 1, 1, 2, 1,
-	1, 2, 2, 2, 2, 2, 3, 3, 2, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 2, 1,
-	3, 1, 1, 1, 3, 1, 3, 3, 7, 1,
-	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
-	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
-	2, 1, 1, 3, 3, 0, 5, 4, 5, 5,
-	0, 2, 1, 3, 3, 2, 3, 1, 2, 0,
-	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
-	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
-	2, 3, 1, 1, 1, 1, 4, 5, 5, 6,
-	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
-	8, 5, 4, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
-	2, 3, 4, 4, 4, 4, 4, 4, 0, 3,
-	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
-	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
-	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 0, 3, 0, 2, 0, 3, 1, 3, 2,
-	0, 1, 1, 0, 2, 4, 4, 0, 2, 4,
+	1, 1, 1, 1, 1, 1, 0, 1, 2, 4,
+	6, 7, 2, 3, 1, 3, 4, 0, 3, 5,
+	12, 1, 3, 1, 3, 7, 8, 1, 1, 9,
+	8, 7, 6, 6, 1, 1, 1, 3, 1, 3,
+	0, 4, 3, 4, 5, 4, 1, 3, 3, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 2, 8,
+	4, 6, 5, 5, 0, 2, 1, 0, 2, 1,
+	3, 3, 4, 5, 4, 2, 4, 1, 3, 3,
+	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 1, 4, 4, 2,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
+	1, 0, 1, 0, 1, 2, 0, 2, 0, 3,
+	0, 1, 0, 3, 3, 0, 2, 2, 0, 2,
+	1, 2, 1, 0, 2, 5, 4, 1, 2, 2,
+	3, 2, 0, 1, 2, 3, 3, 2, 2, 1,
+	1, 0, 1, 1, 3, 2, 3, 1, 10, 11,
+	11, 12, 3, 3, 1, 1, 2, 2, 2, 0,
+	1, 3, 1, 2, 3, 1, 1, 1, 6, 7,
+	7, 7, 7, 4, 5, 4, 4, 7, 5, 5,
+	5, 12, 7, 5, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 7, 1, 3,
+	8, 8, 3, 3, 5, 4, 5, 6, 5, 4,
+	4, 3, 2, 3, 4, 4, 3, 4, 4, 4,
+	4, 4, 4, 3, 2, 3, 3, 2, 3, 4,
+	3, 7, 5, 4, 2, 4, 4, 3, 3, 5,
+	2, 3, 1, 1, 0, 1, 1, 1, 0, 2,
+	2, 0, 2, 2, 0, 2, 0, 1, 1, 2,
+	1, 1, 2, 1, 1, 3, 4, 2, 3, 3,
+	0, 1, 1, 2, 2, 2, 2, 2, 3, 3,
+	2, 0, 2, 0, 2, 1, 2, 2, 0, 1,
+	1, 0, 1, 1, 0, 1, 0, 1, 0, 1,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
+	1, 0, 2, 1, 3, 1, 1, 1, 3, 1,
+	3, 3, 7, 1, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 4, 5, 5, 0, 2, 1, 3, 3, 2,
+	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
+	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
+	5, 6, 6, 6, 4, 4, 6, 6, 6, 8,
+	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 2,
+	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
+	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
+	3, 1, 3, 2, 0, 1, 1, 0, 2, 4,
+	4, 0, 2, 4, 4, 0, 1, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 3, 0, 2, 1, 3, 5, 0, 2, 4,
 	2, 1, 3, 5, 4, 6, 1, 3, 3, 5,
 	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
 	3, 1, 3, 3, 3, 3, 3, 1, 2, 1,
@@ -2948,338 +3150,358 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -191, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 116, 117, 119, 118, 151, 120, 144, 51, 165,
-	166, 168, 169, 25, 145, 146, 149, 150, 31, 32,
-	122, -193, 8, 250, 55, -192, 348, -87, 15, -28,
-	5, -26, -196, -26, -26, -26, -26, -26, -167, -169,
-	55, 91, -120, 126, 73, 242, 123, 124, 130, -123,
-	58, -122, 260, 137, 292, 293, 165, 176, 170, 197,
-	189, 261, 294, 138, 187, 190, 229, 136, 295, 217,
-	224, 67, 168, 238, 296, 147, 185, 181, 297, 269,
-	179, 27, 298, 226, 202, 299, 265, 180, 225, 122,
-	300, 140, 134, 301, 203, 207, 302, 230, 303, 304,
-	305, 174, 175, 306, 232, 201, 135, 33, 262, 36,
-	155, 233, 205, 307, 200, 196, 308, 309, 310, 311,
-	199, 173, 195, 40, 209, 208, 210, 228, 192, 312,
-	313, 314, 141, 315, 182, 18, 316, 317, 318, 319,
-	320, 236, 150, 321, 153, 322, 323, 324, 325, 326,
-	327, 227, 204, 206, 131, 157, 264, 328, 234, 178,
-	329, 142, 154, 149, 237, 143, 330, 331, 332, 333,
-	334, 335, 336, 169, 337, 338, 339, 340, 164, 231,
-	240, 39, 214, 341, 172, 133, 342, 166, 161, 219,
-	193, 156, 343, 344, 183, 184, 198, 171, 194, 167,
-	158, 151, 345, 239, 215, 266, 191, 188, 162, 346,
-	159, 160, 347, 220, 221, 163, 263, 235, 186, 216,
-	-107, 126, 242, 123, 221, 128, 124, 124, 125, 126,
-	242, 123, 124, -54, -129, 58, -122, 126, 124, 109,
-	190, 229, 116, 218, 226, 125, 33, 227, 157, -138,
-	124, -109, 217, 220, 221, 163, 58, 231, 230, 222,
-	-129, 167, -134, -134, -134, -134, -134, 219, 219, -134,
-	-2, -91, 17, 16, -5, -3, -193, 6, 20, 21,
-	-32, 41, 42, -27, -38, 100, -39, -129, -60, 75,
-	-65, 29, 58, -122, 23, -64, -61, -80, -78, -79,
-	109, 110, 111, 98, 99, 106, 76, 112, -69, -67,
-	-68, -70, 60, 59, 68, 61, 62, 63, 64, 69,
-	70, 71, -123, -76, -193, 45, 46, 251, 252, 253,
-	254, 259, 255, 78, 35, 241, 249, 248, 247, 245,
-	246, 243, 244, 257, 258, 129, 242, 104, 250, -107,
-	-107, 11, -48, -49, -54, -56, -129, -99, -137, 167,
-	-103, 231, 230, -124, -101, -123, -121, 229, 190, 228,
-	121, 267, 74, 22, 24, 212, 77, 109, 16, 78,
-	108, 251, 116, 49, 268, 243, 244, 241, 253, 254,
-	242, 218, 29, 10, 270, 25, 145, 21, 34, 102,
-	118, 81, 82, 148, 23, 146, 71, 273, 19, 52,
-	11, 13, 274, 275, 14, 129, 128, 93, 125, 47,
-	8, 112, 26, 90, 43, 276, 28, 277, 278, 279,
-	280, 45, 91, 17, 245, 246, 31, 281, 259, 152,
-	104, 50, 37, 75, 282, 283, 69, 284, 72, 53,
-	73, 15, 48, 285, 286, 287, 288, 92, 119, 250,
-	46, 289, 123, 6, 256, 30, 144, 44, 290, 124,
-	80, 257, 258, 127, 70, 5, 130, 32, 9, 51,
-	54, 247, 248, 249, 35, 79, 12, 291, -168, 91,
-	-161, 58, -54, 125, -54, 250, -116, 129, -116, -116,
-	124, -54, 58, 58, 116, 118, 121, 53, -18, -54,
-	-115, 129, 58, -115, -115, -115, -54, 113, -54, 58,
-	30, -113, 91, 12, 242, 58, 157, 124, 158, 126,
-	-135, -193, -124, -135, -135, -135, 161, 162, -135, -112,
-	-111, 224, 225, 219, 223, 12, 162, 219, 160, -135,
-	-134, -134, -194, 57, -92, 19, 31, -39, -129, -88,
-	-89, -39, -87, -2, -26, 37, -30, 21, 34, 66,
-	11, -126, 74, 73, 90, -125, 22, -123, 60, 113,
-	-39, -62, 93, 75, 91, 92, 77, 95, 94, 105,
-	98, 99, 100, 101, 102, 103, 104, 96, 97, 108,
-	83, 84, 85, 86, 87, 88, 89, -108, -193, -79,
-	-193, 114, 115, -65, -65, -65, -65, -65, -65, -65,
-	-65, -193, -2, -74, -39, -193, -193, -193, -193, -193,
-	-193, -193, -193, -193, -83, -39, -193, -197, -71, -193,
-	-197, -71, -197, -71, -197, -193, -197, -71, -197, -71,
-	-197, -197, -71, -193, -193, -193, -193, -193, -193, -55,
-	26, -54, -41, -42, -43, -44, -57, -79, -193, -54,
-	-54, -48, -195, 56, 11, 54, -195, 56, 113, 56,
-	-99, 167, -100, -104, 232, 234, 83, -128, -123, 60,
-	29, 30, 57, 56, -54, -140, -143, -145, -144, -146,
-	-141, -142, 187, 188, 109, 191, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 30, 147, 183, 184,
-	185, 186, 203, 204, 205, 206, 207, 208, 209, 210,
-	170, 189, 261, 171, 172, 173, 174, 175, 176, 178,
-	179, 180, 181, 182, 58, -135, 126, 58, 75, 58,
-	-54, -54, -135, -135, -135, 159, 159, 124, 124, 164,
-	-54, 56, 127, -48, 23, 53, -54, 58, 58, -130,
-	-129, -121, -135, -113, 60, -39, -135, -135, -135, -54,
-	-135, -135, -135, -135, 11, -110, 11, 93, -39, -114,
-	91, 53, 9, 93, 56, 18, 113, 56, -90, 24,
-	25, -91, -194, -32, -66, -123, 61, 64, -31, 44,
-	-54, -39, -39, -72, 69, 75, 70, 71, -125, 100,
-	-130, -124, -121, -65, -73, -76, -79, 65, 93, 91,
-	92, 77, -65, -65, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -65, -65, -65, -136, 58, 60,
-	58, -64, -64, -123, -37, 21, 34, -36, -38, -194,
-	56, -194, -2, -36, -36, -39, -39, -80, 60, -36,
-	-80, 60, -36, -36, -30, -81, -82, 79, -80, -123,
-	-129, -194, -65, -123, -123, -36, -37, -36, -36, -95,
-	153, -54, 30, 56, -50, -52, -51, -53, 43, 47,
-	49, 44, 45, 46, 50, -133, 22, -41, -193, -132,
-	153, -131, 22, -129, 60, -95, 54, -41, -54, -41,
-	-56, -129, 100, -103, -100, 56, 233, 235, 236, 53,
-	72, -39, -152, 108, -170, -171, -172, -124, 60, 61,
-	-161, -162, -163, -173, 139, -178, 131, 133, 130, -164,
-	140, 125, 28, 57, -157, 69, 75, -153, 215, -147,
-	55, -147, -147, -147, -147, -151, 190, -151, -151, -151,
-	55, 55, -147, -147, -147, -155, 55, -155, -155, -156,
-	55, -156, -127, 54, -54, -135, 23, -135, -117, 121,
-	118, 119, -181, 117, 212, 190, 67, 29, 15, 251,
-	153, 266, 58, 154, -54, -54, -54, -54, -54, 121,
-	118, -54, -54, -54, -135, -54, -113, -129, -129, 60,
-	-54, 39, -39, -39, -130, -89, -92, -106, 19, 11,
-	35, 35, -36, 69, 70, 71, 113, -193, -73, -65,
-	-65, -65, -35, 148, 74, -194, -194, -36, -36, 56,
-	-39, -194, -194, -194, 56, 54, 22, 11, 11, -194,
-	11, 11, -194, -194, -36, -84, -82, 81, -39, -194,
-	113, -194, 56, 56, -194, -194, -194, -194, -63, 30,
-	35, -2, -193, -193, -98, -102, -80, -42, -43, -43,
-	-42, -43, 43, 43, 43, 48, 43, 48, 43, -51,
-	-129, -194, -58, 51, 128, 52, -193, -131, -59, 12,
-	-41, -59, -59, 113, -104, -105, 237, 234, 240, 58,
-	60, 56, -172, 83, 55, 58, 28, -164, -164, -165,
-	58, -165, 28, -149, 29, 69, -154, 216, 61, -151,
-	-151, -152, 30, -152, -152, -152, -160, 60, -160, 61,
-	61, 53, -123, -135, -134, -187, 136, 132, 139, 140,
-	134, 58, 125, 28, 131, 133, 153, 130, -187, -118,
-	-119, 127, 22, 125, 28, 153, -186, 54, 159, 212,
-	159, 127, -135, -110, 40, 113, -54, -40, 11, 100,
-	-124, -37, -35, 74, -65, -65, -194, -194, -38, -139,
-	109, 187, 147, 185, 181, 201, 192, 214, 183, 215,
-	-136, -139, -65, -65, -65, -65, 260, -87, 82, -39,
-	80, -124, -65, -65, -97, 53, -98, -75, -77, -76,
-	-193, -2, -93, -123, -96, -123, -59, 56, 83, -46,
-	-45, 53, 54, -47, 53, -45, 43, 43, 125, 125,
-	125, -96, -87, -39, -59, 234, 238, 239, -171, -172,
-	-175, -174, -123, -178, -165, -165, 55, -150, 53, -65,
-	57, -152, -152, 58, 109, 57, 56, 57, 56, 57,
-	56, -54, -134, -134, -54, -134, -123, -184, 263, -185,
-	58, -123, -123, -123, -54, -113, -59, -41, -194, -65,
-	-194, -147, -147, -147, -156, -147, 175, -147, 175, -194,
-	-194, 19, 19, 19, 19, -193, -34, 256, -39, 56,
-	56, 27, -97, 56, -194, -194, -194, 56, 113, -194,
-	56, -87, -102, -39, -39, 55, -39, -193, -193, -193,
-	-194, -91, 57, 56, -147, -94, -123, -158, 212, 9,
-	-151, 60, -151, 61, 61, -135, 26, -183, -182, -124,
-	55, 54, -85, 13, -151, 58, -65, -65, -65, -65,
-	-65, -91, 60, -65, -65, 28, -77, 35, -2, -193,
-	-123, -123, -123, -91, -94, -94, -194, -94, -94, -132,
-	-177, -176, 54, 135, 67, -174, 57, 56, -159, 131,
-	28, 130, -68, -152, -152, 57, 57, -193, 56, 83,
-	-94, -54, -86, 14, 16, -194, -194, -194, -194, -33,
-	93, 263, -194, -194, -194, 9, -75, -2, 113, 57,
-	-194, -194, -194, -58, -176, 58, -166, 83, 60, 142,
-	-123, -148, 67, 28, 28, -179, -180, 153, -182, -172,
-	57, -39, -74, -194, 261, 50, 264, -98, -194, -123,
-	61, -54, 60, -194, 56, -123, -186, 40, 262, 265,
-	55, -180, 35, -184, 40, -94, 155, 263, 57, 156,
-	264, -189, -190, 53, -193, 265, -190, 53, 10, 9,
-	-65, 152, -188, 143, 138, 141, 30, -188, -194, -194,
-	137, 29, 69,
+	-1000, -212, -1, -2, -11, -12, -13, -14, -15, -16,
+	-17, -18, -19, -20, -24, -25, -26, -28, -29, -30,
+	-31, -32, -33, -27, -21, -3, -7, 7, -38, 9,
+	10, 30, -22, 117, 118, 120, 119, 152, 121, 145,
+	52, 167, 168, 170, 171, 172, 173, 174, 25, 146,
+	147, 150, 151, 31, 32, 123, -4, -5, 6, 269,
+	8, 256, -214, 56, -213, 358, -3, -35, -217, -35,
+	-35, -35, -35, -188, -190, 56, 92, -140, 127, 74,
+	248, 124, 125, 162, 131, -143, 59, -142, 266, 138,
+	296, 297, 167, 182, 176, 203, 195, 267, 298, 139,
+	193, 196, 235, 137, 299, 223, 230, 68, 170, 244,
+	300, 175, 353, 148, 191, 187, 301, 275, 185, 27,
+	302, 232, 208, 303, 271, 186, 231, 123, 357, 141,
+	135, 304, 209, 213, 305, 236, 306, 307, 308, 180,
+	181, 309, 238, 207, 136, 33, 268, 36, 156, 239,
+	211, 310, 206, 202, 311, 312, 313, 314, 205, 179,
+	201, 40, 215, 214, 216, 234, 198, 315, 316, 317,
+	142, 318, 188, 18, 319, 320, 321, 322, 323, 242,
+	151, 324, 154, 325, 326, 327, 356, 328, 329, 233,
+	210, 212, 132, 158, 270, 330, 240, 184, 331, 143,
+	155, 150, 243, 144, 332, 333, 334, 335, 336, 337,
+	338, 171, 354, 351, 172, 339, 340, 341, 342, 166,
+	237, 246, 39, 220, 343, 178, 134, 344, 168, 163,
+	225, 199, 157, 345, 346, 189, 190, 204, 177, 200,
+	169, 159, 152, 355, 245, 221, 272, 197, 194, 164,
+	347, 160, 161, 348, 226, 227, 165, 241, 192, 222,
+	-127, 127, 248, 124, 227, 129, 125, 125, 162, 126,
+	127, 248, 124, 125, -63, -149, 59, -142, 162, 127,
+	125, 110, 196, 235, 117, 224, 232, 126, 33, 233,
+	158, -158, 125, -129, 223, 226, 227, 165, 59, 237,
+	236, 228, -149, 169, 128, -143, 172, -34, 175, 270,
+	-154, -154, -154, -154, -154, 225, 225, -154, -96, 15,
+	-37, 5, -35, -8, 293, -9, -149, -2, -47, 101,
+	-48, -149, -69, 76, -74, 29, 59, -142, 23, -73,
+	-70, -89, -87, -88, 110, 111, 112, 99, 100, 107,
+	77, 113, -78, -76, -77, -79, 61, 60, 69, 62,
+	63, 64, 65, 70, 71, 72, -143, -85, -214, 46,
+	47, 257, 258, 259, 260, 265, 261, 79, 35, 247,
+	255, 254, 253, 251, 252, 249, 250, 263, 264, 130,
+	248, 105, 256, -36, -127, -127, 11, -57, -58, -63,
+	-65, -149, -119, -157, 169, -123, 237, 236, -144, -121,
+	-143, -141, 235, 196, 234, 122, 273, 75, 22, 24,
+	218, 78, 110, 16, 79, 109, 257, 117, 50, 274,
+	249, 250, 247, 259, 260, 248, 224, 29, 10, 276,
+	25, 146, 21, 34, 103, 119, 82, 83, 149, 23,
+	147, 72, 279, 19, 53, 11, 13, 280, 281, 14,
+	130, 129, 94, 126, 48, 8, 113, 26, 91, 44,
+	282, 28, 283, 284, 285, 286, 46, 92, 17, 251,
+	252, 31, 287, 265, 153, 105, 51, 37, 76, 288,
+	289, 70, 290, 73, 54, 74, 15, 49, 349, 291,
+	352, 292, 293, 93, 120, 256, 47, 294, 124, 6,
+	262, 30, 145, 45, 295, 125, 81, 263, 264, 128,
+	71, 5, 131, 32, 9, 52, 55, 253, 254, 255,
+	35, 80, 12, 350, 269, -189, 92, -182, 59, -63,
+	126, -63, 256, -136, 130, -136, -136, 125, 125, -63,
+	59, 59, 117, 119, 122, 54, -23, -63, -135, 130,
+	125, 59, -135, -135, -135, -63, 114, -63, 59, 30,
+	-133, 92, 12, 248, 59, 158, 125, 159, 127, -155,
+	-214, -144, -155, -155, -155, 163, 164, -155, -132, -131,
+	230, 231, 225, 229, 12, 164, 225, 161, -155, -143,
+	172, -143, 62, -154, -154, -100, 17, 16, -6, -4,
+	-214, 6, 20, 21, -41, 41, 42, 57, -8, -10,
+	-214, -215, 58, 11, -146, 75, 74, 91, -145, 22,
+	-143, 61, 114, -48, -149, -71, 94, 76, 92, 93,
+	78, 96, 95, 106, 99, 100, 101, 102, 103, 104,
+	105, 97, 98, 109, 84, 85, 86, 87, 88, 89,
+	90, -128, -214, -88, -214, 115, 116, -74, -74, -74,
+	-74, -74, -74, -74, -74, -214, -2, -83, -48, -214,
+	-214, -214, -214, -214, -214, -214, -214, -214, -92, -48,
+	-214, -218, -80, -214, -218, -80, -218, -80, -218, -214,
+	-218, -80, -218, -80, -218, -218, -80, -214, -214, -214,
+	-214, -214, -214, 67, -64, 26, -63, -50, -51, -52,
+	-53, -66, -88, -214, -63, -63, -57, -216, 57, 11,
+	55, -216, 57, 114, 57, -119, 169, -120, -124, 238,
+	240, 84, -148, -143, 61, 29, 30, 58, 57, -63,
+	-160, -163, -165, -164, -166, -161, -162, 193, 194, 110,
+	197, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 30, 148, 189, 190, 191, 192, 209, 210, 211,
+	212, 213, 214, 215, 216, 176, 195, 267, 177, 178,
+	179, 180, 181, 182, 184, 185, 186, 187, 188, 59,
+	-155, 127, 59, 76, 59, -63, -136, -63, -155, -155,
+	-155, 160, 160, 125, 125, 166, -63, 57, 128, -57,
+	23, -135, 54, -63, 59, 59, -150, -149, -141, -155,
+	-133, 61, -48, -155, -155, -155, -63, -155, -155, -155,
+	-155, 11, -130, 11, 94, -48, -134, 92, 54, -143,
+	-112, 19, 31, -48, -97, -98, -48, -96, -2, -35,
+	37, -39, 21, 34, -9, 22, -114, -143, -63, -48,
+	-48, -81, 70, 76, 71, 72, -145, 101, -150, -144,
+	-141, 114, -74, -82, -85, -88, 66, 94, 92, 93,
+	78, -74, -74, -74, -74, -74, -74, -74, -74, -74,
+	-74, -74, -74, -74, -74, -74, -156, 59, 61, 59,
+	-73, -73, -143, -46, 21, 34, -45, -47, -215, 57,
+	-215, -2, -45, -45, -48, -48, -89, 61, -45, -89,
+	61, -45, -45, -39, -90, -91, 80, -89, -143, -149,
+	-215, -74, -143, -143, -45, -46, -45, -45, -115, 154,
+	-63, 30, 57, -59, -61, -60, -62, 44, 48, 50,
+	45, 46, 47, 51, -153, 22, -50, -214, -152, 154,
+	-151, 22, -149, 61, -115, 55, -50, -63, -50, -65,
+	-149, 101, -123, -120, 57, 239, 241, 242, 54, 73,
+	-48, -172, 109, -191, -192, -193, -144, 61, 62, -182,
+	-183, -184, -194, 140, -199, 132, 134, 131, -185, 141,
+	126, 28, 58, -178, 70, 76, -173, 221, -167, 56,
+	-167, -167, -167, -167, -171, 196, -171, -171, -171, 56,
+	56, -167, -167, -167, -176, 56, -176, -176, -177, 56,
+	-177, -147, 55, -63, -155, 23, -155, -63, -137, 122,
+	119, 120, -202, 118, 218, 196, 68, 29, 15, 257,
+	154, 272, 59, 155, -63, -63, -63, -63, -63, 122,
+	119, -63, -63, -57, -63, -155, -63, -133, -149, -149,
+	61, -63, 9, 94, 57, 18, 57, -99, 24, 25,
+	-100, -215, -41, -75, -143, 62, 65, -40, 45, -88,
+	-215, 57, 70, 71, 72, 114, -214, -150, -82, -74,
+	-74, -74, -44, 149, 75, -215, -215, -45, -45, 57,
+	-48, -215, -215, -215, 57, 55, 22, 11, 11, -215,
+	11, 11, -215, -215, -45, -93, -91, 82, -48, -215,
+	114, -215, 57, 57, -215, -215, -215, -215, -72, 30,
+	35, -2, -214, -214, -118, -122, -89, -51, -52, -52,
+	-51, -52, 44, 44, 44, 49, 44, 49, 44, -60,
+	-149, -215, -67, 52, 129, 53, -214, -151, -68, 12,
+	-50, -68, -68, 114, -124, -125, 243, 240, 246, 59,
+	61, 57, -193, 84, 56, 59, 28, -185, -185, -186,
+	59, -186, 28, -169, 29, 70, -174, 222, 62, -171,
+	-171, -172, 30, -172, -172, -172, -181, 61, -181, 62,
+	62, 54, -143, -155, -154, -208, 137, 133, 140, 141,
+	135, 59, 126, 28, 132, 134, 154, 131, -208, -138,
+	-139, 128, 22, 126, 28, 154, -207, 55, 160, 218,
+	160, 128, -155, -130, 39, -48, -48, -98, -112, -126,
+	19, 11, 35, 35, -175, 43, -143, 101, -144, -46,
+	114, -44, 75, -74, -74, -101, 349, -215, -215, -47,
+	-159, 110, 193, 148, 191, 187, 207, 198, 220, 189,
+	221, -156, -159, -74, -74, -74, -74, 266, -96, 83,
+	-48, 81, -144, -74, -74, -117, 54, -118, -84, -86,
+	-85, -214, -2, -113, -143, -116, -143, -68, 57, 84,
+	-55, -54, 54, 55, -56, 54, -54, 44, 44, 126,
+	126, 126, -116, -96, -48, -68, 240, 244, 245, -192,
+	-193, -196, -195, -143, -199, -186, -186, 56, -170, 54,
+	-74, 58, -172, -172, 59, 110, 58, 57, 58, 57,
+	58, 57, -63, -154, -154, -63, -154, -143, -205, 269,
+	-206, 59, -143, -143, -143, -63, -133, 40, -63, -45,
+	-215, -74, -143, -214, -101, -101, -215, -167, -167, -167,
+	-177, -167, 181, -167, 181, -215, -215, 19, 19, 19,
+	19, -214, -43, 262, -48, 57, 57, 27, -117, 57,
+	-215, -215, -215, 57, 114, -215, 57, -96, -122, -48,
+	-48, 56, -48, -214, -214, -214, -215, -100, 58, 57,
+	-167, -114, -179, 218, 9, -171, 61, -171, 62, 62,
+	-155, 26, -204, -203, -144, 56, 55, -49, 11, -102,
+	-103, 59, -171, 59, -74, -74, -74, -74, -74, -100,
+	61, -74, -74, 28, -86, 35, -2, -214, -143, -143,
+	-143, -100, -114, -114, -215, -114, -114, -152, -198, -197,
+	55, 136, 68, -195, 58, -180, 132, 28, 131, -77,
+	-172, -172, 58, 58, -214, 57, 84, -114, -63, -68,
+	-50, -215, -104, 154, -215, -215, -215, -215, -42, 94,
+	269, -215, -215, -215, 9, -84, -2, 114, 58, -215,
+	-215, -215, -67, -197, 59, -187, 84, 61, 143, -168,
+	68, 28, 28, -200, -201, 154, -203, -193, 58, -94,
+	13, -96, 16, -215, 267, 51, 270, -118, -215, -143,
+	62, -63, 61, -215, 57, -143, -207, -95, 14, 16,
+	-105, -106, 351, 352, -83, 40, 268, 271, 56, -201,
+	35, -205, -109, 350, -48, -83, -107, 78, 353, 355,
+	-108, 62, 65, 113, 40, -114, 156, -110, -111, -143,
+	-107, 354, 356, 357, 356, 357, -74, 269, 58, 157,
+	57, 22, 75, -143, 270, -210, -211, 54, -214, -111,
+	-214, -107, 271, -211, 54, 10, 9, -74, 153, -102,
+	-209, 144, 139, 142, 30, -209, -215, -215, -215, 138,
+	29, 70,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	26, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 565, 0, 319, 319, 319, 319, 319, 319,
-	0, 636, 619, 0, 0, 0, 0, -2, 306, 307,
-	0, 309, 310, 938, 938, 938, 938, 938, 0, 0,
-	938, 0, 35, 36, 936, 1, 3, 573, 0, 0,
-	323, 326, 321, 0, 619, 619, 0, 0, 65, 66,
-	0, 0, 0, 925, 0, 617, 617, 617, 637, 638,
-	641, 642, 767, 768, 769, 770, 771, 772, 773, 774,
-	775, 776, 777, 778, 779, 780, 781, 782, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 818, 819, 820, 821, 822, 823, 824,
-	825, 826, 827, 828, 829, 830, 831, 832, 833, 834,
-	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
-	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
-	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
-	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
-	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
-	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
-	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
-	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	926, 927, 928, 929, 930, 931, 932, 933, 934, 935,
-	0, 0, 0, 0, 0, 620, 0, 615, 0, 615,
-	615, 615, 0, 258, 392, 645, 646, 925, 0, 0,
-	0, 297, 0, 939, 270, 939, 939, 273, 939, 0,
-	939, 0, 280, 0, 0, 286, 939, 303, 304, 291,
-	305, 308, 311, 312, 313, 314, 315, 938, 938, 318,
-	29, 577, 0, 0, 565, 31, 0, 319, 324, 325,
-	329, 327, 328, 320, 0, 338, 342, 0, 402, 0,
-	407, 409, -2, -2, 0, 444, 445, 446, 447, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
-	474, 475, 550, 551, 552, 553, 554, 555, 556, 557,
-	411, 412, 547, 597, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 538, 0, 509, 509, 509, 509, 509,
-	509, 509, 509, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 44, 46, 392, 50, 0, 914,
-	601, -2, -2, 0, 0, 643, 644, -2, 780, -2,
-	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
-	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
-	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
-	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
-	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
-	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 734, 735, 736, 737, 738,
-	739, 740, 741, 742, 743, 744, 745, 746, 747, 748,
-	749, 750, 751, 752, 753, 754, 755, 756, 757, 758,
-	759, 760, 761, 762, 763, 764, 765, 766, 0, 0,
-	84, 0, 82, 0, 939, 0, 0, 0, 0, 0,
-	0, 939, 939, 939, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 257, 0, 259, 939,
-	297, 262, 0, 0, 939, 939, 939, 0, 939, 939,
-	269, 940, 941, 271, 272, 274, 939, 939, 276, 0,
-	294, 292, 293, 288, 289, 0, 300, 283, 284, 287,
-	316, 317, 30, 937, 24, 0, 0, 574, 0, 566,
-	567, 570, 573, 29, 326, 0, 332, 330, 331, 322,
-	0, 339, 0, 0, 0, 343, 0, 345, 346, 0,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	429, 430, 431, 432, 433, 434, 435, 408, 0, 422,
-	0, 0, 0, 464, 465, 466, 467, 468, 469, 470,
-	0, 334, 29, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 0, 329, 0, 539, 0, 493, 501, 0,
-	494, 502, 495, 503, 496, 0, 497, 504, 498, 505,
-	499, 500, 506, 0, 0, 0, 334, 0, 0, 48,
-	0, 391, 0, 349, 351, 352, 353, -2, 0, 375,
-	-2, 0, 0, 0, 42, 43, 0, 0, 0, 0,
-	51, 914, 53, 54, 0, 0, 0, 162, 610, 611,
-	612, 608, 206, 0, 0, 150, 146, 90, 91, 92,
-	139, 94, 139, 139, 139, 139, 159, 159, 159, 159,
-	122, 123, 124, 125, 126, 0, 0, 109, 139, 139,
-	139, 113, 129, 130, 131, 132, 133, 134, 135, 136,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 141,
-	141, 141, 143, 143, 639, 68, 0, 939, 0, 939,
-	80, 0, 220, 222, 223, 0, 0, 0, 0, 0,
-	0, 0, 0, 252, 616, 0, 939, 255, 256, 393,
-	647, 648, 260, 261, 298, 299, 263, 264, 265, 266,
-	267, 268, 275, 279, 0, 297, 0, 0, 281, 282,
-	0, 0, 578, 0, 0, 0, 0, 0, 569, 571,
-	572, 577, 32, 329, 0, 558, 0, 0, 0, 333,
-	27, 403, 404, 406, 423, 0, 425, 427, 344, 340,
-	0, 548, -2, 413, 414, 438, 439, 440, 0, 0,
-	0, 0, 436, 418, 0, 449, 450, 451, 452, 453,
-	454, 455, 456, 457, 458, 459, 460, 463, 523, 524,
-	0, 461, 462, 471, 0, 0, 0, 335, 336, 441,
-	0, 596, 29, 0, 0, 0, 0, 446, 550, 0,
-	446, 550, 0, 0, 0, 545, 542, 0, 0, 547,
-	0, 510, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 390, 0, 0, 0, 0, 0, 0, 380, 0,
-	0, 383, 0, 0, 0, 0, 374, 0, 0, 395,
-	859, 376, 0, 378, 379, 400, 0, 400, 45, 400,
-	47, 0, 394, 602, 52, 0, 0, 57, 58, 603,
-	604, 605, 606, 0, 81, 207, 209, 212, 213, 214,
-	85, 86, 87, 0, 0, 194, 0, 0, 188, 188,
-	0, 186, 187, 83, 153, 151, 0, 148, 147, 93,
-	0, 159, 159, 116, 117, 162, 0, 162, 162, 162,
-	0, 0, 110, 111, 112, 104, 0, 105, 106, 107,
-	0, 108, 0, 0, 939, 70, 618, 71, 938, 0,
-	0, 631, 221, 621, 622, 623, 624, 625, 626, 627,
-	628, 629, 630, 0, 72, 225, 227, 226, 230, 0,
-	0, 0, 250, 939, 254, 294, 278, 295, 296, 301,
-	285, 0, 575, 576, 0, 568, 25, 0, 613, 614,
-	559, 560, 347, 424, 426, 428, 0, 334, 415, 436,
-	419, 0, 416, 0, 0, 410, 476, 0, 0, 0,
-	443, -2, 480, 481, 0, 0, 0, 0, 0, 516,
-	0, 0, 517, 0, 565, 0, 543, 0, 0, 492,
-	0, 511, 0, 0, 512, 513, 514, 515, 590, 0,
-	0, -2, 0, 0, 400, 598, 0, 350, 369, 371,
-	0, 366, 381, 382, 384, 0, 386, 0, 388, 389,
-	354, 356, 357, 0, 0, 0, 0, 377, 565, 0,
-	400, 40, 41, 0, 55, 56, 0, 0, 62, 163,
-	164, 0, 210, 0, 0, 0, 181, 188, 188, 184,
-	189, 185, 0, 155, 0, 152, 89, 149, 0, 162,
-	162, 118, 0, 119, 120, 121, 0, 137, 0, 0,
-	0, 0, 640, 69, 215, 938, 232, 233, 234, 235,
-	236, 237, 238, 239, 240, 241, 242, 243, 938, 0,
-	938, 632, 633, 634, 635, 0, 75, 0, 0, 0,
-	0, 0, 253, 297, 579, 0, 26, 400, 0, 341,
-	549, 0, 417, 0, 437, 420, 477, 478, 337, 0,
-	139, 139, 528, 139, 143, 531, 139, 533, 139, 536,
-	0, 0, 0, 0, 0, 0, 0, 540, 491, 546,
-	0, 548, 0, 0, 33, 0, 590, 580, 592, 594,
-	0, 29, 0, 586, 0, 361, 565, 0, 0, 363,
-	370, 0, 0, 364, 0, 365, 385, 387, 0, 0,
-	0, 0, 573, 401, 39, 59, 60, 61, 208, 211,
-	0, 190, 139, 193, 182, 183, 0, 157, 0, 154,
-	140, 114, 115, 160, 161, 159, 0, 159, 0, 144,
-	0, 939, 216, 217, 218, 219, 0, 224, 0, 73,
-	74, 0, 0, 229, 251, 277, 561, 348, 479, 421,
-	482, 525, 159, 529, 530, 532, 534, 535, 537, 484,
-	483, 0, 0, 0, 0, 0, 573, 0, 544, 0,
-	0, 0, 34, 0, 595, -2, 0, 0, 0, 49,
-	0, 573, 599, 600, 367, 0, 372, 0, 0, 0,
-	375, 38, 173, 0, 192, 0, 359, 165, 158, 0,
-	162, 138, 162, 0, 0, 67, 0, 76, 77, 0,
-	0, 0, 563, 0, 526, 527, 0, 0, 0, 0,
-	518, 0, 541, 0, 0, 0, 593, 0, -2, 0,
-	588, 587, 362, 37, 0, 0, 397, 0, 0, 395,
-	172, 174, 0, 179, 0, 191, 0, 0, 170, 0,
-	167, 169, 156, 127, 128, 142, 145, 0, 0, 0,
-	0, 231, 28, 0, 0, 485, 487, 486, 488, 0,
-	0, 0, 490, 507, 508, 0, 583, 29, 0, 368,
-	396, 398, 399, 358, 175, 176, 0, 180, 178, 0,
-	360, 88, 0, 166, 168, 0, 245, 0, 78, 79,
-	72, 564, 562, 489, 0, 0, 0, 591, -2, 589,
-	177, 0, 171, 244, 0, 0, 75, 519, 0, 522,
-	0, 246, 0, 228, 520, 0, 0, 0, 195, 0,
-	0, 196, 197, 0, 0, 521, 198, 0, 0, 0,
-	0, 0, 199, 201, 202, 0, 0, 200, 247, 248,
-	203, 204, 205,
+	21, 22, 23, 24, 25, -2, 0, 341, 341, 341,
+	341, 341, 0, 686, 669, 0, 0, 0, 0, -2,
+	320, 321, 0, 323, 324, 0, 0, 330, 995, 995,
+	995, 995, 995, 0, 0, 995, 589, 0, 341, 0,
+	47, 48, 0, 993, 1, 3, -2, 0, 343, 669,
+	669, 0, 0, 77, 78, 0, 0, 0, 983, 0,
+	667, 667, 667, 962, 687, 688, 691, 692, 819, 820,
+	821, 822, 823, 824, 825, 826, 827, 828, 829, 830,
+	831, 832, 833, 834, 835, 836, 837, 838, 839, 840,
+	841, 842, 843, 844, 845, 846, 847, 848, 849, 850,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
+	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
+	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
+	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
+	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
+	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
+	921, 922, 923, 924, 925, 926, 927, 928, 929, 930,
+	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
+	941, 942, 943, 944, 945, 946, 947, 948, 949, 950,
+	951, 952, 953, 954, 955, 956, 957, 958, 959, 960,
+	961, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
+	982, 984, 985, 986, 987, 988, 989, 990, 991, 992,
+	0, 0, 0, 0, 0, 670, 0, 665, 0, 0,
+	665, 665, 665, 0, 272, 416, 695, 696, 962, 983,
+	0, 0, 0, 311, 0, 996, 284, 996, 996, 287,
+	996, 0, 996, 0, 294, 0, 0, 300, 996, 317,
+	318, 305, 319, 322, 0, 327, 0, 0, 331, 332,
+	333, 334, 335, 336, 337, 995, 995, 340, 597, 0,
+	0, 345, 348, 32, 0, 34, 37, 0, 0, 362,
+	366, 0, 426, 0, 431, 433, -2, -2, 0, 468,
+	469, 470, 471, 472, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 497, 498, 499, 574, 575, 576, 577,
+	578, 579, 580, 581, 435, 436, 571, 647, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 562, 0, 533,
+	533, 533, 533, 533, 533, 533, 533, 0, 0, 0,
+	0, 0, 0, 342, 0, 0, 0, 0, 0, 56,
+	58, 416, 62, 0, 972, 651, -2, -2, 0, 0,
+	693, 694, -2, 832, -2, 699, 700, 701, 702, 703,
+	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
+	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
+	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
+	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
+	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
+	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
+	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
+	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
+	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
+	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	814, 815, 816, 817, 818, 0, 0, 97, 0, 95,
+	0, 996, 0, 0, 0, 0, 0, 667, 0, 996,
+	996, 996, 0, 0, 0, 0, 262, 0, 0, 0,
+	665, 0, 0, 0, 0, 271, 0, 273, 996, 311,
+	276, 0, 0, 996, 996, 996, 0, 996, 996, 283,
+	997, 998, 285, 286, 288, 996, 996, 290, 0, 308,
+	306, 307, 302, 303, 0, 314, 297, 298, 301, 325,
+	945, 328, 329, 338, 339, 627, 0, 0, 589, 43,
+	0, 341, 346, 347, 351, 349, 350, 0, 33, 0,
+	0, 42, 994, 0, 363, 0, 0, 0, 367, 0,
+	369, 370, 0, 429, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 453, 454, 455, 456, 457, 458,
+	459, 432, 0, 446, 0, 0, 0, 488, 489, 490,
+	491, 492, 493, 494, 0, 358, 0, 0, 466, 0,
+	0, 0, 0, 0, 0, 0, 0, 351, 0, 563,
+	0, 517, 525, 0, 518, 526, 519, 527, 520, 0,
+	521, 528, 522, 529, 523, 524, 530, 0, 0, 0,
+	358, 0, 0, 344, 60, 0, 415, 0, 373, 375,
+	376, 377, -2, 0, 399, -2, 0, 0, 0, 54,
+	55, 0, 0, 0, 0, 63, 972, 65, 66, 0,
+	0, 0, 175, 660, 661, 662, 658, 219, 0, 0,
+	163, 159, 103, 104, 105, 152, 107, 152, 152, 152,
+	152, 172, 172, 172, 172, 135, 136, 137, 138, 139,
+	0, 0, 122, 152, 152, 152, 126, 142, 143, 144,
+	145, 146, 147, 148, 149, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 154, 154, 154, 156, 156, 689,
+	80, 0, 996, 0, 996, 92, 0, 0, 233, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 265,
+	666, 0, 0, 996, 269, 270, 417, 697, 698, 274,
+	275, 312, 313, 277, 278, 279, 280, 281, 282, 289,
+	293, 0, 311, 0, 0, 295, 296, 0, 0, 326,
+	29, 0, 0, 598, 590, 591, 594, 597, 0, 348,
+	0, 354, 352, 353, 35, 0, 0, 383, 39, 427,
+	428, 430, 447, 0, 449, 451, 368, 364, 0, 572,
+	-2, 0, 437, 438, 462, 463, 464, 0, 0, 0,
+	0, 460, 442, 0, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 487, 547, 548, 0,
+	485, 486, 495, 0, 0, 0, 359, 360, 465, 0,
+	646, 0, 0, 0, 0, 0, 470, 574, 0, 470,
+	574, 0, 0, 0, 569, 566, 0, 0, 571, 0,
+	534, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 0, 0, 0, 404, 0, 0,
+	407, 0, 0, 0, 0, 398, 0, 0, 419, 913,
+	400, 0, 402, 403, 424, 0, 424, 57, 424, 59,
+	0, 418, 652, 64, 0, 0, 69, 70, 653, 654,
+	655, 656, 0, 94, 220, 222, 225, 226, 227, 98,
+	99, 100, 0, 0, 207, 0, 0, 201, 201, 0,
+	199, 200, 96, 166, 164, 0, 161, 160, 106, 0,
+	172, 172, 129, 130, 175, 0, 175, 175, 175, 0,
+	0, 123, 124, 125, 117, 0, 118, 119, 120, 0,
+	121, 0, 0, 996, 82, 668, 83, 93, 995, 0,
+	0, 681, 234, 671, 672, 673, 674, 675, 676, 677,
+	678, 679, 680, 0, 84, 238, 240, 239, 243, 0,
+	0, 0, 263, 266, 996, 268, 308, 292, 309, 310,
+	315, 299, 628, 0, 0, 0, 0, 593, 595, 596,
+	627, 44, 351, 0, 582, 0, 0, 356, 355, 36,
+	38, 0, 448, 450, 452, 0, 358, 0, 439, 460,
+	443, 0, 440, 0, 0, 434, 601, 0, 0, 0,
+	467, -2, 504, 505, 0, 0, 0, 0, 0, 540,
+	0, 0, 541, 0, 589, 0, 567, 0, 0, 516,
+	0, 535, 0, 0, 536, 537, 538, 539, 640, 0,
+	0, 631, 0, 0, 424, 648, 0, 374, 393, 395,
+	0, 390, 405, 406, 408, 0, 410, 0, 412, 413,
+	378, 380, 381, 0, 0, 0, 0, 401, 589, 0,
+	424, 52, 53, 0, 67, 68, 0, 0, 74, 176,
+	177, 0, 223, 0, 0, 0, 194, 201, 201, 197,
+	202, 198, 0, 168, 0, 165, 102, 162, 0, 175,
+	175, 131, 0, 132, 133, 134, 0, 150, 0, 0,
+	0, 0, 690, 81, 228, 995, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 995, 0,
+	995, 682, 683, 684, 685, 0, 87, 0, 0, 0,
+	0, 0, 267, 311, 0, 599, 600, 592, 30, 0,
+	663, 664, 583, 584, 0, 357, 384, 365, 573, 0,
+	0, 441, 0, 461, 444, 500, 0, 601, 601, 361,
+	0, 152, 152, 552, 152, 156, 555, 152, 557, 152,
+	560, 0, 0, 0, 0, 0, 0, 0, 564, 515,
+	570, 0, 572, 0, 0, 45, 0, 640, 630, 642,
+	644, 0, 0, 0, 636, 0, 385, 589, 0, 0,
+	387, 394, 0, 0, 388, 0, 389, 409, 411, 0,
+	0, 0, 0, 597, 425, 51, 71, 72, 73, 221,
+	224, 0, 203, 152, 206, 195, 196, 0, 170, 0,
+	167, 153, 127, 128, 173, 174, 172, 0, 172, 0,
+	157, 0, 996, 229, 230, 231, 232, 0, 237, 0,
+	85, 86, 0, 0, 242, 264, 291, 629, 31, 371,
+	503, 445, 602, 605, 501, 502, 506, 549, 172, 553,
+	554, 556, 558, 559, 561, 508, 507, 0, 0, 0,
+	0, 0, 597, 0, 568, 0, 0, 0, 46, 0,
+	645, -2, 0, 0, 0, 61, 0, 597, 649, 650,
+	391, 0, 396, 0, 0, 0, 399, 50, 186, 0,
+	205, 0, 178, 171, 0, 175, 151, 175, 0, 0,
+	79, 0, 88, 89, 0, 0, 0, 424, 0, 0,
+	607, 606, 550, 551, 0, 0, 0, 0, 542, 0,
+	565, 0, 0, 0, 643, 0, 634, 0, 638, 637,
+	386, 49, 0, 0, 421, 0, 0, 419, 185, 187,
+	0, 192, 0, 204, 0, 183, 0, 180, 182, 169,
+	140, 141, 155, 158, 0, 0, 0, 0, 244, 585,
+	372, 603, 589, 0, 509, 511, 510, 512, 0, 0,
+	0, 514, 531, 532, 0, 633, 0, 0, 392, 420,
+	422, 423, 382, 188, 189, 0, 193, 191, 0, 101,
+	0, 179, 181, 0, 258, 0, 90, 91, 84, 587,
+	0, 609, 0, 513, 0, 0, 0, 641, -2, 639,
+	190, 0, 184, 257, 0, 0, 87, 622, 0, 0,
+	604, 0, 612, 613, 608, 543, 0, 546, 0, 259,
+	0, 241, 40, 0, 588, 586, 610, 0, 0, 0,
+	0, 619, 620, 0, 544, 0, 0, 623, 624, 0,
+	0, 614, 615, 616, 617, 618, 0, 0, 208, 0,
+	0, 0, 0, 621, 0, 209, 210, 0, 0, 625,
+	605, 611, 545, 211, 0, 0, 0, 0, 0, 0,
+	212, 214, 215, 0, 0, 213, 260, 261, 626, 216,
+	217, 218,
 }
 var yyTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 76, 3, 3, 3, 103, 95, 3,
-	55, 57, 100, 98, 56, 99, 113, 101, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 348,
-	84, 83, 85, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 77, 3, 3, 3, 104, 96, 3,
+	56, 58, 101, 99, 57, 100, 114, 102, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 358,
+	85, 84, 86, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 105, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 106, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 94, 3, 106,
+	3, 3, 3, 3, 95, 3, 107,
 }
 var yyTok2 = [...]int{
 
@@ -3288,11 +3510,11 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 77, 78, 79, 80, 81, 82, 86, 87, 88,
-	89, 90, 91, 92, 93, 96, 97, 102, 104, 107,
-	108, 109, 110, 111, 112, 114, 115, 116, 117, 118,
+	75, 76, 78, 79, 80, 81, 82, 83, 87, 88,
+	89, 90, 91, 92, 93, 94, 97, 98, 103, 105,
+	108, 109, 110, 111, 112, 113, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
@@ -3325,7 +3547,9 @@ var yyTok3 = [...]int{
 	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
-	57670, 345, 57671, 346, 57672, 347, 0,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3667,35 +3891,53 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:324
+//line sql.y:357
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:329
+//line sql.y:362
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:363
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:367
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:356
+//line sql.y:392
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 27:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:398
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 28:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:402
+		{
+			switch sel := yyDollar[2].selStmt.(type) {
+			case *Select:
+				sel.With = yyDollar[1].with
+			case *Union:
+				sel.With = yyDollar[1].with
+			}
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 29:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:362
+//line sql.y:415
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3703,57 +3945,99 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 25:
+	case 30:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:370
+//line sql.y:423
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 26:
+	case 31:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:374
+//line sql.y:427
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 27:
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:433
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:437
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:443
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:447
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:453
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 37:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:458
+		{
+			yyVAL.columns = nil
+		}
+	case 38:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:462
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 39:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:380
+//line sql.y:468
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 28:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:387
+	case 40:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:475
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SQLCalcFoundRows: bool(yyDollar[6].boolVal), SelectExprs: yyDollar[7].selectExprs, From: yyDollar[8].tableExprs, Where: NewWhere(WhereStr, yyDollar[9].expr), GroupBy: GroupBy(yyDollar[10].exprs), Having: NewWhere(HavingStr, yyDollar[11].expr), Windows: yyDollar[12].namedWindows}
 		}
-	case 29:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:393
+//line sql.y:481
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:397
+//line sql.y:485
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:491
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 32:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:407
+//line sql.y:495
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 33:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:414
+//line sql.y:502
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3765,9 +4049,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 34:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:426
+//line sql.y:514
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3777,334 +4061,341 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 35:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:438
+//line sql.y:526
 		{
 			yyVAL.str = InsertStr
 		}
-	case 36:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:442
+//line sql.y:530
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 37:
+	case 49:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:448
+//line sql.y:536
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 38:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:454
+//line sql.y:542
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 39:
+	case 51:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:458
+//line sql.y:546
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 40:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:462
+//line sql.y:550
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 41:
+	case 53:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:466
+//line sql.y:554
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 42:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:559
 		{
 		}
-	case 43:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:472
+//line sql.y:560
 		{
 		}
-	case 44:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:476
+//line sql.y:564
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 45:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:480
+//line sql.y:568
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 46:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:486
+//line sql.y:574
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 47:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:490
+//line sql.y:578
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 48:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:495
+//line sql.y:583
 		{
 			yyVAL.partitions = nil
 		}
-	case 49:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:499
+//line sql.y:587
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 50:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:505
+//line sql.y:593
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 51:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:509
+//line sql.y:597
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 52:
+	case 64:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:513
+//line sql.y:601
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 53:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:517
+//line sql.y:605
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 54:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:523
+//line sql.y:611
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 55:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:527
+//line sql.y:615
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 56:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:533
+//line sql.y:621
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 57:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:537
+//line sql.y:625
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 58:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:541
+//line sql.y:629
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 59:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:547
+//line sql.y:635
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 60:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:551
+//line sql.y:639
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 61:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:555
+//line sql.y:643
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 62:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:559
+//line sql.y:647
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 63:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:565
+//line sql.y:653
 		{
 			yyVAL.str = SessionStr
 		}
-	case 64:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:569
+//line sql.y:657
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 65:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:575
+//line sql.y:663
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 66:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:580
+//line sql.y:668
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 67:
+	case 79:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:586
+//line sql.y:674
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 68:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:591
+//line sql.y:679
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 69:
+	case 81:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:595
+//line sql.y:683
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 70:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:599
+//line sql.y:687
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 71:
+	case 83:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:603
+//line sql.y:691
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 72:
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:608
+//line sql.y:696
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 73:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:612
+//line sql.y:700
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 74:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:618
+//line sql.y:706
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 75:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:623
+//line sql.y:711
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 76:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:628
+//line sql.y:716
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 77:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:722
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 78:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:639
+//line sql.y:727
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 79:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:645
+//line sql.y:733
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 80:
+	case 92:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:651
+//line sql.y:739
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 81:
+	case 93:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:744
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, Temporary: true}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:658
+//line sql.y:751
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 82:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:665
+//line sql.y:758
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 83:
+	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:669
+//line sql.y:762
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 84:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:675
+//line sql.y:768
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:773
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:684
+//line sql.y:777
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:781
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 88:
+	case 101:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:694
+//line sql.y:787
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4114,826 +4405,826 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 89:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:705
+//line sql.y:798
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 93:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:716
+//line sql.y:809
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 94:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:721
+//line sql.y:814
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 95:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:727
+//line sql.y:820
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:731
+//line sql.y:824
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:735
+//line sql.y:828
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:739
+//line sql.y:832
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:743
+//line sql.y:836
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:747
+//line sql.y:840
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:751
+//line sql.y:844
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:755
+//line sql.y:848
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:759
+//line sql.y:852
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:765
+//line sql.y:858
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:771
+//line sql.y:864
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:777
+//line sql.y:870
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 107:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:876
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:789
+//line sql.y:882
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:797
+//line sql.y:890
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 110:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:801
+//line sql.y:894
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 111:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:805
+//line sql.y:898
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 112:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:809
+//line sql.y:902
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 113:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:813
+//line sql.y:906
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 114:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:819
+//line sql.y:912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 115:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:823
+//line sql.y:916
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 116:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:827
+//line sql.y:920
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:831
+//line sql.y:924
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:835
+//line sql.y:928
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:839
+//line sql.y:932
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 120:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:843
+//line sql.y:936
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 121:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:847
+//line sql.y:940
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:851
+//line sql.y:944
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:855
+//line sql.y:948
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:952
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 125:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:956
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:867
+//line sql.y:960
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:871
+//line sql.y:964
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 128:
+	case 141:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:876
+//line sql.y:969
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 129:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:975
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:886
+//line sql.y:979
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:890
+//line sql.y:983
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:987
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:991
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:995
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:999
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:1003
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:916
+//line sql.y:1009
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 138:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:921
+//line sql.y:1014
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 139:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:926
+//line sql.y:1019
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 140:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:930
+//line sql.y:1023
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 141:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:935
+//line sql.y:1028
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 142:
+	case 155:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:939
+//line sql.y:1032
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:947
+//line sql.y:1040
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 144:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:951
+//line sql.y:1044
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 145:
+	case 158:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:957
+//line sql.y:1050
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:965
+//line sql.y:1058
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 147:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:1062
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 148:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:974
+//line sql.y:1067
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:978
+//line sql.y:1071
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:984
+//line sql.y:1077
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 151:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:988
+//line sql.y:1081
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:992
+//line sql.y:1085
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:997
+//line sql.y:1090
 		{
 			yyVAL.optVal = nil
 		}
-	case 154:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1001
+//line sql.y:1094
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 155:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1006
+//line sql.y:1099
 		{
 			yyVAL.optVal = nil
 		}
-	case 156:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1010
+//line sql.y:1103
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 157:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1015
+//line sql.y:1108
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 158:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1019
+//line sql.y:1112
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 159:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1024
+//line sql.y:1117
 		{
 			yyVAL.str = ""
 		}
-	case 160:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1028
+//line sql.y:1121
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 161:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1032
+//line sql.y:1125
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 162:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1037
+//line sql.y:1130
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1041
+//line sql.y:1134
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 164:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1045
+//line sql.y:1138
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 165:
+	case 178:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1050
+//line sql.y:1143
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 166:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1147
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 167:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1058
+//line sql.y:1151
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 168:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1062
+//line sql.y:1155
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 169:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1066
+//line sql.y:1159
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 170:
+	case 183:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1071
+//line sql.y:1164
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 171:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1075
+//line sql.y:1168
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 172:
+	case 185:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1081
+//line sql.y:1174
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 173:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1085
+//line sql.y:1178
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 174:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1091
+//line sql.y:1184
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 175:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1095
+//line sql.y:1188
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 176:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1101
+//line sql.y:1194
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 177:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1105
+//line sql.y:1198
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 178:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1110
+//line sql.y:1203
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 179:
+	case 192:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1116
+//line sql.y:1209
 		{
 			yyVAL.str = ""
 		}
-	case 180:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1213
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 181:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1126
+//line sql.y:1219
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 182:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1130
+//line sql.y:1223
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 183:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1134
+//line sql.y:1227
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 184:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1138
+//line sql.y:1231
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 185:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1142
+//line sql.y:1235
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 186:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1241
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 187:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1245
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 188:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1157
+//line sql.y:1250
 		{
 			yyVAL.str = ""
 		}
-	case 189:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1161
+//line sql.y:1254
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1260
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 191:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1171
+//line sql.y:1264
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 192:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1177
+//line sql.y:1270
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 193:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1183
+//line sql.y:1276
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 194:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1280
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 195:
+	case 208:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1194
+//line sql.y:1287
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 196:
+	case 209:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1198
+//line sql.y:1291
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 197:
+	case 210:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1202
+//line sql.y:1295
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 198:
+	case 211:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1206
+//line sql.y:1299
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 199:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1212
+//line sql.y:1305
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 200:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1218
+//line sql.y:1311
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 201:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1317
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 202:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1228
+//line sql.y:1321
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 203:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1325
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 204:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1236
+//line sql.y:1329
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 205:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1240
+//line sql.y:1333
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 206:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1245
+//line sql.y:1338
 		{
 			yyVAL.str = ""
 		}
-	case 207:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1342
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 208:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1253
+//line sql.y:1346
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 209:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1354
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 210:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1265
+//line sql.y:1358
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 211:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1269
+//line sql.y:1362
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 212:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1368
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 213:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1372
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 214:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1283
+//line sql.y:1376
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 215:
+	case 228:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1289
+//line sql.y:1382
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 216:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1293
+//line sql.y:1386
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 217:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1297
+//line sql.y:1390
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 218:
+	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1301
+//line sql.y:1394
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 219:
+	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1306
+//line sql.y:1399
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 220:
+	case 233:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1311
+//line sql.y:1404
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 221:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1315
+//line sql.y:1408
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 222:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1319
+//line sql.y:1412
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].bytes)}
 		}
-	case 223:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1323
+//line sql.y:1416
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].bytes)}
 		}
-	case 224:
+	case 237:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1327
+//line sql.y:1420
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4945,9 +5236,9 @@ yydefault:
 				},
 			}
 		}
-	case 225:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1339
+//line sql.y:1432
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4957,21 +5248,21 @@ yydefault:
 				},
 			}
 		}
-	case 226:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1349
+//line sql.y:1442
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 227:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1353
+//line sql.y:1446
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 228:
+	case 241:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1357
+//line sql.y:1450
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4984,9 +5275,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 229:
+	case 242:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1370
+//line sql.y:1463
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4996,15 +5287,15 @@ yydefault:
 				},
 			}
 		}
-	case 230:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1473
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 244:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1384
+//line sql.y:1477
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5015,59 +5306,59 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1411
+//line sql.y:1504
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1417
+//line sql.y:1510
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1421
+//line sql.y:1514
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 260:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1427
+//line sql.y:1520
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 261:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1431
+//line sql.y:1524
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1437
+//line sql.y:1530
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1443
+//line sql.y:1536
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1447
+//line sql.y:1540
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
+	case 265:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1455
+//line sql.y:1548
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5075,16 +5366,26 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 253:
+	case 266:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1556
+		{
+			var exists bool
+			if yyDollar[4].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[5].tableNames, IfExists: exists, Temporary: true}
+		}
+	case 267:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1463
+//line sql.y:1564
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 268:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1468
+//line sql.y:1569
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5092,150 +5393,150 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1476
+//line sql.y:1577
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1480
+//line sql.y:1581
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1486
+//line sql.y:1587
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1490
+//line sql.y:1591
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1495
+//line sql.y:1596
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1501
+//line sql.y:1602
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1506
+//line sql.y:1607
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 262:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1511
+//line sql.y:1612
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 263:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1516
+//line sql.y:1617
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1521
+//line sql.y:1622
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1525
+//line sql.y:1626
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 266:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1529
+//line sql.y:1630
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 267:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1533
+//line sql.y:1634
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1537
+//line sql.y:1638
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 269:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1541
+//line sql.y:1642
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1545
+//line sql.y:1646
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1549
+//line sql.y:1650
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1553
+//line sql.y:1654
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1557
+//line sql.y:1658
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1561
+//line sql.y:1662
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1565
+//line sql.y:1666
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1569
+//line sql.y:1670
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 291:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1573
+//line sql.y:1674
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 292:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1578
+//line sql.y:1679
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5245,651 +5546,711 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1588
+//line sql.y:1689
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1693
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1596
+//line sql.y:1697
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1602
+//line sql.y:1703
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 283:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1607
+//line sql.y:1708
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 284:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1611
+//line sql.y:1712
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 299:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1615
+//line sql.y:1716
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 286:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1720
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 287:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1633
+//line sql.y:1734
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 288:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1639
+//line sql.y:1740
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 289:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1643
+//line sql.y:1744
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 290:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1649
+//line sql.y:1750
 		{
 			yyVAL.str = ""
 		}
-	case 291:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1653
+//line sql.y:1754
 		{
 			yyVAL.str = "full "
 		}
-	case 292:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1760
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1663
+//line sql.y:1764
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 294:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1669
+//line sql.y:1770
 		{
 			yyVAL.str = ""
 		}
-	case 295:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1673
+//line sql.y:1774
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 296:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1677
+//line sql.y:1778
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 297:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1683
+//line sql.y:1784
 		{
 			yyVAL.showFilter = nil
 		}
-	case 298:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1687
+//line sql.y:1788
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 299:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1691
+//line sql.y:1792
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 300:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1697
+//line sql.y:1798
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1701
+//line sql.y:1802
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 316:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1707
+//line sql.y:1808
 		{
 			yyVAL.str = ""
 		}
-	case 303:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1711
+//line sql.y:1812
 		{
 			yyVAL.str = SessionStr
 		}
-	case 304:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1715
+//line sql.y:1816
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 305:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1721
+//line sql.y:1822
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 306:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1725
+//line sql.y:1826
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 307:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1832
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 308:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1735
+//line sql.y:1836
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1741
+//line sql.y:1842
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 310:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1848
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 311:
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1852
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[3].colIdent}
+		}
+	case 326:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1856
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[4].colIdent}
+		}
+	case 327:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1862
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1868
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1874
+		{
+			yyVAL.statement = &Kill{Type: yyDollar[2].str, ConnectionID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 330:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1879
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1883
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1887
+		{
+			yyVAL.str = KillQueryStr
+		}
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1753
+//line sql.y:1893
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 312:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1757
+//line sql.y:1897
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1761
+//line sql.y:1901
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1765
+//line sql.y:1905
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 315:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1769
+//line sql.y:1909
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1773
+//line sql.y:1913
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1777
+//line sql.y:1917
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1783
+//line sql.y:1923
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 319:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1787
+//line sql.y:1927
 		{
 			setAllowComments(yylex, true)
 		}
-	case 320:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1791
+//line sql.y:1931
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 321:
+	case 343:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1797
+//line sql.y:1937
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 322:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1941
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 323:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1807
+//line sql.y:1947
 		{
 			yyVAL.str = UnionStr
 		}
-	case 324:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1811
+//line sql.y:1951
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 325:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:1955
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 326:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1820
+//line sql.y:1960
 		{
 			yyVAL.str = ""
 		}
-	case 327:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1824
+//line sql.y:1964
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 328:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1828
+//line sql.y:1968
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 329:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1833
+//line sql.y:1973
 		{
 			yyVAL.str = ""
 		}
-	case 330:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1837
+//line sql.y:1977
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 331:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1841
+//line sql.y:1981
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1846
+//line sql.y:1986
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1990
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 356:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1995
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1999
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1855
+//line sql.y:2004
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1859
+//line sql.y:2008
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1865
+//line sql.y:2014
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1869
+//line sql.y:2018
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1875
+//line sql.y:2024
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 339:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1879
+//line sql.y:2028
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1883
+//line sql.y:2032
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 341:
+	case 365:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1887
+//line sql.y:2036
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 342:
+	case 366:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1892
+//line sql.y:2041
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 343:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1896
+//line sql.y:2045
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 344:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1900
+//line sql.y:2049
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 346:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:2056
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 347:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1912
+//line sql.y:2061
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 348:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1916
+//line sql.y:2065
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 349:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1922
+//line sql.y:2071
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 350:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1926
+//line sql.y:2075
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 353:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1936
+//line sql.y:2085
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 354:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1940
+//line sql.y:2089
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 355:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1944
+//line sql.y:2093
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 356:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2099
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 357:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1956
+//line sql.y:2105
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 358:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1960
+//line sql.y:2109
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 359:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1966
+//line sql.y:2115
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 360:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1970
+//line sql.y:2119
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 361:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+//line sql.y:2125
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 362:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1980
+//line sql.y:2129
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 363:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1993
+//line sql.y:2142
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 364:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1997
+//line sql.y:2146
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 365:
+	case 389:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2001
+//line sql.y:2150
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 366:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2005
+//line sql.y:2154
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 367:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2011
+//line sql.y:2160
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 368:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2013
+//line sql.y:2162
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 369:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2017
+//line sql.y:2166
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 370:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2019
+//line sql.y:2168
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 371:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2023
+//line sql.y:2172
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 372:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2025
+//line sql.y:2174
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 373:
+	case 397:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2028
+//line sql.y:2177
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 374:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2030
+//line sql.y:2179
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 375:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2033
+//line sql.y:2182
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 376:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:2186
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 377:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2041
+//line sql.y:2190
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 379:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2197
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 380:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2054
+//line sql.y:2203
 		{
 			yyVAL.str = JoinStr
 		}
-	case 381:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2058
+//line sql.y:2207
 		{
 			yyVAL.str = JoinStr
 		}
-	case 382:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2062
+//line sql.y:2211
 		{
 			yyVAL.str = JoinStr
 		}
-	case 383:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:2217
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 384:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2223
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 385:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2078
+//line sql.y:2227
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 386:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2082
+//line sql.y:2231
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 387:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2086
+//line sql.y:2235
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 388:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2092
+//line sql.y:2241
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 389:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2096
+//line sql.y:2245
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5897,471 +6258,471 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 390:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2106
+//line sql.y:2255
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 391:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:2259
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 392:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2116
+//line sql.y:2265
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 393:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2120
+//line sql.y:2269
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 394:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2126
+//line sql.y:2275
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 395:
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2131
+//line sql.y:2280
 		{
 			yyVAL.indexHints = nil
 		}
-	case 396:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2135
+//line sql.y:2284
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 397:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2139
+//line sql.y:2288
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 398:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2143
+//line sql.y:2292
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 399:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2147
+//line sql.y:2296
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 400:
+	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2152
+//line sql.y:2301
 		{
 			yyVAL.expr = nil
 		}
-	case 401:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2305
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 402:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2162
+//line sql.y:2311
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 403:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2315
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 404:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2319
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 405:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2174
+//line sql.y:2323
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 406:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2178
+//line sql.y:2327
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 407:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2182
+//line sql.y:2331
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 408:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2186
+//line sql.y:2335
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 409:
+	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2192
+//line sql.y:2341
 		{
 			yyVAL.str = ""
 		}
-	case 410:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2196
+//line sql.y:2345
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 411:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2202
+//line sql.y:2351
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 412:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2206
+//line sql.y:2355
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 413:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2212
+//line sql.y:2361
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 414:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:2365
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 415:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2220
+//line sql.y:2369
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 416:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2224
+//line sql.y:2373
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 417:
+	case 441:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2228
+//line sql.y:2377
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 418:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2232
+//line sql.y:2381
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 419:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2236
+//line sql.y:2385
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 420:
+	case 444:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2240
+//line sql.y:2389
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 421:
+	case 445:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2244
+//line sql.y:2393
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 422:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2248
+//line sql.y:2397
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 423:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:2403
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 424:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2258
+//line sql.y:2407
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 425:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2262
+//line sql.y:2411
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 426:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2266
+//line sql.y:2415
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 427:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2270
+//line sql.y:2419
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 428:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2274
+//line sql.y:2423
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 429:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2280
+//line sql.y:2429
 		{
 			yyVAL.str = EqualStr
 		}
-	case 430:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2284
+//line sql.y:2433
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 431:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2288
+//line sql.y:2437
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 432:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2292
+//line sql.y:2441
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 433:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2296
+//line sql.y:2445
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 434:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2300
+//line sql.y:2449
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 435:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2304
+//line sql.y:2453
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 436:
+	case 460:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2309
+//line sql.y:2458
 		{
 			yyVAL.expr = nil
 		}
-	case 437:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2313
+//line sql.y:2462
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 438:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2319
+//line sql.y:2468
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 439:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2323
+//line sql.y:2472
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 440:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2327
+//line sql.y:2476
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 441:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2333
+//line sql.y:2482
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 442:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2339
+//line sql.y:2488
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 443:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2343
+//line sql.y:2492
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 444:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2349
+//line sql.y:2498
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 445:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2353
+//line sql.y:2502
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 446:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2357
+//line sql.y:2506
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 447:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2361
+//line sql.y:2510
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 448:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2365
+//line sql.y:2514
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 449:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2369
+//line sql.y:2518
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 450:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2373
+//line sql.y:2522
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 451:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2377
+//line sql.y:2526
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2381
+//line sql.y:2530
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2385
+//line sql.y:2534
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2389
+//line sql.y:2538
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 455:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2393
+//line sql.y:2542
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 456:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2397
+//line sql.y:2546
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 457:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2401
+//line sql.y:2550
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 458:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2405
+//line sql.y:2554
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 459:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2409
+//line sql.y:2558
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 460:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2413
+//line sql.y:2562
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 461:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2417
+//line sql.y:2566
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 462:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2421
+//line sql.y:2570
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2425
+//line sql.y:2574
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 464:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2429
+//line sql.y:2578
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 465:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2433
+//line sql.y:2582
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 466:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2437
+//line sql.y:2586
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 467:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2441
+//line sql.y:2590
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6369,9 +6730,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 468:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2449
+//line sql.y:2598
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6385,21 +6746,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 469:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2463
+//line sql.y:2612
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 470:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2467
+//line sql.y:2616
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 471:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2471
+//line sql.y:2620
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6407,491 +6768,491 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 476:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2489
+	case 500:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2638
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 477:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2493
+	case 501:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2642
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 478:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2497
+	case 502:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2646
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 479:
+	case 503:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2501
+//line sql.y:2650
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 480:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2511
+//line sql.y:2660
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 481:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2515
+//line sql.y:2664
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 482:
+	case 506:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2519
+//line sql.y:2668
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 483:
+	case 507:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2523
+//line sql.y:2672
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 484:
+	case 508:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2527
+//line sql.y:2676
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 485:
+	case 509:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2531
+//line sql.y:2680
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 486:
+	case 510:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2535
+//line sql.y:2684
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 487:
+	case 511:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2539
+//line sql.y:2688
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 488:
+	case 512:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2543
+//line sql.y:2692
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 489:
+	case 513:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2547
+//line sql.y:2696
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 490:
+	case 514:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2551
+//line sql.y:2700
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 491:
+	case 515:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2555
+//line sql.y:2704
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 492:
+	case 516:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2559
+//line sql.y:2708
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 493:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2569
+//line sql.y:2718
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 494:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2573
+//line sql.y:2722
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 495:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2577
+//line sql.y:2726
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 496:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2582
+//line sql.y:2731
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 497:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2587
+//line sql.y:2736
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 498:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2592
+//line sql.y:2741
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 499:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2598
+//line sql.y:2747
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 500:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2752
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 501:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2757
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 502:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2612
+//line sql.y:2761
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 503:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2616
+//line sql.y:2765
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 504:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2621
+//line sql.y:2770
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 505:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2626
+//line sql.y:2775
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 506:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2631
+//line sql.y:2780
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 507:
+	case 531:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2635
+//line sql.y:2784
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 508:
+	case 532:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2639
+//line sql.y:2788
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 511:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2649
+//line sql.y:2798
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 512:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2659
+//line sql.y:2808
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 513:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2663
+//line sql.y:2812
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 514:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2667
+//line sql.y:2816
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 515:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2671
+//line sql.y:2820
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 516:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2675
+//line sql.y:2824
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 517:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2679
+//line sql.y:2828
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 518:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2685
+//line sql.y:2834
 		{
 			yyVAL.str = ""
 		}
-	case 519:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2689
+//line sql.y:2838
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 520:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2693
+//line sql.y:2842
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 521:
+	case 545:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2697
+//line sql.y:2846
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 522:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2701
+//line sql.y:2850
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 523:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2707
+//line sql.y:2856
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 524:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2711
+//line sql.y:2860
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 525:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2717
+//line sql.y:2866
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 526:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2721
+//line sql.y:2870
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 527:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2725
+//line sql.y:2874
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 528:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2729
+//line sql.y:2878
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 529:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2733
+//line sql.y:2882
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 530:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:2886
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 531:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2743
+//line sql.y:2892
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 532:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2747
+//line sql.y:2896
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 533:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2751
+//line sql.y:2900
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 534:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2755
+//line sql.y:2904
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 535:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:2908
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 536:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2763
+//line sql.y:2912
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 537:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2767
+//line sql.y:2916
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 538:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2772
+//line sql.y:2921
 		{
 			yyVAL.expr = nil
 		}
-	case 539:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2776
+//line sql.y:2925
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 540:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2781
+//line sql.y:2930
 		{
 			yyVAL.str = string("")
 		}
-	case 541:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2785
+//line sql.y:2934
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 542:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2791
+//line sql.y:2940
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 543:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2795
+//line sql.y:2944
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 544:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2801
+//line sql.y:2950
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 545:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2806
+//line sql.y:2955
 		{
 			yyVAL.expr = nil
 		}
-	case 546:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2810
+//line sql.y:2959
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 547:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2816
+//line sql.y:2965
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 548:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2820
+//line sql.y:2969
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 549:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2824
+//line sql.y:2973
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 550:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2830
+//line sql.y:2979
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 551:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2834
+//line sql.y:2983
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 552:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2838
+//line sql.y:2987
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 553:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2842
+//line sql.y:2991
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 554:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2846
+//line sql.y:2995
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 555:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2850
+//line sql.y:2999
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 556:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2854
+//line sql.y:3003
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 557:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2858
+//line sql.y:3007
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 558:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2864
+//line sql.y:3013
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6900,239 +7261,395 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 559:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2873
+//line sql.y:3022
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 560:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2877
+//line sql.y:3026
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 561:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2882
+//line sql.y:3031
 		{
 			yyVAL.exprs = nil
 		}
-	case 562:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2886
+//line sql.y:3035
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 563:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2891
+//line sql.y:3040
 		{
 			yyVAL.expr = nil
 		}
-	case 564:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2895
+//line sql.y:3044
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 565:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2900
+//line sql.y:3049
 		{
 			yyVAL.orderBy = nil
 		}
-	case 566:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2904
+//line sql.y:3053
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 567:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2910
+//line sql.y:3059
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 568:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2914
+//line sql.y:3063
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 569:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2920
+//line sql.y:3069
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 570:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2925
+//line sql.y:3074
 		{
 			yyVAL.str = AscScr
 		}
-	case 571:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2929
+//line sql.y:3078
 		{
 			yyVAL.str = AscScr
 		}
-	case 572:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3082
 		{
 			yyVAL.str = DescScr
 		}
-	case 573:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2938
+//line sql.y:3087
 		{
 			yyVAL.limit = nil
 		}
-	case 574:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2942
+//line sql.y:3091
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 575:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2946
+//line sql.y:3095
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 576:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2950
+//line sql.y:3099
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 577:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3104
+		{
+			yyVAL.overClause = nil
+		}
+	case 602:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3108
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 603:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3112
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 604:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3118
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].frameClause}
+		}
+	case 605:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3123
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 606:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3127
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 607:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3132
+		{
+			yyVAL.exprs = nil
+		}
+	case 608:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3136
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 609:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3141
+		{
+			yyVAL.frameClause = nil
+		}
+	case 610:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3145
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 611:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3149
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3155
+		{
+			yyVAL.str = RowsStr
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3159
+		{
+			yyVAL.str = RangeStr
+		}
+	case 614:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3165
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 615:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3169
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 616:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3173
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 617:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3177
+		{
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 618:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3181
+		{
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3187
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3191
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 621:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3195
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 622:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3200
+		{
+			yyVAL.namedWindows = nil
+		}
+	case 623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3204
+		{
+			yyVAL.namedWindows = yyDollar[2].namedWindows
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3210
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 625:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3214
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 626:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3220
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 627:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3225
 		{
 			yyVAL.str = ""
 		}
-	case 578:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2959
+//line sql.y:3229
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 579:
+	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2963
+//line sql.y:3233
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 580:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2976
+//line sql.y:3246
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 581:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2980
+//line sql.y:3250
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 582:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2984
+//line sql.y:3254
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 583:
+	case 633:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2989
+//line sql.y:3259
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 584:
+	case 634:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2993
+//line sql.y:3263
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 585:
+	case 635:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2997
+//line sql.y:3267
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 586:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3004
+//line sql.y:3274
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 587:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3008
+//line sql.y:3278
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 588:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3012
+//line sql.y:3282
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 589:
+	case 639:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3016
+//line sql.y:3286
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 590:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3021
+//line sql.y:3291
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 591:
+	case 641:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3025
+//line sql.y:3295
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 592:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3031
+//line sql.y:3301
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 593:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3035
+//line sql.y:3305
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 594:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3041
+//line sql.y:3311
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 595:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3045
+//line sql.y:3315
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 596:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3051
+//line sql.y:3321
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 597:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3057
+//line sql.y:3327
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7140,312 +7657,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 598:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3067
+//line sql.y:3337
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 599:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3071
+//line sql.y:3341
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 600:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3077
+//line sql.y:3347
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 601:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
+//line sql.y:3353
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 602:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3087
+//line sql.y:3357
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 603:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3093
+//line sql.y:3363
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 604:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3097
+//line sql.y:3367
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 605:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3101
+//line sql.y:3371
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 606:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3105
+//line sql.y:3375
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 608:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3112
+//line sql.y:3382
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 610:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3119
+//line sql.y:3389
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 611:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3123
+//line sql.y:3393
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 612:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3127
+//line sql.y:3397
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 615:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3136
+//line sql.y:3406
 		{
 			yyVAL.byt = 0
 		}
-	case 616:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3138
+//line sql.y:3408
 		{
 			yyVAL.byt = 1
 		}
-	case 617:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3141
+//line sql.y:3411
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 618:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3143
+//line sql.y:3413
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3146
+//line sql.y:3416
 		{
 			yyVAL.str = ""
 		}
-	case 620:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3148
+//line sql.y:3418
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 621:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3152
+//line sql.y:3422
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 622:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3424
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 623:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3156
+//line sql.y:3426
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3428
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3160
+//line sql.y:3430
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3432
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3164
+//line sql.y:3434
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3436
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3168
+//line sql.y:3438
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3170
+//line sql.y:3440
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3173
+//line sql.y:3443
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3175
+//line sql.y:3445
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3177
+//line sql.y:3447
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3181
+//line sql.y:3451
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3183
+//line sql.y:3453
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 636:
+	case 686:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3186
+//line sql.y:3456
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 637:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3188
+//line sql.y:3458
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3190
+//line sql.y:3460
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3193
+//line sql.y:3463
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 640:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3195
+//line sql.y:3465
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 641:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3199
+//line sql.y:3469
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 642:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3473
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 644:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3480
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 645:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3216
+//line sql.y:3486
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 646:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3220
+//line sql.y:3490
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 648:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3497
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 936:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3540
+//line sql.y:3817
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 937:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3549
+//line sql.y:3826
 		{
 			decNesting(yylex)
 		}
-	case 938:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3554
+//line sql.y:3831
 		{
 			skipToEnd(yylex)
 		}
-	case 939:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3559
+//line sql.y:3836
 		{
 			skipToEnd(yylex)
 		}
-	case 940:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3563
+//line sql.y:3840
 		{
 			skipToEnd(yylex)
 		}
-	case 941:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3567
+//line sql.y:3844
 		{
 			skipToEnd(yylex)
 		}
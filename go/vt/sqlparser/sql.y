@@ -85,6 +85,15 @@ func skipToEnd(yylex interface{}) {
   orderBy       OrderBy
   order         *Order
   limit         *Limit
+  with          *With
+  cte           *CommonTableExpr
+  ctes          []*CommonTableExpr
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  namedWindows  NamedWindows
+  namedWindow   *NamedWindow
   updateExprs   UpdateExprs
   setExprs      SetExprs
   updateExpr    *UpdateExpr
@@ -124,7 +133,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> DISTINCTROW
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
-%token <bytes> SQL_NO_CACHE SQL_CACHE
+%token <bytes> SQL_NO_CACHE SQL_CACHE SQL_CALC_FOUND_ROWS
 %left <bytes> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <bytes> ON USING
 %token <empty> '(' ',' ')'
@@ -164,11 +173,15 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <bytes> VINDEX VINDEXES
+%token <bytes> TEMPORARY
 %token <bytes> STATUS VARIABLES WARNINGS
 %token <bytes> SEQUENCE
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
+
+// Kill
+%token <bytes> KILL CONNECTION
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -203,20 +216,29 @@ func skipToEnd(yylex interface{}) {
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <bytes> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM
+%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
+%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <bytes> THREAD_PRIORITY TIES VCPU VISIBLE
+
+// Window functions
+%token <bytes> OVER WINDOW ROWS RANGE CURRENT ROW UNBOUNDED PRECEDING FOLLOWING
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement select_body base_select union_lhs union_rhs
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> common_table_expr
+%type <columns> cte_column_list_opt
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
 %type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
+%type <statement> kill_statement
+%type <str> kill_type_opt
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
@@ -256,6 +278,16 @@ func skipToEnd(yylex interface{}) {
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
+%type <overClause> over_opt
+%type <windowSpec> window_spec
+%type <colIdent> window_name_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_opt
+%type <str> frame_unit
+%type <framePoint> frame_point
+%type <expr> frame_offset
+%type <namedWindows> window_opt named_window_list
+%type <namedWindow> named_window
 %type <str> lock_opt
 %type <columns> ins_column_list column_list
 %type <partitions> opt_partition_clause partition_list
@@ -287,6 +319,7 @@ func skipToEnd(yylex interface{}) {
 %type <optVal> column_default_opt on_update_opt
 %type <str> charset_opt collate_opt
 %type <boolVal> unsigned_opt zero_fill_opt
+%type <boolVal> sql_calc_found_rows_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
 %type <boolVal> null_opt auto_increment_opt
 %type <colKeyOpt> column_key_opt
@@ -350,6 +383,9 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
+| kill_statement
 | other_statement
 | flush_statement
 | /*empty*/
@@ -358,6 +394,23 @@ command:
 }
 
 select_statement:
+  select_body
+  {
+    $$ = $1
+  }
+| with_clause select_body
+  {
+    switch sel := $2.(type) {
+    case *Select:
+      sel.With = $1
+    case *Union:
+      sel.With = $1
+    }
+    $$ = $2
+  }
+
+// select_body is a SELECT statement without a WITH clause.
+select_body:
   base_select order_by_opt limit_opt lock_opt
   {
     sel := $1.(*Select)
@@ -375,6 +428,41 @@ select_statement:
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
   }
 
+with_clause:
+  WITH cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+cte_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id cte_column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
+  }
+
+cte_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb column_list closeb
+  {
+    $$ = $2
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -383,13 +471,13 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt cache_opt distinct_opt straight_join_opt sql_calc_found_rows_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt window_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SQLCalcFoundRows: bool($6), SelectExprs: $7, From: $8, Where: NewWhere(WhereStr, $9), GroupBy: GroupBy($10), Having: NewWhere(HavingStr, $11), Windows: $12}
   }
 
 union_lhs:
-  select_statement
+  select_body
   {
     $$ = $1
   }
@@ -652,6 +740,11 @@ create_table_prefix:
     $$ = &DDL{Action: CreateStr, Table: $4}
     setDDL(yylex, $$)
   }
+| CREATE TEMPORARY TABLE not_exists_opt table_name
+  {
+    $$ = &DDL{Action: CreateStr, Table: $5, Temporary: true}
+    setDDL(yylex, $$)
+  }
 
 table_spec:
   '(' table_column_list ')' table_option_list
@@ -1459,6 +1552,14 @@ drop_statement:
     }
     $$ = &DDL{Action: DropStr, FromTables: $4, IfExists: exists}
   }
+| DROP TEMPORARY TABLE exists_opt table_name_list
+  {
+    var exists bool
+    if $4 != 0 {
+      exists = true
+    }
+    $$ = &DDL{Action: DropStr, FromTables: $5, IfExists: exists, Temporary: true}
+  }
 | DROP INDEX ID ON table_name ddl_skip_to_end
   {
     // Change this to an alter statement
@@ -1747,6 +1848,45 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK TO sql_id
+  {
+    $$ = &SRollback{Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &SRollback{Name: $4}
+  }
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
+
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    $$ = &Kill{Type: $2, ConnectionID: NewIntVal($3)}
+  }
+
+kill_type_opt:
+  {
+    $$ = KillConnectionStr
+  }
+| CONNECTION
+  {
+    $$ = KillConnectionStr
+  }
+| QUERY
+  {
+    $$ = KillQueryStr
+  }
 
 other_statement:
   DESC skip_to_end
@@ -1851,6 +1991,15 @@ straight_join_opt:
     $$ = StraightJoinHint
   }
 
+sql_calc_found_rows_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| SQL_CALC_FOUND_ROWS
+  {
+    $$ = BoolVal(true)
+  }
+
 select_expression_list_opt:
   {
     $$ = nil
@@ -2485,18 +2634,18 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
-| sql_id openb DISTINCTROW select_expression_list closeb
+| sql_id openb DISTINCTROW select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
-  }  
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
+  }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
@@ -2951,6 +3100,127 @@ limit_opt:
     $$ = &Limit{Offset: $4, Rowcount: $2}
   }
 
+over_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+window_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| ID
+  {
+    $$ = NewColIdent(string($1))
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| frame_offset PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| frame_offset FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
+  }
+
+frame_offset:
+  INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewValArg($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &NamedWindow{Name: $1, WindowSpec: $4}
+  }
+
 lock_opt:
   {
     $$ = ""
@@ -3323,6 +3593,7 @@ reserved_keyword:
 | OUTER
 | OVER
 | PERCENT_RANK
+| RANGE
 | RANK
 | RECURSIVE
 | REGEXP
@@ -3356,6 +3627,7 @@ reserved_keyword:
 | WHEN
 | WHERE
 | WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3388,6 +3660,8 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CONNECTION
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3487,6 +3761,9 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
+| SAVEPOINT
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_LOAD
@@ -3503,6 +3780,7 @@ non_reserved_keyword:
 | START
 | STATUS
 | TABLES
+| TEMPORARY
 | TEXT
 | THAN
 | THREAD_PRIORITY
@@ -3530,7 +3808,6 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
@@ -416,6 +416,32 @@ func (itc *internalTabletConn) BeginExecuteBatch(ctx context.Context, target *qu
 	return results, transactionID, err
 }
 
+// ReserveExecute is part of queryservice.QueryService
+func (itc *internalTabletConn) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	bindVars = sqltypes.CopyBindVariables(bindVars)
+	reply, reservedID, err := itc.tablet.qsc.QueryService().ReserveExecute(ctx, target, preQueries, query, bindVars, transactionID, options)
+	if err != nil {
+		return nil, reservedID, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+	}
+	return reply, reservedID, nil
+}
+
+// ReserveBeginExecute is part of queryservice.QueryService
+func (itc *internalTabletConn) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	bindVars = sqltypes.CopyBindVariables(bindVars)
+	reply, id, err := itc.tablet.qsc.QueryService().ReserveBeginExecute(ctx, target, preQueries, query, bindVars, reservedID, options)
+	if err != nil {
+		return nil, id, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+	}
+	return reply, id, nil
+}
+
+// Release is part of queryservice.QueryService
+func (itc *internalTabletConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	err := itc.tablet.qsc.QueryService().Release(ctx, target, reservedID)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 // MessageStream is part of queryservice.QueryService
 func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
 	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, callback)
@@ -728,5 +754,9 @@ func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context,
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) Close() {
 }
@@ -97,6 +97,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -271,6 +272,9 @@ var commands = []commandGroup{
 			{"WaitForFilteredReplication", commandWaitForFilteredReplication,
 				"[-max_delay <max_delay, default 30s>] <keyspace/shard>",
 				"Blocks until the specified shard has caught up with the filtered replication of its source shard."},
+			{"CheckLagThrottler", commandCheckLagThrottler,
+				"[-app <app name>] <keyspace/shard>",
+				"Asks the lag throttler of the master of the shard whether the app may write now, and prints the replication lag it observed. Fails if the app is throttled."},
 			{"RemoveShardCell", commandRemoveShardCell,
 				"[-force] [-recursive] <keyspace/shard> <cell>",
 				"Removes the cell from the shard's Cells list."},
@@ -397,8 +401,20 @@ var commands = []commandGroup{
 				"[-exclude_tables=''] [-include-views] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-wait_slave_timeout=10s] [-ddl_strategy=direct] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. If -ddl_strategy=online is set, ALTER TABLE statements start online schema migrations instead, which are managed with the OnlineDDL command. Every schema change is recorded as a schema migration, see ListSchemaMigrations."},
+			{"ListSchemaMigrations", commandListSchemaMigrations,
+				"[-status=<status>] <keyspace>",
+				"Lists the schema migrations recorded by ApplySchema, along with their status on every shard: queued, running, complete, failed or cancelled. If -status is set, only the shards where the migrations have this status are listed."},
+			{"CancelSchemaMigration", commandCancelSchemaMigration,
+				"<keyspace.uuid>",
+				"Cancels a schema migration on the shards where it's not complete. A queued or failed migration, or a running online schema migration can be cancelled."},
+			{"RetrySchemaMigration", commandRetrySchemaMigration,
+				"<keyspace.uuid>",
+				"Runs a schema migration again on the shards where it's queued, failed or was cancelled. A running online schema migration is started over."},
+			{"OnlineDDL", commandOnlineDDL,
+				"[-filtered_replication_wait_time=30s] <keyspace.uuid> {status|complete}",
+				"Manages an online schema migration started by ApplySchema -ddl_strategy=online. 'status' shows its progress on every shard, and 'complete' swaps in the altered table on the shards that are done copying rows. Use CancelSchemaMigration and RetrySchemaMigration to cancel or retry it."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-wait_slave_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -1498,6 +1514,31 @@ func commandWaitForFilteredReplication(ctx context.Context, wr *wrangler.Wrangle
 	return wr.WaitForFilteredReplication(ctx, keyspace, shard, *maxDelay)
 }
 
+func commandCheckLagThrottler(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	app := subFlags.String("app", "", "The name of the app that wants to write")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the CheckLagThrottler command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	result, err := wr.CheckLagThrottler(ctx, keyspace, shard, *app)
+	if err != nil {
+		return err
+	}
+	if err := printJSON(wr.Logger(), result); err != nil {
+		return err
+	}
+	if result.StatusCode != http.StatusOK {
+		return fmt.Errorf("app %q is throttled on %v/%v: %s", *app, keyspace, shard, result.Message)
+	}
+	return nil
+}
+
 func commandRemoveShardCell(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Proceeds even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	recursive := subFlags.Bool("recursive", false, "Also delete all tablets in that cell belonging to the specified shard.")
@@ -2276,6 +2317,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitSlaveTimeout, "The amount of time to wait for slaves to receive the schema change via replication.")
+	ddlStrategy := subFlags.String("ddl_strategy", schemamanager.DDLStrategyDirect, "How to apply ALTER TABLE statements: direct, or online to run them as online schema migrations")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2293,6 +2335,9 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	if err := executor.SetDDLStrategy(*ddlStrategy); err != nil {
+		return err
+	}
 	return schemamanager.Run(
 		ctx,
 		schemamanager.NewPlainController(change, keyspace),
@@ -2300,6 +2345,75 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandListSchemaMigrations(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	status := subFlags.String("status", "", "Only list the shards where the migrations have this status")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ListSchemaMigrations command")
+	}
+	migrations, err := wr.ListSchemaMigrations(ctx, subFlags.Arg(0), *status)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), migrations)
+}
+
+func commandCancelSchemaMigration(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace.uuid> argument is required for the CancelSchemaMigration command")
+	}
+	keyspace, migration, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.CancelSchemaMigration(ctx, keyspace, migration)
+}
+
+func commandRetrySchemaMigration(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace.uuid> argument is required for the RetrySchemaMigration command")
+	}
+	keyspace, migration, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.RunSchemaMigration(ctx, keyspace, migration)
+}
+
+func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for the altered table to catch up during the cut-over. Writes to the table fail in the meantime, and the cut-over is aborted on timeout.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace.uuid> and <action> arguments are required for the OnlineDDL command")
+	}
+	keyspace, migration, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	switch action := subFlags.Arg(1); action {
+	case "status":
+		statuses, err := wr.OnlineDDLStatus(ctx, keyspace, migration)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), statuses)
+	case "complete":
+		return wr.CompleteOnlineDDL(ctx, keyspace, migration, *filteredReplicationWaitTime)
+	default:
+		return fmt.Errorf("unknown action for the OnlineDDL command: %s", action)
+	}
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
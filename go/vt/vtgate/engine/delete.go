@@ -38,10 +38,37 @@ var _ Primitive = (*Delete)(nil)
 type Delete struct {
 	DML
 
+	// Targets specifies the other target tables of a multi-table
+	// delete whose owned vindex entries must be deleted.
+	Targets []*DeleteTarget
+
 	// Delete does not take inputs
 	noInputs
 }
 
+// DeleteTarget is a target table of a multi-table delete that owns
+// vindexes. Its fields have the same meaning as the ones in DML.
+type DeleteTarget struct {
+	Table            *vindexes.Table
+	KsidVindex       vindexes.SingleColumn
+	OwnedVindexQuery string
+}
+
+// MarshalJSON serializes the DeleteTarget into a JSON representation.
+// It's used for testing and diagnostics.
+func (dt *DeleteTarget) MarshalJSON() ([]byte, error) {
+	marshalTarget := struct {
+		Table            string
+		OwnedVindexQuery string
+		KsidVindex       string
+	}{
+		Table:            dt.Table.Name.String(),
+		OwnedVindexQuery: dt.OwnedVindexQuery,
+		KsidVindex:       dt.KsidVindex.String(),
+	}
+	return jsonutil.MarshalNoEscape(marshalTarget)
+}
+
 // MarshalJSON serializes the Delete into a JSON representation.
 // It's used for testing and diagnostics.
 func (del *Delete) MarshalJSON() ([]byte, error) {
@@ -66,6 +93,10 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		KsidVindex           string               `json:",omitempty"`
 		MultiShardAutocommit bool                 `json:",omitempty"`
 		QueryTimeout         int                  `json:",omitempty"`
+		LimitQuery           string               `json:",omitempty"`
+		Limit                *sqltypes.PlanValue  `json:",omitempty"`
+		OrderBy              []OrderbyParams      `json:",omitempty"`
+		Targets              []*DeleteTarget      `json:",omitempty"`
 	}{
 		Opcode:               del.RouteType(),
 		Keyspace:             del.Keyspace,
@@ -77,6 +108,12 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		KsidVindex:           ksidVindexName,
 		MultiShardAutocommit: del.MultiShardAutocommit,
 		QueryTimeout:         del.QueryTimeout,
+		LimitQuery:           del.LimitQuery,
+		OrderBy:              del.OrderBy,
+		Targets:              del.Targets,
+	}
+	if del.LimitQuery != "" {
+		marshalDelete.Limit = &del.Limit
 	}
 	return jsonutil.MarshalNoEscape(marshalDelete)
 }
@@ -161,23 +198,38 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
-	if del.OwnedVindexQuery != "" {
-		err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
-		if err != nil {
-			return nil, vterrors.Wrap(err, "execDeleteEqual")
-		}
+	err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteEqual")
 	}
 	rewritten := sqlannotation.AddKeyspaceIDs(del.Query, [][]byte{ksid}, "")
 	return execShard(vcursor, rewritten, bindVars, rs, true /* isDML */, true /* canAutocommit */)
 }
 
 // deleteVindexEntries performs an delete if table owns vindex.
+// For a multi-table delete, it also does so for the other targets.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
 func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+	if del.OwnedVindexQuery != "" {
+		if err := deleteOwnedVindexEntries(vcursor, bindVars, rss, del.Table, del.KsidVindex, del.OwnedVindexQuery); err != nil {
+			return err
+		}
+	}
+	for _, target := range del.Targets {
+		if err := deleteOwnedVindexEntries(vcursor, bindVars, rss, target.Table, target.KsidVindex, target.OwnedVindexQuery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteOwnedVindexEntries deletes the entries of the vindexes owned by the table
+// for the rows returned by the ownedVindexQuery.
+func deleteOwnedVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, table *vindexes.Table, ksidVindex vindexes.SingleColumn, ownedVindexQuery string) error {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
+		queries[i] = &querypb.BoundQuery{Sql: ownedVindexQuery, BindVariables: bindVars}
 	}
 	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
@@ -192,11 +244,11 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 
 	for _, row := range subQueryResults.Rows {
 		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		ksid, err := resolveKeyspaceID(vcursor, ksidVindex, row[0])
 		if err != nil {
 			return err
 		}
-		for _, colVindex := range del.Table.Owned {
+		for _, colVindex := range table.Owned {
 			// Fetch the column values. colnum must keep incrementing.
 			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
 			for range colVindex.Columns {
@@ -219,6 +271,10 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 		return nil, vterrors.Wrap(err, "execDeleteScatter")
 	}
 
+	if del.LimitQuery != "" {
+		return del.execDeleteWithLimit(vcursor, bindVars, rss)
+	}
+
 	queries := make([]*querypb.BoundQuery, len(rss))
 	sql := sqlannotation.AnnotateIfDML(del.Query, nil)
 	for i := range rss {
@@ -227,7 +283,7 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 			BindVariables: bindVars,
 		}
 	}
-	if len(del.Table.Owned) > 0 {
+	if len(del.Table.Owned) > 0 || len(del.Targets) > 0 {
 		err = del.deleteVindexEntries(vcursor, bindVars, rss)
 		if err != nil {
 			return nil, err
@@ -237,3 +293,34 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 	res, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	return res, vterrors.Aggregate(errs)
 }
+
+// execDeleteWithLimit deletes the rows of a multi-shard delete with
+// a LIMIT clause. Every shard is sent the number of rows it must delete.
+func (del *Delete) execDeleteWithLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	rss, shardVars, err := del.resolveLimit(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteWithLimit")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	sql := sqlannotation.AnnotateIfDML(del.Query, nil)
+	for i, rs := range rss {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           sql,
+			BindVariables: shardVars[i],
+		}
+		if len(del.Table.Owned) > 0 || len(del.Targets) > 0 {
+			if err := del.deleteVindexEntries(vcursor, shardVars[i], []*srvtopo.ResolvedShard{rs}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The rows to delete were read by a separate query,
+	// so the delete cannot be autocommitted on its own.
+	res, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, false /* autocommit */)
+	return res, vterrors.Aggregate(errs)
+}
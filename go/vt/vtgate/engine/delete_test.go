@@ -237,6 +237,68 @@ func TestDeleteOwnedVindex(t *testing.T) {
 	})
 }
 
+func TestDeleteOwnedVindexMultiTable(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:   Equal,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_delete",
+			Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:   []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			Table:    ks.Tables["t2"],
+		},
+		Targets: []*DeleteTarget{{
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+		}},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		// The table of the plan owns no vindexes, but the other target does.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete /* vtgate:: keyspace_id:166b40b44aba4bd6 */ {} true true`,
+	})
+
+	// Scatter
+	del.Opcode = Scatter
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+}
+
 func TestDeleteSharded(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	del := &Delete{
@@ -360,3 +422,145 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
 	})
 }
+
+func TestDeleteScatterLimit(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:     Scatter,
+			Keyspace:   ks.Keyspace,
+			Query:      "dummy_delete",
+			Table:      ks.Tables["t2"],
+			LimitQuery: "dummy_limit",
+			Limit:      sqltypes.PlanValue{Value: sqltypes.NewInt64(3)},
+			OrderBy:    []OrderbyParams{{Col: 2, Desc: true}},
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"shard|id|col",
+			"uint64|int64|int64",
+		),
+		"0|15|5",
+		"1|19|9",
+		"0|13|3",
+		"1|17|7",
+		"0|11|1",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"0" } ` +
+			`sharded.20-: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"1" } ` +
+			`false false`,
+		// The top 3 rows are 19 and 17 from 20-, and 15 from -20.
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"15" > } ` +
+			`sharded.20-: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"19" > values:<type:INT64 value:"17" > } ` +
+			`true false`,
+	})
+
+	// Only one shard has rows to delete.
+	results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"shard|id|col",
+			"uint64|int64|int64",
+		),
+		"1|19|9",
+	)}
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"0" } ` +
+			`sharded.20-: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"1" } ` +
+			`false false`,
+		`ExecuteMultiShard sharded.20-: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"19" > } true false`,
+	})
+
+	// No rows to delete.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"0" } ` +
+			`sharded.20-: dummy_limit {__dml_limit: type:UINT64 value:"3" __dml_shard: type:UINT64 value:"1" } ` +
+			`false false`,
+	})
+}
+
+func TestDeleteScatterLimitOwnedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:           Scatter,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_delete",
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			LimitQuery:       "dummy_limit",
+			Limit:            sqltypes.PlanValue{Value: sqltypes.NewInt64(1)},
+		},
+	}
+
+	results := []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"shard|id",
+				"uint64|int64",
+			),
+			"1|1",
+		),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3",
+				"int64|int64|int64|int64",
+			),
+			"1|4|5|6",
+		),
+	}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_limit {__dml_limit: type:UINT64 value:"1" __dml_shard: type:UINT64 value:"0" } ` +
+			`sharded.20-: dummy_limit {__dml_limit: type:UINT64 value:"1" __dml_shard: type:UINT64 value:"1" } ` +
+			`false false`,
+		// The subquery only goes to the shard that has the row to delete.
+		`ExecuteMultiShard sharded.20-: dummy_subquery {__dml_keys: type:TUPLE values:<type:INT64 value:"1" > } false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.20-: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"1" > } true false`,
+	})
+}
@@ -17,9 +17,16 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+	"sort"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // DML contains the common elements between Update and Delete plans
@@ -58,6 +65,112 @@ type DML struct {
 
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
+
+	// LimitQuery is only set for multi-shard DMLs with a LIMIT clause.
+	// It reads the primary keys of the rows that each shard could affect,
+	// along with their ORDER BY values. The rows are sorted to find out
+	// the ones the DML affects. Their primary keys are then sent to
+	// their shard as the DMLKeysVarName bind variable, which the DML
+	// and the OwnedVindexQuery use instead of an ORDER BY and a LIMIT.
+	LimitQuery string
+
+	// Limit is the row count of the LIMIT clause of a multi-shard DML.
+	Limit sqltypes.PlanValue
+
+	// OrderBy specifies the sort order of the rows read by LimitQuery.
+	OrderBy []OrderbyParams
+}
+
+// resolveLimit executes the LimitQuery on the specified shards, and
+// returns the shards that have rows to affect, along with the bind
+// variables to send to each of them.
+func (dml *DML) resolveLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	resolved, err := dml.Limit.ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	count, err := sqltypes.ToUint64(resolved)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Every shard returns its own index as the first column
+	// of the rows, so that the rows can be attributed to it.
+	// The second column is the primary key of the row.
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           dml.LimitQuery,
+			BindVariables: dmlBindVars(bindVars, uint64(i), count),
+		}
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, false /* isDML */, false /* autocommit */)
+	if errs != nil {
+		return nil, nil, vterrors.Aggregate(errs)
+	}
+
+	sh := &sortHeap{
+		rows:    result.Rows,
+		orderBy: dml.OrderBy,
+	}
+	sort.Sort(sh)
+	if sh.err != nil {
+		return nil, nil, sh.err
+	}
+	rows := sh.rows
+	if uint64(len(rows)) > count {
+		rows = rows[:count]
+	}
+
+	shardKeys := make([][]sqltypes.Value, len(rss))
+	for _, row := range rows {
+		index, err := sqltypes.ToUint64(row[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		if index >= uint64(len(rss)) {
+			return nil, nil, fmt.Errorf("BUG: unexpected shard index in limit query result: %d", index)
+		}
+		shardKeys[index] = append(shardKeys[index], row[1])
+	}
+
+	var limitedRss []*srvtopo.ResolvedShard
+	var shardVars []map[string]*querypb.BindVariable
+	for i, rs := range rss {
+		if len(shardKeys[i]) == 0 {
+			continue
+		}
+		limitedRss = append(limitedRss, rs)
+		shardVars = append(shardVars, dmlKeysBindVars(bindVars, shardKeys[i]))
+	}
+	return limitedRss, shardVars, nil
+}
+
+// dmlBindVars returns a copy of the bind variables, with the
+// shard index and the row count of the LimitQuery.
+func dmlBindVars(bindVars map[string]*querypb.BindVariable, shardIndex, count uint64) map[string]*querypb.BindVariable {
+	out := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+	for k, v := range bindVars {
+		out[k] = v
+	}
+	out[DMLShardVarName] = sqltypes.Uint64BindVariable(shardIndex)
+	out[DMLLimitVarName] = sqltypes.Uint64BindVariable(count)
+	return out
+}
+
+// dmlKeysBindVars returns a copy of the bind variables, with
+// the primary keys of the rows to affect on a shard.
+func dmlKeysBindVars(bindVars map[string]*querypb.BindVariable, keys []sqltypes.Value) map[string]*querypb.BindVariable {
+	out := make(map[string]*querypb.BindVariable, len(bindVars)+1)
+	for k, v := range bindVars {
+		out[k] = v
+	}
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	for _, key := range keys {
+		bv.Values = append(bv.Values, sqltypes.ValueToProto(key))
+	}
+	out[DMLKeysVarName] = bv
+	return out
 }
 
 // DMLOpcode is a number representing the opcode
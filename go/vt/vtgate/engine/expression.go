@@ -0,0 +1,468 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Expr is an expression that vtgate can evaluate against a row
+// of results. Only a small subset of the MySQL expressions is
+// supported: column references, literals, bind variables,
+// comparisons, IS [NOT] NULL, logical operators, arithmetic
+// and the COALESCE and IFNULL functions.
+//
+// Boolean results are returned as 1 or 0, and NULL is handled
+// the same way MySQL does. A text value can only be compared with
+// a binary value, in which case both are compared as binary, like
+// MySQL does. The comparison of two text values depends on their
+// collation, so it fails. The planner avoids this by pushing such
+// comparisons down to MySQL whenever it can.
+type Expr interface {
+	// Evaluate returns the value of the expression for the row.
+	Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error)
+	// Type returns the type of the values produced by the
+	// expression, given the fields of the input rows.
+	Type(fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) querypb.Type
+	// String returns a representation of the expression.
+	// It's used for testing and diagnostics.
+	String() string
+}
+
+var (
+	_ Expr = (*ColumnExpr)(nil)
+	_ Expr = (*ValueExpr)(nil)
+	_ Expr = (*ComparisonExpr)(nil)
+	_ Expr = (*IsExpr)(nil)
+	_ Expr = (*LogicalExpr)(nil)
+	_ Expr = (*NotExpr)(nil)
+	_ Expr = (*ArithmeticExpr)(nil)
+	_ Expr = (*CoalesceExpr)(nil)
+)
+
+var (
+	trueValue  = sqltypes.NewInt64(1)
+	falseValue = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return trueValue
+	}
+	return falseValue
+}
+
+// isTrue returns true if v is not null and is not zero.
+// Non-numeric strings evaluate to 0, like in MySQL.
+func isTrue(v sqltypes.Value) bool {
+	if v.IsNull() {
+		return false
+	}
+	f, err := sqltypes.ToFloat64(v)
+	return err == nil && f != 0
+}
+
+// ColumnExpr is a reference to a column of the row.
+type ColumnExpr struct {
+	Col int
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *ColumnExpr) Evaluate(row []sqltypes.Value, _ map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	return row[e.Col], nil
+}
+
+// Type satisfies the Expr interface.
+func (e *ColumnExpr) Type(fields []*querypb.Field, _ map[string]*querypb.BindVariable) querypb.Type {
+	return fields[e.Col].Type
+}
+
+func (e *ColumnExpr) String() string {
+	return fmt.Sprintf("[COLUMN %d]", e.Col)
+}
+
+// ValueExpr is a literal, a bind variable, or a list of them.
+// A list can only be used as the right operand of an IN construct.
+type ValueExpr struct {
+	Value sqltypes.PlanValue
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *ValueExpr) Evaluate(_ []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	return e.Value.ResolveValue(bindVars)
+}
+
+// Type satisfies the Expr interface.
+func (e *ValueExpr) Type(_ []*querypb.Field, bindVars map[string]*querypb.BindVariable) querypb.Type {
+	if e.Value.Key != "" {
+		if bv, ok := bindVars[e.Value.Key]; ok {
+			return bv.Type
+		}
+		return sqltypes.Null
+	}
+	return e.Value.Value.Type()
+}
+
+func (e *ValueExpr) String() string {
+	return formatPlanValue(e.Value)
+}
+
+func formatPlanValue(pv sqltypes.PlanValue) string {
+	switch {
+	case pv.Key != "":
+		return ":" + pv.Key
+	case pv.ListKey != "":
+		return "::" + pv.ListKey
+	case pv.Values != nil:
+		vals := make([]string, 0, len(pv.Values))
+		for _, val := range pv.Values {
+			vals = append(vals, formatPlanValue(val))
+		}
+		return "(" + strings.Join(vals, ", ") + ")"
+	case pv.Value.IsNull():
+		return "null"
+	}
+	buf := &bytes.Buffer{}
+	pv.Value.EncodeSQL(buf)
+	return buf.String()
+}
+
+// ComparisonExpr compares two values. Operator is one of the
+// comparison operators of sqlparser.ComparisonExpr, except the
+// LIKE, REGEXP and JSON operators. For the IN operators, Right
+// must be a ValueExpr that represents a list.
+type ComparisonExpr struct {
+	Operator    string
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *ComparisonExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	left, err := e.Left.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch e.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return e.evaluateIn(left, bindVars)
+	}
+	right, err := e.Right.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if e.Operator == sqlparser.NullSafeEqualStr {
+		if left.IsNull() || right.IsNull() {
+			return boolValue(left.IsNull() && right.IsNull()), nil
+		}
+	} else if left.IsNull() || right.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := compareValues(left, right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch e.Operator {
+	case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+		return boolValue(cmp == 0), nil
+	case sqlparser.NotEqualStr:
+		return boolValue(cmp != 0), nil
+	case sqlparser.LessThanStr:
+		return boolValue(cmp < 0), nil
+	case sqlparser.LessEqualStr:
+		return boolValue(cmp <= 0), nil
+	case sqlparser.GreaterThanStr:
+		return boolValue(cmp > 0), nil
+	case sqlparser.GreaterEqualStr:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported comparison operator: %s", e.Operator)
+}
+
+// evaluateIn follows the semantics of SQL: if the value is not
+// found and the list contains a NULL, the result is NULL.
+func (e *ComparisonExpr) evaluateIn(left sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	right, ok := e.Right.(*ValueExpr)
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("right operand of %s is not a list: %s", e.Operator, e.Right)
+	}
+	vals, err := right.Value.ResolveList(bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if left.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	hasNull := false
+	for _, val := range vals {
+		if val.IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := compareValues(left, val)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(e.Operator == sqlparser.InStr), nil
+		}
+	}
+	if hasNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(e.Operator == sqlparser.NotInStr), nil
+}
+
+// compareValues compares two values that are not NULL.
+func compareValues(v1, v2 sqltypes.Value) (int, error) {
+	switch {
+	case v1.IsText() && v2.IsText():
+		return 0, fmt.Errorf("cannot compare text values without their collation: %v vs %v", v1.Type(), v2.Type())
+	case v1.IsText() && v2.IsBinary(), v1.IsBinary() && v2.IsText():
+		return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+	}
+	return sqltypes.NullsafeCompare(v1, v2)
+}
+
+// Type satisfies the Expr interface.
+func (e *ComparisonExpr) Type(_ []*querypb.Field, _ map[string]*querypb.BindVariable) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (e *ComparisonExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(e.Left), e.Operator, formatOperand(e.Right))
+}
+
+// IsExpr is an IS NULL or IS NOT NULL construct.
+type IsExpr struct {
+	Operator string
+	Expr     Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *IsExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	v, err := e.Expr.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch e.Operator {
+	case sqlparser.IsNullStr:
+		return boolValue(v.IsNull()), nil
+	case sqlparser.IsNotNullStr:
+		return boolValue(!v.IsNull()), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("unsupported operator: %s", e.Operator)
+}
+
+// Type satisfies the Expr interface.
+func (e *IsExpr) Type(_ []*querypb.Field, _ map[string]*querypb.BindVariable) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (e *IsExpr) String() string {
+	return fmt.Sprintf("%s %s", formatOperand(e.Expr), e.Operator)
+}
+
+// LogicalExpr is an AND or OR construct.
+type LogicalExpr struct {
+	// Operator is "and" or "or".
+	Operator    string
+	Left, Right Expr
+}
+
+// These are the operators of LogicalExpr.
+const (
+	LogicalAnd = "and"
+	LogicalOr  = "or"
+)
+
+// Evaluate satisfies the Expr interface.
+func (e *LogicalExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	left, err := e.Left.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	ltrue := isTrue(left)
+	// Short-circuit if the result is already known.
+	switch {
+	case e.Operator == LogicalAnd && !left.IsNull() && !ltrue:
+		return falseValue, nil
+	case e.Operator == LogicalOr && ltrue:
+		return trueValue, nil
+	}
+	right, err := e.Right.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rtrue := isTrue(right)
+	switch {
+	case e.Operator == LogicalAnd && !right.IsNull() && !rtrue:
+		return falseValue, nil
+	case e.Operator == LogicalOr && rtrue:
+		return trueValue, nil
+	case left.IsNull() || right.IsNull():
+		return sqltypes.NULL, nil
+	}
+	return boolValue(e.Operator == LogicalAnd), nil
+}
+
+// Type satisfies the Expr interface.
+func (e *LogicalExpr) Type(_ []*querypb.Field, _ map[string]*querypb.BindVariable) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (e *LogicalExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(e.Left), e.Operator, formatOperand(e.Right))
+}
+
+// NotExpr negates a boolean expression.
+type NotExpr struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *NotExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	v, err := e.Expr.Evaluate(row, bindVars)
+	if err != nil || v.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!isTrue(v)), nil
+}
+
+// Type satisfies the Expr interface.
+func (e *NotExpr) Type(_ []*querypb.Field, _ map[string]*querypb.BindVariable) querypb.Type {
+	return sqltypes.Int64
+}
+
+func (e *NotExpr) String() string {
+	return "not " + formatOperand(e.Expr)
+}
+
+// ArithmeticExpr is an addition, subtraction,
+// multiplication or division of two values.
+type ArithmeticExpr struct {
+	Operator    string
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *ArithmeticExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	left, err := e.Left.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := e.Right.Evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result sqltypes.Value
+	switch e.Operator {
+	case sqlparser.PlusStr:
+		result, err = sqltypes.Add(left, right)
+	case sqlparser.MinusStr:
+		result, err = sqltypes.Subtract(left, right)
+	case sqlparser.MultStr:
+		result, err = sqltypes.Multiply(left, right)
+	case sqlparser.DivStr:
+		result, err = sqltypes.Divide(left, right)
+	default:
+		return sqltypes.NULL, fmt.Errorf("unsupported arithmetic operator: %s", e.Operator)
+	}
+	return result, err
+}
+
+// Type satisfies the Expr interface. The result is a
+// float unless both operands are integral and the
+// operation is not a division.
+func (e *ArithmeticExpr) Type(fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) querypb.Type {
+	if e.Operator == sqlparser.DivStr {
+		return sqltypes.Float64
+	}
+	result := sqltypes.Int64
+	for _, operand := range []Expr{e.Left, e.Right} {
+		typ := operand.Type(fields, bindVars)
+		switch {
+		case typ == sqltypes.Null, sqltypes.IsSigned(typ):
+		case sqltypes.IsUnsigned(typ):
+			if result == sqltypes.Int64 {
+				result = sqltypes.Uint64
+			}
+		default:
+			result = sqltypes.Float64
+		}
+	}
+	return result
+}
+
+func (e *ArithmeticExpr) String() string {
+	return fmt.Sprintf("%s %s %s", formatOperand(e.Left), e.Operator, formatOperand(e.Right))
+}
+
+// CoalesceExpr returns the first of Exprs that is not null.
+// It's used for both COALESCE and IFNULL.
+type CoalesceExpr struct {
+	Exprs []Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (e *CoalesceExpr) Evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	for _, expr := range e.Exprs {
+		v, err := expr.Evaluate(row, bindVars)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if !v.IsNull() {
+			return v, nil
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+// Type satisfies the Expr interface. The type of the
+// first argument that's not a NULL literal is used.
+func (e *CoalesceExpr) Type(fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) querypb.Type {
+	for _, expr := range e.Exprs {
+		if typ := expr.Type(fields, bindVars); typ != sqltypes.Null {
+			return typ
+		}
+	}
+	return sqltypes.Null
+}
+
+func (e *CoalesceExpr) String() string {
+	args := make([]string, 0, len(e.Exprs))
+	for _, expr := range e.Exprs {
+		args = append(args, expr.String())
+	}
+	return "coalesce(" + strings.Join(args, ", ") + ")"
+}
+
+// formatOperand formats an operand of an operator,
+// parenthesizing it if it's not a simple expression.
+func formatOperand(e Expr) string {
+	switch e.(type) {
+	case *ColumnExpr, *ValueExpr, *CoalesceExpr:
+		return e.String()
+	}
+	return "(" + e.String() + ")"
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestExprEvaluate(t *testing.T) {
+	// The row is (1, null, 'a').
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("a"),
+	}
+	fields := sqltypes.MakeTestFields(
+		"c0|c1|c2",
+		"int64|int64|varchar",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"one":  sqltypes.Int64BindVariable(1),
+		"list": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+	col0 := &ColumnExpr{Col: 0}
+	col1 := &ColumnExpr{Col: 1}
+	col2 := &ColumnExpr{Col: 2}
+	one := &ValueExpr{Value: sqltypes.PlanValue{Key: "one"}}
+	two := &ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)}}
+	null := &ValueExpr{}
+	list := &ValueExpr{Value: sqltypes.PlanValue{ListKey: "list"}}
+	nullList := &ValueExpr{Value: sqltypes.PlanValue{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(2)}, {}}}}
+	binA := &ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewVarBinary("a")}}
+	binList := &ValueExpr{Value: sqltypes.PlanValue{Values: []sqltypes.PlanValue{{Value: sqltypes.NewVarBinary("A")}, {Value: sqltypes.NewVarBinary("b")}}}}
+
+	testcases := []struct {
+		expr Expr
+		want sqltypes.Value
+		typ  querypb.Type
+	}{{
+		expr: col2,
+		want: sqltypes.NewVarChar("a"),
+		typ:  sqltypes.VarChar,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.EqualStr, Left: col0, Right: one},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.LessThanStr, Left: two, Right: col0},
+		want: falseValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.EqualStr, Left: col1, Right: one},
+		want: sqltypes.NULL,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.NullSafeEqualStr, Left: col1, Right: null},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.InStr, Left: col0, Right: list},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.NotInStr, Left: col0, Right: nullList},
+		want: sqltypes.NULL,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.EqualStr, Left: col2, Right: binA},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ComparisonExpr{Operator: sqlparser.InStr, Left: col2, Right: binList},
+		want: falseValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &IsExpr{Operator: sqlparser.IsNotNullStr, Expr: col1},
+		want: falseValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalAnd, Left: col1, Right: col0},
+		want: sqltypes.NULL,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalAnd, Left: col1, Right: col2},
+		want: falseValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &LogicalExpr{Operator: LogicalOr, Left: col1, Right: col0},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &NotExpr{Expr: col1},
+		want: sqltypes.NULL,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &NotExpr{Expr: col2},
+		want: trueValue,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ArithmeticExpr{Operator: sqlparser.MultStr, Left: col0, Right: two},
+		want: sqltypes.NewInt64(2),
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &ArithmeticExpr{Operator: sqlparser.DivStr, Left: col0, Right: two},
+		want: sqltypes.NewFloat64(0.5),
+		typ:  sqltypes.Float64,
+	}, {
+		expr: &ArithmeticExpr{Operator: sqlparser.MinusStr, Left: col1, Right: two},
+		want: sqltypes.NULL,
+		typ:  sqltypes.Int64,
+	}, {
+		expr: &CoalesceExpr{Exprs: []Expr{null, col1, two}},
+		want: sqltypes.NewInt64(2),
+		typ:  sqltypes.Int64,
+	}}
+	for _, tc := range testcases {
+		got, err := tc.expr.Evaluate(row, bindVars)
+		if err != nil {
+			t.Errorf("%s: %v", tc.expr, err)
+			continue
+		}
+		if got.Type() != tc.want.Type() || got.ToString() != tc.want.ToString() {
+			t.Errorf("%s: %v, want %v", tc.expr, got, tc.want)
+		}
+		if typ := tc.expr.Type(fields, bindVars); typ != tc.typ {
+			t.Errorf("%s: type %v, want %v", tc.expr, typ, tc.typ)
+		}
+	}
+}
+
+func TestExprCompareText(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewVarChar("a"),
+		sqltypes.NewVarChar("A"),
+	}
+	testcases := []Expr{
+		&ComparisonExpr{Operator: sqlparser.EqualStr, Left: &ColumnExpr{Col: 0}, Right: &ColumnExpr{Col: 1}},
+		&ComparisonExpr{Operator: sqlparser.LessThanStr, Left: &ColumnExpr{Col: 0}, Right: &ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewVarChar("b")}}},
+		&ComparisonExpr{Operator: sqlparser.InStr, Left: &ColumnExpr{Col: 1}, Right: &ValueExpr{Value: sqltypes.PlanValue{Values: []sqltypes.PlanValue{{Value: sqltypes.NewVarChar("a")}}}}},
+	}
+	want := "cannot compare text values without their collation: VARCHAR vs VARCHAR"
+	for _, expr := range testcases {
+		_, err := expr.Evaluate(row, nil)
+		if err == nil || err.Error() != want {
+			t.Errorf("%s: %v, want %s", expr, err, want)
+		}
+	}
+}
@@ -51,6 +51,10 @@ func (t noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t noopVCursor) InTransaction() bool {
+	return false
+}
+
 func (t noopVCursor) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	return func() {}
 }
@@ -78,6 +82,14 @@ func (t noopVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedSha
 	panic("unimplemented")
 }
 
+func (t noopVCursor) SetFoundRows(count uint64) {
+	panic("unimplemented")
+}
+
+func (t noopVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	panic("unimplemented")
+}
+
 func (t noopVCursor) ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, isDML, autocommit bool) (*sqltypes.Result, error) {
 	panic("unimplemented")
 }
@@ -165,6 +177,15 @@ func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.Resolve
 	return callback(r)
 }
 
+func (f *loggingVCursor) SetFoundRows(count uint64) {
+	f.log = append(f.log, fmt.Sprintf("SetFoundRows %d", count))
+}
+
+func (f *loggingVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	f.log = append(f.log, fmt.Sprintf("ExecuteLock %s %s %s %v", rs.Target.Keyspace, rs.Target.Shard, query.Sql, printBindVars(query.BindVariables)))
+	return f.nextResult()
+}
+
 func (f *loggingVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	f.log = append(f.log, fmt.Sprintf("ResolveDestinations %v %v %v", keyspace, ids, key.DestinationsString(destinations)))
 	if f.shardErr != nil {
@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter evaluates expressions against the rows of the underlying
+// primitive. It's used for the predicates and the select expressions
+// that cannot be pushed down into a route, like the ones that reference
+// the right side of a cross-shard left join.
+type Filter struct {
+	// Predicate, if set, is evaluated for every input row.
+	// Rows for which it's not true are discarded.
+	Predicate Expr
+
+	// Exprs builds the columns of the result.
+	Exprs []Expr
+
+	// Aliases specifies the names of the result fields.
+	// If an alias is empty, the expression must be a
+	// ColumnExpr, and the input field is reused.
+	Aliases []string
+
+	Input Primitive
+}
+
+// MarshalJSON serializes the Filter into a JSON representation.
+// It's used for testing and diagnostics.
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	exprs := make([]string, 0, len(f.Exprs))
+	for i, expr := range f.Exprs {
+		if f.Aliases[i] == "" {
+			exprs = append(exprs, expr.String())
+			continue
+		}
+		exprs = append(exprs, expr.String()+" as "+f.Aliases[i])
+	}
+	marshalFilter := struct {
+		Opcode    string
+		Predicate string `json:",omitempty"`
+		Exprs     []string
+		Input     Primitive
+	}{
+		Opcode: "Filter",
+		Exprs:  exprs,
+		Input:  f.Input,
+	}
+	if f.Predicate != nil {
+		marshalFilter.Predicate = f.Predicate.String()
+	}
+	return json.Marshal(marshalFilter)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	inner, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return f.buildResult(inner, bindVars)
+}
+
+// StreamExecute performs a streaming exec.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(inner *sqltypes.Result) error {
+		result, err := f.buildResult(inner, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	inner, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: f.buildFields(inner.Fields, bindVars)}, nil
+}
+
+// Inputs returns the input to this primitive
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// buildResult returns the rows of inner that satisfy the
+// predicate, after evaluating the expressions against them.
+func (f *Filter) buildResult(inner *sqltypes.Result, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{Fields: f.buildFields(inner.Fields, bindVars)}
+	for _, innerRow := range inner.Rows {
+		if f.Predicate != nil {
+			v, err := f.Predicate.Evaluate(innerRow, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			if !isTrue(v) {
+				continue
+			}
+		}
+		row := make([]sqltypes.Value, 0, len(f.Exprs))
+		for _, expr := range f.Exprs {
+			v, err := expr.Evaluate(innerRow, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, v)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// buildFields returns nil if the inner fields were not requested.
+// An input like SingleRow returns an empty list of fields instead.
+func (f *Filter) buildFields(inner []*querypb.Field, bindVars map[string]*querypb.BindVariable) []*querypb.Field {
+	if inner == nil {
+		return nil
+	}
+	fields := make([]*querypb.Field, 0, len(f.Exprs))
+	for i, expr := range f.Exprs {
+		if f.Aliases[i] == "" {
+			fields = append(fields, inner[expr.(*ColumnExpr).Col])
+			continue
+		}
+		fields = append(fields, &querypb.Field{
+			Name: f.Aliases[i],
+			Type: expr.Type(inner, bindVars),
+		})
+	}
+	return fields
+}
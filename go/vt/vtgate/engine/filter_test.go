@@ -0,0 +1,193 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func filterTestPrimitive() *fakePrimitive {
+	return &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"2|null",
+				"3|5",
+			),
+		},
+	}
+}
+
+// filterTestPlan builds a Filter for:
+// select id, col+1 as c ... where col is null or col > :val
+func filterTestPlan(input Primitive) *Filter {
+	return &Filter{
+		Predicate: &LogicalExpr{
+			Operator: LogicalOr,
+			Left: &IsExpr{
+				Operator: sqlparser.IsNullStr,
+				Expr:     &ColumnExpr{Col: 1},
+			},
+			Right: &ComparisonExpr{
+				Operator: sqlparser.GreaterThanStr,
+				Left:     &ColumnExpr{Col: 1},
+				Right:    &ValueExpr{Value: sqltypes.PlanValue{Key: "val"}},
+			},
+		},
+		Exprs: []Expr{
+			&ColumnExpr{Col: 0},
+			&ArithmeticExpr{
+				Operator: sqlparser.PlusStr,
+				Left:     &ColumnExpr{Col: 1},
+				Right:    &ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}},
+			},
+		},
+		Aliases: []string{"", "c"},
+		Input:   input,
+	}
+}
+
+func TestFilterExecute(t *testing.T) {
+	input := filterTestPrimitive()
+	f := filterTestPlan(input)
+	bv := map[string]*querypb.BindVariable{
+		"val": sqltypes.Int64BindVariable(5),
+	}
+
+	r, err := f.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input.ExpectLog(t, []string{
+		`Execute val: type:INT64 value:"5"  true`,
+	})
+	expectResult(t, "f.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c",
+			"int64|int64",
+		),
+		"1|11",
+		"2|null",
+	))
+
+	// StreamExecute
+	input.rewind()
+	r, err = wrapStreamExecute(f, noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "f.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c",
+			"int64|int64",
+		),
+		"1|11",
+		"2|null",
+	))
+
+	// GetFields
+	input.rewind()
+	r, err = f.GetFields(noopVCursor{}, bv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "f.GetFields", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"id|c",
+			"int64|int64",
+		),
+	})
+}
+
+func TestFilterSingleRow(t *testing.T) {
+	// select :__lastInsertId + 1 as a, 'b' as b from dual
+	f := &Filter{
+		Exprs: []Expr{
+			&ArithmeticExpr{
+				Operator: sqlparser.PlusStr,
+				Left:     &ValueExpr{Value: sqltypes.PlanValue{Key: "__lastInsertId"}},
+				Right:    &ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}},
+			},
+			&ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.NewVarChar("b")}},
+		},
+		Aliases: []string{"a", "b"},
+		Input:   &SingleRow{},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"__lastInsertId": sqltypes.Uint64BindVariable(5),
+	}
+	wantFields := []*querypb.Field{
+		{Name: "a", Type: sqltypes.Uint64},
+		{Name: "b", Type: sqltypes.VarChar},
+	}
+	wantRows := [][]sqltypes.Value{{sqltypes.NewUint64(6), sqltypes.NewVarChar("b")}}
+
+	result, err := f.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "f.Execute", result, &sqltypes.Result{Fields: wantFields, Rows: wantRows, RowsAffected: 1})
+
+	result, err = f.Execute(noopVCursor{}, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "f.Execute", result, &sqltypes.Result{Rows: wantRows, RowsAffected: 1})
+
+	result, err = f.GetFields(noopVCursor{}, bv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "f.GetFields", result, &sqltypes.Result{Fields: wantFields})
+}
+
+func TestFilterExecuteErrors(t *testing.T) {
+	input := &fakePrimitive{
+		sendErr: errors.New("input err"),
+	}
+	f := filterTestPlan(input)
+	_, err := f.Execute(noopVCursor{}, nil, true)
+	expectError(t, "f.Execute", err, "input err")
+
+	// Missing bind variable.
+	f = filterTestPlan(filterTestPrimitive())
+	_, err = f.Execute(noopVCursor{}, nil, true)
+	expectError(t, "f.Execute", err, "missing bind var val")
+}
+
+func TestFilterMarshalJSON(t *testing.T) {
+	f := filterTestPlan(nil)
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Opcode":"Filter","Predicate":"([COLUMN 1] is null) or ([COLUMN 1] \u003e :val)","Exprs":["[COLUMN 0]","[COLUMN 1] + 1 as c"],"Input":null}`
+	if got := string(b); got != want {
+		t.Errorf("json.Marshal:\n%s, want\n%s", got, want)
+	}
+}
@@ -0,0 +1,433 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashAggregate)(nil)
+
+// HashAggregate is a primitive that groups the rows of the underlying
+// primitive using an in-memory hash table. Unlike OrderedAggregate,
+// the input does not need to be sorted. This allows it to be used on
+// top of primitives like Join or Subquery that cannot perform any
+// aggregation themselves. The input values are expected to be raw
+// (not pre-aggregated) values, unless Partial is set.
+//
+// The aggregates are computed in place: the output row has the same
+// shape as the input row, and the column of each aggregate is replaced
+// by the aggregated value. Non-aggregate columns that are not keys
+// retain the value of the first row seen for the group.
+type HashAggregate struct {
+	// Aggregates specifies the aggregation parameters for each
+	// aggregation function: function opcode and input column number.
+	// Alias is used as the name of the resulting field.
+	Aggregates []AggregateParams
+
+	// Keys specifies the input values that must be used for
+	// the aggregation key.
+	Keys []int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Partial is set if the input is a join of routes that have
+	// grouped their rows and computed partial aggregates.
+	Partial *PartialAggregates `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// PartialAggregates describes how the partial aggregates of the
+// sides of a join are combined. For example, if the LHS returns
+// 'a, count(*), sum(x) ... group by a' and the RHS returns
+// 'count(*), sum(y)', then a joined row stands for count(lhs)*count(rhs)
+// rows, the sum of x for those rows is sum(x)*count(rhs), and the
+// sum of y is sum(y)*count(lhs). Partial counts and sums are added
+// up after being multiplied, while partial minimums and maximums
+// are combined as is.
+type PartialAggregates struct {
+	// RowCounts are the columns that have the number of rows
+	// grouped by each side of the join. A joined row where one of
+	// them is zero stands for no rows, and is skipped.
+	RowCounts []int
+
+	// Multipliers has, for each aggregate, the row count columns
+	// of the other sides of the join.
+	Multipliers [][]int
+}
+
+// MarshalJSON serializes the HashAggregate into a JSON representation.
+// It's used for testing and diagnostics.
+func (ha *HashAggregate) MarshalJSON() ([]byte, error) {
+	marshalHashAggregate := struct {
+		Opcode              string
+		Aggregates          []AggregateParams
+		Keys                []int
+		TruncateColumnCount int                `json:",omitempty"`
+		Partial             *PartialAggregates `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "HashAggregate",
+		Aggregates:          ha.Aggregates,
+		Keys:                ha.Keys,
+		TruncateColumnCount: ha.TruncateColumnCount,
+		Partial:             ha.Partial,
+		Input:               ha.Input,
+	}
+	return json.Marshal(marshalHashAggregate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (ha *HashAggregate) RouteType() string {
+	return ha.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (ha *HashAggregate) GetKeyspaceName() string {
+	return ha.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (ha *HashAggregate) GetTableName() string {
+	return ha.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (ha *HashAggregate) SetTruncateColumnCount(count int) {
+	ha.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (ha *HashAggregate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := ha.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	groups := newHashGroups(ha)
+	for _, row := range result.Rows {
+		if err := groups.add(row); err != nil {
+			return nil, err
+		}
+		if len(groups.rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	out := &sqltypes.Result{
+		Fields: ha.convertFields(result.Fields),
+		Extras: result.Extras,
+	}
+	out.Rows, err = ha.finish(vcursor, bindVars, groups, len(result.Fields))
+	if err != nil {
+		return nil, err
+	}
+	out.RowsAffected = uint64(len(out.Rows))
+	return out.Truncate(ha.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+// The rows can only be returned after the entire input has been
+// consumed. So, the results are accumulated and sent at the end.
+func (ha *HashAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(ha.TruncateColumnCount))
+	}
+
+	groups := newHashGroups(ha)
+	var fields []*querypb.Field
+	err := ha.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+			if err := cb(&sqltypes.Result{Fields: ha.convertFields(fields)}); err != nil {
+				return err
+			}
+		}
+		for _, row := range qr.Rows {
+			if err := groups.add(row); err != nil {
+				return err
+			}
+		}
+		if len(groups.rows) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	rows, err := ha.finish(vcursor, bindVars, groups, len(fields))
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// GetFields is a Primitive function.
+func (ha *HashAggregate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ha.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: ha.convertFields(qr.Fields)}
+	return qr.Truncate(ha.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this aggregation
+func (ha *HashAggregate) Inputs() []Primitive {
+	return []Primitive{ha.Input}
+}
+
+// finish returns the aggregated rows. If there are no grouping keys
+// and there were no input rows, a single row with the zero values
+// of the aggregates is returned, as MySQL would do.
+func (ha *HashAggregate) finish(vcursor VCursor, bindVars map[string]*querypb.BindVariable, groups *hashGroups, width int) ([][]sqltypes.Value, error) {
+	if len(groups.rows) != 0 || len(ha.Keys) != 0 {
+		return groups.rows, nil
+	}
+	if width == 0 {
+		// The input did not return fields. We need them to
+		// know the shape of the row.
+		qr, err := ha.Input.GetFields(vcursor, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		width = len(qr.Fields)
+	}
+	row := make([]sqltypes.Value, width)
+	for _, aggr := range ha.Aggregates {
+		value, err := createEmptyValueFor(aggr.Opcode)
+		if err != nil {
+			return nil, err
+		}
+		row[aggr.Col] = value
+	}
+	return [][]sqltypes.Value{row}, nil
+}
+
+func (ha *HashAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	out := make([]*querypb.Field, len(fields))
+	copy(out, fields)
+	for _, aggr := range ha.Aggregates {
+		typ := fields[aggr.Col].Type
+		switch aggr.Opcode {
+		case AggregateCount, AggregateCountDistinct:
+			typ = sqltypes.Int64
+		case AggregateSum, AggregateSumDistinct:
+			typ = sqltypes.Decimal
+		}
+		out[aggr.Col] = &querypb.Field{
+			Name: aggr.Alias,
+			Type: typ,
+		}
+	}
+	return out
+}
+
+// hashGroups accumulates the aggregated rows by key.
+type hashGroups struct {
+	ha   *HashAggregate
+	rows [][]sqltypes.Value
+	// index maps the hash key to the row number.
+	index map[string]int
+	// distincts tracks the values seen by each distinct
+	// aggregate of each group. It's indexed by row number and then
+	// by aggregate number.
+	distincts []map[int]map[string]bool
+}
+
+func newHashGroups(ha *HashAggregate) *hashGroups {
+	return &hashGroups{
+		ha:    ha,
+		index: make(map[string]int),
+	}
+}
+
+func (hg *hashGroups) add(row []sqltypes.Value) error {
+	if hg.ha.Partial != nil {
+		empty, err := hg.ha.Partial.isEmpty(row)
+		if err != nil || empty {
+			return err
+		}
+	}
+	key := hashKey(row, hg.ha.Keys)
+	num, ok := hg.index[key]
+	if !ok {
+		newRow, err := hg.convertRow(row)
+		if err != nil {
+			return err
+		}
+		hg.index[key] = len(hg.rows)
+		hg.rows = append(hg.rows, newRow)
+		return nil
+	}
+	current := hg.rows[num]
+	for i, aggr := range hg.ha.Aggregates {
+		val, err := hg.value(row, i)
+		if err != nil {
+			return err
+		}
+		if val.IsNull() {
+			continue
+		}
+		if aggr.isDistinct() {
+			seen := hg.distincts[num][i]
+			dkey := hashKey(row, []int{aggr.Col})
+			if seen[dkey] {
+				continue
+			}
+			seen[dkey] = true
+		}
+		switch aggr.Opcode {
+		case AggregateCount, AggregateCountDistinct:
+			if hg.ha.Partial == nil {
+				val = countOne
+			}
+			current[aggr.Col] = sqltypes.NullsafeAdd(current[aggr.Col], val, sqltypes.Int64)
+		case AggregateSum, AggregateSumDistinct:
+			current[aggr.Col] = sqltypes.NullsafeAdd(current[aggr.Col], val, sqltypes.Decimal)
+		case AggregateMin:
+			current[aggr.Col], err = sqltypes.Min(current[aggr.Col], val)
+		case AggregateMax:
+			current[aggr.Col], err = sqltypes.Max(current[aggr.Col], val)
+		default:
+			return fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertRow converts the first input row of a group into
+// its initial aggregated form.
+func (hg *hashGroups) convertRow(row []sqltypes.Value) ([]sqltypes.Value, error) {
+	newRow := sqltypes.CopyRow(row)
+	distincts := make(map[int]map[string]bool)
+	for i, aggr := range hg.ha.Aggregates {
+		val, err := hg.value(row, i)
+		if err != nil {
+			return nil, err
+		}
+		switch aggr.Opcode {
+		case AggregateCount, AggregateCountDistinct:
+			switch {
+			case val.IsNull():
+				newRow[aggr.Col] = countZero
+			case hg.ha.Partial != nil:
+				newRow[aggr.Col], err = sqltypes.Cast(val, sqltypes.Int64)
+			default:
+				newRow[aggr.Col] = countOne
+			}
+		case AggregateSum, AggregateSumDistinct:
+			if !val.IsNull() {
+				newRow[aggr.Col], err = sqltypes.Cast(val, sqltypes.Decimal)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if aggr.isDistinct() {
+			distincts[i] = make(map[string]bool)
+			if !val.IsNull() {
+				distincts[i][hashKey(row, []int{aggr.Col})] = true
+			}
+		}
+	}
+	hg.distincts = append(hg.distincts, distincts)
+	return newRow, nil
+}
+
+// value returns the input value of the i'th aggregate. For a partial
+// aggregate, it's multiplied by the row counts of the other sides of
+// the join. A NULL row count comes from the unmatched side of an outer
+// join, which stands for a single row.
+func (hg *hashGroups) value(row []sqltypes.Value, i int) (sqltypes.Value, error) {
+	aggr := hg.ha.Aggregates[i]
+	val := row[aggr.Col]
+	if hg.ha.Partial == nil || val.IsNull() {
+		return val, nil
+	}
+	switch aggr.Opcode {
+	case AggregateMin, AggregateMax:
+		return val, nil
+	}
+	for _, col := range hg.ha.Partial.Multipliers[i] {
+		if row[col].IsNull() {
+			continue
+		}
+		var err error
+		val, err = sqltypes.Multiply(val, row[col])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	return val, nil
+}
+
+// isEmpty returns true if one of the sides of the joined row
+// stands for no rows.
+func (pa *PartialAggregates) isEmpty(row []sqltypes.Value) (bool, error) {
+	for _, col := range pa.RowCounts {
+		if row[col].IsNull() {
+			continue
+		}
+		count, err := sqltypes.ToInt64(row[col])
+		if err != nil {
+			return false, err
+		}
+		if count == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hashKey builds a key that uniquely identifies the values
+// of the specified columns. NULLs are treated as equal to
+// each other, which matches the MySQL GROUP BY behavior.
+func hashKey(row []sqltypes.Value, cols []int) string {
+	var buf bytes.Buffer
+	for _, col := range cols {
+		if row[col].IsNull() {
+			buf.WriteString("N;")
+			continue
+		}
+		raw := row[col].ToBytes()
+		buf.WriteString(strconv.Itoa(len(raw)))
+		buf.WriteByte(':')
+		buf.Write(raw)
+	}
+	return buf.String()
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestHashAggregateExecute(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|id|val",
+				"varbinary|int64|int64",
+			),
+			"b|1|10",
+			"a|2|20",
+			"b|null|30",
+			"a|3|null",
+			"c|4|5",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+			Alias:  "count(id)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+			Alias:  "sum(val)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(id)|sum(val)",
+			"varbinary|int64|decimal",
+		),
+		"b|1|40",
+		"a|2|20",
+		"c|1|5",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateStreamExecute(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|val",
+				"varbinary|int64",
+			),
+			"a|3",
+			"b|2",
+			"a|1",
+			"b|7",
+			"a|2",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateMin,
+			Col:    1,
+			Alias:  "min(val)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := wrapStreamExecute(ha, noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|min(val)",
+			"varbinary|int64",
+		),
+		"a|1",
+		"b|2",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|c|s",
+				"varbinary|int64|int64",
+			),
+			"a|1|1",
+			"a|1|1",
+			"a|2|2",
+			"b|null|null",
+			"b|3|3",
+			"b|3|4",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct c)",
+		}, {
+			Opcode: AggregateSumDistinct,
+			Col:    2,
+			Alias:  "sum(distinct s)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct c)|sum(distinct s)",
+			"varbinary|int64|decimal",
+		),
+		"a|2|3",
+		"b|1|7",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateNoKeysNoRows(t *testing.T) {
+	assert := assert.New(t)
+	fields := sqltypes.MakeTestFields(
+		"col|val",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+			Alias:  "count(*)",
+		}},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, true)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(*)",
+			"varbinary|int64",
+		),
+		"null|0",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateTruncate(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|val|weight_string(col)",
+				"varchar|int64|varbinary",
+			),
+			"a|1|A",
+			"A|1|A",
+			"b|2|B",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateMax,
+			Col:    1,
+			Alias:  "max(val)",
+		}},
+		Keys:                []int{2},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|max(val)",
+			"varchar|int64",
+		),
+		"a|1",
+		"b|2",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregatePartial(t *testing.T) {
+	assert := assert.New(t)
+	// The input is 'u.col, count(*), sum(u.val)' grouped by the LHS
+	// joined with 'count(*), max(ue.val)' grouped by the RHS.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|count(*)|sum(val)|count(*)|max(val)",
+				"varbinary|int64|decimal|int64|int64",
+			),
+			"a|2|10|3|7",
+			"a|1|5|2|9",
+			"b|4|8|0|null",
+			"c|1|null|2|1",
+			"d|1|4|null|null",
+		)},
+	}
+
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+			Alias:  "count(*)",
+		}, {
+			Opcode: AggregateSum,
+			Col:    2,
+			Alias:  "sum(u.val)",
+		}, {
+			Opcode: AggregateMax,
+			Col:    4,
+			Alias:  "max(ue.val)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 4,
+		Partial: &PartialAggregates{
+			RowCounts:   []int{1, 3},
+			Multipliers: [][]int{{3}, {3}, nil},
+		},
+		Input: fp,
+	}
+
+	result, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(*)|sum(u.val)|count(*)",
+			"varbinary|int64|decimal|int64",
+		),
+		"a|8|40|3",
+		"c|2|null|2",
+		"d|1|4|null",
+	)
+	assert.Equal(wantResult, result)
+}
+
+func TestHashAggregateSumError(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|val",
+				"varbinary|varchar",
+			),
+			"a|abc",
+		)},
+	}
+	ha := &HashAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    1,
+			Alias:  "sum(val)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+	_, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.Error(t, err)
+}
+
+func TestHashAggregateInputError(t *testing.T) {
+	fp := &fakePrimitive{
+		sendErr: errors.New("input fail"),
+	}
+	ha := &HashAggregate{
+		Keys:  []int{0},
+		Input: fp,
+	}
+	_, err := ha.Execute(noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "input fail")
+
+	_, err = wrapStreamExecute(ha, noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "input fail")
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every row of the
+// LHS, HashJoin collects the distinct join key values of the LHS
+// and sends them to the RHS as a single list bind variable. The
+// rows returned by the RHS are then hashed by their join key and
+// matched against the LHS rows.
+//
+// The RHS rows are matched by the weight strings of the join keys
+// if they are text columns. Otherwise, numbers are matched by value
+// regardless of their type, and a text value matches a number if it
+// converts to it, like MySQL does when it compares them.
+//
+// The RHS is streamed into the hash table. As soon as it returns more
+// rows than the allowed in-memory limit, the primitive falls back to a
+// nested loop join, which sends one key value at a time to the RHS.
+// This is also the case inside a transaction, because the streaming
+// queries are not executed as part of it.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It follows the same convention
+	// as Join.
+	Cols []int `json:",omitempty"`
+
+	// LHSKey and RHSKey are the column numbers of the
+	// join key in the LHS and RHS results.
+	LHSKey, RHSKey int
+
+	// LHSWeightString and RHSWeightString are the column numbers
+	// of the weight_string of the join keys, if they are text columns.
+	// They're 0 otherwise.
+	LHSWeightString int `json:",omitempty"`
+	RHSWeightString int `json:",omitempty"`
+
+	// ListVar is the name of the bind variable that the
+	// RHS uses for the list of LHS key values.
+	ListVar string
+}
+
+// MarshalJSON serializes the HashJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (hj *HashJoin) MarshalJSON() ([]byte, error) {
+	opcode := "HashJoin"
+	if hj.Opcode == LeftJoin {
+		opcode = "HashLeftJoin"
+	}
+	marshalHashJoin := struct {
+		Opcode          string
+		Left, Right     Primitive `json:",omitempty"`
+		Cols            []int     `json:",omitempty"`
+		LHSKey          int
+		RHSKey          int
+		LHSWeightString int `json:",omitempty"`
+		RHSWeightString int `json:",omitempty"`
+		ListVar         string
+	}{
+		Opcode:          opcode,
+		Left:            hj.Left,
+		Right:           hj.Right,
+		Cols:            hj.Cols,
+		LHSKey:          hj.LHSKey,
+		RHSKey:          hj.RHSKey,
+		LHSWeightString: hj.LHSWeightString,
+		RHSWeightString: hj.RHSWeightString,
+		ListVar:         hj.ListVar,
+	}
+	return json.Marshal(marshalHashJoin)
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return hj.join(vcursor, bindVars, lresult, wantfields)
+}
+
+// StreamExecute performs a streaming exec.
+// The LHS is streamed, and every batch of LHS rows is joined
+// with the RHS using a single RHS query.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	err := hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if len(lresult.Fields) != 0 {
+			lfields = lresult.Fields
+		}
+		if len(lresult.Rows) == 0 && !wantfields {
+			return nil
+		}
+		result, err := hj.join(vcursor, bindVars, &sqltypes.Result{Fields: lfields, Rows: lresult.Rows}, wantfields)
+		if err != nil {
+			return err
+		}
+		wantfields = false
+		return callback(result)
+	})
+	return err
+}
+
+// join joins the rows of lresult with the matching rows of the RHS.
+func (hj *HashJoin) join(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lresult *sqltypes.Result, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	keys := distinctKeys(lresult.Rows, hj.LHSKey, hj.LHSWeightString)
+	if len(keys) == 0 {
+		if wantfields {
+			if err := hj.joinFields(vcursor, bindVars, lresult, result); err != nil {
+				return nil, err
+			}
+		}
+		if hj.Opcode == LeftJoin {
+			for _, lrow := range lresult.Rows {
+				result.Rows = append(result.Rows, joinRows(lrow, nil, hj.Cols))
+			}
+			result.RowsAffected = uint64(len(result.Rows))
+		}
+		return result, nil
+	}
+
+	if vcursor.InTransaction() {
+		if wantfields {
+			if err := hj.joinFields(vcursor, bindVars, lresult, result); err != nil {
+				return nil, err
+			}
+		}
+		return hj.nestedLoop(vcursor, bindVars, lresult, result)
+	}
+
+	table := make(map[string][][]sqltypes.Value)
+	var rfields []*querypb.Field
+	numRows := 0
+	tooManyRows := false
+	err := hj.Right.StreamExecute(vcursor, combineVars(bindVars, listBindVar(hj.ListVar, keys)), wantfields, func(rresult *sqltypes.Result) error {
+		if len(rresult.Fields) != 0 {
+			rfields = rresult.Fields
+		}
+		numRows += len(rresult.Rows)
+		if numRows > vcursor.MaxMemoryRows() {
+			tooManyRows = true
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		for _, rrow := range rresult.Rows {
+			for _, key := range joinKeys(rrow, hj.RHSKey, hj.RHSWeightString, false) {
+				table[key] = append(table[key], rrow)
+			}
+		}
+		return nil
+	})
+	if wantfields {
+		if rfields != nil {
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		} else if tooManyRows {
+			if err := hj.joinFields(vcursor, bindVars, lresult, result); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if tooManyRows {
+		return hj.nestedLoop(vcursor, bindVars, lresult, result)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lrow := range lresult.Rows {
+		var matches [][]sqltypes.Value
+		for _, key := range joinKeys(lrow, hj.LHSKey, hj.LHSWeightString, true) {
+			matches = append(matches, table[key]...)
+		}
+		if err := hj.appendRows(vcursor, result, lrow, matches); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// joinFields sets the fields of the joined result.
+func (hj *HashJoin) joinFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lresult, result *sqltypes.Result) error {
+	rresult, err := hj.Right.GetFields(vcursor, combineVars(bindVars, listBindVar(hj.ListVar, nil)))
+	if err != nil {
+		return err
+	}
+	result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	return nil
+}
+
+// nestedLoop is the fallback used if the RHS rows cannot be held in
+// memory. The RHS is executed once for every LHS row, with the list
+// bind variable containing only the key of that row.
+func (hj *HashJoin) nestedLoop(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lresult, result *sqltypes.Result) (*sqltypes.Result, error) {
+	for _, lrow := range lresult.Rows {
+		var matches [][]sqltypes.Value
+		if key := lrow[hj.LHSKey]; !key.IsNull() {
+			rresult, err := hj.Right.Execute(vcursor, combineVars(bindVars, listBindVar(hj.ListVar, []sqltypes.Value{key})), false)
+			if err != nil {
+				return nil, err
+			}
+			matches = rresult.Rows
+		}
+		if err := hj.appendRows(vcursor, result, lrow, matches); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (hj *HashJoin) appendRows(vcursor VCursor, result *sqltypes.Result, lrow []sqltypes.Value, matches [][]sqltypes.Value) error {
+	for _, rrow := range matches {
+		result.Rows = append(result.Rows, joinRows(lrow, rrow, hj.Cols))
+	}
+	if hj.Opcode == LeftJoin && len(matches) == 0 {
+		result.Rows = append(result.Rows, joinRows(lrow, nil, hj.Cols))
+		result.RowsAffected++
+	} else {
+		result.RowsAffected += uint64(len(matches))
+	}
+	if len(result.Rows) > vcursor.MaxMemoryRows() {
+		return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return nil
+}
+
+// distinctKeys returns the distinct non-null key values of the rows.
+func distinctKeys(rows [][]sqltypes.Value, keyCol, weightCol int) []sqltypes.Value {
+	var keys []sqltypes.Value
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		if row[keyCol].IsNull() {
+			continue
+		}
+		key := joinKeys(row, keyCol, weightCol, false)[0]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, row[keyCol])
+	}
+	return keys
+}
+
+// listBindVar returns the list bind variable that contains the keys.
+func listBindVar(name string, keys []sqltypes.Value) map[string]*querypb.BindVariable {
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	if len(keys) == 0 {
+		// An empty list is not valid SQL. The NULL will
+		// not match anything.
+		bv.Values = append(bv.Values, sqltypes.ValueToProto(sqltypes.NULL))
+	}
+	for _, key := range keys {
+		bv.Values = append(bv.Values, sqltypes.ValueToProto(key))
+	}
+	return map[string]*querypb.BindVariable{name: bv}
+}
+
+// joinKeys returns the keys under which the join value of a row is
+// matched. The keys are prefixed by their kind:
+// n: the normalized value of a number.
+// s: the weight string of a text value, or its bytes if it has none.
+// t: the normalized number that a text value converts to.
+// The RHS rows are stored under their 'n', or 's' and 't' keys. If
+// probe is set, the keys to look up for an LHS row are returned
+// instead: a number matches the 'n' and 't' keys, and a text value
+// matches the 's' keys, and the 'n' keys of the number it converts to.
+func joinKeys(row []sqltypes.Value, keyCol, weightCol int, probe bool) []string {
+	val := row[keyCol]
+	if val.IsNull() {
+		return nil
+	}
+	if val.IsIntegral() || val.IsFloat() || val.Type() == sqltypes.Decimal {
+		num := normalizeNumber(val)
+		if probe {
+			return []string{"n" + num, "t" + num}
+		}
+		return []string{"n" + num}
+	}
+	text := val
+	if weightCol != 0 {
+		text = row[weightCol]
+	}
+	keys := []string{"s" + text.ToString()}
+	if f, err := strconv.ParseFloat(strings.TrimSpace(val.ToString()), 64); err == nil {
+		num := normalizeFloat(f)
+		if probe {
+			keys = append(keys, "n"+num)
+		} else {
+			keys = append(keys, "t"+num)
+		}
+	}
+	return keys
+}
+
+// normalizeNumber returns the same string for numbers
+// of different types that have the same value.
+func normalizeNumber(val sqltypes.Value) string {
+	if val.IsIntegral() {
+		return val.ToString()
+	}
+	f, err := sqltypes.ToFloat64(val)
+	if err != nil {
+		return val.ToString()
+	}
+	return normalizeFloat(f)
+}
+
+func normalizeFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, combineVars(bindVars, listBindVar(hj.ListVar, nil)))
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
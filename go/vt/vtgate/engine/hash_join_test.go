@@ -0,0 +1,450 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func hashJoinTestPrimitives() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|a",
+				"4|null",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"varchar|int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"a|10",
+				"a|11",
+				"c|12",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinTestPrimitives()
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __hj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  true`,
+	})
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|int64",
+		),
+		"1|a|10",
+		"1|a|11",
+		"3|a|10",
+		"3|a|11",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|int64",
+		),
+		"1|a|10",
+		"1|a|11",
+		"2|b|null",
+		"3|a|10",
+		"3|a|11",
+		"4|null|null",
+	))
+}
+
+func TestHashJoinExecuteNoLHSRows(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"varchar|int64",
+				),
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields __hj1: type:TUPLE values:<> `,
+		`Execute __hj1: type:TUPLE values:<>  true`,
+	})
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|int64",
+		),
+	})
+}
+
+func TestHashJoinNestedLoopFallback(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim, rightPrim := hashJoinTestPrimitives()
+	rightFields := rightPrim.results[0].Fields
+	rightPrim.results = append(rightPrim.results,
+		sqltypes.MakeTestResult(rightFields, "a|10"),
+		sqltypes.MakeTestResult(rightFields),
+		sqltypes.MakeTestResult(rightFields, "a|10"),
+	)
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The RHS is abandoned as soon as it returns more than two rows.
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __hj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  false`,
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|int64",
+		),
+		"1|10",
+		"3|10",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.Execute", r, want)
+}
+
+// txVCursor is a vcursor whose session is in a transaction.
+type txVCursor struct {
+	noopVCursor
+}
+
+func (txVCursor) InTransaction() bool {
+	return true
+}
+
+func TestHashJoinInTransaction(t *testing.T) {
+	leftPrim, rightPrim := hashJoinTestPrimitives()
+	rightFields := rightPrim.results[0].Fields
+	rightPrim.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(rightFields, "a|10"),
+		sqltypes.MakeTestResult(rightFields),
+		sqltypes.MakeTestResult(rightFields, "a|10"),
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := hj.Execute(txVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The streaming queries don't run in the transaction.
+	// So, the RHS is executed for every LHS row.
+	rightPrim.ExpectLog(t, []string{
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+		`Execute __hj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|int64",
+		),
+		"1|10",
+		"3|10",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.Execute", r, want)
+}
+
+func TestHashJoinWeightString(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|weight_string(col2)",
+					"int64|varchar|varbinary",
+				),
+				"1|a|A",
+				"2|A|A",
+				"3|b|B",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4|weight_string(col3)",
+					"varchar|int64|varbinary",
+				),
+				"a|10|A",
+				"A|11|A",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:          NormalJoin,
+		Left:            leftPrim,
+		Right:           rightPrim,
+		Cols:            []int{-1, 2},
+		LHSKey:          1,
+		RHSKey:          0,
+		LHSWeightString: 2,
+		RHSWeightString: 2,
+		ListVar:         "__hj1",
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 'a' and 'A' are the same key.
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __hj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|int64",
+		),
+		"1|10",
+		"1|11",
+		"2|10",
+		"2|11",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.Execute", r, want)
+}
+
+func TestHashJoinTypeCoercion(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|3",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"decimal|varchar",
+				),
+				"1.00|x",
+				"2.5|y",
+			),
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"varchar|varchar",
+				),
+				"01|x",
+				"3|y",
+				"c|z",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"varchar|varchar",
+		),
+		"a|x",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.Execute", r, want)
+
+	// Text values that convert to the LHS numbers match them.
+	leftPrim.rewind()
+	r, err = hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"varchar|varchar",
+		),
+		"a|x",
+		"c|y",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.Execute", r, want)
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinTestPrimitives()
+	rightFields := rightPrim.results[0].Fields
+	// The fake primitive streams two rows at a time. So,
+	// the RHS gets executed once for each batch.
+	rightPrim.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(rightFields, "a|10", "b|20"),
+		sqltypes.MakeTestResult(rightFields, "a|10"),
+	}
+	hj := &HashJoin{
+		Opcode:  NormalJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		RHSKey:  0,
+		ListVar: "__hj1",
+	}
+	r, err := wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __hj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  false`,
+		`StreamExecute __hj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|int64",
+		),
+		"1|10",
+		"2|20",
+		"3|10",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.StreamExecute", r, want)
+}
+
+func TestHashJoinExecuteErrors(t *testing.T) {
+	// Error on left query
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left: &fakePrimitive{
+			sendErr: errors.New("left err"),
+		},
+	}
+	_, err := hj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "hj.Execute", err, "left err")
+
+	// Error on right query
+	leftPrim, _ := hashJoinTestPrimitives()
+	hj = &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right: &fakePrimitive{
+			sendErr: errors.New("right err"),
+		},
+		Cols:    []int{-1, 2},
+		LHSKey:  1,
+		ListVar: "__hj1",
+	}
+	_, err = hj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "hj.Execute", err, "right err")
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -38,6 +39,10 @@ import (
 
 var _ Primitive = (*Insert)(nil)
 
+// insertSelectBatchSize is the maximum number of rows sent
+// to the shards in one round-trip by INSERT ... SELECT.
+var insertSelectBatchSize = 500
+
 // Insert represents the instructions to perform an insert operation.
 type Insert struct {
 	// Opcode is the execution opcode.
@@ -81,8 +86,38 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// Input is only set for INSERT ... SELECT into a sharded table.
+	// It produces the rows to be inserted. For such plans, VindexValues
+	// and Mid are unused, and VindexValueOffset is used instead.
+	Input Primitive
+
+	// VindexValueOffset specifies the offsets of the vindex columns
+	// in the rows produced by Input. It is indexed like VindexValues:
+	// Insert.VindexValueOffset[i][j] is the offset of the j'th column
+	// of the i'th colVindex.
+	VindexValueOffset [][]int
+
+	// OwnedVindexQuery is used to read the existing rows that the
+	// insert replaces or updates, for maintaining the owned vindexes.
+	// It's only set for REPLACE and ON DUPLICATE KEY UPDATE statements
+	// on tables with owned vindexes. The existing rows are the ones
+	// that conflict with the inserted rows on a unique key. The query
+	// returns a conflict flag for every inserted row, followed by the
+	// primary vindex column and the owned vindex columns.
+	OwnedVindexQuery string
+
+	// KsidVindex is the primary vindex of the table. It is used to
+	// compute the keyspace ids of the rows read by OwnedVindexQuery.
+	KsidVindex vindexes.SingleColumn
+
+	// ChangedVindexColumns contains the owned vindex columns
+	// that are changed by an ON DUPLICATE KEY UPDATE clause.
+	ChangedVindexColumns []string
+
+	// TargetDestination is only set for InsertUnsharded plans
+	// into a temporary table of a sharded keyspace. It specifies
+	// the shard that holds the table.
+	TargetDestination key.Destination
 }
 
 // NewQueryInsert creates an Insert with a query string.
@@ -119,10 +154,17 @@ func NewInsert(opcode InsertOpcode, keyspace *vindexes.Keyspace, vindexValues []
 // MarshalJSON serializes the Insert into a JSON representation.
 // It's used for testing and diagnostics.
 func (ins *Insert) MarshalJSON() ([]byte, error) {
-	var tname string
+	var tname, ksidVindexName string
 	if ins.Table != nil {
 		tname = ins.Table.Name.String()
 	}
+	if ins.KsidVindex != nil {
+		ksidVindexName = ins.KsidVindex.String()
+	}
+	var targetDestination string
+	if ins.TargetDestination != nil {
+		targetDestination = ins.TargetDestination.String()
+	}
 	marshalInsert := struct {
 		Opcode               InsertOpcode
 		Keyspace             *vindexes.Keyspace   `json:",omitempty"`
@@ -135,6 +177,12 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Suffix               string               `json:",omitempty"`
 		MultiShardAutocommit bool                 `json:",omitempty"`
 		QueryTimeout         int                  `json:",omitempty"`
+		VindexValueOffset    [][]int              `json:",omitempty"`
+		OwnedVindexQuery     string               `json:",omitempty"`
+		KsidVindex           string               `json:",omitempty"`
+		ChangedVindexColumns []string             `json:",omitempty"`
+		TargetDestination    string               `json:",omitempty"`
+		Input                Primitive            `json:",omitempty"`
 	}{
 		Opcode:               ins.Opcode,
 		Keyspace:             ins.Keyspace,
@@ -147,6 +195,12 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Suffix:               ins.Suffix,
 		MultiShardAutocommit: ins.MultiShardAutocommit,
 		QueryTimeout:         ins.QueryTimeout,
+		VindexValueOffset:    ins.VindexValueOffset,
+		OwnedVindexQuery:     ins.OwnedVindexQuery,
+		KsidVindex:           ksidVindexName,
+		ChangedVindexColumns: ins.ChangedVindexColumns,
+		TargetDestination:    targetDestination,
+		Input:                ins.Input,
 	}
 	return jsonutil.MarshalNoEscape(marshalInsert)
 }
@@ -161,6 +215,11 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is the offset of the column in the rows produced
+	// by the Input of an INSERT ... SELECT. It's used instead
+	// of Values for such plans. If the offset is past the end
+	// of the rows, all values for the column are generated.
+	Offset int `json:",omitempty"`
 }
 
 // InsertOpcode is a number representing the opcode
@@ -179,12 +238,17 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertShardedReplace is for REPLACE constructs.
+	// The owned vindex entries of the replaced rows are
+	// deleted before the new ones are created.
+	InsertShardedReplace
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertShardedReplace: "InsertShardedReplace",
 }
 
 // MarshalJSON serializes the InsertOpcode as a JSON string.
@@ -221,7 +285,10 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	switch ins.Opcode {
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
-	case InsertSharded, InsertShardedIgnore:
+	case InsertSharded, InsertShardedIgnore, InsertShardedReplace:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -234,6 +301,14 @@ func (ins *Insert) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.B
 	return fmt.Errorf("query %q cannot be used for streaming", ins.Query)
 }
 
+// Inputs returns the input to the insert, if any.
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 // GetFields fetches the field info.
 func (ins *Insert) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for %q", ins.Query)
@@ -245,7 +320,11 @@ func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*que
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
 	}
 
-	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	var dest key.Destination = key.DestinationAllShards{}
+	if ins.TargetDestination != nil {
+		dest = ins.TargetDestination
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{dest})
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
 	}
@@ -289,6 +368,59 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// execInsertSelect executes the Input, and inserts the rows it produced
+// into the shards they map to. The rows are sent in batches of at most
+// insertSelectBatchSize rows.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	selResult, err := ins.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	rows := selResult.Rows
+	result := &sqltypes.Result{}
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	insertID, err := ins.processGenerateRows(vcursor, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+
+	// A single round-trip autocommit is possible only if all
+	// the rows fit in one batch.
+	singleBatch := len(rows) <= insertSelectBatchSize
+	for start := 0; start < len(rows); start += insertSelectBatchSize {
+		end := start + insertSelectBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		rss, queries, err := ins.getInsertSelectRoute(vcursor, bindVars, rows[start:end])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+		if len(rss) == 0 {
+			// InsertShardedIgnore: all the rows of the batch were skipped.
+			continue
+		}
+
+		autocommit := singleBatch && (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+		qr, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
+		if errs != nil {
+			return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertSelect")
+		}
+		result.RowsAffected += qr.RowsAffected
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -303,8 +435,54 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return 0, vterrors.Wrap(err, "processGenerate")
 	}
+	insertID, err = ins.generateValues(vcursor, resolved)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range resolved {
+		bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
+	}
+	return insertID, nil
+}
+
+// processGenerateRows is the INSERT ... SELECT counterpart of processGenerate.
+// The values are taken from the rows at the Generate offset, and the generated
+// ones are stored back into the rows.
+func (ins *Insert) processGenerateRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+
+	offset := ins.Generate.Offset
+	values := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		if offset < len(row) {
+			values[i] = row[offset]
+		}
+	}
+	insertID, err = ins.generateValues(vcursor, values)
+	if err != nil {
+		return 0, err
+	}
+	for i, row := range rows {
+		if offset < len(row) {
+			row[offset] = values[i]
+			continue
+		}
+		if offset != len(row) {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: auto-inc column offset %d is out of range for row %v", offset, row)
+		}
+		rows[i] = append(row, values[i])
+	}
+	return insertID, nil
+}
+
+// generateValues fetches new values from the sequence, and fills
+// the NULL values with them. It returns the first generated value,
+// or 0 if none was generated.
+func (ins *Insert) generateValues(vcursor VCursor, values []sqltypes.Value) (insertID int64, err error) {
 	count := int64(0)
-	for _, val := range resolved {
+	for _, val := range values {
 		if val.IsNull() {
 			count++
 		}
@@ -334,12 +512,10 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 
 	// Fill the holes where no value was supplied.
 	cur := insertID
-	for i, v := range resolved {
+	for i, v := range values {
 		if v.IsNull() {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
+			values[i] = sqltypes.NewInt64(cur)
 			cur++
-		} else {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
 		}
 	}
 	return insertID, nil
@@ -390,28 +566,11 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	// The output from the following 'process' functions is a list of
-	// keyspace ids. For regular inserts, a failure to find a route
-	// results in an error. For 'ignore' type inserts, the keyspace
-	// id is returned as nil, which is used later to drop the corresponding rows.
-	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	keyspaceIDs, err := ins.processVindexes(vcursor, bindVars, vindexRowsValues)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
 
-	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
-		colVindex := ins.Table.ColumnVindexes[vIdx]
-		var err error
-		if colVindex.Owned {
-			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		} else {
-			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		}
-		if err != nil {
-			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
-		}
-	}
-
 	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
 	// we're executing an insert ignore.
 	for vIdx, colVindex := range ins.Table.ColumnVindexes {
@@ -427,6 +586,117 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
+	rss, queries, err := ins.buildShardQueries(vcursor, keyspaceIDs, ins.Mid, bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+	return rss, queries, nil
+}
+
+// getInsertSelectRoute is the INSERT ... SELECT counterpart of
+// getInsertShardedRoute. It performs the same vindex related work
+// for the specified rows, and returns a map of shard to queries.
+// The values of each row are supplied to the queries as bind variables.
+func (ins *Insert) getInsertSelectRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	// Build the values of all vindex columns. The 3-d structure
+	// indexes are colVindex, row, col, as in getInsertShardedRoute.
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		if len(offsets) != len(ins.Table.ColumnVindexes[vIdx].Columns) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column offsets don't match vschema: %v %v", offsets, ins.Table.ColumnVindexes[vIdx].Columns)
+		}
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			for _, offset := range offsets {
+				if offset >= len(row) {
+					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: vindex column offset %d is out of range for row %v", offset, row)
+				}
+				vindexRowsValues[vIdx][rowNum] = append(vindexRowsValues[vIdx][rowNum], row[offset])
+			}
+		}
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, bindVars, vindexRowsValues)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertSelectRoute")
+	}
+
+	// Reverse mapping could have supplied values for unowned
+	// vindex columns. Store them back into the rows.
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
+			for colIdx, vindexKey := range rowColumnKeys {
+				rows[rowNum][offsets[colIdx]] = vindexKey
+			}
+		}
+	}
+
+	// Build the bind variables and the value tuple of every row.
+	// Skip rows with nil keyspace ids in case we're executing
+	// an insert ignore.
+	rowVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(rows)*len(rows[0]))
+	for k, v := range bindVars {
+		rowVars[k] = v
+	}
+	mids := make([]string, len(rows))
+	for rowNum, row := range rows {
+		if keyspaceIDs[rowNum] == nil {
+			continue
+		}
+		names := make([]string, len(row))
+		for colNum, val := range row {
+			name := insertSelectVarName(rowNum, colNum)
+			rowVars[name] = sqltypes.ValueBindVariable(val)
+			names[colNum] = ":" + name
+		}
+		mids[rowNum] = "(" + strings.Join(names, ", ") + ")"
+	}
+
+	rss, queries, err := ins.buildShardQueries(vcursor, keyspaceIDs, mids, rowVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertSelectRoute")
+	}
+	return rss, queries, nil
+}
+
+// processVindexes maps the vindex values of the rows to keyspace ids,
+// and performs the work required for the other vindexes of the table.
+// For regular inserts, a failure to find a route results in an error.
+// For 'ignore' type inserts, the keyspace id is returned as nil, which
+// is used later to drop the corresponding rows.
+func (ins *Insert) processVindexes(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value) ([][]byte, error) {
+	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []bool
+	if ins.OwnedVindexQuery != "" {
+		updated, err = ins.processExisting(vcursor, bindVars, vindexRowsValues, keyspaceIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
+		colVindex := ins.Table.ColumnVindexes[vIdx]
+		var err error
+		if colVindex.Owned {
+			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs, updated)
+		} else {
+			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyspaceIDs, nil
+}
+
+// buildShardQueries resolves the keyspace ids to shards, and builds
+// the query for each shard from the Mids of the rows it receives.
+// Rows with nil keyspace ids are skipped.
+func (ins *Insert) buildShardQueries(vcursor VCursor, keyspaceIDs [][]byte, mids []string, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 	// We need to know the keyspace ids and the Mids associated with
 	// each RSS.  So we pass the ksid indexes in as ids, and get them back
 	// as values. We also skip nil KeyspaceIds, no need to resolve them.
@@ -448,21 +718,21 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 
 	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, nil, err
 	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
 		var ksids [][]byte
-		var mids []string
+		var shardMids []string
 		for _, indexValue := range indexesPerRss[i] {
 			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
 			if keyspaceIDs[index] != nil {
 				ksids = append(ksids, keyspaceIDs[index])
-				mids = append(mids, ins.Mid[index])
+				shardMids = append(shardMids, mids[index])
 			}
 		}
-		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
+		rewritten := ins.Prefix + strings.Join(shardMids, ",") + ins.Suffix
 		rewritten = sqlannotation.AddKeyspaceIDs(rewritten, ksids, "")
 		queries[i] = &querypb.BoundQuery{
 			Sql:           rewritten,
@@ -499,9 +769,143 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 	return keyspaceIDs, nil
 }
 
+// processExisting reads the existing rows that the insert replaces or
+// updates, and maintains their owned vindex entries. For REPLACE, the
+// entries of the existing rows are deleted. For ON DUPLICATE KEY UPDATE,
+// the entries of the changed columns are updated, and the returned list
+// flags the rows that update an existing row: no entries must be created
+// for them.
+// Note: the commit order may be different from the DML order because it's possible
+// for DMLs to reuse existing transactions.
+func (ins *Insert) processExisting(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	// OwnedVindexQuery compares the unique keys against the inserted
+	// values, which include the vindex columns.
+	var destinations []key.Destination
+	for rowNum, ksid := range ksids {
+		if ksid == nil {
+			continue
+		}
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+		for vIdx, colVindex := range ins.Table.ColumnVindexes {
+			for colIdx, col := range colVindex.Columns {
+				bindVars[insertVarName(col, rowNum)] = sqltypes.ValueBindVariable(vindexRowsValues[vIdx][rowNum][colIdx])
+			}
+		}
+	}
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: ins.OwnedVindexQuery, BindVariables: bindVars}
+	}
+	subQueryResults, errs := vcursor.ExecuteMultiShard(rss, queries, false, false)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+
+	updated := make([]bool, len(ksids))
+	for _, row := range subQueryResults.Rows {
+		// The first columns flag the inserted rows that
+		// conflict with the existing row.
+		if len(row) < len(ksids)+1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected column count for owned vindex query: %d", len(row))
+		}
+		ksid, err := resolveKeyspaceID(vcursor, ins.KsidVindex, row[len(ksids)])
+		if err != nil {
+			return nil, err
+		}
+		// MySQL applies the inserted rows in order. So, the
+		// first conflicting row on the same shard replaces or
+		// updates the existing row.
+		rowNum := -1
+		for i := range ksids {
+			if !bytes.Equal(ksids[i], ksid) {
+				continue
+			}
+			conflict, err := sqltypes.ToInt64(row[i])
+			if err == nil && conflict != 0 {
+				rowNum = i
+				break
+			}
+		}
+		if rowNum == -1 {
+			continue
+		}
+		if ins.Opcode != InsertShardedReplace && updated[rowNum] {
+			// An inserted row updates only one existing row.
+			continue
+		}
+		updated[rowNum] = true
+
+		colnum := len(ksids) + 1
+		for _, colVindex := range ins.Table.Owned {
+			// Fetch the column values. colnum must keep incrementing.
+			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for range colVindex.Columns {
+				fromIds = append(fromIds, row[colnum])
+				colnum++
+			}
+			lookup := colVindex.Vindex.(vindexes.Lookup)
+			if ins.Opcode == InsertShardedReplace {
+				if err := lookup.Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			// Update the entry only if one of its columns is being changed.
+			changed := false
+			toIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for colIdx, col := range colVindex.Columns {
+				if ins.isChangedVindexColumn(col) {
+					changed = true
+					toIds = append(toIds, vindexRowsValues[ins.columnVindexIndex(colVindex)][rowNum][colIdx])
+				} else {
+					toIds = append(toIds, fromIds[colIdx])
+				}
+			}
+			if changed {
+				if err := lookup.Update(vcursor, fromIds, ksid, toIds); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if ins.Opcode == InsertShardedReplace {
+		// The replaced rows are deleted: the entries
+		// of the inserted rows must all be created.
+		return nil, nil
+	}
+	return updated, nil
+}
+
+func (ins *Insert) isChangedVindexColumn(col sqlparser.ColIdent) bool {
+	for _, name := range ins.ChangedVindexColumns {
+		if col.EqualString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ins *Insert) columnVindexIndex(colVindex *vindexes.ColumnVindex) int {
+	for vIdx, cv := range ins.Table.ColumnVindexes {
+		if cv == colVindex {
+			return vIdx
+		}
+	}
+	return -1
+}
+
 // processOwned creates vindex entries for the values of an owned column.
-func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
-	if ins.Opcode == InsertSharded {
+// No entries are created for the rows that updated an existing row.
+func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte, updated []bool) error {
+	if ins.Opcode != InsertShardedIgnore {
 		return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, vindexColumnsKeys, ksids, false /* ignoreMode */)
 	}
 
@@ -511,7 +915,7 @@ func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.
 	var createKsids [][]byte
 
 	for rowNum, rowColumnKeys := range vindexColumnsKeys {
-		if ksids[rowNum] == nil {
+		if ksids[rowNum] == nil || (updated != nil && updated[rowNum]) {
 			continue
 		}
 		createIndexes = append(createIndexes, rowNum)
@@ -605,3 +1009,7 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltype
 func insertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return "_" + col.CompliantName() + strconv.Itoa(rowNum)
 }
+
+func insertSelectVarName(rowNum, colNum int) string {
+	return "_c" + strconv.Itoa(rowNum) + "_" + strconv.Itoa(colNum)
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -63,6 +64,33 @@ func TestInsertUnsharded(t *testing.T) {
 	expectError(t, "Execute", err, "Keyspace does not have exactly one shard: []")
 }
 
+func TestInsertUnshardedTargetDestination(t *testing.T) {
+	ins := NewQueryInsert(
+		InsertUnsharded,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_insert",
+	)
+	ins.TargetDestination = key.DestinationKeyspaceID{0}
+
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{{
+			RowsAffected: 1,
+		}},
+	}
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteMultiShard ks.-20: dummy_insert {} true true`,
+	})
+}
+
 func TestInsertUnshardedGenerate(t *testing.T) {
 	ins := NewQueryInsert(
 		InsertUnsharded,
@@ -1583,3 +1611,671 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelectSimple(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// The second column of the input rows is id.
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	ins.VindexValueOffset = [][]int{{1}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"name|id",
+				"varchar|int64",
+			),
+			"a|1",
+			"b|2",
+			"c|3",
+		)},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results:      []*sqltypes.Result{{RowsAffected: 3}},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// Based on shardForKsid, values returned will be 20-, -20, 20-.
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		// Row 2 will go to -20, rows 1 & 3 will go to 20-
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1),(:_c2_0, :_c2_1) suffix /* vtgate:: keyspace_id:166b40b44aba4bd6,4eb190c9a2fa169c */ ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" ` +
+			`_c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"2" ` +
+			`_c2_0: type:VARCHAR value:"c" _c2_1: type:INT64 value:"3" } ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1) suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" ` +
+			`_c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"2" ` +
+			`_c2_0: type:VARCHAR value:"c" _c2_1: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+
+	// An empty input inserts nothing.
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"name|id",
+				"varchar|int64",
+			),
+		)},
+	}
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+
+	// Failure cases
+	ins.Input = &fakePrimitive{sendErr: errors.New("input fail")}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSelect: input fail")
+
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"name|id",
+				"varchar|int64",
+			),
+			"a|1",
+		)},
+	}
+	vc = &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-"},
+		multiShardErrs: []error{
+			errors.New("shard_error"),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSelect: shard_error")
+}
+
+func TestInsertSelectBatches(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	defer func(size int) { insertSelectBatchSize = size }(insertSelectBatchSize)
+	insertSelectBatchSize = 2
+
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.VindexValueOffset = [][]int{{0}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+			"1",
+			"2",
+			"3",
+		)},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			{RowsAffected: 2, InsertID: 5},
+			{RowsAffected: 1, InsertID: 7},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// The first batch has rows 1 and 2.
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0) /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c0_0: type:INT64 value:"1" _c1_0: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix (:_c1_0) /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c0_0: type:INT64 value:"1" _c1_0: type:INT64 value:"2" } ` +
+			`true false`,
+		// The second batch has row 3, which is not autocommitted.
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0) /* vtgate:: keyspace_id:4eb190c9a2fa169c */ ` +
+			`{_c0_0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	// The insert id of the first batch is returned.
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 5})
+}
+
+func TestInsertSelectOwnedGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// The input rows are (c3, id). The rows don't have a value
+	// for the auto-inc column, which is added after them.
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.VindexValueOffset = [][]int{{1}, {0}}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 2,
+	}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"c3|id",
+				"int64|int64",
+			),
+			"10|1",
+			"11|2",
+		)},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{},
+			{RowsAffected: 2},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1) ` +
+			`from0: type:INT64 value:"10" from1: type:INT64 value:"11" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c0_0, :_c0_1, :_c0_2) /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c0_0: type:INT64 value:"10" _c0_1: type:INT64 value:"1" _c0_2: type:INT64 value:"4" ` +
+			`_c1_0: type:INT64 value:"11" _c1_1: type:INT64 value:"2" _c1_2: type:INT64 value:"5" } ` +
+			`sharded.-20: prefix (:_c1_0, :_c1_1, :_c1_2) /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c0_0: type:INT64 value:"10" _c0_1: type:INT64 value:"1" _c0_2: type:INT64 value:"4" ` +
+			`_c1_0: type:INT64 value:"11" _c1_1: type:INT64 value:"2" _c1_2: type:INT64 value:"5" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2, InsertID: 4})
+}
+
+func TestInsertShardedReplaceOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+	ins.KsidVindex = ks.Vindexes["hash"].(vindexes.SingleColumn)
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-", "-20"},
+		results: []*sqltypes.Result{
+			// Only the row with id 1 exists. It conflicts
+			// with the first inserted row.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"conflict0|conflict1|id|c3",
+					"int64|int64|int64|int64",
+				),
+				"1|0|1|4",
+			),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: dummy_select {_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: dummy_select {_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } false false`,
+		// The entry of the replaced row is deleted.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"4" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1) ` +
+			`from0: type:INT64 value:"10" from1: type:INT64 value:"11" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+}
+
+func TestInsertShardedUpsertOwnedSameKeyspaceID(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// Both inserted rows have the same primary vindex value,
+	// but conflict with different existing rows.
+	ins := NewInsert(
+		InsertShardedIgnore,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "dummy_select"
+	ins.KsidVindex = ks.Vindexes["hash"].(vindexes.SingleColumn)
+	ins.ChangedVindexColumns = []string{"c3"}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "20-", "20-", "20-"},
+		results: []*sqltypes.Result{
+			// The first existing row conflicts with the second
+			// inserted row. The second existing row conflicts
+			// with both: the first inserted row updates it.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"conflict0|conflict1|id|c3",
+					"int64|int64|int64|int64",
+				),
+				"0|1|1|4",
+				"1|1|1|5",
+			),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.20-: dummy_select {_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"1" } false false`,
+		// Each existing row gets the value of the row that updates it.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"4" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"11" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// No entries are created: both rows are updates.
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1, mid2 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6,166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"1" } ` +
+			`true true`,
+	})
+}
+
+func TestInsertShardedUpsertOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+					"twocol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp2",
+							"from":  "from1,from2",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}, {
+							Name:    "twocol",
+							Columns: []string{"c1", "c2"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedIgnore,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				// rows for c1
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(20),
+				}, {
+					Value: sqltypes.NewInt64(21),
+				}},
+			}, {
+				// rows for c2
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(30),
+				}, {
+					Value: sqltypes.NewInt64(31),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	// The ON DUPLICATE KEY UPDATE clause changes c3 and c1.
+	ins.OwnedVindexQuery = "dummy_select"
+	ins.KsidVindex = ks.Vindexes["hash"].(vindexes.SingleColumn)
+	ins.ChangedVindexColumns = []string{"c3", "c1"}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-", "-20"},
+		results: []*sqltypes.Result{
+			// Only the row with id 1 exists. It conflicts
+			// with the first inserted row.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"conflict0|conflict1|id|c3|c1|c2",
+					"int64|int64|int64|int64|int64|int64",
+				),
+				"1|0|1|4|5|6",
+			),
+			// Results of the lookup vindex updates.
+			{}, {}, {}, {},
+			// Results of the lookup vindex creations and verifications.
+			{},
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields("from", "int64"), "11"),
+			{},
+			sqltypes.MakeTestResult(sqltypes.MakeTestFields("from1", "int64"), "21"),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: dummy_select {_c10: type:INT64 value:"20" _c11: type:INT64 value:"21" _c20: type:INT64 value:"30" _c21: type:INT64 value:"31" _c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: dummy_select {_c10: type:INT64 value:"20" _c11: type:INT64 value:"21" _c20: type:INT64 value:"30" _c21: type:INT64 value:"31" _c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } false false`,
+		// The entries of the existing row are updated to the inserted values
+		// for the changed columns.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"4" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"5" from2: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"20" from20: type:INT64 value:"6" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// Entries are created only for the new row.
+		`Execute insert ignore into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"11" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute select from from lkp1 where from = :from and toc = :toc from: type:INT64 value:"11" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  false`,
+		`Execute insert ignore into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"21" from20: type:INT64 value:"31" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute select from1 from lkp2 where from1 = :from1 and toc = :toc from1: type:INT64 value:"21" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  false`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c10: type:INT64 value:"20" _c11: type:INT64 value:"21" _c20: type:INT64 value:"30" _c21: type:INT64 value:"31" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c10: type:INT64 value:"20" _c11: type:INT64 value:"21" _c20: type:INT64 value:"30" _c21: type:INT64 value:"31" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Lock)(nil)
+
+// Lock is a primitive that executes advisory lock functions
+// like GET_LOCK and RELEASE_LOCK. The query is sent to the
+// lock shard of the keyspace, on the dedicated lock connection
+// of the session, which holds the locks until the session ends.
+type Lock struct {
+	// Keyspace specifies the keyspace to send the query to.
+	Keyspace *vindexes.Keyspace
+
+	// TargetDestination specifies the destination of the lock shard.
+	TargetDestination key.Destination
+
+	// Query specifies the query to be executed.
+	Query string
+
+	// Lock does not take inputs
+	noInputs
+}
+
+// MarshalJSON serializes the Lock into a JSON representation.
+// It's used for testing and diagnostics.
+func (l *Lock) MarshalJSON() ([]byte, error) {
+	marshalLock := struct {
+		Opcode            string
+		Keyspace          *vindexes.Keyspace `json:",omitempty"`
+		TargetDestination string             `json:",omitempty"`
+		Query             string             `json:",omitempty"`
+	}{
+		Opcode:            l.RouteType(),
+		Keyspace:          l.Keyspace,
+		TargetDestination: l.TargetDestination.String(),
+		Query:             l.Query,
+	}
+	return jsonutil.MarshalNoEscape(marshalLock)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (l *Lock) RouteType() string {
+	return "Lock"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (l *Lock) GetKeyspaceName() string {
+	return l.Keyspace.Name
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (l *Lock) GetTableName() string {
+	return "dual"
+}
+
+// Execute performs a non-streaming exec.
+func (l *Lock) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(l.Keyspace.Name, nil, []key.Destination{l.TargetDestination})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execLock")
+	}
+	if len(rss) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "lock query can be routed to only one shard: %d shards resolved", len(rss))
+	}
+	query := &querypb.BoundQuery{
+		Sql:           l.Query,
+		BindVariables: bindVars,
+	}
+	return vcursor.ExecuteLock(rss[0], query)
+}
+
+// StreamExecute performs a streaming exec.
+func (l *Lock) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	qr, err := l.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(qr)
+}
+
+// GetFields is not supported for lock functions, because they
+// can't be executed without acquiring or releasing the locks.
+func (l *Lock) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "GetFields is not supported for lock functions")
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestLockExecute(t *testing.T) {
+	lock := &Lock{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		TargetDestination: key.DestinationKeyspaceID{0},
+		Query:             "select get_lock(:lockname, 10) from dual",
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"get_lock(:lockname, 10)",
+			"int64",
+		),
+		"1",
+	)
+
+	vc := &loggingVCursor{results: []*sqltypes.Result{wantResult}}
+	bv := map[string]*querypb.BindVariable{"lockname": sqltypes.StringBindVariable("a")}
+	result, err := lock.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock ks -20 select get_lock(:lockname, 10) from dual lockname: type:VARCHAR value:"a" `,
+	})
+	expectResult(t, "Execute", result, wantResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(lock, vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "StreamExecute", result, wantResult)
+
+	// Failure cases
+	vc = &loggingVCursor{shardErr: errors.New("shard_error")}
+	_, err = lock.Execute(vc, bv, false)
+	expectError(t, "Execute", err, "execLock: shard_error")
+
+	_, err = lock.GetFields(vc, bv)
+	expectError(t, "GetFields", err, "GetFields is not supported for lock functions")
+}
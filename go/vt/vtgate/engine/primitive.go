@@ -37,6 +37,18 @@ const (
 	// This is used for sending different IN clause values
 	// to different shards.
 	ListVarName = "__vals"
+	// DMLLimitVarName is a reserved bind var name for the
+	// row count of the LimitQuery of multi-shard DMLs with
+	// a LIMIT.
+	DMLLimitVarName = "__dml_limit"
+	// DMLKeysVarName is a reserved bind var name for the
+	// primary keys of the rows that a multi-shard DML with
+	// a LIMIT affects on a shard.
+	DMLKeysVarName = "__dml_keys"
+	// DMLShardVarName is a reserved bind var name used to
+	// identify the shard that returned a row read by the
+	// LimitQuery of a multi-shard DML.
+	DMLShardVarName = "__dml_shard"
 )
 
 // VCursor defines the interface the engine will use
@@ -48,12 +60,19 @@ type VCursor interface {
 	// MaxMemoryRows returns the maxMemoryRows flag value.
 	MaxMemoryRows() int
 
+	// InTransaction returns true if the session is in a transaction.
+	InTransaction() bool
+
 	// SetContextTimeout updates the context and sets a timeout.
 	SetContextTimeout(timeout time.Duration) context.CancelFunc
 
 	// RecordWarning stores the given warning in the current session
 	RecordWarning(warning *querypb.QueryWarning)
 
+	// SetFoundRows records the value FOUND_ROWS() returns
+	// after the current query.
+	SetFoundRows(uint64)
+
 	// V3 functions.
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	AutocommitApproval() bool
@@ -62,6 +81,7 @@ type VCursor interface {
 	ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, isDML, canAutocommit bool) (*sqltypes.Result, []error)
 	ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error)
 	StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error
+	ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
 
 	// Keyspace ID level functions.
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, isDML, autocommit bool) (*sqltypes.Result, error)
@@ -98,6 +118,10 @@ type Plan struct {
 	NeedsLastInsertID bool `json:"-"` // don't include in the json representation
 	// NeedsDatabaseName signals whether this plan will need to be provided with the database name
 	NeedsDatabaseName bool `json:"-"` // don't include in the json representation
+	// NeedsFoundRows signals whether this plan will need to be provided with found_rows
+	NeedsFoundRows bool `json:"-"` // don't include in the json representation
+	// QueryTimeout bounds the execution of the whole plan, if set
+	QueryTimeout time.Duration `json:",omitempty"`
 }
 
 // AddStats updates the plan execution statistics
@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -85,6 +86,11 @@ type Route struct {
 	// ScatterErrorsAsWarnings is true if results should be returned even if some shards have an error
 	ScatterErrorsAsWarnings bool
 
+	// SysTableSchema specifies the schema that a SelectDBA query
+	// filters on. If it names a keyspace, the query is sent to that
+	// keyspace, and the tablet maps it to its database name.
+	SysTableSchema sqltypes.PlanValue
+
 	// Route does not take inputs
 	noInputs
 }
@@ -121,6 +127,10 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 	if route.Vindex != nil {
 		vindexName = route.Vindex.String()
 	}
+	var sysTableSchema *sqltypes.PlanValue
+	if !route.SysTableSchema.IsNull() {
+		sysTableSchema = &route.SysTableSchema
+	}
 	marshalRoute := struct {
 		Opcode                  RouteOpcode
 		Keyspace                *vindexes.Keyspace   `json:",omitempty"`
@@ -133,6 +143,7 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		QueryTimeout            int                  `json:",omitempty"`
 		ScatterErrorsAsWarnings bool                 `json:",omitempty"`
 		Table                   string               `json:",omitempty"`
+		SysTableSchema          *sqltypes.PlanValue  `json:",omitempty"`
 	}{
 		Opcode:                  route.Opcode,
 		Keyspace:                route.Keyspace,
@@ -145,6 +156,7 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		QueryTimeout:            route.QueryTimeout,
 		ScatterErrorsAsWarnings: route.ScatterErrorsAsWarnings,
 		Table:                   route.TableName,
+		SysTableSchema:          sysTableSchema,
 	}
 	return jsonutil.MarshalNoEscape(marshalRoute)
 }
@@ -242,8 +254,10 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 	var bvs []map[string]*querypb.BindVariable
 	var err error
 	switch route.Opcode {
-	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
+	case SelectUnsharded, SelectNext, SelectReference:
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+	case SelectDBA:
+		rss, bvs, err = route.paramsSystemTable(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
@@ -301,8 +315,10 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		defer cancel()
 	}
 	switch route.Opcode {
-	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
+	case SelectUnsharded, SelectNext, SelectReference:
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+	case SelectDBA:
+		rss, bvs, err = route.paramsSystemTable(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
@@ -393,6 +409,40 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 	return rss, multiBindVars, nil
 }
 
+// paramsSystemTable sends a SelectDBA query to the keyspace named by
+// SysTableSchema, if there is one. The schema name is passed along as
+// a bind variable so that the tablet can replace it with its database name.
+func (route *Route) paramsSystemTable(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if route.SysTableSchema.IsNull() {
+		return route.paramsAnyShard(vcursor, bindVars)
+	}
+	schema, err := route.SysTableSchema.ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSystemTable")
+	}
+	keyspace := schema.ToString()
+	newBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+1)
+	for k, v := range bindVars {
+		newBindVars[k] = v
+	}
+	newBindVars[sqlparser.SchemaNameVarName] = sqltypes.StringBindVariable(keyspace)
+
+	rss, _, err := vcursor.ResolveDestinations(keyspace, nil, []key.Destination{key.DestinationAnyShard{}})
+	if err != nil {
+		// The schema is not a keyspace, e.g. mysql or performance_schema:
+		// any tablet can answer for it.
+		rss, _, err = vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "paramsSystemTable")
+		}
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = newBindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	key, err := route.Values[0].ResolveValue(bindVars)
 	if err != nil {
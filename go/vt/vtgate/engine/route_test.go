@@ -493,6 +493,44 @@ func TestSelectDBA(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectDBASysTableSchema(t *testing.T) {
+	sel := NewRoute(
+		SelectDBA,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.SysTableSchema = sqltypes.PlanValue{Key: "schema"}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"schema": sqltypes.StringBindVariable("other"),
+	}
+	result, err := sel.Execute(vc, bv, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations other [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard other.-20: dummy_select {__vtschemaname: type:VARCHAR value:"other" schema: type:VARCHAR value:"other" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, _ = wrapStreamExecute(sel, vc, bv, false)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations other [] Destinations:DestinationAnyShard()`,
+		`StreamExecuteMulti dummy_select other.-20: {__vtschemaname: type:VARCHAR value:"other" schema: type:VARCHAR value:"other" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+}
+
 func TestSelectReference(t *testing.T) {
 	sel := NewRoute(
 		SelectReference,
@@ -0,0 +1,345 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// SemiJoin filters the rows of the LHS using a correlated subquery
+// that could not be merged with the LHS. The subquery is executed
+// once for every LHS row, with the joinVars built from that row,
+// and the LHS row is returned only if the result of the subquery
+// satisfies the EXISTS or IN condition specified by the Opcode.
+//
+// If ListVar is set, the subquery is correlated to the LHS only by
+// an equality between a subquery column and an LHS column. Like in
+// a HashJoin, the subquery is then executed only once, with the list
+// of all the LHS key values, and its rows are matched against the
+// LHS rows by their keys. If the subquery returns more rows than
+// the allowed in-memory limit, or inside a transaction, it's executed
+// once for every LHS row, with a list that contains only its key.
+//
+// The values of the IN constructs and the keys are matched the same
+// way as the keys of a HashJoin: text values are matched by their
+// weight strings, if they're supplied.
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+	// Left is the primitive whose rows are filtered, and
+	// Right is the subquery.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left results
+	// should be used to build the return result.
+	Cols []int `json:",omitempty"`
+
+	// Vars defines the list of joinVars that need to
+	// be built from the LHS result before invoking
+	// the subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// Col is the column of the left results that is searched
+	// in the results of the subquery. It's used only by the
+	// SemiJoinIn and SemiJoinNotIn opcodes.
+	Col int
+
+	// ColWeightString and SubqueryWeightString are the column numbers
+	// of the weight strings of Col and of the first subquery column,
+	// if they may be text values. They're 0 otherwise.
+	ColWeightString      int `json:",omitempty"`
+	SubqueryWeightString int `json:",omitempty"`
+
+	// ListVar is the name of the bind variable that the subquery
+	// uses for the list of LHS key values. It's empty if the
+	// subquery is executed for every LHS row.
+	ListVar string `json:",omitempty"`
+
+	// LHSKey and RHSKey are the column numbers of the key in
+	// the LHS and subquery results, and LHSWeightString and
+	// RHSWeightString are those of their weight strings, like
+	// in a HashJoin. They're used only if ListVar is set.
+	LHSKey, RHSKey                   int `json:",omitempty"`
+	LHSWeightString, RHSWeightString int `json:",omitempty"`
+}
+
+// MarshalJSON serializes the SemiJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (sj *SemiJoin) MarshalJSON() ([]byte, error) {
+	marshalSemiJoin := struct {
+		Opcode      SemiJoinOpcode
+		Left, Right Primitive      `json:",omitempty"`
+		Cols        []int          `json:",omitempty"`
+		Vars        map[string]int `json:",omitempty"`
+		Col         *int           `json:",omitempty"`
+
+		ColWeightString      int    `json:",omitempty"`
+		SubqueryWeightString int    `json:",omitempty"`
+		ListVar              string `json:",omitempty"`
+		LHSKey, RHSKey       *int   `json:",omitempty"`
+		LHSWeightString      int    `json:",omitempty"`
+		RHSWeightString      int    `json:",omitempty"`
+	}{
+		Opcode:               sj.Opcode,
+		Left:                 sj.Left,
+		Right:                sj.Right,
+		Cols:                 sj.Cols,
+		Vars:                 sj.Vars,
+		ColWeightString:      sj.ColWeightString,
+		SubqueryWeightString: sj.SubqueryWeightString,
+		ListVar:              sj.ListVar,
+		LHSWeightString:      sj.LHSWeightString,
+		RHSWeightString:      sj.RHSWeightString,
+	}
+	if sj.Opcode == SemiJoinIn || sj.Opcode == SemiJoinNotIn {
+		marshalSemiJoin.Col = &sj.Col
+	}
+	if sj.ListVar != "" {
+		marshalSemiJoin.LHSKey = &sj.LHSKey
+		marshalSemiJoin.RHSKey = &sj.RHSKey
+	}
+	return json.Marshal(marshalSemiJoin)
+}
+
+// Execute performs a non-streaming exec.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return sj.filter(vcursor, bindVars, lresult)
+}
+
+// StreamExecute performs a streaming exec.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return sj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result, err := sj.filter(vcursor, bindVars, lresult)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// filter returns the rows of lresult that satisfy the condition.
+func (sj *SemiJoin) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lresult *sqltypes.Result) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	if lresult.Fields != nil {
+		result.Fields = sj.projectFields(lresult.Fields)
+	}
+	if sj.ListVar != "" && !vcursor.InTransaction() {
+		table, err := sj.subqueryTable(vcursor, bindVars, lresult)
+		if err != nil {
+			return nil, err
+		}
+		if table != nil {
+			for _, lrow := range lresult.Rows {
+				var rrows [][]sqltypes.Value
+				for _, key := range joinKeys(lrow, sj.LHSKey, sj.LHSWeightString, true) {
+					rrows = append(rrows, table[key]...)
+				}
+				sj.appendRow(result, lrow, rrows)
+			}
+			return result, nil
+		}
+	}
+	joinVars := make(map[string]*querypb.BindVariable)
+	for _, lrow := range lresult.Rows {
+		for k, col := range sj.Vars {
+			joinVars[k] = sqltypes.ValueBindVariable(lrow[col])
+		}
+		var rrows [][]sqltypes.Value
+		if sj.ListVar != "" {
+			// A NULL key matches nothing.
+			if key := lrow[sj.LHSKey]; !key.IsNull() {
+				rresult, err := sj.Right.Execute(vcursor, combineVars(bindVars, listBindVar(sj.ListVar, []sqltypes.Value{key})), false)
+				if err != nil {
+					return nil, err
+				}
+				rrows = rresult.Rows
+			}
+		} else {
+			rresult, err := sj.Right.Execute(vcursor, combineVars(bindVars, joinVars), false)
+			if err != nil {
+				return nil, err
+			}
+			rrows = rresult.Rows
+		}
+		sj.appendRow(result, lrow, rrows)
+	}
+	return result, nil
+}
+
+// subqueryTable executes the subquery with the list of all the LHS
+// keys, and returns its rows hashed by their key. It returns nil
+// if the subquery returns more rows than the in-memory limit.
+func (sj *SemiJoin) subqueryTable(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lresult *sqltypes.Result) (map[string][][]sqltypes.Value, error) {
+	table := make(map[string][][]sqltypes.Value)
+	keys := distinctKeys(lresult.Rows, sj.LHSKey, sj.LHSWeightString)
+	if len(keys) == 0 {
+		return table, nil
+	}
+	numRows := 0
+	tooManyRows := false
+	err := sj.Right.StreamExecute(vcursor, combineVars(bindVars, listBindVar(sj.ListVar, keys)), false, func(rresult *sqltypes.Result) error {
+		numRows += len(rresult.Rows)
+		if numRows > vcursor.MaxMemoryRows() {
+			tooManyRows = true
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		for _, rrow := range rresult.Rows {
+			for _, key := range joinKeys(rrow, sj.RHSKey, sj.RHSWeightString, false) {
+				table[key] = append(table[key], rrow)
+			}
+		}
+		return nil
+	})
+	if tooManyRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// appendRow appends lrow to the result if the subquery rows
+// satisfy the condition for it.
+func (sj *SemiJoin) appendRow(result *sqltypes.Result, lrow []sqltypes.Value, rrows [][]sqltypes.Value) {
+	if !sj.matches(lrow, rrows) {
+		return
+	}
+	result.Rows = append(result.Rows, sj.projectRow(lrow))
+	result.RowsAffected++
+}
+
+// matches returns true if the subquery rows satisfy the condition for lrow.
+// For the IN opcodes, the semantics of SQL are followed: a NULL on either
+// side yields a NULL result, which is treated as false.
+func (sj *SemiJoin) matches(lrow []sqltypes.Value, rrows [][]sqltypes.Value) bool {
+	switch sj.Opcode {
+	case SemiJoinExists:
+		return len(rrows) != 0
+	case SemiJoinNotExists:
+		return len(rrows) == 0
+	}
+	if len(rrows) == 0 {
+		return sj.Opcode == SemiJoinNotIn
+	}
+	probeKeys := joinKeys(lrow, sj.Col, sj.ColWeightString, true)
+	if probeKeys == nil {
+		return false
+	}
+	hasNull := false
+	for _, rrow := range rrows {
+		if rrow[0].IsNull() {
+			hasNull = true
+			continue
+		}
+		for _, key := range joinKeys(rrow, 0, sj.SubqueryWeightString, false) {
+			for _, probeKey := range probeKeys {
+				if key == probeKey {
+					return sj.Opcode == SemiJoinIn
+				}
+			}
+		}
+	}
+	return sj.Opcode == SemiJoinNotIn && !hasNull
+}
+
+func (sj *SemiJoin) projectFields(lfields []*querypb.Field) []*querypb.Field {
+	fields := make([]*querypb.Field, len(sj.Cols))
+	for i, col := range sj.Cols {
+		fields[i] = lfields[col]
+	}
+	return fields
+}
+
+func (sj *SemiJoin) projectRow(lrow []sqltypes.Value) []sqltypes.Value {
+	row := make([]sqltypes.Value, len(sj.Cols))
+	for i, col := range sj.Cols {
+		row[i] = lrow[col]
+	}
+	return row
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: sj.projectFields(lresult.Fields)}, nil
+}
+
+// Inputs returns the input primitives for this semi join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	SemiJoinExists = SemiJoinOpcode(iota)
+	SemiJoinNotExists
+	SemiJoinIn
+	SemiJoinNotIn
+)
+
+var semiJoinName = map[SemiJoinOpcode]string{
+	SemiJoinExists:    "SemiJoinExists",
+	SemiJoinNotExists: "SemiJoinNotExists",
+	SemiJoinIn:        "SemiJoinIn",
+	SemiJoinNotIn:     "SemiJoinNotIn",
+}
+
+func (code SemiJoinOpcode) String() string {
+	return semiJoinName[code]
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
@@ -0,0 +1,500 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func semiJoinTestPrimitives() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|int64",
+				),
+				"1|a|10",
+				"2|b|null",
+				"3|c|30",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"10",
+				"11",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"20",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestSemiJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := semiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0, 1},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:VARCHAR value:"a"  false`,
+		`Execute bv: type:VARCHAR value:"b"  false`,
+		`Execute bv: type:VARCHAR value:"c"  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"2|b",
+	))
+
+	// Not Exists
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinNotExists
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"3|c",
+	))
+
+	// In
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinIn
+	sj.Col = 2
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+	))
+
+	// Not In: a NULL value never satisfies the condition,
+	// unless the subquery returns no rows.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinNotIn
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"3|c",
+	))
+}
+
+func TestSemiJoinNotInWithNull(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col2",
+		"int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1",
+				"null",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"3",
+				"null",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode: SemiJoinNotIn,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+		Col:    0,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The second row is not returned because 2 not in (3, null) is null.
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+	))
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := semiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{1},
+		Vars: map[string]int{
+			"bv": 0,
+		},
+	}
+	r, err := wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute bv: type:INT64 value:"1"  false`,
+		`Execute bv: type:INT64 value:"2"  false`,
+		`Execute bv: type:INT64 value:"3"  false`,
+	})
+	expectResult(t, "sj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col2",
+			"varchar",
+		),
+		"a",
+		"b",
+	))
+}
+
+func TestSemiJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := semiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{1, 0},
+	}
+	r, err := sj.GetFields(noopVCursor{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.GetFields", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col2|col1",
+			"varchar|int64",
+		),
+	})
+}
+
+func TestSemiJoinExecuteErrors(t *testing.T) {
+	// Error on left query
+	leftPrim := &fakePrimitive{
+		sendErr: errors.New("left err"),
+	}
+	sj := &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+	}
+	_, err := sj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "sj.Execute", err, "left err")
+
+	// Error on right query
+	leftPrim, _ = semiJoinTestPrimitives()
+	rightPrim := &fakePrimitive{
+		sendErr: errors.New("right err"),
+	}
+	sj = &SemiJoin{
+		Opcode: SemiJoinExists,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{0},
+	}
+	_, err = sj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "sj.Execute", err, "right err")
+}
+
+func TestSemiJoinInWeightString(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|weight_string(col2)",
+					"int64|varchar|varbinary",
+				),
+				"1|a|A",
+				"2|b|B",
+				"3|null|null",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|weight_string(col3)",
+		"varchar|varbinary",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rightFields, "A|A"),
+			sqltypes.MakeTestResult(rightFields, "c|C"),
+			sqltypes.MakeTestResult(rightFields, "c|C"),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:               SemiJoinIn,
+		Left:                 leftPrim,
+		Right:                rightPrim,
+		Cols:                 []int{0},
+		Vars:                 map[string]int{"bv": 0},
+		Col:                  1,
+		ColWeightString:      2,
+		SubqueryWeightString: 1,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 'a' is in ('A') because their weight strings are equal.
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+	))
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinNotIn
+	r, err = sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"2",
+	))
+}
+
+func semiJoinBatchTestPrimitives() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|weight_string(col2)",
+					"int64|varchar|varbinary",
+				),
+				"1|a|A",
+				"2|A|A",
+				"3|b|B",
+				"4|null|null",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|weight_string(col3)",
+					"varchar|varbinary",
+				),
+				"a|A",
+				"a|A",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestSemiJoinBatch(t *testing.T) {
+	leftPrim, rightPrim := semiJoinBatchTestPrimitives()
+	sj := &SemiJoin{
+		Opcode:          SemiJoinExists,
+		Left:            leftPrim,
+		Right:           rightPrim,
+		Cols:            []int{0},
+		ListVar:         "__sj1",
+		LHSKey:          1,
+		LHSWeightString: 2,
+		RHSKey:          0,
+		RHSWeightString: 1,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 'a' and 'A' are the same key, and the subquery is executed once.
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __sj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"2",
+	))
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = SemiJoinNotExists
+	r, err = sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"3",
+		"4",
+	))
+}
+
+func TestSemiJoinBatchFallback(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim, rightPrim := semiJoinBatchTestPrimitives()
+	rightFields := rightPrim.results[0].Fields
+	rightPrim.results = append(rightPrim.results,
+		sqltypes.MakeTestResult(rightFields, "a|A"),
+		sqltypes.MakeTestResult(rightFields, "A|A"),
+		sqltypes.MakeTestResult(rightFields),
+	)
+	sj := &SemiJoin{
+		Opcode:          SemiJoinExists,
+		Left:            leftPrim,
+		Right:           rightPrim,
+		Cols:            []int{0},
+		ListVar:         "__sj1",
+		LHSKey:          1,
+		LHSWeightString: 2,
+		RHSKey:          0,
+		RHSWeightString: 1,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The subquery is abandoned as soon as it returns more than one
+	// row, and it's then executed for every LHS row that has a key.
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute __sj1: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"A" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"2",
+	))
+}
+
+func TestSemiJoinBatchInTransaction(t *testing.T) {
+	leftPrim, rightPrim := semiJoinBatchTestPrimitives()
+	rightFields := rightPrim.results[0].Fields
+	rightPrim.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(rightFields, "a|A"),
+		sqltypes.MakeTestResult(rightFields),
+		sqltypes.MakeTestResult(rightFields),
+	}
+	sj := &SemiJoin{
+		Opcode:          SemiJoinExists,
+		Left:            leftPrim,
+		Right:           rightPrim,
+		Cols:            []int{0},
+		ListVar:         "__sj1",
+		LHSKey:          1,
+		LHSWeightString: 2,
+		RHSKey:          0,
+		RHSWeightString: 1,
+	}
+	r, err := sj.Execute(txVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The streaming queries don't run in the transaction.
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"A" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+	))
+}
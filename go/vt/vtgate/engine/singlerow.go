@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*SingleRow)(nil)
+
+// SingleRow is a primitive that returns a single row without
+// columns, like the dual table. It's used as the input of a Filter
+// that computes the values of a select from dual within vtgate.
+type SingleRow struct {
+	// SingleRow does not take inputs
+	noInputs
+}
+
+// MarshalJSON serializes the SingleRow into a JSON representation.
+// It's used for testing and diagnostics.
+func (s *SingleRow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Opcode string
+	}{
+		Opcode: "SingleRow",
+	})
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (s *SingleRow) RouteType() string {
+	return "SingleRow"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (s *SingleRow) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (s *SingleRow) GetTableName() string {
+	return "dual"
+}
+
+// Execute performs a non-streaming exec.
+func (s *SingleRow) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{
+		Rows:         [][]sqltypes.Value{{}},
+		RowsAffected: 1,
+	}
+	if wantfields {
+		result.Fields = []*querypb.Field{}
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (s *SingleRow) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := s.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields fetches the field info.
+func (s *SingleRow) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: []*querypb.Field{}}, nil
+}
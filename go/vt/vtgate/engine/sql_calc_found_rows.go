@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*SQLCalcFoundRows)(nil)
+
+// SQLCalcFoundRows is a primitive for a select with SQL_CALC_FOUND_ROWS.
+// It returns the rows of the limited query, and records the number of
+// rows the query would have returned without the limit, as counted by
+// the count query, for FOUND_ROWS().
+type SQLCalcFoundRows struct {
+	// LimitPrimitive executes the query with its limit.
+	LimitPrimitive Primitive
+	// CountPrimitive returns a single row with the number of rows
+	// matched by the query without its limit.
+	CountPrimitive Primitive
+}
+
+// MarshalJSON serializes the SQLCalcFoundRows into a JSON representation.
+// It's used for testing and diagnostics.
+func (s *SQLCalcFoundRows) MarshalJSON() ([]byte, error) {
+	marshalSQLCalcFoundRows := struct {
+		Opcode         string
+		LimitPrimitive Primitive
+		CountPrimitive Primitive
+	}{
+		Opcode:         "SQLCalcFoundRows",
+		LimitPrimitive: s.LimitPrimitive,
+		CountPrimitive: s.CountPrimitive,
+	}
+	return json.Marshal(marshalSQLCalcFoundRows)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (s *SQLCalcFoundRows) RouteType() string {
+	return "SQLCalcFoundRows"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (s *SQLCalcFoundRows) GetKeyspaceName() string {
+	return s.LimitPrimitive.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (s *SQLCalcFoundRows) GetTableName() string {
+	return s.LimitPrimitive.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (s *SQLCalcFoundRows) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := s.LimitPrimitive.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setFoundRows(vcursor, bindVars); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (s *SQLCalcFoundRows) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if err := s.LimitPrimitive.StreamExecute(vcursor, bindVars, wantfields, callback); err != nil {
+		return err
+	}
+	return s.setFoundRows(vcursor, bindVars)
+}
+
+// GetFields fetches the field info.
+func (s *SQLCalcFoundRows) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return s.LimitPrimitive.GetFields(vcursor, bindVars)
+}
+
+// Inputs returns the inputs to this primitive
+func (s *SQLCalcFoundRows) Inputs() []Primitive {
+	return []Primitive{s.LimitPrimitive, s.CountPrimitive}
+}
+
+// setFoundRows executes the count query, and records its result
+// in the session.
+func (s *SQLCalcFoundRows) setFoundRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable) error {
+	result, err := s.CountPrimitive.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0]) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "count query returned %d rows, want a single value", len(result.Rows))
+	}
+	count, err := sqltypes.ToUint64(result.Rows[0][0])
+	if err != nil {
+		return err
+	}
+	vcursor.SetFoundRows(count)
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestSQLCalcFoundRowsExecute(t *testing.T) {
+	limitResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"2",
+	)
+	countResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*)",
+			"int64",
+		),
+		"5",
+	)
+	scfr := &SQLCalcFoundRows{
+		LimitPrimitive: &fakePrimitive{results: []*sqltypes.Result{limitResult}},
+		CountPrimitive: &fakePrimitive{results: []*sqltypes.Result{countResult}},
+	}
+
+	vc := &loggingVCursor{}
+	result, err := scfr.Execute(vc, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "Execute", result, limitResult)
+	vc.ExpectLog(t, []string{"SetFoundRows 5"})
+
+	scfr.LimitPrimitive.(*fakePrimitive).rewind()
+	scfr.CountPrimitive.(*fakePrimitive).rewind()
+	vc = &loggingVCursor{}
+	result, err = wrapStreamExecute(scfr, vc, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "StreamExecute", result, limitResult)
+	vc.ExpectLog(t, []string{"SetFoundRows 5"})
+}
+
+func TestSQLCalcFoundRowsExecuteErrors(t *testing.T) {
+	scfr := &SQLCalcFoundRows{
+		LimitPrimitive: &fakePrimitive{sendErr: errors.New("limit err")},
+		CountPrimitive: &fakePrimitive{},
+	}
+	_, err := scfr.Execute(&loggingVCursor{}, nil, true)
+	expectError(t, "Execute", err, "limit err")
+
+	scfr = &SQLCalcFoundRows{
+		LimitPrimitive: &fakePrimitive{results: []*sqltypes.Result{{}}},
+		CountPrimitive: &fakePrimitive{results: []*sqltypes.Result{{}}},
+	}
+	vc := &loggingVCursor{}
+	_, err = scfr.Execute(vc, nil, true)
+	expectError(t, "Execute", err, "count query returned 0 rows, want a single value")
+	vc.ExpectLog(t, nil)
+}
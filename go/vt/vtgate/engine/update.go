@@ -73,6 +73,9 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		KsidVindex           string                  `json:",omitempty"`
 		MultiShardAutocommit bool                    `json:",omitempty"`
 		QueryTimeout         int                     `json:",omitempty"`
+		LimitQuery           string                  `json:",omitempty"`
+		Limit                *sqltypes.PlanValue     `json:",omitempty"`
+		OrderBy              []OrderbyParams         `json:",omitempty"`
 	}{
 		Opcode:               upd.RouteType(),
 		Keyspace:             upd.Keyspace,
@@ -85,6 +88,11 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		KsidVindex:           ksidVindexName,
 		MultiShardAutocommit: upd.MultiShardAutocommit,
 		QueryTimeout:         upd.QueryTimeout,
+		LimitQuery:           upd.LimitQuery,
+		OrderBy:              upd.OrderBy,
+	}
+	if upd.LimitQuery != "" {
+		marshalUpdate.Limit = &upd.Limit
 	}
 	return jsonutil.MarshalNoEscape(marshalUpdate)
 }
@@ -246,6 +254,10 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 		return nil, vterrors.Wrap(err, "execUpdateByDestination")
 	}
 
+	if upd.LimitQuery != "" {
+		return upd.execUpdateWithLimit(vcursor, bindVars, rss)
+	}
+
 	queries := make([]*querypb.BoundQuery, len(rss))
 	sql := sqlannotation.AnnotateIfDML(upd.Query, nil)
 	for i := range rss {
@@ -266,3 +278,35 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	return result, vterrors.Aggregate(errs)
 }
+
+// execUpdateWithLimit updates the rows of a multi-shard update with
+// a LIMIT clause. Every shard is sent the number of rows it must update.
+func (upd *Update) execUpdateWithLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	rss, shardVars, err := upd.resolveLimit(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateWithLimit")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	sql := sqlannotation.AnnotateIfDML(upd.Query, nil)
+	for i, rs := range rss {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           sql,
+			BindVariables: shardVars[i],
+		}
+		// update any owned vindexes
+		if len(upd.ChangedVindexValues) != 0 {
+			if err := upd.updateVindexEntries(vcursor, shardVars[i], []*srvtopo.ResolvedShard{rs}); err != nil {
+				return nil, vterrors.Wrap(err, "execUpdateWithLimit")
+			}
+		}
+	}
+
+	// The rows to update were read by a separate query,
+	// so the update cannot be autocommitted on its own.
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, false /* autocommit */)
+	return result, vterrors.Aggregate(errs)
+}
@@ -137,6 +137,59 @@ func TestUpdateScatter(t *testing.T) {
 	})
 }
 
+func TestUpdateScatterLimit(t *testing.T) {
+	upd := &Update{
+		DML: DML{
+			Opcode: Scatter,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:                "dummy_update",
+			LimitQuery:           "dummy_limit",
+			Limit:                sqltypes.PlanValue{Key: "lim"},
+			OrderBy:              []OrderbyParams{{Col: 2}},
+			MultiShardAutocommit: true,
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"shard|id|col",
+			"uint64|int64|int64",
+		),
+		"1|12|2",
+		"0|11|1",
+		"0|13|3",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	bv := map[string]*querypb.BindVariable{"lim": sqltypes.Int64BindVariable(5)}
+	_, err := upd.Execute(vc, bv, false)
+	require.NoError(t, err)
+
+	// The limit exceeds the number of rows, so they all get updated.
+	// The update is not autocommitted, even with multishard autocommit.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`ks.-20: dummy_limit {__dml_limit: type:UINT64 value:"5" __dml_shard: type:UINT64 value:"0" lim: type:INT64 value:"5" } ` +
+			`ks.20-: dummy_limit {__dml_limit: type:UINT64 value:"5" __dml_shard: type:UINT64 value:"1" lim: type:INT64 value:"5" } ` +
+			`false false`,
+		`ExecuteMultiShard ` +
+			`ks.-20: dummy_update {__dml_keys: type:TUPLE values:<type:INT64 value:"11" > values:<type:INT64 value:"13" > lim: type:INT64 value:"5" } ` +
+			`ks.20-: dummy_update {__dml_keys: type:TUPLE values:<type:INT64 value:"12" > lim: type:INT64 value:"5" } ` +
+			`true false`,
+	})
+
+	// Missing limit bind variable.
+	vc = &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "execUpdateWithLimit: missing bind var lim")
+}
+
 func TestUpdateEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -38,7 +39,6 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/key"
-	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlannotation"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
@@ -96,6 +96,10 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm VSchemaManager
+
+	// processList keeps track of the client connections,
+	// for SHOW PROCESSLIST and KILL.
+	processList *processList
 }
 
 var executorOnce sync.Once
@@ -115,6 +119,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell, statsName strin
 		plans:       cache.NewLRUCache(queryPlanCacheSize),
 		normalize:   normalize,
 		streamSize:  streamSize,
+		processList: newProcessList(),
 	}
 
 	vschemaacl.Init()
@@ -241,6 +246,10 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtRollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, logStats)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return e.handleSavepoint(ctx, safeSession, sql, logStats)
+	case sqlparser.StmtKill:
+		return e.handleKill(ctx, sql, logStats)
 	case sqlparser.StmtSet:
 		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtShow:
@@ -299,11 +308,17 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 			if rewriteResult.NeedLastInsertID {
 				bindVars[sqlparser.LastInsertIDName] = sqltypes.Uint64BindVariable(safeSession.GetLastInsertId())
 			}
+			if rewriteResult.NeedFoundRows {
+				bindVars[sqlparser.FoundRowsName] = sqltypes.Uint64BindVariable(safeSession.GetFoundRows())
+			}
 		}
 		logStats.PlanTime = execStart.Sub(logStats.StartTime)
 		logStats.SQL = sql
 		logStats.BindVariables = bindVars
 		result, err := e.destinationExec(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
+		if err == nil {
+			updateLastInsertIDAndFoundRows(safeSession, stmtType, result, false)
+		}
 		logStats.ExecuteTime = time.Since(execStart)
 		e.updateQueryCounts("ShardDirect", "", "", int64(logStats.ShardQueries))
 		return result, err
@@ -328,16 +343,10 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		return nil, err
 	}
 
-	if plan.NeedsLastInsertID {
-		bindVars[sqlparser.LastInsertIDName] = sqltypes.Uint64BindVariable(safeSession.GetLastInsertId())
-	}
-	if plan.NeedsDatabaseName {
-		keyspace, _, _, _ := e.ParseDestinationTarget(safeSession.TargetString)
-		if keyspace == "" {
-			bindVars[sqlparser.DBVarName] = sqltypes.NullBindVariable
-		} else {
-			bindVars[sqlparser.DBVarName] = sqltypes.StringBindVariable(keyspace)
-		}
+	e.addNeededBindVars(plan, bindVars, safeSession)
+	if plan.QueryTimeout != 0 {
+		cancel := vcursor.SetContextTimeout(plan.QueryTimeout)
+		defer cancel()
 	}
 
 	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
@@ -351,9 +360,7 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		errCount = 1
 	} else {
 		logStats.RowsAffected = qr.RowsAffected
-		if qr != nil && stmtType == sqlparser.StmtInsert {
-			safeSession.LastInsertId = qr.InsertID
-		}
+		updateLastInsertIDAndFoundRows(safeSession, stmtType, qr, vcursor.foundRowsSet)
 	}
 
 	// Check if there was partial DML execution. If so, rollback the transaction.
@@ -367,6 +374,39 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	return qr, err
 }
 
+// addNeededBindVars adds the bind variables the plan needs for
+// the values that are only known by vtgate.
+func (e *Executor) addNeededBindVars(plan *engine.Plan, bindVars map[string]*querypb.BindVariable, safeSession *SafeSession) {
+	if plan.NeedsLastInsertID {
+		bindVars[sqlparser.LastInsertIDName] = sqltypes.Uint64BindVariable(safeSession.GetLastInsertId())
+	}
+	if plan.NeedsDatabaseName {
+		keyspace, _, _, _ := e.ParseDestinationTarget(safeSession.TargetString)
+		if keyspace == "" {
+			bindVars[sqlparser.DBVarName] = sqltypes.NullBindVariable
+		} else {
+			bindVars[sqlparser.DBVarName] = sqltypes.StringBindVariable(keyspace)
+		}
+	}
+	if plan.NeedsFoundRows {
+		bindVars[sqlparser.FoundRowsName] = sqltypes.Uint64BindVariable(safeSession.GetFoundRows())
+	}
+}
+
+// updateLastInsertIDAndFoundRows updates the values LAST_INSERT_ID()
+// and FOUND_ROWS() return after a successful query, like MySQL does:
+// the last insert id only changes if the query generated one, and the
+// found rows are the rows returned by a select, unless the plan already
+// counted them for SQL_CALC_FOUND_ROWS.
+func updateLastInsertIDAndFoundRows(safeSession *SafeSession, stmtType sqlparser.StatementType, qr *sqltypes.Result, foundRowsSet bool) {
+	if qr.InsertID != 0 {
+		safeSession.LastInsertId = qr.InsertID
+	}
+	if stmtType == sqlparser.StmtSelect && !foundRowsSet {
+		safeSession.FoundRows = uint64(len(qr.Rows))
+	}
+}
+
 func (e *Executor) destinationExec(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
 	return e.resolver.Execute(ctx, sql, bindVars, destKeyspace, destTabletType, dest, safeSession.Session, false /* notInTransaction */, safeSession.Options, logStats)
 }
@@ -403,6 +443,26 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 
 	if dest == nil {
 		dest = key.DestinationAllShards{}
+		if ok {
+			temporary, err := isTemporaryTableDDL(safeSession, destKeyspace, ddl)
+			if err != nil {
+				return nil, err
+			}
+			if temporary {
+				dest = temporaryTableShard
+			}
+		}
+	}
+
+	createsTemporaryTable := false
+	if ok && ddl.Temporary && ddl.Action == sqlparser.CreateStr {
+		if destTabletType != topodatapb.TabletType_MASTER {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "temporary tables are only supported on master")
+		}
+		// A temporary table only exists on the connection that created
+		// it, so the session switches to reserved connections before
+		// the table gets created on the shards.
+		createsTemporaryTable = safeSession.AddTemporaryTable(destKeyspace, ddl.Table.Name.String())
 	}
 
 	execStart := time.Now()
@@ -412,9 +472,56 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 
 	e.updateQueryCounts("DDL", "", "", int64(logStats.ShardQueries))
 
+	switch {
+	case err != nil && createsTemporaryTable:
+		safeSession.RemoveTemporaryTable(destKeyspace, ddl.Table.Name.String())
+	case err == nil && ok && ddl.Action == sqlparser.DropStr:
+		// Dropping a table drops the temporary table of that name, if any.
+		dropped := false
+		for _, table := range ddl.FromTables {
+			if safeSession.RemoveTemporaryTable(destKeyspace, table.Name.String()) {
+				dropped = true
+			}
+		}
+		if dropped && len(safeSession.TemporaryTables) == 0 && len(safeSession.SystemVariables) == 0 && !safeSession.InTransaction() {
+			if err := e.txConn.Release(ctx, safeSession); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return result, err
 }
 
+// isTemporaryTableDDL returns true if the DDL is for temporary tables:
+// it either creates or drops temporary tables, or it drops, alters or
+// truncates tables that are all temporary tables of the session. A DDL
+// for both temporary and regular tables can't be sent to a single
+// destination, and is rejected.
+func isTemporaryTableDDL(safeSession *SafeSession, keyspace string, ddl *sqlparser.DDL) (bool, error) {
+	if ddl.Temporary {
+		return true, nil
+	}
+	var tables sqlparser.TableNames
+	switch ddl.Action {
+	case sqlparser.DropStr:
+		tables = ddl.FromTables
+	case sqlparser.AlterStr, sqlparser.TruncateStr:
+		tables = sqlparser.TableNames{ddl.Table}
+	default:
+		return false, nil
+	}
+	temporary := 0
+	for _, table := range tables {
+		if (table.Qualifier.IsEmpty() || table.Qualifier.String() == keyspace) && safeSession.HasTemporaryTable(keyspace, table.Name.String()) {
+			temporary++
+		}
+	}
+	if temporary != 0 && temporary != len(tables) {
+		return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s of temporary and regular tables in a single statement", ddl.Action)
+	}
+	return temporary != 0, nil
+}
+
 func (e *Executor) handleVSchemaDDL(ctx context.Context, safeSession *SafeSession, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, ddl *sqlparser.DDL, logStats *LogStats) error {
 	vschema := e.vm.GetCurrentSrvVschema()
 	if vschema == nil {
@@ -486,6 +593,101 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	var name sqlparser.ColIdent
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		name = stmt.Name
+	case *sqlparser.SRollback:
+		name = stmt.Name
+	case *sqlparser.Release:
+		name = stmt.Name
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
+	}
+
+	// Savepoint names are case insensitive. The session records
+	// them as statements, which are replayed on the shards that
+	// join the transaction later.
+	savepoint := sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name.Lowered())})
+	savepoints := safeSession.TransactionSavepoints()
+	idx := -1
+	for i, sp := range savepoints {
+		if sp == savepoint {
+			idx = i
+		}
+	}
+	if _, ok := stmt.(*sqlparser.Savepoint); !ok && idx == -1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name.String())
+	}
+	if !safeSession.InTransaction() {
+		// Like in MySQL, a savepoint outside of a transaction is a no-op.
+		return &sqltypes.Result{}, nil
+	}
+
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	e.updateQueryCounts("Savepoint", "", "", int64(logStats.ShardQueries))
+	if err := e.txConn.Savepoint(ctx, safeSession, sqlparser.String(stmt)); err != nil {
+		return nil, err
+	}
+
+	var newSavepoints []string
+	switch stmt.(type) {
+	case *sqlparser.Savepoint:
+		// A savepoint with the same name is replaced.
+		for i, sp := range savepoints {
+			if i != idx {
+				newSavepoints = append(newSavepoints, sp)
+			}
+		}
+		newSavepoints = append(newSavepoints, savepoint)
+	case *sqlparser.SRollback:
+		// The savepoints set after the one rolled back to are deleted.
+		newSavepoints = append(newSavepoints, savepoints[:idx+1]...)
+	case *sqlparser.Release:
+		// The released savepoint and the ones set after it are deleted.
+		newSavepoints = append(newSavepoints, savepoints[:idx]...)
+	}
+	safeSession.SetSavepoints(newSavepoints)
+	return &sqltypes.Result{}, nil
+}
+
+func (e *Executor) handleKill(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
+	}
+	id, err := strconv.ParseUint(string(kill.ConnectionID.Val), 10, 32)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "Unknown thread id: %s", kill.ConnectionID.Val)
+	}
+	actor := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	if err := e.processList.kill(actor, uint32(id), kill.Type == sqlparser.KillQueryStr); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
@@ -502,6 +704,9 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 		return &sqltypes.Result{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 	}
 
+	// sysVars collects the system variables that have to be
+	// applied to MySQL through reserved connections.
+	sysVars := make(map[string]string)
 	for k, v := range vals {
 		switch k.Scope {
 		case sqlparser.GlobalStr:
@@ -669,9 +874,6 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for wait_timeout: %T", v)
 			}
-		case "sql_mode", "net_write_timeout", "net_read_timeout", "lc_messages", "collation_connection", "foreign_key_checks", "sql_quote_show_create", "unique_checks":
-			log.Warningf("Ignored inapplicable SET %v = %v", k, v)
-			warnings.Add("IgnoredSet", 1)
 		case "charset", "names":
 			val, ok := v.(string)
 			if !ok {
@@ -684,12 +886,153 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, fmt.Errorf("unexpected value for charset/names: %v", val)
 			}
 		default:
-			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
+			if strings.HasPrefix(k.Key, "@") || !sessionSystemVariables[k.Key] {
+				// User defined variables are not supported,
+				// and neither are the system variables that
+				// are unsafe to set on a reserved connection.
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
+			}
+			expr, err := systemVariableExpr(k.Key, v)
+			if err != nil {
+				return nil, err
+			}
+			sysVars[k.Key] = expr
+		}
+	}
+	if len(sysVars) != 0 {
+		if err := e.setSystemVariables(ctx, safeSession, sysVars); err != nil {
+			return nil, err
 		}
 	}
 	return &sqltypes.Result{}, nil
 }
 
+// sessionSystemVariables are the system variables that can be set
+// on the reserved connections of a session. Variables that affect
+// replication or the state of the server, like sql_log_bin, gtid_next
+// or read_only, must not leak into the connection pools and are
+// not in the list.
+var sessionSystemVariables = map[string]bool{
+	"collation_connection":     true,
+	"default_week_format":      true,
+	"div_precision_increment":  true,
+	"foreign_key_checks":       true,
+	"group_concat_max_len":     true,
+	"innodb_lock_wait_timeout": true,
+	"join_buffer_size":         true,
+	"lc_messages":              true,
+	"lc_time_names":            true,
+	"lock_wait_timeout":        true,
+	"long_query_time":          true,
+	"max_execution_time":       true,
+	"max_heap_table_size":      true,
+	"max_sort_length":          true,
+	"net_read_timeout":         true,
+	"net_write_timeout":        true,
+	"optimizer_switch":         true,
+	"sort_buffer_size":         true,
+	"sql_big_selects":          true,
+	"sql_buffer_result":        true,
+	"sql_mode":                 true,
+	"sql_notes":                true,
+	"sql_quote_show_create":    true,
+	"sql_warnings":             true,
+	"time_zone":                true,
+	"tmp_table_size":           true,
+	"unique_checks":            true,
+}
+
+// setSystemVariables records the system variables in the session, so that
+// subsequent queries run on reserved connections that carry them. The
+// reserved connections the session already holds are updated. Variables
+// set to the default of the server are not recorded. If no variables are
+// left, the connections are released.
+func (e *Executor) setSystemVariables(ctx context.Context, safeSession *SafeSession, sysVars map[string]string) error {
+	names := make([]string, 0, len(sysVars))
+	for name := range sysVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	unchanged, err := e.unchangedSystemVariables(ctx, safeSession, names, sysVars)
+	if err != nil {
+		return err
+	}
+	setExprs := make([]string, 0, len(names))
+	for _, name := range names {
+		expr := sysVars[name]
+		if expr == "default" || unchanged[name] {
+			safeSession.SetSystemVariable(name, "")
+		} else {
+			safeSession.SetSystemVariable(name, expr)
+		}
+		setExprs = append(setExprs, fmt.Sprintf("%s = %s", name, expr))
+	}
+
+	if len(safeSession.SystemVariables) == 0 && len(safeSession.TemporaryTables) == 0 && !safeSession.InTransaction() {
+		return e.txConn.Release(ctx, safeSession)
+	}
+	return e.txConn.SetSystemVariables(ctx, safeSession, "set "+strings.Join(setExprs, ", "))
+}
+
+// unchangedSystemVariables compares the values of the system variables
+// with their defaults on a tablet of the target keyspace, and returns
+// the ones that are set to their default. If the keyspace is not known,
+// all the variables are assumed to change.
+func (e *Executor) unchangedSystemVariables(ctx context.Context, safeSession *SafeSession, names []string, sysVars map[string]string) (map[string]bool, error) {
+	destKeyspace, destTabletType, _, err := e.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil {
+		return nil, err
+	}
+	if destKeyspace == "" {
+		return nil, nil
+	}
+	var checked []string
+	var exprs []string
+	for _, name := range names {
+		if sysVars[name] == "default" {
+			continue
+		}
+		checked = append(checked, name)
+		exprs = append(exprs, fmt.Sprintf("@@%s <=> %s", name, sysVars[name]))
+	}
+	if len(checked) == 0 {
+		return nil, nil
+	}
+	qr, err := e.resolver.Execute(ctx, "select "+strings.Join(exprs, ", ")+" from dual", nil, destKeyspace, destTabletType, key.DestinationAnyShard{}, &vtgatepb.Session{}, false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != len(checked) {
+		return nil, nil
+	}
+	unchanged := make(map[string]bool)
+	for i, name := range checked {
+		if qr.Rows[0][i].ToString() == "1" {
+			unchanged[name] = true
+		}
+	}
+	return unchanged, nil
+}
+
+// systemVariableExpr returns the SQL expression for the value of a system variable.
+func systemVariableExpr(name string, v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "null", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		if strings.EqualFold(v, "default") {
+			return "default", nil
+		}
+		return sqlparser.String(sqlparser.NewStrVal([]byte(v))), nil
+	default:
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for %s: %T", name, v)
+	}
+}
+
 func (e *Executor) handleSetVitessMetadata(ctx context.Context, session *SafeSession, k sqlparser.SetKey, v interface{}) (*sqltypes.Result, error) {
 	//TODO(kalfonso): move to its own acl check and consolidate into an acl component that can handle multiple operations (vschema, metadata)
 	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx))
@@ -801,6 +1144,9 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			}
 			return e.handleOther(ctx, safeSession, sql, bindVars, dest, keyspaces[0], destTabletType, logStats)
 		}
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		actor := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+		return e.processList.result(actor), nil
 	// for STATUS, return empty result set
 	case sqlparser.KeywordString(sqlparser.STATUS):
 		return &sqltypes.Result{
@@ -1197,6 +1543,12 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		return err
 	}
 
+	e.addNeededBindVars(plan, bindVars, safeSession)
+	if plan.QueryTimeout != 0 {
+		cancel := vcursor.SetContextTimeout(plan.QueryTimeout)
+		defer cancel()
+	}
+
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
@@ -1206,6 +1558,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	// dictated by stream_buffer_size.
 	result := &sqltypes.Result{}
 	byteCount := 0
+	rowCount := 0
 	err = plan.Instructions.StreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
 		// If the row has field info, send it separately.
 		// TODO(sougou): this behavior is for handling tests because
@@ -1217,6 +1570,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 			}
 		}
 
+		rowCount += len(qr.Rows)
 		for _, row := range qr.Rows {
 			result.Rows = append(result.Rows, row)
 			for _, col := range row {
@@ -1244,6 +1598,9 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 
 	logStats.ExecuteTime = time.Since(execStart)
 
+	if err == nil && logStats.StmtType == sqlparser.StmtSelect.String() && !vcursor.foundRowsSet {
+		safeSession.FoundRows = uint64(rowCount)
+	}
 	return err
 }
 
@@ -1405,21 +1762,26 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	if e.VSchema() == nil {
 		return nil, errors.New("vschema not initialized")
 	}
+	// The temporary tables of a session change how its queries are
+	// routed, so such sessions neither use nor fill the plan cache.
+	useCache := vcursor.safeSession == nil || len(vcursor.safeSession.TemporaryTables) == 0
 	keyspace := vcursor.keyspace
 	planKey := keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + sql
-	if result, ok := e.plans.Get(planKey); ok {
-		return result.(*engine.Plan), nil
+	if useCache {
+		if result, ok := e.plans.Get(planKey); ok {
+			return result.(*engine.Plan), nil
+		}
 	}
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
 		return nil, err
 	}
 	if !e.normalize {
-		plan, err := planbuilder.BuildFromStmt(sql, stmt, vcursor, false, false)
+		plan, err := planbuilder.BuildFromStmt(sql, stmt, vcursor, false, false, false)
 		if err != nil {
 			return nil, err
 		}
-		if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(stmt) {
+		if useCache && !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(stmt) {
 			e.plans.Set(planKey, plan)
 		}
 		return plan, nil
@@ -1439,14 +1801,16 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 
 	planKey = keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + normalized
-	if result, ok := e.plans.Get(planKey); ok {
-		return result.(*engine.Plan), nil
+	if useCache {
+		if result, ok := e.plans.Get(planKey); ok {
+			return result.(*engine.Plan), nil
+		}
 	}
-	plan, err := planbuilder.BuildFromStmt(normalized, rewrittenStatement, vcursor, result.NeedLastInsertID, result.NeedDatabase)
+	plan, err := planbuilder.BuildFromStmt(normalized, rewrittenStatement, vcursor, result.NeedLastInsertID, result.NeedDatabase, result.NeedFoundRows)
 	if err != nil {
 		return nil, err
 	}
-	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(rewrittenStatement) {
+	if useCache && !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(rewrittenStatement) {
 		e.plans.Set(planKey, plan)
 	}
 	return plan, nil
@@ -1681,7 +2045,8 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease,
+		sqlparser.StmtKill:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -491,7 +491,7 @@ func TestDeleteScatter(t *testing.T) {
 
 func TestDeleteByDestination(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
-	// This query is not supported in v3, so we know for sure is taking the DeleteByDestination route
+	// Keyrange targeting makes the delete take the DeleteByDestination route
 	_, err := executorExec(executor, "delete from `TestExecutor[-]`.user_extra limit 10", nil)
 	require.NoError(t, err)
 	// Queries get annotatted.
@@ -905,9 +905,18 @@ func TestInsertOnDupKey(t *testing.T) {
 	// correctly. The full set of use cases are covered by TestInsertShardedIgnore.
 	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
 	query := "insert into insert_ignore_test(pv, owned, verify) values (1, 1, 1) on duplicate key update col = 2"
+	// The row doesn't exist yet.
+	sbc1.SetResults([]*sqltypes.Result{{}})
 	_, err := executorExec(executor, query, nil)
 	require.NoError(t, err)
 	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select pv = :_pv0, pv, owned from insert_ignore_test where pv = :_pv0 for update",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_pv0":     sqltypes.Int64BindVariable(1),
+			"_owned0":  sqltypes.Int64BindVariable(1),
+			"_verify0": sqltypes.Int64BindVariable(1),
+		},
+	}, {
 		Sql: "insert into insert_ignore_test(pv, owned, verify) values (:_pv0, :_owned0, :_verify0) on duplicate key update col = 2 /* vtgate:: keyspace_id:166b40b44aba4bd6 */",
 		BindVariables: map[string]*querypb.BindVariable{
 			"_pv0":     sqltypes.Int64BindVariable(1),
@@ -942,6 +951,33 @@ func TestInsertOnDupKey(t *testing.T) {
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
 		t.Errorf("sbclookup.Queries: \n%+v, want \n%+v", sbclookup.Queries, wantQueries)
 	}
+
+	// If the row exists, its owned vindex entry is kept as is.
+	sbc1.Queries = nil
+	sbclookup.Queries = nil
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("conflict|pv|owned", "int64|int64|int64"),
+		"1|1|2",
+	)})
+	_, err = executorExec(executor, query, nil)
+	require.NoError(t, err)
+	if len(sbc1.Queries) != 2 {
+		t.Errorf("sbc1.Queries: %+v, want 2 queries", sbc1.Queries)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "select user_id from music_user_map where music_id = :music_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		Sql: "select user_id from music_user_map where music_id = :music_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id": sqltypes.Int64BindVariable(1),
+		},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("sbclookup.Queries: \n%+v, want \n%+v", sbclookup.Queries, wantQueries)
+	}
 }
 
 func TestInsertComments(t *testing.T) {
@@ -1782,6 +1818,8 @@ func TestUpdateLastInsertID(t *testing.T) {
 
 	sql := "update user set a = last_insert_id() where id = 1"
 	masterSession.LastInsertId = 43
+	// The update doesn't generate an id, so the session keeps its last insert id.
+	defer func() { masterSession.LastInsertId = 0 }()
 	_, err := executorExec(executor, sql, map[string]*querypb.BindVariable{})
 	require.NoError(t, err)
 	wantQueries := []*querypb.BoundQuery{{
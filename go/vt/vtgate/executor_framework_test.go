@@ -201,6 +201,11 @@ var executorVSchema = `
 					"column": "verify",
 					"name": "hash_index"
 				}
+			],
+			"unique_keys": [
+				{
+					"columns": ["pv"]
+				}
 			]
 		},
 		"noauto_table": {
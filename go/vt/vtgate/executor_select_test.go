@@ -224,15 +224,79 @@ func TestSelectLastInsertId(t *testing.T) {
 	logChan := QueryLogger.Subscribe("Test")
 	defer QueryLogger.Unsubscribe(logChan)
 
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", LastInsertId: 52})
 	sql := "select last_insert_id()"
-	_, err := executorExec(executor, sql, map[string]*querypb.BindVariable{})
+	result, err := executor.Execute(context.Background(), "TestExecute", session, sql, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "last_insert_id()", Type: sqltypes.Uint64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewUint64(52),
+		}},
+		RowsAffected: 1,
+	}
+	assert.Equal(t, wantResult, result)
+
+	// The value is computed by vtgate, and no query is sent to the shards.
+	assert.Empty(t, sbc1.Queries)
+}
+
+func TestSelectFoundRows(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	sbclookup.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2", "3"),
+	})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "select id from main1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, session.FoundRows)
+
+	sbclookup.Queries = nil
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "select found_rows()", nil)
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "found_rows()", Type: sqltypes.Uint64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewUint64(3),
+		}},
+		RowsAffected: 1,
+	}
+	assert.Equal(t, wantResult, result)
+	assert.Empty(t, sbclookup.Queries)
+}
+
+func TestSelectSQLCalcFoundRows(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	sbclookup.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2"),
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "5"),
+	})
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "select sql_calc_found_rows id from main1 limit 2", nil)
 	require.NoError(t, err)
+	assert.Len(t, result.Rows, 2)
+	assert.EqualValues(t, 5, session.FoundRows)
 	wantQueries := []*querypb.BoundQuery{{
-		Sql:           "select :__lastInsertId as `last_insert_id()` from dual",
-		BindVariables: map[string]*querypb.BindVariable{"__lastInsertId": sqltypes.Uint64BindVariable(0)},
+		Sql:           "select id from main1 limit :vtg1",
+		BindVariables: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(2)},
+	}, {
+		Sql:           "select count(*) from main1",
+		BindVariables: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(2)},
 	}}
+	assert.Equal(t, wantQueries, sbclookup.Queries)
 
-	assert.Equal(t, wantQueries, sbc1.Queries)
+	// The rows counted by the select are returned by FOUND_ROWS().
+	result, err = executor.Execute(context.Background(), "TestExecute", session, "select found_rows() as cnt", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(5)}}, result.Rows)
 }
 
 func TestSelectLastInsertIdInUnion(t *testing.T) {
@@ -341,6 +405,26 @@ func TestSelectDatabase(t *testing.T) {
 	assert.Equal(t, wantQueries, sbc1.Queries)
 }
 
+func TestSelectSystemTableSchema(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	sql := "select table_name from information_schema.tables where table_schema = 'TestUnsharded'"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	// The query goes to the keyspace it's about, not the default one.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select table_name from information_schema.`tables` where table_schema = :__vtschemaname",
+		BindVariables: map[string]*querypb.BindVariable{
+			"vtg1":           sqltypes.BytesBindVariable([]byte("TestUnsharded")),
+			"__vtschemaname": sqltypes.StringBindVariable("TestUnsharded"),
+		},
+	}}
+	assert.Equal(t, wantQueries, sbclookup.Queries)
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+}
+
 func TestSelectBindvars(t *testing.T) {
 	executor, sbc1, sbc2, lookup := createExecutorEnv()
 	logChan := QueryLogger.Subscribe("Test")
@@ -121,7 +121,7 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{TargetString: "@master"}
+	wantSession = &vtgatepb.Session{TargetString: "@master", FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("begin: %v, want %v", session.Session, wantSession)
 	}
@@ -146,7 +146,7 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{TargetString: "@master"}
+	wantSession = &vtgatepb.Session{TargetString: "@master", FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("begin: %v, want %v", session.Session, wantSession)
 	}
@@ -244,7 +244,7 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	wantSession = &vtgatepb.Session{TargetString: "@master", Autocommit: true, FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("begin: %v, want %v", session.Session, wantSession)
 	}
@@ -274,7 +274,7 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	wantSession = &vtgatepb.Session{TargetString: "@master", Autocommit: true, FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("begin: %v, want %v", session.Session, wantSession)
 	}
@@ -434,6 +434,21 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set foo = 1",
 		err: "unsupported construct: set foo = 1",
+	}, {
+		in:  "set sql_log_bin = 0",
+		err: "unsupported construct: set sql_log_bin = 0",
+	}, {
+		in:  "set gtid_next = 'automatic'",
+		err: "unsupported construct: set gtid_next = 'automatic'",
+	}, {
+		in:  "set read_only = 1",
+		err: "unsupported construct: set read_only = 1",
+	}, {
+		in:  "set long_query_time = 1.5",
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"long_query_time": "1.5"}},
+	}, {
+		in:  "set @foo = 1",
+		err: "unsupported construct: set @foo = 1",
 	}, {
 		in:  "set names utf8",
 		out: &vtgatepb.Session{Autocommit: true},
@@ -451,21 +466,24 @@ func TestExecutorSet(t *testing.T) {
 		err: "unexpected value for charset/names: ascii",
 	}, {
 		in:  "set net_write_timeout = 600",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"net_write_timeout": "600"}},
 	}, {
 		in:  "set sql_mode = 'STRICT_ALL_TABLES'",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"sql_mode": "'strict_all_tables'"}},
 	}, {
 		in:  "set net_read_timeout = 600",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"net_read_timeout": "600"}},
 	}, {
 		in:  "set sql_quote_show_create = 1",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"sql_quote_show_create": "1"}},
 	}, {
 		in:  "set foreign_key_checks = 0",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"foreign_key_checks": "0"}},
 	}, {
 		in:  "set unique_checks = 0",
+		out: &vtgatepb.Session{Autocommit: true, SystemVariables: map[string]string{"unique_checks": "0"}},
+	}, {
+		in:  "set sql_mode = default",
 		out: &vtgatepb.Session{Autocommit: true},
 	}, {
 		in:  "set skip_query_plan_cache = 1",
@@ -507,6 +525,388 @@ func TestExecutorSet(t *testing.T) {
 	}
 }
 
+func TestExecutorSetSystemVariables(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = 'traditional'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sql_mode": "'traditional'"}, session.SystemVariables)
+	assert.Empty(t, session.ShardSessions)
+
+	// The first query reserves a connection carrying the variable.
+	sql := "select id from user where id = 1"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	reservedID := session.ShardSessions[0].ReservedId
+	assert.NotZero(t, reservedID)
+	assert.Zero(t, session.ShardSessions[0].TransactionId)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "set sql_mode = 'traditional'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           sql,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           sql,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	testQueries(t, "sbc1", sbc1, wantQueries)
+
+	// A transaction is started on the reserved connection,
+	// which is retained after the commit.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+	require.NoError(t, err)
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, reservedID, session.ShardSessions[0].TransactionId)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	testCommitCount(t, "sbc1", sbc1, 1)
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, reservedID, session.ShardSessions[0].ReservedId)
+	assert.Zero(t, session.ShardSessions[0].TransactionId)
+
+	// Changing the variable updates the reserved connection.
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{{
+		Sql:           "set sql_mode = ''",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+
+	// Resetting the last variable releases the reserved connection.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = default", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.Empty(t, session.SystemVariables)
+	assert.Empty(t, session.ShardSessions)
+}
+
+func TestExecutorSetSystemVariablesUnchanged(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+
+	// A variable set to the default of the server is not recorded.
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@net_write_timeout <=> 600|@@sql_mode <=> 'traditional'", "int64|int64"),
+		"1|0",
+	)})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = 'traditional', net_write_timeout = 600", nil)
+	require.NoError(t, err)
+	testQueries(t, "sbclookup", sbclookup, []*querypb.BoundQuery{{
+		Sql:           "select @@net_write_timeout <=> 600, @@sql_mode <=> 'traditional' from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+	assert.Equal(t, map[string]string{"sql_mode": "'traditional'"}, session.SystemVariables)
+
+	// Setting the last variable back to the default releases the connection.
+	sbclookup.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from music_user_map where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReserveCount.Get())
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@sql_mode <=> ''", "int64"),
+		"1",
+	)})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.SystemVariables)
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 1, sbclookup.ReleaseCount.Get())
+}
+
+func TestExecutorSetSystemVariablesReplica(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@replica", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = 'traditional'", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "system variables and temporary tables are only supported on master: cannot target replica")
+	assert.EqualValues(t, 0, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+}
+
+func TestExecutorTemporaryTables(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", Autocommit: true})
+
+	// The temporary table is created on a reserved connection
+	// of the first shard of the sharded keyspace.
+	create := "create temporary table temp_t (id bigint)"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, create, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TestExecutor.temp_t"}, session.TemporaryTables)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	assert.Equal(t, "-20", session.ShardSessions[0].Target.Shard)
+
+	// Inserts, queries and DMLs on the temporary table are sent
+	// to that shard, on the same connection, and their plans
+	// are not cached.
+	sbc1.Queries = nil
+	insert := "insert into temp_t(id) values (1), (2)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, insert, nil)
+	require.NoError(t, err)
+	sql := "select id from temp_t where id = 1"
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")})
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(qr.Rows))
+	update := "update temp_t set id = 3 where id = 2"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, update, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{{
+		Sql:           insert,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           sql,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           update + " /* vtgate:: keyspace_id:00 */",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+	assert.Empty(t, executor.plans.Keys())
+
+	// The rows of a select could come from any shard.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "insert into temp_t(id) select id from user", nil)
+	require.EqualError(t, err, "unsupported: subquery in insert into temporary table temp_t of a sharded keyspace")
+
+	// Dropping the last temporary table releases the connection.
+	sbc1.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "drop temporary table temp_t", nil)
+	require.NoError(t, err)
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{{
+		Sql:           "drop temporary table temp_t",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.Empty(t, session.TemporaryTables)
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@replica", Autocommit: true})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, create, nil)
+	require.EqualError(t, err, "temporary tables are only supported on master")
+	assert.Empty(t, session.TemporaryTables)
+}
+
+func TestExecutorTemporaryTableDDL(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", Autocommit: true})
+	execute := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+	bq := func(sql string) *querypb.BoundQuery {
+		return &querypb.BoundQuery{
+			Sql:           sql,
+			BindVariables: map[string]*querypb.BindVariable{},
+		}
+	}
+	require.NoError(t, execute("create temporary table temp_t (id bigint)"))
+
+	// DDLs that name the temporary table without the TEMPORARY
+	// keyword are also sent to its shard only.
+	sbc1.Queries = nil
+	require.NoError(t, execute("alter table temp_t add column col bigint"))
+	require.NoError(t, execute("truncate table temp_t"))
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{
+		bq("alter table temp_t add column col bigint"),
+		bq("truncate table temp_t"),
+	})
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+
+	// A temporary and a regular table can't be dropped together.
+	sbc1.Queries = nil
+	require.EqualError(t, execute("drop table temp_t, music"), "unsupported: drop of temporary and regular tables in a single statement")
+	assert.Empty(t, sbc1.Queries)
+	assert.Equal(t, []string{"TestExecutor.temp_t"}, session.TemporaryTables)
+
+	require.NoError(t, execute("drop table temp_t"))
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{bq("drop table temp_t")})
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.Empty(t, session.TemporaryTables)
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+}
+
+func TestExecutorSavepoint(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true, TransactionMode: vtgatepb.TransactionMode_MULTI})
+	execute := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+	bq := func(sql string) *querypb.BoundQuery {
+		return &querypb.BoundQuery{
+			Sql:           sql,
+			BindVariables: map[string]*querypb.BindVariable{},
+		}
+	}
+
+	// Outside of a transaction, savepoints are no-ops.
+	require.NoError(t, execute("savepoint a"))
+	assert.Empty(t, session.Savepoints)
+	require.EqualError(t, execute("rollback to a"), "SAVEPOINT a does not exist")
+
+	require.NoError(t, execute("begin"))
+	require.NoError(t, execute("savepoint a"))
+	assert.Equal(t, []string{"savepoint a"}, session.Savepoints)
+
+	// The savepoint is replayed on the shard joining the transaction.
+	require.NoError(t, execute("select id from user where id = 1"))
+	require.NoError(t, execute("savepoint b"))
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{
+		bq("savepoint a"),
+		bq("select id from user where id = 1"),
+		bq("savepoint b"),
+	})
+	require.NoError(t, execute("select id from user where id = 3"))
+	testQueries(t, "sbc2", sbc2, []*querypb.BoundQuery{
+		bq("savepoint a"),
+		bq("savepoint b"),
+		bq("select id from user where id = 3"),
+	})
+	if got := sbc2.BeginCount.Get(); got != 1 {
+		t.Errorf("sbc2.BeginCount: %d, want 1", got)
+	}
+
+	// Rolling back to a savepoint applies to all shards.
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	require.NoError(t, execute("rollback to savepoint A"))
+	assert.Equal(t, []string{"savepoint a"}, session.Savepoints)
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{bq("rollback to A")})
+	testQueries(t, "sbc2", sbc2, []*querypb.BoundQuery{bq("rollback to A")})
+	require.EqualError(t, execute("release savepoint b"), "SAVEPOINT b does not exist")
+
+	require.NoError(t, execute("savepoint c"))
+	require.NoError(t, execute("release savepoint a"))
+	assert.Empty(t, session.Savepoints)
+
+	require.NoError(t, execute("savepoint d"))
+	require.NoError(t, execute("commit"))
+	assert.Empty(t, session.Savepoints)
+	testCommitCount(t, "sbc1", sbc1, 1)
+	testCommitCount(t, "sbc2", sbc2, 1)
+}
+
+func TestExecutorLock(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@master", Autocommit: true})
+	execute := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+	wantLockSession := &vtgatepb.Session_ShardSession{
+		Target:     &querypb.Target{Keyspace: "TestExecutor", Shard: "-20", TabletType: topodatapb.TabletType_MASTER},
+		ReservedId: 1,
+	}
+
+	// The lock keyspace is required with more than one keyspace.
+	err := execute("select get_lock('a', 10) from dual")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lock functions require the lock_keyspace flag when there is more than one keyspace")
+
+	defer func(ks string) { *lockKeyspace = ks }(*lockKeyspace)
+	*lockKeyspace = "TestExecutor"
+
+	// The lock connection is reserved on the lock shard on first use,
+	// whatever the keyspace targeted by the session.
+	session.TargetString = "TestUnsharded@master"
+	require.NoError(t, execute("select get_lock('a', 10) from dual"))
+	session.TargetString = "TestExecutor@master"
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc2.ReserveCount.Get())
+	assert.True(t, proto.Equal(wantLockSession, session.LockSession), "LockSession: %v, want %v", session.LockSession, wantLockSession)
+
+	// Lock functions run on the lock connection, outside of any transaction.
+	require.NoError(t, execute("begin"))
+	require.NoError(t, execute("select release_lock('a') from dual"))
+	require.NoError(t, execute("rollback"))
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 0, sbc1.BeginCount.Get())
+	assert.Empty(t, session.ShardSessions)
+	assert.True(t, proto.Equal(wantLockSession, session.LockSession), "LockSession: %v, want %v", session.LockSession, wantLockSession)
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{{
+		Sql:           "select get_lock('a', 10) from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select release_lock('a') from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+
+	// A lost lock connection is replaced by the next lock query.
+	sbc1.MustFailCodes[vtrpcpb.Code_ABORTED] = 1
+	require.Error(t, execute("select is_free_lock('a') from dual"))
+	assert.Nil(t, session.LockSession)
+	require.NoError(t, execute("select is_free_lock('a') from dual"))
+	assert.EqualValues(t, 2, sbc1.ReserveCount.Get())
+	assert.NotNil(t, session.LockSession)
+
+	// Closing the session releases the lock connection.
+	require.NoError(t, executor.txConn.Release(context.Background(), session))
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get())
+	assert.Nil(t, session.LockSession)
+}
+
+func TestExecutorProcessList(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	ctx := callerid.NewContext(context.Background(), &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: "user1"})
+
+	closed := false
+	executor.processList.add(7, "user1", "localhost:3306", func() { closed = true })
+	queryCtx, cancel := context.WithCancel(context.Background())
+	executor.processList.startQuery(7, "TestExecutor", "select sleep(100) from dual", cancel)
+
+	// The process list is answered by vtgate.
+	result, err := executor.Execute(ctx, "TestExecute", session, "show full processlist", nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewUint64(7),
+		sqltypes.NewVarChar("user1"),
+		sqltypes.NewVarChar("localhost:3306"),
+		sqltypes.NewVarChar("TestExecutor"),
+		sqltypes.NewVarChar("Query"),
+		sqltypes.NewInt64(0),
+		sqltypes.NewVarChar("executing"),
+		sqltypes.NewVarChar("select sleep(100) from dual"),
+	}}
+	assert.Equal(t, wantRows, result.Rows)
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbclookup.Queries)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill query 7", nil)
+	require.NoError(t, err)
+	assert.Equal(t, context.Canceled, queryCtx.Err())
+	assert.False(t, closed)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 7", nil)
+	require.NoError(t, err)
+	assert.True(t, closed)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 8", nil)
+	require.EqualError(t, err, "Unknown thread id: 8")
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 8589934592", nil)
+	require.EqualError(t, err, "Unknown thread id: 8589934592")
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -609,7 +1009,7 @@ func TestExecutorAutocommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession := &vtgatepb.Session{TargetString: "@master", InTransaction: true}
+	wantSession := &vtgatepb.Session{TargetString: "@master", InTransaction: true, FoundRows: 1}
 	testSession := *session.Session
 	testSession.ShardSessions = nil
 	if !proto.Equal(&testSession, wantSession) {
@@ -643,7 +1043,7 @@ func TestExecutorAutocommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{Autocommit: true, TargetString: "@master"}
+	wantSession = &vtgatepb.Session{Autocommit: true, TargetString: "@master", FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("autocommit=1: %v, want %v", session.Session, wantSession)
 	}
@@ -672,7 +1072,7 @@ func TestExecutorAutocommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{InTransaction: true, Autocommit: true, TargetString: "@master"}
+	wantSession = &vtgatepb.Session{InTransaction: true, Autocommit: true, TargetString: "@master", FoundRows: 1}
 	testSession = *session.Session
 	testSession.ShardSessions = nil
 	if !proto.Equal(&testSession, wantSession) {
@@ -694,7 +1094,7 @@ func TestExecutorAutocommit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wantSession = &vtgatepb.Session{Autocommit: true, TargetString: "@master"}
+	wantSession = &vtgatepb.Session{Autocommit: true, TargetString: "@master", FoundRows: 1}
 	if !proto.Equal(session.Session, wantSession) {
 		t.Errorf("autocommit=1: %v, want %v", session.Session, wantSession)
 	}
@@ -2455,6 +2855,20 @@ func TestGetPlanNormalized(t *testing.T) {
 	}
 }
 
+func TestGetPlanQueryTimeout(t *testing.T) {
+	r, _, _, _ := createExecutorEnv()
+	emptyvc := newVCursorImpl(context.Background(), nil, "", 0, makeComments(""), r, nil)
+
+	logStats := NewLogStats(context.Background(), "Test", "", nil)
+	plan, err := r.getPlan(emptyvc, "select /*vt+ QUERY_TIMEOUT_MS=500 */ id from music_user_map where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false, logStats)
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, plan.QueryTimeout)
+
+	plan, err = r.getPlan(emptyvc, "select id from music_user_map where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false, logStats)
+	require.NoError(t, err)
+	assert.Zero(t, plan.QueryTimeout)
+}
+
 func TestPassthroughDDL(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 	masterSession.TargetString = "TestExecutor"
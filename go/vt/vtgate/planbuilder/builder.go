@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -122,6 +123,7 @@ type ContextVSchema interface {
 	FindTablesOrVindex(tablename sqlparser.TableName) ([]*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error)
 	DefaultKeyspace() (*vindexes.Keyspace, error)
 	TargetString() string
+	LockTarget() (*vindexes.Keyspace, key.Destination, error)
 }
 
 //-------------------------------------------------------------------------
@@ -263,14 +265,14 @@ func Build(query string, vschema ContextVSchema) (*engine.Plan, error) {
 		return nil, err
 	}
 
-	return BuildFromStmt(query, result.AST, vschema, result.NeedLastInsertID, result.NeedDatabase)
+	return BuildFromStmt(query, result.AST, vschema, result.NeedLastInsertID, result.NeedDatabase, result.NeedFoundRows)
 }
 
 // BuildFromStmt builds a plan based on the AST provided.
 // TODO(sougou): The query input is trusted as the source
 // of the AST. Maybe this function just returns instructions
 // and engine.Plan can be built by the caller.
-func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchema, needsLastInsertID, needsDBName bool) (*engine.Plan, error) {
+func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchema, needsLastInsertID, needsDBName, needsFoundRows bool) (*engine.Plan, error) {
 	var err error
 	var instruction engine.Primitive
 	switch stmt := stmt.(type) {
@@ -313,6 +315,8 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 		Instructions:      instruction,
 		NeedsLastInsertID: needsLastInsertID,
 		NeedsDatabaseName: needsDBName,
+		NeedsFoundRows:    needsFoundRows,
+		QueryTimeout:      time.Duration(sqlparser.QueryTimeoutDirective(stmt)) * time.Millisecond,
 	}
 	return plan, nil
 }
@@ -0,0 +1,378 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var errRecursiveCrossShard = errors.New("unsupported: recursive common table expression in cross-shard query")
+
+// processWith prepares the WITH clause of a SELECT statement for planning.
+//
+// The common table expressions of a non-recursive WITH clause are treated
+// like derived tables: every reference to them is replaced by a copy of
+// their subquery, and the WITH clause is removed from the statement. The
+// subqueries then get merged into the route of the outer query whenever
+// possible.
+//
+// The common table expressions of a recursive WITH clause cannot be expanded.
+// The WITH clause is instead passed through as is, which is possible only if
+// the entire query goes to a single unsharded keyspace, or to a single shard
+// of a sharded keyspace. References to the common table expressions are
+// resolved as tables of that keyspace. The caller must verify the resulting
+// route with checkRecursiveWith.
+func (pb *primitiveBuilder) processWith(stmt sqlparser.SelectStatement, with *sqlparser.With) error {
+	if with.Recursive {
+		return pb.processRecursiveWith(with)
+	}
+	ctes := make(map[string]*sqlparser.CommonTableExpr)
+	for _, cte := range with.CTEs {
+		// A common table expression can reference the ones before it.
+		if err := expandCTEs(cte.Subquery, ctes); err != nil {
+			return err
+		}
+		ctes[cte.Name.String()] = cte
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.With = nil
+	case *sqlparser.Union:
+		stmt.With = nil
+	}
+	return expandCTEs(stmt, ctes)
+}
+
+// expandCTEs replaces the references to the specified common table
+// expressions by derived tables.
+func expandCTEs(root sqlparser.SQLNode, ctes map[string]*sqlparser.CommonTableExpr) error {
+	if len(ctes) == 0 {
+		return nil
+	}
+	var err error
+	sqlparser.Rewrite(root, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			if node != root && node.With != nil {
+				err = expandCTEs(node, shadowCTEs(ctes, node.With))
+				return false
+			}
+		case *sqlparser.Union:
+			if node != root && node.With != nil {
+				err = expandCTEs(node, shadowCTEs(ctes, node.With))
+				return false
+			}
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true
+			}
+			cte, ok := ctes[tableName.Name.String()]
+			if !ok {
+				return true
+			}
+			var subquery *sqlparser.Subquery
+			subquery, err = cteSubquery(cte)
+			if err != nil {
+				return false
+			}
+			node.Expr = subquery
+			if node.As.IsEmpty() {
+				node.As = tableName.Name
+			}
+			// The subquery was already expanded.
+			return false
+		}
+		return true
+	}, nil)
+	return err
+}
+
+// shadowCTEs returns the common table expressions that remain
+// visible inside a statement that has its own WITH clause.
+func shadowCTEs(ctes map[string]*sqlparser.CommonTableExpr, with *sqlparser.With) map[string]*sqlparser.CommonTableExpr {
+	visible := make(map[string]*sqlparser.CommonTableExpr, len(ctes))
+	for name, cte := range ctes {
+		visible[name] = cte
+	}
+	for _, cte := range with.CTEs {
+		delete(visible, cte.Name.String())
+	}
+	return visible
+}
+
+// cteSubquery returns a copy of the subquery of the common table
+// expression, with its columns renamed as per the column list.
+// A copy is required because the planner modifies the AST, and a
+// common table expression can be referenced more than once.
+func cteSubquery(cte *sqlparser.CommonTableExpr) (*sqlparser.Subquery, error) {
+	stmt, err := sqlparser.Parse(sqlparser.String(cte.Subquery.Select))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
+	}
+	if len(cte.Columns) == 0 {
+		return &sqlparser.Subquery{Select: sel}, nil
+	}
+
+	// The column names are determined by the first SELECT of a UNION.
+	first := firstSelect(sel)
+	firstSel, ok := first.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected SELECT type: %T", first)
+	}
+	if len(firstSel.SelectExprs) != len(cte.Columns) {
+		return nil, fmt.Errorf("column count of common table expression %s does not match its column list", cte.Name.String())
+	}
+	for i, selectExpr := range firstSel.SelectExprs {
+		expr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: '*' expression in common table expression with a column list: %s", cte.Name.String())
+		}
+		expr.As = cte.Columns[i]
+	}
+	return &sqlparser.Subquery{Select: sel}, nil
+}
+
+// firstSelect returns the first SELECT of a UNION.
+func firstSelect(sel sqlparser.SelectStatement) sqlparser.SelectStatement {
+	for {
+		switch node := sel.(type) {
+		case *sqlparser.Union:
+			sel = node.Left
+			continue
+		case *sqlparser.ParenSelect:
+			sel = node.Select
+			continue
+		}
+		return sel
+	}
+}
+
+// processRecursiveWith identifies the keyspace referenced by the
+// recursive WITH clause, and makes the common table expressions resolve
+// to tables of that keyspace.
+func (pb *primitiveBuilder) processRecursiveWith(with *sqlparser.With) error {
+	names := make(map[string]bool)
+	for _, cte := range with.CTEs {
+		names[cte.Name.String()] = true
+	}
+	var keyspace *vindexes.Keyspace
+	readsShardedTables := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || (tableName.Qualifier.IsEmpty() && names[tableName.Name.String()]) {
+			return true, nil
+		}
+		vst, _, _, _, err := pb.vschema.FindTable(tableName)
+		if err != nil {
+			return false, err
+		}
+		if keyspace != nil && keyspace.Name != vst.Keyspace.Name {
+			return false, errRecursiveCrossShard
+		}
+		keyspace = vst.Keyspace
+		if vst.Keyspace.Sharded && vst.Type != vindexes.TypeReference {
+			readsShardedTables = true
+		}
+		return true, nil
+	}, with)
+	if err != nil {
+		return err
+	}
+	if keyspace == nil {
+		// The common table expressions don't reference any table.
+		keyspace, err = pb.vschema.DefaultKeyspace()
+		if err != nil {
+			return err
+		}
+	}
+	pb.vschema = &cteVSchema{
+		ContextVSchema:     pb.vschema,
+		keyspace:           keyspace,
+		names:              names,
+		with:               with,
+		readsShardedTables: readsShardedTables,
+	}
+	return nil
+}
+
+// checkRecursiveWith verifies that a query with a recursive WITH clause
+// was built as a single route to the keyspace of the common table
+// expressions. Only then can the WITH clause be passed through.
+// If the keyspace is sharded, the route must also go to a single
+// shard, and the common table expressions then only see the rows of
+// that shard. So, if their anchor members reference sharded tables,
+// the shard is determined by them, and the query must either have an
+// equality on the same unique vindex value, or reference only the
+// common table expressions. Otherwise, the query must have an
+// equality on a unique vindex, or reference only the common table
+// expressions and reference tables.
+func (pb *primitiveBuilder) checkRecursiveWith() error {
+	vschema, ok := pb.vschema.(*cteVSchema)
+	if !ok {
+		return errors.New("BUG: recursive common table expressions were not processed")
+	}
+	rb, ok := pb.bldr.(*route)
+	if !ok || !rb.removeOptionsWithUnmatchedKeyspace(vschema.keyspace.Name) {
+		return errRecursiveCrossShard
+	}
+	if !vschema.keyspace.Sharded {
+		return nil
+	}
+	anchor, err := vschema.anchorRoute()
+	if err != nil {
+		return err
+	}
+	if anchor == nil {
+		if vschema.readsShardedTables {
+			return errRecursiveCrossShard
+		}
+		if rb.removeOptions(func(ro *routeOption) bool {
+			return ro.eroute.Opcode == engine.SelectEqualUnique
+		}) {
+			return nil
+		}
+		if !rb.removeOptions(func(ro *routeOption) bool {
+			return ro.eroute.Opcode == engine.SelectReference
+		}) {
+			return errRecursiveCrossShard
+		}
+		return nil
+	}
+	if rb.removeOptions(func(ro *routeOption) bool {
+		return ro.eroute.Opcode == engine.SelectEqualUnique && ro.eroute.Vindex == anchor.Vindex && matchesAnchorValue(ro, anchor)
+	}) {
+		return nil
+	}
+	if !rb.removeOptions(func(ro *routeOption) bool {
+		return ro.eroute.Opcode == engine.SelectReference
+	}) {
+		return errRecursiveCrossShard
+	}
+	for _, ro := range rb.routeOptions {
+		ro.eroute.Opcode = anchor.Opcode
+		ro.eroute.Vindex = anchor.Vindex
+		ro.eroute.Values = anchor.Values
+	}
+	return nil
+}
+
+// matchesAnchorValue returns true if the unique vindex value of the
+// route option is the one of the anchor route. The values of the
+// route option are not resolved yet, so its condition is compared.
+func matchesAnchorValue(ro *routeOption, anchor *engine.Route) bool {
+	pv, err := sqlparser.NewPlanValue(ro.condition)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(anchor.Values, []sqltypes.PlanValue{pv})
+}
+
+// cteVSchema resolves the names of recursive common table
+// expressions as tables of their keyspace. In a sharded keyspace,
+// they are resolved as reference tables, because they're computed
+// by the shard that the query goes to. All other names are resolved
+// by the underlying ContextVSchema.
+type cteVSchema struct {
+	ContextVSchema
+	keyspace *vindexes.Keyspace
+	names    map[string]bool
+
+	// with is the recursive WITH clause, and readsShardedTables
+	// is set if it references sharded tables.
+	with               *sqlparser.With
+	readsShardedTables bool
+}
+
+// anchorRoute returns the route of the anchor members of the common
+// table expressions, which is the first SELECT of their UNION. It
+// returns nil if they don't reference sharded tables. Otherwise,
+// they must all go to the same single shard.
+func (vs *cteVSchema) anchorRoute() (*engine.Route, error) {
+	var anchor *engine.Route
+	for _, cte := range vs.with.CTEs {
+		// The planner modifies the AST. So, plan a copy.
+		stmt, err := sqlparser.Parse(sqlparser.String(firstSelect(cte.Subquery.Select)))
+		if err != nil {
+			return nil, err
+		}
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok {
+			return nil, errRecursiveCrossShard
+		}
+		pb := newPrimitiveBuilder(vs, newJointab(sqlparser.GetBindvars(sel)))
+		if err := pb.processSelect(sel, nil); err != nil {
+			return nil, err
+		}
+		if err := pb.bldr.Wireup(pb.bldr, pb.jt); err != nil {
+			return nil, err
+		}
+		rb, ok := pb.bldr.(*route)
+		if !ok {
+			return nil, errRecursiveCrossShard
+		}
+		eroute := rb.routeOptions[0].eroute
+		switch {
+		case eroute.Keyspace.Name != vs.keyspace.Name:
+			return nil, errRecursiveCrossShard
+		case eroute.Opcode == engine.SelectReference:
+			continue
+		case eroute.Opcode != engine.SelectEqualUnique:
+			return nil, errRecursiveCrossShard
+		case anchor != nil && (anchor.Vindex != eroute.Vindex || !reflect.DeepEqual(anchor.Values, eroute.Values)):
+			return nil, errRecursiveCrossShard
+		}
+		anchor = eroute
+	}
+	return anchor, nil
+}
+
+// FindTablesOrVindex satisfies the ContextVSchema interface.
+func (vs *cteVSchema) FindTablesOrVindex(tablename sqlparser.TableName) ([]*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
+	if !tablename.Qualifier.IsEmpty() || !vs.names[tablename.Name.String()] {
+		return vs.ContextVSchema.FindTablesOrVindex(tablename)
+	}
+	vst := &vindexes.Table{
+		Name:     tablename.Name,
+		Keyspace: vs.keyspace,
+	}
+	if vs.keyspace.Sharded {
+		vst.Type = vindexes.TypeReference
+	}
+	return []*vindexes.Table{vst}, nil, vs.keyspace.Name, topodatapb.TabletType_MASTER, nil, nil
+}
@@ -21,11 +21,12 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Delete, error) {
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+	dml, st, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +38,13 @@ func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Del
 		return edel, nil
 	}
 
+	if edel.Table == nil {
+		if err := buildMultiTableDeleteTargets(del, edel, st); err != nil {
+			return nil, err
+		}
+		return edel, nil
+	}
+
 	if len(del.Targets) > 1 {
 		return nil, vterrors.New(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement in sharded keyspace")
 	}
@@ -52,3 +60,48 @@ func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Del
 
 	return edel, nil
 }
+
+// buildMultiTableDeleteTargets sets up the targets of a multi-table delete.
+// The first target becomes the table of the plan, and the other targets
+// are added to the plan only if they own vindexes. Reference tables can't
+// be targets.
+func buildMultiTableDeleteTargets(del *sqlparser.Delete, edel *engine.Delete, st *symtab) error {
+	if len(del.Targets) == 0 {
+		return vterrors.New(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement without targets in sharded keyspace")
+	}
+	for i, target := range del.Targets {
+		t, err := st.FindTable(target)
+		if err != nil || t.vschemaTable == nil {
+			return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", target.Name.String())
+		}
+		// Every shard has its own copy of a reference table, but the
+		// delete is only sent to the shards of the tables it joins.
+		if t.vschemaTable.Type == vindexes.TypeReference {
+			return vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete from reference table %s", target.Name.String())
+		}
+		ksidVindex, ksidCol, err := getKsidVindex(t.vschemaTable)
+		if err != nil {
+			return err
+		}
+		var ownedVindexQuery string
+		if len(t.vschemaTable.Owned) > 0 {
+			ownedVindexQuery = generateMultiTableDMLSubquery(del.TableExprs, del.Where, t.alias, t.vschemaTable, ksidCol)
+		}
+		if i == 0 {
+			edel.Table = t.vschemaTable
+			if ownedVindexQuery != "" {
+				edel.OwnedVindexQuery = ownedVindexQuery
+				edel.KsidVindex = ksidVindex
+			}
+			continue
+		}
+		if ownedVindexQuery != "" {
+			edel.Targets = append(edel.Targets, &engine.DeleteTarget{
+				Table:            t.vschemaTable,
+				KsidVindex:       ksidVindex,
+				OwnedVindexQuery: ownedVindexQuery,
+			})
+		}
+	}
+	return nil
+}
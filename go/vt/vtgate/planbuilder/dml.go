@@ -29,8 +29,20 @@ import (
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
 func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+	if table.Pinned != nil {
+		// Pinned tables have their keyspace ids already assigned.
+		// Use the Binary vindex, which is the identity function
+		// for keyspace id.
+		vindex, _ := vindexes.NewBinary("binary", nil)
+		return engine.Equal, nil, "", vindex.(vindexes.SingleColumn), []sqltypes.PlanValue{{Value: sqltypes.MakeTrusted(sqltypes.VarBinary, table.Pinned)}}, nil
+	}
+	ksidVindex, ksidCol, err := getKsidVindex(table)
+	if err != nil {
+		return engine.Scatter, nil, "", nil, nil, err
+	}
+	if where == nil {
+		return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	}
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
@@ -39,24 +51,27 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 		if !ok {
 			continue
 		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
-		}
-		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
-		}
-
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
 			return engine.Equal, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
 		}
 	}
-	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
-	}
 	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
 }
 
+// getKsidVindex returns the vindex and column that are used to compute
+// the keyspace id of the rows of the table: the first unique vindex.
+func getKsidVindex(table *vindexes.Table) (vindexes.SingleColumn, string, error) {
+	for _, index := range table.Ordered {
+		if !index.Vindex.IsUnique() {
+			continue
+		}
+		if single, ok := index.Vindex.(vindexes.SingleColumn); ok {
+			return single, sqlparser.String(index.Columns[0]), nil
+		}
+	}
+	return nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+}
+
 // getMatch returns the matched value if there is an equality
 // constraint on the specified column that can be used to
 // decide on a route.
@@ -99,12 +114,15 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+// buildDMLPlan builds the parts of the plan that are common to UPDATE and DELETE.
+// For a multi-table DML in a sharded keyspace, the Table of the plan is left
+// unset, and the returned symtab is used to find the tables it affects.
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *symtab, vindexes.SingleColumn, string, error) {
 	eupd := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
-	ro, err := pb.processDMLTable(tableExprs)
+	ro, err := pb.processDMLTable(tableExprs, where)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, "", err
 	}
 	eupd.Keyspace = ro.eroute.Keyspace
 	if !eupd.Keyspace.Sharded {
@@ -113,21 +131,22 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		subqueryArgs = append(subqueryArgs, nodes...)
 		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
 		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		eupd.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		eupd.Query = generateQuery(stmt)
-		return eupd, nil, "", nil
+		return eupd, pb.st, nil, "", nil
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
-	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+	multiTable := len(pb.st.tables) != 1
+	if multiTable && (len(orderBy) != 0 || limit != nil) {
+		return nil, nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by or limit in multi-table %s statement", dmlType)
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -140,35 +159,160 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	}
 
 	eupd.QueryTimeout = queryTimeout(directives)
-	eupd.Table = ro.vschemaTable
-	if eupd.Table == nil {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
+	if !multiTable {
+		eupd.Table = ro.vschemaTable
+		if eupd.Table == nil {
+			return nil, nil, nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
+		}
 	}
 
 	if ro.eroute.TargetDestination != nil {
 		if ro.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		eupd.Opcode = engine.ByDestination
 		eupd.TargetDestination = ro.eroute.TargetDestination
-		return eupd, nil, "", nil
+		return eupd, pb.st, nil, "", nil
+	}
+
+	if multiTable {
+		if err := buildMultiTableDMLRouting(pb, ro, eupd, where); err != nil {
+			return nil, nil, nil, "", err
+		}
+		return eupd, pb.st, nil, "", nil
 	}
 
 	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, eupd.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, "", err
 	}
 	eupd.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+			if err := buildDMLLimit(eupd, stmt, tableExprs, where, orderBy, limit, dmlType); err != nil {
+				return nil, nil, nil, "", err
+			}
 		}
 	} else {
 		eupd.Vindex = vindex
 		eupd.Values = values
 	}
 
-	return eupd, ksidVindex, ksidCol, nil
+	return eupd, pb.st, ksidVindex, ksidCol, nil
+}
+
+// buildMultiTableDMLRouting sets the routing of a multi-table DML. All its
+// tables have been merged into the route of the routeOption. Since their
+// columns can be qualified, the WHERE clause is used to improve the route
+// the same way as it's done for a SELECT.
+func buildMultiTableDMLRouting(pb *primitiveBuilder, ro *routeOption, eupd *engine.DML, where *sqlparser.Where) error {
+	if where != nil {
+		if err := pb.st.ResolveSymbols(where); err != nil {
+			return err
+		}
+		for _, filter := range splitAndExpression(nil, where.Expr) {
+			ro.UpdatePlan(pb, filter)
+		}
+	}
+	if ro.eroute.Opcode != engine.SelectEqualUnique {
+		eupd.Opcode = engine.Scatter
+		return nil
+	}
+	pv, err := sqlparser.NewPlanValue(ro.condition)
+	if err != nil {
+		return err
+	}
+	eupd.Opcode = engine.Equal
+	eupd.Vindex = ro.eroute.Vindex
+	eupd.Values = []sqltypes.PlanValue{pv}
+	return nil
+}
+
+// buildDMLLimit sets up a multi-shard DML with a LIMIT clause. The
+// LimitQuery reads the primary key and the ORDER BY values of the
+// candidate rows from all shards. The engine sorts them to find the
+// rows that the DML must affect, and sends every shard the primary
+// keys of its rows. So, the ORDER BY and LIMIT clauses of the DML are
+// replaced by a condition on the primary key, which also applies to
+// the OwnedVindexQuery.
+func buildDMLLimit(eupd *engine.DML, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, dmlType string) error {
+	if limit.Offset != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit offset", dmlType)
+	}
+	if len(eupd.Table.UniqueKeys) == 0 || len(eupd.Table.UniqueKeys[0]) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit on table %v without a single column primary key in the vschema", dmlType, eupd.Table.Name)
+	}
+	pkCol := eupd.Table.UniqueKeys[0][0]
+	pv, err := sqlparser.NewPlanValue(limit.Rowcount)
+	if err != nil {
+		return err
+	}
+	eupd.Limit = pv
+
+	// The first column is the shard index, and the
+	// second one is the primary key.
+	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	buf.Myprintf("select :%s, %v", engine.DMLShardVarName, pkCol)
+	for i, order := range orderBy {
+		if _, ok := order.Expr.(*sqlparser.SQLVal); ok {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column number in order by of multi shard %s with limit", dmlType)
+		}
+		// Text values are sorted by their weight strings,
+		// if the vschema knows the type of the column.
+		if isTextColumn(eupd.Table, order.Expr) {
+			buf.Myprintf(", weight_string(%v)", order.Expr)
+		} else {
+			buf.Myprintf(", %v", order.Expr)
+		}
+		eupd.OrderBy = append(eupd.OrderBy, engine.OrderbyParams{
+			Col:  i + 2,
+			Desc: order.Direction == sqlparser.DescScr,
+		})
+	}
+	limit.Rowcount = sqlparser.NewValArg([]byte(":" + engine.DMLLimitVarName))
+	buf.Myprintf(" from %v%v%v%v for update", tableExprs, where, orderBy, limit)
+	eupd.LimitQuery = buf.String()
+
+	keysCond := &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     &sqlparser.ColName{Name: pkCol},
+		Right:    sqlparser.ListArg([]byte("::" + engine.DMLKeysVarName)),
+	}
+	newWhere := &sqlparser.Where{Type: sqlparser.WhereStr, Expr: keysCond}
+	if where != nil {
+		expr := where.Expr
+		if _, ok := expr.(*sqlparser.OrExpr); ok {
+			expr = &sqlparser.ParenExpr{Expr: expr}
+		}
+		newWhere.Expr = &sqlparser.AndExpr{Left: expr, Right: keysCond}
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		stmt.Where, stmt.OrderBy, stmt.Limit = newWhere, nil, nil
+	case *sqlparser.Delete:
+		stmt.Where, stmt.OrderBy, stmt.Limit = newWhere, nil, nil
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type for %s with limit: %T", dmlType, stmt)
+	}
+
+	// The query must be regenerated to use the new condition.
+	eupd.Query = generateQuery(stmt)
+	return nil
+}
+
+// isTextColumn returns true if the expression is a column of the
+// table that the vschema declares with a text type.
+func isTextColumn(table *vindexes.Table, expr sqlparser.Expr) bool {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return false
+	}
+	for _, column := range table.Columns {
+		if column.Name.Equal(col.Name) {
+			return sqltypes.IsText(column.Type)
+		}
+	}
+	return false
 }
 
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
@@ -183,6 +327,20 @@ func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limi
 	return buf.String()
 }
 
+// generateMultiTableDMLSubquery generates the OwnedVindexQuery for one of the
+// tables of a multi-table DML. The columns are qualified by the table alias.
+func generateMultiTableDMLSubquery(tableExprs sqlparser.TableExprs, where *sqlparser.Where, alias sqlparser.TableName, table *vindexes.Table, ksidCol string) string {
+	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	buf.Myprintf("select %v.%s", alias, ksidCol)
+	for _, cv := range table.Owned {
+		for _, column := range cv.Columns {
+			buf.Myprintf(", %v.%v", alias, column)
+		}
+	}
+	buf.Myprintf(" from %v%v for update", tableExprs, where)
+	return buf.String()
+}
+
 func generateQuery(statement sqlparser.Statement) string {
 	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	statement.Format(buf)
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// isDualSelect returns true if the only table of the select is dual.
+func isDualSelect(sel *sqlparser.Select) bool {
+	if len(sel.From) != 1 {
+		return false
+	}
+	aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	tableName, ok := aliased.Expr.(sqlparser.TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.EqualString("dual")
+}
+
+// buildDualPlan builds a plan that computes a select from dual within
+// vtgate, if the select needs values that only vtgate knows, like
+// LAST_INSERT_ID() and FOUND_ROWS(). Those functions are rewritten into
+// bind variables before the plan is built. It returns nil if the select
+// has to be sent to a tablet, which then receives the bind variables.
+func buildDualPlan(sel *sqlparser.Select) engine.Primitive {
+	if !isDualSelect(sel) || sel.With != nil || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil || sel.Lock != "" || sel.Distinct != "" {
+		return nil
+	}
+	if !usesVTGateValues(sel.SelectExprs) {
+		return nil
+	}
+	efilter := &engine.Filter{Input: &engine.SingleRow{}}
+	// The conversion doesn't need an input, because
+	// column references are rejected upfront.
+	f := &filter{}
+	for _, selectExpr := range sel.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok || hasColumnsOrSubqueries(aliased.Expr) {
+			return nil
+		}
+		expr, err := f.convert(nil, aliased.Expr)
+		if err != nil {
+			return nil
+		}
+		alias := aliased.As.String()
+		if alias == "" {
+			alias = sqlparser.String(aliased.Expr)
+		}
+		efilter.Exprs = append(efilter.Exprs, expr)
+		efilter.Aliases = append(efilter.Aliases, alias)
+	}
+	return efilter
+}
+
+// usesVTGateValues returns true if the expressions reference the
+// bind variables of LAST_INSERT_ID() or FOUND_ROWS().
+func usesVTGateValues(exprs sqlparser.SelectExprs) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if val, ok := node.(*sqlparser.SQLVal); ok && val.Type == sqlparser.ValArg {
+			switch string(val.Val[1:]) {
+			case sqlparser.LastInsertIDName, sqlparser.FoundRowsName:
+				found = true
+				return false, nil
+			}
+		}
+		return true, nil
+	}, exprs)
+	return found
+}
+
+func hasColumnsOrSubqueries(expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case *sqlparser.ColName, *sqlparser.Subquery:
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
@@ -85,6 +85,15 @@ type subqueryInfo struct {
 // If an expression has no references to the current query, then the left-most
 // origin is chosen as the default.
 func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pulloutSubquery, origin builder, pushExpr sqlparser.Expr, err error) {
+	pullouts, _, origin, pushExpr, err = pb.findFilterOrigin(expr, false)
+	return pullouts, origin, pushExpr, err
+}
+
+// findFilterOrigin is like findOrigin. Additionally, if allowSemiJoin is
+// true, a correlated subquery that cannot be merged does not fail the
+// analysis if the whole expression is a construct that a semiJoin can
+// evaluate. The semiJoin is then returned instead of a pushExpr.
+func (pb *primitiveBuilder) findFilterOrigin(expr sqlparser.Expr, allowSemiJoin bool) (pullouts []*pulloutSubquery, sj *semiJoin, origin builder, pushExpr sqlparser.Expr, err error) {
 	// highestOrigin tracks the highest origin referenced by the expression.
 	// Default is the First.
 	highestOrigin := pb.bldr.First()
@@ -154,7 +163,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 		return true, nil
 	}, expr)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	highestRoute, _ := highestOrigin.(*route)
@@ -164,7 +173,13 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			if allowSemiJoin {
+				if sj = pb.semiJoinFor(expr, sqi, constructsMap[sqi.ast]); sj != nil {
+					// The semiJoin replaces the whole expression.
+					return nil, sj, highestOrigin, nil, nil
+				}
+			}
+			return nil, nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -216,7 +231,45 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			pullouts = append(pullouts, newPulloutSubquery(engine.PulloutExists, sqName, hasValues, sqi.bldr))
 		}
 	}
-	return pullouts, highestOrigin, expr, nil
+	return pullouts, nil, highestOrigin, expr, nil
+}
+
+// semiJoinFor returns a semiJoin that can evaluate expr, which must
+// consist of only an EXISTS, NOT EXISTS, IN or NOT IN construct with
+// the correlated subquery. For IN and NOT IN, the left operand must be
+// a local column, and the subquery must return a single column.
+// The subquery must not be a UNION.
+// It returns nil if these conditions are not met.
+func (pb *primitiveBuilder) semiJoinFor(expr sqlparser.Expr, sqi subqueryInfo, construct sqlparser.Expr) *semiJoin {
+	if _, ok := sqi.ast.Select.(*sqlparser.Select); !ok {
+		return nil
+	}
+	expr = skipParenthesis(expr)
+	switch construct := construct.(type) {
+	case *sqlparser.ExistsExpr:
+		if expr == construct {
+			return newSemiJoin(engine.SemiJoinExists, nil, sqi.bldr)
+		}
+		if not, ok := expr.(*sqlparser.NotExpr); ok && skipParenthesis(not.Expr) == construct {
+			return newSemiJoin(engine.SemiJoinNotExists, nil, sqi.bldr)
+		}
+	case *sqlparser.ComparisonExpr:
+		if expr != construct || len(sqi.bldr.ResultColumns()) != 1 {
+			return nil
+		}
+		col, ok := construct.Left.(*sqlparser.ColName)
+		if !ok {
+			return nil
+		}
+		if _, isLocal, err := pb.st.Find(col); err != nil || !isLocal {
+			return nil
+		}
+		if construct.Operator == sqlparser.InStr {
+			return newSemiJoin(engine.SemiJoinIn, col, sqi.bldr)
+		}
+		return newSemiJoin(engine.SemiJoinNotIn, col, sqi.bldr)
+	}
+	return nil
 }
 
 func hasSubquery(node sqlparser.SQLNode) bool {
@@ -0,0 +1,423 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*filter)(nil)
+
+// filter is the builder for engine.Filter.
+// It's built on top of every cross-shard left join. The
+// WHERE clause filters and the select expressions that
+// reference the right side of a left join cannot be pushed
+// into its routes, because the join produces NULL values for
+// the rows of the left side that have no match. Instead, the
+// filter evaluates them against the rows of the join. If
+// there's nothing to evaluate, the filter is omitted from
+// the plan.
+type filter struct {
+	builderCommon
+	resultColumns []*resultColumn
+	weightStrings map[*resultColumn]int
+
+	// jb is the left join. It's also the input of the filter,
+	// unless an ORDER BY replaces the input with a memorySort.
+	jb *join
+
+	efilter *engine.Filter
+}
+
+// newFilter builds a new filter for the left join.
+func newFilter(jb *join) *filter {
+	return &filter{
+		builderCommon: newBuilderCommon(jb),
+		weightStrings: make(map[*resultColumn]int),
+		jb:            jb,
+		efilter:       &engine.Filter{},
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (f *filter) Primitive() engine.Primitive {
+	if f.efilter.Predicate == nil && f.isIdentity() && len(f.input.ResultColumns()) == len(f.resultColumns) {
+		return f.input.Primitive()
+	}
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
+
+// isIdentity returns true if the result columns of the filter
+// are the leading result columns of the input, in the same order.
+func (f *filter) isIdentity() bool {
+	for i, expr := range f.efilter.Exprs {
+		col, ok := expr.(*engine.ColumnExpr)
+		if !ok || col.Col != i {
+			return false
+		}
+	}
+	return true
+}
+
+// ResultColumns satisfies the builder interface.
+func (f *filter) ResultColumns() []*resultColumn {
+	return f.resultColumns
+}
+
+// PushFilter satisfies the builder interface.
+// Only WHERE clause filters that reference the right side of
+// the join are evaluated by the filter.
+func (f *filter) PushFilter(pb *primitiveBuilder, expr sqlparser.Expr, whereType string, origin builder) error {
+	if f.jb.isOnLeft(origin.Order()) || whereType != sqlparser.WhereStr {
+		return f.input.PushFilter(pb, expr, whereType, origin)
+	}
+	predicate, err := f.convert(pb, expr)
+	if err != nil {
+		return errors.New("unsupported: cross-shard left join and where clause")
+	}
+	if f.efilter.Predicate != nil {
+		predicate = &engine.LogicalExpr{
+			Operator: engine.LogicalAnd,
+			Left:     f.efilter.Predicate,
+			Right:    predicate,
+		}
+	}
+	f.efilter.Predicate = predicate
+	return nil
+}
+
+// PushSelect satisfies the builder interface.
+// Expressions that reference the right side of the join
+// are evaluated by the filter, unless they're simple columns.
+func (f *filter) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if _, ok := expr.Expr.(*sqlparser.ColName); ok || f.jb.isOnLeft(origin.Order()) {
+		rc, colNumber, err = f.input.PushSelect(pb, expr, origin)
+		if err != nil {
+			return nil, 0, err
+		}
+		f.addColumn(rc, &engine.ColumnExpr{Col: colNumber}, "")
+		return rc, len(f.resultColumns) - 1, nil
+	}
+
+	eexpr, err := f.convert(pb, expr.Expr)
+	if err != nil {
+		return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+	}
+	alias := expr.As.String()
+	if alias == "" {
+		alias = sqlparser.String(expr.Expr)
+	}
+	rc = newResultColumn(expr, f)
+	f.addColumn(rc, eexpr, alias)
+	return rc, len(f.resultColumns) - 1, nil
+}
+
+func (f *filter) addColumn(rc *resultColumn, expr engine.Expr, alias string) {
+	f.resultColumns = append(f.resultColumns, rc)
+	f.efilter.Exprs = append(f.efilter.Exprs, expr)
+	f.efilter.Aliases = append(f.efilter.Aliases, alias)
+}
+
+// MakeDistinct satisfies the builder interface.
+func (f *filter) MakeDistinct() error {
+	return f.input.MakeDistinct()
+}
+
+// PushGroupBy satisfies the builder interface.
+func (f *filter) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return f.input.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The filter preserves the order of the rows. So, the ORDER BY
+// can be pushed down unless it references the computed expressions,
+// or uses ordinals that don't map to the same input columns.
+// Otherwise, a memory sort is used.
+func (f *filter) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if f.isIdentity() || !f.referencesResults(orderBy) {
+		bldr, err := f.input.PushOrderBy(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		f.input = bldr
+		return f, nil
+	}
+	return newMemorySort(f, orderBy)
+}
+
+// referencesResults returns true if the ORDER BY uses ordinals
+// or references the expressions computed by the filter.
+func (f *filter) referencesResults(orderBy sqlparser.OrderBy) bool {
+	for _, order := range orderBy {
+		if _, ok := order.Expr.(*sqlparser.SQLVal); ok {
+			return true
+		}
+		references := false
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			if col, ok := node.(*sqlparser.ColName); ok && col.Metadata.(*column).Origin() == f {
+				references = true
+				return false, nil
+			}
+			return true, nil
+		}, order.Expr)
+		if references {
+			return true
+		}
+	}
+	return false
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The limit can be pushed down only if no rows are filtered.
+func (f *filter) SetUpperLimit(count *sqlparser.SQLVal) {
+	if f.efilter.Predicate == nil {
+		f.input.SetUpperLimit(count)
+	}
+}
+
+// SupplyCol satisfies the builder interface.
+func (f *filter) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range f.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+
+	rc, colNumber = f.input.SupplyCol(col)
+	f.addColumn(rc, &engine.ColumnExpr{Col: colNumber}, "")
+	return rc, len(f.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (f *filter) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := f.resultColumns[colNumber]
+	if weightcolNumber, ok := f.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	col, ok := f.efilter.Exprs[colNumber].(*engine.ColumnExpr)
+	if !ok {
+		return 0, errors.New("unsupported: cannot order by on a computed expression of a cross-shard left join")
+	}
+	sourceCol, err := f.input.SupplyWeightString(col.Col)
+	if err != nil {
+		return 0, err
+	}
+	f.addColumn(rc, &engine.ColumnExpr{Col: sourceCol}, "")
+	f.weightStrings[rc] = len(f.resultColumns) - 1
+	return len(f.resultColumns) - 1, nil
+}
+
+// convert converts the expression into one that can be evaluated by
+// the engine. The columns it references are supplied by the input.
+func (f *filter) convert(pb *primitiveBuilder, expr sqlparser.Expr) (engine.Expr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.ColName:
+		origin, isLocal, err := pb.st.Find(node)
+		if err != nil {
+			return nil, err
+		}
+		// The column must come from a route that's under the filter.
+		if !isLocal || origin.Order() < f.input.First().Order() || origin.Order() > f.input.Order() {
+			return nil, fmt.Errorf("unsupported: reference to column %s from outside the join", sqlparser.String(node))
+		}
+		_, colNumber := f.input.SupplyCol(node)
+		return &engine.ColumnExpr{Col: colNumber}, nil
+	case *sqlparser.SQLVal:
+		if node.Type == sqlparser.FloatVal {
+			return &engine.ValueExpr{Value: sqltypes.PlanValue{Value: sqltypes.MakeTrusted(sqltypes.Float64, node.Val)}}, nil
+		}
+		return f.convertValue(node)
+	case *sqlparser.NullVal:
+		return f.convertValue(node)
+	case sqlparser.BoolVal:
+		v := sqltypes.NewInt64(0)
+		if node {
+			v = sqltypes.NewInt64(1)
+		}
+		return &engine.ValueExpr{Value: sqltypes.PlanValue{Value: v}}, nil
+	case *sqlparser.ParenExpr:
+		return f.convert(pb, node.Expr)
+	case *sqlparser.AndExpr:
+		return f.convertLogical(pb, engine.LogicalAnd, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return f.convertLogical(pb, engine.LogicalOr, node.Left, node.Right)
+	case *sqlparser.NotExpr:
+		inner, err := f.convert(pb, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.NotExpr{Expr: inner}, nil
+	case *sqlparser.ComparisonExpr:
+		return f.convertComparison(pb, node)
+	case *sqlparser.IsExpr:
+		switch node.Operator {
+		case sqlparser.IsNullStr, sqlparser.IsNotNullStr:
+		default:
+			return nil, fmt.Errorf("unsupported: operator %s", node.Operator)
+		}
+		inner, err := f.convert(pb, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.IsExpr{Operator: node.Operator, Expr: inner}, nil
+	case *sqlparser.BinaryExpr:
+		switch node.Operator {
+		case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr:
+		default:
+			return nil, fmt.Errorf("unsupported: operator %s", node.Operator)
+		}
+		left, err := f.convert(pb, node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := f.convert(pb, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.ArithmeticExpr{Operator: node.Operator, Left: left, Right: right}, nil
+	case *sqlparser.FuncExpr:
+		return f.convertFunc(pb, node)
+	}
+	return nil, fmt.Errorf("unsupported: expression %s", sqlparser.String(expr))
+}
+
+func (f *filter) convertValue(node sqlparser.Expr) (engine.Expr, error) {
+	pv, err := sqlparser.NewPlanValue(node)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ValueExpr{Value: pv}, nil
+}
+
+func (f *filter) convertLogical(pb *primitiveBuilder, operator string, l, r sqlparser.Expr) (engine.Expr, error) {
+	left, err := f.convert(pb, l)
+	if err != nil {
+		return nil, err
+	}
+	right, err := f.convert(pb, r)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.LogicalExpr{Operator: operator, Left: left, Right: right}, nil
+}
+
+func (f *filter) convertComparison(pb *primitiveBuilder, node *sqlparser.ComparisonExpr) (engine.Expr, error) {
+	// Comparisons are pushed down as select expressions whenever
+	// possible, because only mysql knows the collations of the
+	// text values they compare.
+	// If the comparison cannot be pushed, like when it references
+	// the right side of a nested left join, it's evaluated here.
+	if origin := f.pushableOrigin(pb, node); origin != nil && f.input == builder(f.jb) {
+		if _, colNumber, err := f.jb.pushSelect(pb, &sqlparser.AliasedExpr{Expr: node}, origin); err == nil {
+			return &engine.ColumnExpr{Col: colNumber}, nil
+		}
+	}
+	left, err := f.convert(pb, node.Left)
+	if err != nil {
+		return nil, err
+	}
+	var right engine.Expr
+	switch node.Operator {
+	case sqlparser.EqualStr, sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterEqualStr, sqlparser.NotEqualStr, sqlparser.NullSafeEqualStr:
+		right, err = f.convert(pb, node.Right)
+	case sqlparser.InStr, sqlparser.NotInStr:
+		// The list must be made of values.
+		right, err = f.convertValue(node.Right)
+	default:
+		return nil, fmt.Errorf("unsupported: operator %s", node.Operator)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ComparisonExpr{Operator: node.Operator, Left: left, Right: right}, nil
+}
+
+// pushableOrigin returns the route into which the comparison
+// can be pushed as a select expression, or nil if it must be
+// evaluated by the filter. A comparison is pushable if it yields
+// NULL whenever one of its columns is NULL: this way, the rows
+// of the left side that have no match get the same result, no
+// matter where it's evaluated. Comparisons that reference the
+// left side of the join are pushed into the right side, which
+// receives the values of the left side as join variables.
+func (f *filter) pushableOrigin(pb *primitiveBuilder, node *sqlparser.ComparisonExpr) builder {
+	switch node.Operator {
+	case sqlparser.EqualStr, sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterEqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+	default:
+		return nil
+	}
+	var origin builder
+	pushable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr, *sqlparser.ParenExpr, *sqlparser.BinaryExpr, *sqlparser.SQLVal,
+			*sqlparser.NullVal, sqlparser.BoolVal, sqlparser.ValTuple, sqlparser.ListArg, sqlparser.Exprs:
+		case *sqlparser.ColName:
+			colOrigin, isLocal, err := pb.st.Find(node)
+			if err != nil || !isLocal || colOrigin.Order() < f.input.First().Order() || colOrigin.Order() > f.input.Order() {
+				pushable = false
+				return false, nil
+			}
+			if origin == nil || colOrigin.Order() > origin.Order() {
+				origin = colOrigin
+			}
+			return false, nil
+		default:
+			pushable = false
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	if !pushable {
+		return nil
+	}
+	return origin
+}
+
+func (f *filter) convertFunc(pb *primitiveBuilder, node *sqlparser.FuncExpr) (engine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, fmt.Errorf("unsupported: function %s", sqlparser.String(node))
+	}
+	switch fname := node.Name.Lowered(); {
+	case fname == "coalesce" && len(node.Exprs) > 0:
+	case fname == "ifnull" && len(node.Exprs) == 2:
+	default:
+		return nil, fmt.Errorf("unsupported: function %s", sqlparser.String(node))
+	}
+	coalesce := &engine.CoalesceExpr{}
+	for _, selectExpr := range node.Exprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: function %s", sqlparser.String(node))
+		}
+		arg, err := f.convert(pb, aliased.Expr)
+		if err != nil {
+			return nil, err
+		}
+		coalesce.Exprs = append(coalesce.Exprs, arg)
+	}
+	return coalesce, nil
+}
@@ -29,10 +29,21 @@ import (
 // This file has functions to analyze the FROM clause.
 
 // processDMLTable analyzes the FROM clause for DMLs and returns a routeOption.
-func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs) (*routeOption, error) {
-	if err := pb.processTableExprs(tableExprs); err != nil {
+// Tables joined with the ',' operator are merged into a single route if
+// the WHERE clause contains a join constraint that makes them mergeable.
+func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs, where *sqlparser.Where) (*routeOption, error) {
+	if err := pb.processTableExpr(tableExprs[0]); err != nil {
 		return nil, err
 	}
+	for _, tableExpr := range tableExprs[1:] {
+		rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+		if err := rpb.processTableExpr(tableExpr); err != nil {
+			return nil, err
+		}
+		if err := pb.join(rpb, nil, where); err != nil {
+			return nil, err
+		}
+	}
 	rb, ok := pb.bldr.(*route)
 	if !ok {
 		return nil, errors.New("unsupported: multi-shard or vindex write statement")
@@ -58,7 +69,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) e
 	if err := rpb.processTableExprs(tableExprs[1:]); err != nil {
 		return err
 	}
-	return pb.join(rpb, nil)
+	return pb.join(rpb, nil, nil)
 }
 
 // processTableExpr produces a builder subtree for the given TableExpr.
@@ -282,7 +293,7 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
 	}
-	return pb.join(rpb, ajoin)
+	return pb.join(rpb, ajoin, nil)
 }
 
 // convertToLeftJoin converts a right join into a left join.
@@ -299,7 +310,7 @@ func convertToLeftJoin(ajoin *sqlparser.JoinTableExpr) {
 	ajoin.Join = sqlparser.LeftJoinStr
 }
 
-func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
+func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr, where *sqlparser.Where) error {
 	// Merge the symbol tables. In the case of a left join, we have to
 	// ideally create new symbols that originate from the join primitive.
 	// However, this is not worth it for now, because the Push functions
@@ -321,7 +332,7 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 outer:
 	for _, lro := range lRoute.routeOptions {
 		for _, rro := range rRoute.routeOptions {
-			if lro.JoinCanMerge(pb, rro, ajoin) {
+			if lro.JoinCanMerge(pb, rro, ajoin, where) {
 				lro.MergeJoin(rro, isLeftJoin)
 				mergedRouteOptions = append(mergedRouteOptions, lro)
 				continue outer
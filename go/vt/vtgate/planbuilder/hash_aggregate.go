@@ -0,0 +1,375 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*hashAggregate)(nil)
+
+// hashAggregate is the builder for engine.HashAggregate.
+// This gets built if there are aggregations on top of a
+// primitive that is not a route, like a join or a cross-shard
+// subquery. Such primitives cannot perform the grouping or
+// ordering themselves.
+//
+// If the input is a join of two routes, each route groups its
+// rows by all of its non-aggregate columns and computes the
+// aggregates of its side, along with a count(*). The partial
+// aggregates are then combined by vtgate.
+// For example: 'select u.col, count(ue.id) from u join ue group by u.col'
+// will push 'u.col, count(*) ... group by u.col' into the LHS route and
+// 'count(ue.id), count(*)' into the RHS route, and the resulting
+// primitive will be:
+//    &engine.HashAggregate {
+//      Aggregates: []AggregateParams{{
+//        Opcode: AggregateCount,
+//        Col: 1,
+//        Alias: "count(ue.id)",
+//      }},
+//      Keys: []int{0},
+//      TruncateColumnCount: 2,
+//      Partial: &engine.PartialAggregates{
+//        RowCounts: []int{2, 3},
+//        Multipliers: [][]int{{2}},
+//      },
+//      Input: (Join),
+//    }
+//
+// Otherwise, the values inside the aggregate functions are pushed
+// down as is, and the aggregation is entirely performed by vtgate.
+// A count(*) is then pushed down as the constant 1. This is also
+// the case for distinct aggregates, which cannot be combined.
+type hashAggregate struct {
+	resultsBuilder
+	eaggr *engine.HashAggregate
+
+	// partial is the join whose routes compute the partial aggregates,
+	// if any. rowCounts has the column of the count(*) of each route,
+	// and aggrRoutes has the route that computes each aggregate.
+	partial    *join
+	rowCounts  map[builder]int
+	aggrRoutes []builder
+}
+
+func newHashAggregate(bldr builder, sel *sqlparser.Select) *hashAggregate {
+	eaggr := &engine.HashAggregate{}
+	ha := &hashAggregate{
+		resultsBuilder: newResultsBuilder(bldr, eaggr),
+		eaggr:          eaggr,
+	}
+	if jb := partialAggregateJoin(bldr, sel); jb != nil {
+		ha.partial = jb
+		ha.rowCounts = make(map[builder]int)
+		jb.Left.(*route).groupByAll = true
+		jb.Right.(*route).groupByAll = true
+	}
+	return ha
+}
+
+// partialAggregateJoin returns the join if bldr is a join of two
+// routes that can compute the partial aggregates of sel. This is
+// not possible for distinct aggregates.
+func partialAggregateJoin(bldr builder, sel *sqlparser.Select) *join {
+	jb, ok := bldr.(*join)
+	if !ok || jb.ejoin.Opcode != engine.NormalJoin || sel.Distinct != "" {
+		return nil
+	}
+	for _, side := range []builder{jb.Left, jb.Right} {
+		rb, ok := side.(*route)
+		if !ok {
+			return nil
+		}
+		rsel, ok := rb.Select.(*sqlparser.Select)
+		if !ok || rsel.Distinct != "" || len(rsel.GroupBy) != 0 {
+			return nil
+		}
+	}
+	hasAggregates := false
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil
+		}
+		funcExpr, ok := aliased.Expr.(*sqlparser.FuncExpr)
+		if !ok {
+			continue
+		}
+		if _, ok := engine.SupportedAggregates[funcExpr.Name.Lowered()]; !ok {
+			continue
+		}
+		if funcExpr.Distinct {
+			return nil
+		}
+		hasAggregates = true
+	}
+	if !hasAggregates {
+		return nil
+	}
+	return jb
+}
+
+// Primitive satisfies the builder interface.
+func (ha *hashAggregate) Primitive() engine.Primitive {
+	ha.eaggr.Input = ha.input.Primitive()
+	return ha.eaggr
+}
+
+// PushFilter satisfies the builder interface.
+func (ha *hashAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("unsupported: filtering on results of aggregates")
+}
+
+// PushSelect satisfies the builder interface.
+// Normal expressions are pushed through to the underlying primitive.
+// For aggregate expressions, only the inner expression is pushed down,
+// and ha becomes the originator of the aggregated result column.
+func (ha *hashAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
+		if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
+			return ha.pushAggr(pb, expr, origin)
+		}
+	}
+
+	// Ensure that there are no aggregates in the expression.
+	if nodeHasAggregates(expr.Expr) {
+		return nil, 0, errors.New("unsupported: in cross-shard query: complex aggregate expression")
+	}
+
+	innerRC, _, err := ha.input.PushSelect(pb, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	ha.resultColumns = append(ha.resultColumns, innerRC)
+	return innerRC, len(ha.resultColumns) - 1, nil
+}
+
+func (ha *hashAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
+	opcode := engine.SupportedAggregates[funcExpr.Name.Lowered()]
+	if len(funcExpr.Exprs) != 1 {
+		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
+	}
+	var innerAliased *sqlparser.AliasedExpr
+	switch inner := funcExpr.Exprs[0].(type) {
+	case *sqlparser.AliasedExpr:
+		innerAliased = &sqlparser.AliasedExpr{Expr: inner.Expr}
+	case *sqlparser.StarExpr:
+		if opcode != engine.AggregateCount || funcExpr.Distinct {
+			return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		innerAliased = &sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte{'1'})}
+	default:
+		return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+	}
+	if funcExpr.Distinct {
+		switch opcode {
+		case engine.AggregateCount:
+			opcode = engine.AggregateCountDistinct
+		case engine.AggregateSum:
+			opcode = engine.AggregateSumDistinct
+		}
+	}
+	if ha.partial != nil {
+		// The route computes the aggregate for its rows.
+		innerAliased = &sqlparser.AliasedExpr{Expr: funcExpr}
+	}
+	_, innerCol, err := ha.input.PushSelect(pb, innerAliased, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ha.partial != nil {
+		side := ha.partial.Right
+		if ha.partial.isOnLeft(origin.Order()) {
+			side = ha.partial.Left
+		}
+		if _, ok := funcExpr.Exprs[0].(*sqlparser.StarExpr); ok {
+			if _, ok := ha.rowCounts[side]; !ok {
+				ha.rowCounts[side] = innerCol
+			}
+		}
+		ha.aggrRoutes = append(ha.aggrRoutes, side)
+	}
+	var alias string
+	if expr.As.IsEmpty() {
+		alias = sqlparser.String(expr.Expr)
+	} else {
+		alias = expr.As.String()
+	}
+	ha.eaggr.Aggregates = append(ha.eaggr.Aggregates, engine.AggregateParams{
+		Opcode: opcode,
+		Col:    innerCol,
+		Alias:  alias,
+	})
+
+	// Build a new rc with ha as origin because it's semantically different
+	// from the expression we pushed down.
+	rc = newResultColumn(expr, ha)
+	ha.resultColumns = append(ha.resultColumns, rc)
+	return rc, len(ha.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (ha *hashAggregate) MakeDistinct() error {
+	for i, rc := range ha.resultColumns {
+		if rc.column.Origin() == ha {
+			return errors.New("unsupported: distinct cannot be combined with aggregate functions")
+		}
+		ha.eaggr.Keys = append(ha.eaggr.Keys, i)
+	}
+	return nil
+}
+
+// PushGroupBy satisfies the builder interface.
+func (ha *hashAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	for _, expr := range groupBy {
+		colNumber := -1
+		switch node := expr.(type) {
+		case *sqlparser.ColName:
+			c := node.Metadata.(*column)
+			if c.Origin() == ha {
+				return fmt.Errorf("group by expression cannot reference an aggregate function: %v", sqlparser.String(node))
+			}
+			for i, rc := range ha.resultColumns {
+				if rc.column == c {
+					colNumber = i
+					break
+				}
+			}
+			if colNumber == -1 {
+				return errors.New("unsupported: in cross-shard query: group by column must reference column in SELECT list")
+			}
+		case *sqlparser.SQLVal:
+			num, err := ResultFromNumber(ha.resultColumns, node)
+			if err != nil {
+				return err
+			}
+			if ha.resultColumns[num].column.Origin() == ha {
+				return fmt.Errorf("group by expression cannot reference an aggregate function: %v", sqlparser.String(node))
+			}
+			colNumber = num
+		default:
+			return errors.New("unsupported: in cross-shard query: only simple references allowed")
+		}
+		ha.eaggr.Keys = append(ha.eaggr.Keys, colNumber)
+	}
+	return nil
+}
+
+// PushOrderBy satisfies the builder interface.
+// The hash aggregation does not preserve the order of its input.
+// So, any ordering has to be performed by a memory sort on top
+// of it. This allows the results to be ordered by aggregates also.
+func (ha *hashAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+	bldr, err := ha.input.PushOrderBy(nil)
+	if err != nil {
+		return nil, err
+	}
+	ha.input = bldr
+	if len(orderBy) == 0 {
+		return ha, nil
+	}
+	return newMemorySort(ha, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because all the input rows are
+// needed to compute the aggregates.
+func (ha *hashAggregate) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the keys, then the function modifies
+// the primitive to pull a corresponding weight_string from mysql and
+// compare those instead. This is because we currently don't have the
+// ability to mimic mysql's collation behavior.
+func (ha *hashAggregate) Wireup(bldr builder, jt *jointab) error {
+	for i, colNumber := range ha.eaggr.Keys {
+		rc := ha.resultColumns[colNumber]
+		if sqltypes.IsText(rc.column.typ) {
+			if weightcolNumber, ok := ha.weightStrings[rc]; ok {
+				ha.eaggr.Keys[i] = weightcolNumber
+				continue
+			}
+			weightcolNumber, err := ha.input.SupplyWeightString(colNumber)
+			if err != nil {
+				return err
+			}
+			ha.weightStrings[rc] = weightcolNumber
+			ha.eaggr.Keys[i] = weightcolNumber
+			ha.eaggr.TruncateColumnCount = len(ha.resultColumns)
+		}
+	}
+	if ha.partial != nil {
+		if err := ha.wireupPartial(); err != nil {
+			return err
+		}
+	}
+	return ha.input.Wireup(bldr, jt)
+}
+
+// wireupPartial pushes a count(*) into the routes that don't
+// return one yet, and sets up the combination of the partial
+// aggregates: each of them is multiplied by the row count
+// of the other route.
+func (ha *hashAggregate) wireupPartial() error {
+	routes := []builder{ha.partial.Left, ha.partial.Right}
+	partial := &engine.PartialAggregates{}
+	for _, rb := range routes {
+		colNumber, ok := ha.rowCounts[rb]
+		if !ok {
+			countStar := &sqlparser.AliasedExpr{Expr: &sqlparser.FuncExpr{
+				Name:  sqlparser.NewColIdent("count"),
+				Exprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+			}}
+			var err error
+			_, colNumber, err = ha.input.PushSelect(nil, countStar, rb)
+			if err != nil {
+				return err
+			}
+			ha.rowCounts[rb] = colNumber
+			ha.eaggr.TruncateColumnCount = len(ha.resultColumns)
+		}
+		partial.RowCounts = append(partial.RowCounts, colNumber)
+	}
+	for i, aggr := range ha.eaggr.Aggregates {
+		var multipliers []int
+		switch aggr.Opcode {
+		case engine.AggregateCount, engine.AggregateSum:
+			for _, rb := range routes {
+				if rb != ha.aggrRoutes[i] {
+					multipliers = append(multipliers, ha.rowCounts[rb])
+				}
+			}
+		}
+		partial.Multipliers = append(partial.Multipliers, multipliers)
+	}
+	ha.eaggr.Partial = partial
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"strconv"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -32,7 +33,7 @@ import (
 func buildInsertPlan(ins *sqlparser.Insert, vschema ContextVSchema) (engine.Primitive, error) {
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(ins)))
 	exprs := sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: ins.Table}}
-	ro, err := pb.processDMLTable(exprs)
+	ro, err := pb.processDMLTable(exprs, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +48,10 @@ func buildInsertPlan(ins *sqlparser.Insert, vschema ContextVSchema) (engine.Prim
 		}
 		return buildInsertUnshardedPlan(ins, ro.vschemaTable)
 	}
-	if ins.Action == sqlparser.ReplaceStr {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
+	if ro.vschemaTable.Temporary {
+		return buildInsertTemporaryPlan(ins, ro.vschemaTable)
 	}
-	return buildInsertShardedPlan(ins, ro.vschemaTable)
+	return buildInsertShardedPlan(ins, ro.vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -61,7 +62,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	)
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.ParenSelect:
 		if eins.Table.AutoIncrement != nil {
 			return nil, errors.New("unsupported: auto-inc and select in insert")
 		}
@@ -97,7 +98,25 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+// buildInsertTemporaryPlan builds the plan for an insert into a temporary
+// table of a sharded keyspace, which is sent to the shard the table is
+// pinned to. The rows of a select could come from any shard, so they're
+// not supported.
+func buildInsertTemporaryPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+	if _, ok := ins.Rows.(sqlparser.Values); !ok || hasSubquery(ins) {
+		return nil, fmt.Errorf("unsupported: subquery in insert into temporary table %v of a sharded keyspace", table.Name)
+	}
+	eins := engine.NewSimpleInsert(
+		engine.InsertUnsharded,
+		table,
+		table.Keyspace,
+	)
+	eins.TargetDestination = key.DestinationKeyspaceID(table.Pinned)
+	eins.Query = generateQuery(ins)
+	return eins, nil
+}
+
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -106,6 +125,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	if ins.Ignore != "" {
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceStr {
+		eins.Opcode = engine.InsertShardedReplace
+	}
 	if ins.OnDup != nil {
 		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
 			return nil, errors.New("unsupported: DML cannot change vindex column")
@@ -129,8 +151,8 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case sqlparser.SelectStatement:
+		return buildInsertSelectPlan(ins, eins, insertValues, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -178,11 +200,196 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		}
 	}
 	eins.VindexValues = routeValues
+	if err := generateInsertOwnedVindexQuery(ins, eins, rows); err != nil {
+		return nil, err
+	}
 	eins.Query = generateQuery(ins)
 	generateInsertShardedQuery(ins, eins, rows)
 	return eins, nil
 }
 
+// generateInsertOwnedVindexQuery generates the query that reads the existing
+// rows of a REPLACE or ON DUPLICATE KEY UPDATE statement, if the table has
+// owned vindexes. The existing rows are the ones that conflict with an
+// inserted row on the primary key or a unique key. For every inserted row,
+// the query returns a flag that tells if the existing row conflicts with it.
+// This lets MySQL compare the key values using the collations of the columns.
+// The flags are followed by the primary vindex column and the owned vindex
+// columns.
+func generateInsertOwnedVindexQuery(ins *sqlparser.Insert, eins *engine.Insert, rows sqlparser.Values) error {
+	if len(eins.Table.Owned) == 0 || (ins.Action != sqlparser.ReplaceStr && ins.OnDup == nil) {
+		return nil
+	}
+	if len(eins.Table.UniqueKeys) == 0 {
+		if ins.Action == sqlparser.ReplaceStr || len(onDupVindexColumns(ins, eins.Table)) != 0 {
+			return fmt.Errorf("unsupported: REPLACE or ON DUPLICATE KEY UPDATE of owned vindex columns without unique keys in the vschema for table %v", eins.Table.Name)
+		}
+		// The owned vindex entries of the updated rows
+		// don't change. The ones of the inserted rows are
+		// created in ignore mode.
+		return nil
+	}
+	primary := eins.Table.ColumnVindexes[0]
+	ksidVindex, ok := primary.Vindex.(vindexes.SingleColumn)
+	if !ok {
+		return errors.New("unsupported: REPLACE or ON DUPLICATE KEY UPDATE with owned vindexes and a multi-column primary vindex")
+	}
+
+	// A unique key can only conflict if all its columns are inserted.
+	var conds []sqlparser.Expr
+	var where sqlparser.Expr
+	for _, row := range rows {
+		var cond sqlparser.Expr
+		for _, uk := range eins.Table.UniqueKeys {
+			var keyCond sqlparser.Expr
+			for _, col := range uk {
+				colNum := findColumn(ins, col)
+				if colNum == -1 {
+					keyCond = nil
+					break
+				}
+				keyCond = andExprs(keyCond, &sqlparser.ComparisonExpr{
+					Operator: sqlparser.EqualStr,
+					Left:     &sqlparser.ColName{Name: col},
+					Right:    row[colNum],
+				})
+			}
+			if keyCond != nil {
+				cond = orExprs(cond, keyCond)
+			}
+		}
+		if cond == nil {
+			// The row can't conflict with an existing row.
+			cond = sqlparser.BoolVal(false)
+		} else {
+			where = orExprs(where, cond)
+		}
+		conds = append(conds, cond)
+	}
+	if where == nil {
+		return nil
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for _, cond := range conds {
+		buf.Myprintf("%v, ", cond)
+	}
+	buf.Myprintf("%v", primary.Columns[0])
+	for _, cv := range eins.Table.Owned {
+		for _, column := range cv.Columns {
+			buf.Myprintf(", %v", column)
+		}
+	}
+	buf.Myprintf(" from %v where %v for update", eins.Table.Name, where)
+	eins.OwnedVindexQuery = buf.String()
+	eins.KsidVindex = ksidVindex
+	eins.ChangedVindexColumns = onDupVindexColumns(ins, eins.Table)
+	return nil
+}
+
+// onDupVindexColumns returns the owned vindex columns that are
+// assigned by the ON DUPLICATE KEY UPDATE clause of an insert.
+// isVindexChanging has already verified that they can only be
+// changed to the inserted values.
+func onDupVindexColumns(ins *sqlparser.Insert, table *vindexes.Table) []string {
+	var columns []string
+	for _, assignment := range ins.OnDup {
+		for _, colVindex := range table.Owned {
+			for _, vCol := range colVindex.Columns {
+				if vCol.Equal(assignment.Name.Name) {
+					columns = append(columns, vCol.String())
+				}
+			}
+		}
+	}
+	return columns
+}
+
+func andExprs(left, right sqlparser.Expr) sqlparser.Expr {
+	if left == nil {
+		return right
+	}
+	return &sqlparser.AndExpr{Left: left, Right: right}
+}
+
+func orExprs(left, right sqlparser.Expr) sqlparser.Expr {
+	if left == nil {
+		return right
+	}
+	return &sqlparser.OrExpr{Left: left, Right: right}
+}
+
+// buildInsertSelectPlan builds the plan for an INSERT ... SELECT into
+// a sharded table. The SELECT is planned independently, and becomes the
+// input of the insert, which routes the rows it produces at execution time.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, sel sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	if len(eins.Table.Owned) != 0 && (ins.Action == sqlparser.ReplaceStr || ins.OnDup != nil) {
+		return nil, errors.New("unsupported: insert into select with owned vindexes and REPLACE or ON DUPLICATE KEY UPDATE")
+	}
+	for {
+		paren, ok := sel.(*sqlparser.ParenSelect)
+		if !ok {
+			break
+		}
+		sel = paren.Select
+	}
+	if s, ok := sel.(*sqlparser.Select); ok && !hasStarExpr(s.SelectExprs) && len(s.SelectExprs) != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+
+	// The query must be generated before the SELECT is planned,
+	// because planning modifies the AST.
+	eins.Query = generateQuery(ins)
+
+	var err error
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		eins.Input, err = buildSelectPlan(sel, vschema)
+	case *sqlparser.Union:
+		eins.Input, err = buildUnionPlan(sel, vschema)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			colNum := findColumn(ins, col)
+			if colNum == -1 {
+				return nil, fmt.Errorf("unsupported: insert into select without a value for vindex column %s", col.String())
+			}
+			eins.VindexValueOffset[vIdx] = append(eins.VindexValueOffset[vIdx], colNum)
+		}
+	}
+
+	if eins.Table.AutoIncrement != nil {
+		// If the auto-inc column is absent, its values are
+		// generated and added after the selected columns.
+		colNum := findColumn(ins, eins.Table.AutoIncrement.Column)
+		if colNum == -1 {
+			ins.Columns = append(ins.Columns, eins.Table.AutoIncrement.Column)
+			colNum = len(ins.Columns) - 1
+		}
+		eins.Generate = newGenerate(eins.Table)
+		eins.Generate.Offset = colNum
+	}
+
+	generateInsertShardedQuery(ins, eins, nil)
+	return eins, nil
+}
+
+// hasStarExpr returns true if any of the select expressions is a '*'.
+func hasStarExpr(exprs sqlparser.SelectExprs) bool {
+	for _, expr := range exprs {
+		if _, ok := expr.(*sqlparser.StarExpr); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -196,8 +403,8 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore,
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		node.Action, node.Comments, node.Ignore,
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -228,21 +435,24 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 		row[colNum] = sqlparser.NewValArg([]byte(":" + engine.SeqVarName + strconv.Itoa(rowNum)))
 	}
 
-	eins.Generate = &engine.Generate{
-		Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
-		Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
-		Values:   autoIncValues,
-	}
+	eins.Generate = newGenerate(eins.Table)
+	eins.Generate.Values = autoIncValues
 	return nil
 }
 
+// newGenerate creates the Generate for the auto-inc column of the table.
+func newGenerate(table *vindexes.Table) *engine.Generate {
+	return &engine.Generate{
+		Keyspace: table.AutoIncrement.Sequence.Keyspace,
+		Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(table.AutoIncrement.Sequence.Name)),
+	}
+}
+
 // findOrAddColumn finds the position of a column in the insert. If it's
 // absent it appends it to the with NULL values and returns that position.
 func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
-	for i, column := range ins.Columns {
-		if col.Equal(column) {
-			return i
-		}
+	if colNum := findColumn(ins, col); colNum != -1 {
+		return colNum
 	}
 	ins.Columns = append(ins.Columns, col)
 	rows := ins.Rows.(sqlparser.Values)
@@ -252,6 +462,17 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	return len(ins.Columns) - 1
 }
 
+// findColumn returns the position of a column in the insert,
+// or -1 if it's absent.
+func findColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	return -1
+}
+
 // isVindexChanging returns true if any of the update
 // expressions modify a vindex column.
 func isVindexChanging(setClauses sqlparser.UpdateExprs, colVindexes []*vindexes.ColumnVindex) bool {
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
@@ -63,7 +64,13 @@ type join struct {
 	// Left and Right are the nodes for the join.
 	Left, Right builder
 
-	ejoin *engine.Join
+	// hashCandidates are the equality conditions between a column
+	// of the LHS and a column of the RHS that were pushed into the RHS.
+	// They're used to decide if a hash join can be used.
+	hashCandidates []*sqlparser.ComparisonExpr
+
+	ejoin     *engine.Join
+	ehashJoin *engine.HashJoin
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -98,7 +105,7 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			return errors.New("unsupported: join with USING(column_list) clause")
 		}
 	}
-	lpb.bldr = &join{
+	jb := &join{
 		weightStrings: make(map[*resultColumn]int),
 		Left:          lpb.bldr,
 		Right:         rpb.bldr,
@@ -107,6 +114,12 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			Vars:   make(map[string]int),
 		},
 	}
+	lpb.bldr = jb
+	if opcode == engine.LeftJoin {
+		// The filter evaluates the constructs that cannot
+		// be pushed into the right side of the left join.
+		lpb.bldr = newFilter(jb)
+	}
 	lpb.bldr.Reorder(0)
 	if ajoin == nil || opcode == engine.LeftJoin {
 		return nil
@@ -129,6 +142,13 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	if jb.ehashJoin != nil {
+		jb.ehashJoin.Opcode = jb.ejoin.Opcode
+		jb.ehashJoin.Left = jb.Left.Primitive()
+		jb.ehashJoin.Right = jb.Right.Primitive()
+		jb.ehashJoin.Cols = jb.ejoin.Cols
+		return jb.ehashJoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -152,11 +172,30 @@ func (jb *join) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereTyp
 	if jb.ejoin.Opcode == engine.LeftJoin {
 		return errors.New("unsupported: cross-shard left join and where clause")
 	}
-	return jb.Right.PushFilter(pb, filter, whereType, origin)
+	if err := jb.Right.PushFilter(pb, filter, whereType, origin); err != nil {
+		return err
+	}
+	if whereType == sqlparser.WhereStr {
+		if cmp, ok := filter.(*sqlparser.ComparisonExpr); ok && cmp.Operator == sqlparser.EqualStr {
+			jb.hashCandidates = append(jb.hashCandidates, cmp)
+		}
+	}
+	return nil
 }
 
 // PushSelect satisfies the builder interface.
 func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	// Pushing of non-trivial expressions not allowed for RHS of left joins.
+	if _, ok := expr.Expr.(*sqlparser.ColName); !ok && !jb.isOnLeft(origin.Order()) && jb.ejoin.Opcode == engine.LeftJoin {
+		return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+	}
+	return jb.pushSelect(pb, expr, origin)
+}
+
+// pushSelect pushes the expression without checking if it can
+// be evaluated by the RHS of a left join. The filter uses it for
+// the expressions that yield NULL if the RHS has no match.
+func (jb *join) pushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
 	if jb.isOnLeft(origin.Order()) {
 		rc, colNumber, err = jb.Left.PushSelect(pb, expr, origin)
 		if err != nil {
@@ -164,11 +203,6 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 		}
 		jb.ejoin.Cols = append(jb.ejoin.Cols, -colNumber-1)
 	} else {
-		// Pushing of non-trivial expressions not allowed for RHS of left joins.
-		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
-		}
-
 		rc, colNumber, err = jb.Right.PushSelect(pb, expr, origin)
 		if err != nil {
 			return nil, 0, err
@@ -283,6 +317,9 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if err := jb.planHashJoin(jt); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
@@ -370,3 +407,82 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 func (jb *join) isOnLeft(nodeNum int) bool {
 	return nodeNum <= jb.leftOrder
 }
+
+// planHashJoin converts the join into a hash join if the RHS is a
+// scatter route that would otherwise be executed once for every row
+// of the LHS. This is possible only if one of the conditions pushed
+// into the RHS is an equality between an LHS and an RHS column, and
+// the RHS has no other dependency on the LHS. The condition is then
+// rewritten as an IN clause that receives the list of all the LHS
+// values, which allows the RHS to be executed only once. If the RHS
+// returns too many rows for the hash table, the engine falls back to
+// a nested loop join. This function must be called before the RHS
+// gets wired up.
+func (jb *join) planHashJoin(jt *jointab) error {
+	if jb.ejoin.Opcode != engine.NormalJoin {
+		return nil
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok {
+		return nil
+	}
+	rb.finalizeOptions()
+	if rb.routeOptions[0].eroute.Opcode != engine.SelectScatter {
+		return nil
+	}
+	for _, cmp := range jb.hashCandidates {
+		lhsCol, rhsCol := jb.hashJoinColumns(rb, cmp)
+		if lhsCol == nil {
+			continue
+		}
+		if rb.hasExternalReference(lhsCol) {
+			return nil
+		}
+		lhsRC, lhsKey := jb.Left.SupplyCol(lhsCol)
+		rhsRC, rhsKey := jb.Right.SupplyCol(rhsCol)
+		ehashJoin := &engine.HashJoin{
+			LHSKey: lhsKey,
+			RHSKey: rhsKey,
+		}
+		// Text keys are matched by their weight strings, because
+		// we can't mimic mysql's collation behavior.
+		if sqltypes.IsText(lhsRC.column.typ) && sqltypes.IsText(rhsRC.column.typ) {
+			var err error
+			if ehashJoin.LHSWeightString, err = jb.Left.SupplyWeightString(lhsKey); err != nil {
+				return err
+			}
+			if ehashJoin.RHSWeightString, err = jb.Right.SupplyWeightString(rhsKey); err != nil {
+				return err
+			}
+		}
+		ehashJoin.ListVar = jt.GenerateHashJoinVar()
+		// Rewrite 'lhs.col = rhs.col' as 'rhs.col in ::list'.
+		cmp.Left = rhsCol
+		cmp.Operator = sqlparser.InStr
+		cmp.Right = sqlparser.ListArg("::" + ehashJoin.ListVar)
+		jb.ehashJoin = ehashJoin
+		return nil
+	}
+	return nil
+}
+
+// hashJoinColumns returns the LHS and RHS columns of the equality
+// condition if it's a comparison between a column of the left
+// side of the join and a column of the RHS route.
+func (jb *join) hashJoinColumns(rb *route, cmp *sqlparser.ComparisonExpr) (lhsCol, rhsCol *sqlparser.ColName) {
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	switch {
+	case rb.isLocal(right) && jb.isOnLeft(left.Metadata.(*column).Origin().Order()):
+		return left, right
+	case rb.isLocal(left) && jb.isOnLeft(right.Metadata.(*column).Origin().Order()):
+		return right, left
+	}
+	return nil, nil
+}
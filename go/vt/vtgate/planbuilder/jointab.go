@@ -85,6 +85,30 @@ func (jt *jointab) GenerateSubqueryVars() (sq, hasValues string) {
 	}
 }
 
+// GenerateHashJoinVar generates the name of the list variable
+// used to send the LHS values of a hash join to its RHS.
+func (jt *jointab) GenerateHashJoinVar() string {
+	for {
+		jt.varIndex++
+		name := "__hj" + strconv.Itoa(jt.varIndex)
+		if !jt.containsAny(name) {
+			return name
+		}
+	}
+}
+
+// GenerateSemiJoinVar generates the name of the list variable
+// used to send the underlying values of a semi join to its subquery.
+func (jt *jointab) GenerateSemiJoinVar() string {
+	for {
+		jt.varIndex++
+		name := "__sj" + strconv.Itoa(jt.varIndex)
+		if !jt.containsAny(name) {
+			return name
+		}
+	}
+}
+
 func (jt *jointab) containsAny(names ...string) bool {
 	for _, name := range names {
 		if _, ok := jt.vars[name]; ok {
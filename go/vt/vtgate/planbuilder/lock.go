@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// lockFunctions are the MySQL advisory lock functions. A lock is held
+// by the connection that acquired it, so these functions are sent to
+// the lock connection of the session instead of a pooled connection.
+var lockFunctions = map[string]bool{
+	"get_lock":          true,
+	"release_lock":      true,
+	"release_all_locks": true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+// buildLockPlan builds the plan for a select of lock functions.
+// They're only supported on their own in a select from dual.
+func buildLockPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	if !isDualSelect(sel) {
+		return nil, errors.New("unsupported: lock functions are only allowed in a select from dual")
+	}
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, errors.New("unsupported: lock functions cannot be mixed with other expressions")
+		}
+		funcExpr, ok := aliased.Expr.(*sqlparser.FuncExpr)
+		if !ok || !isLockFunction(funcExpr) {
+			return nil, errors.New("unsupported: lock functions cannot be mixed with other expressions")
+		}
+	}
+	ks, dest, err := vschema.LockTarget()
+	if err != nil {
+		return nil, err
+	}
+	return &engine.Lock{
+		Keyspace:          ks,
+		TargetDestination: dest,
+		Query:             sqlparser.String(sel),
+	}, nil
+}
+
+// hasLockFunction returns true if the select uses a lock function
+// anywhere, including its subqueries.
+func hasLockFunction(sel *sqlparser.Select) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if funcExpr, ok := node.(*sqlparser.FuncExpr); ok && isLockFunction(funcExpr) {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, sel)
+	return found
+}
+
+func isLockFunction(funcExpr *sqlparser.FuncExpr) bool {
+	return funcExpr.Qualifier.IsEmpty() && lockFunctions[funcExpr.Name.Lowered()]
+}
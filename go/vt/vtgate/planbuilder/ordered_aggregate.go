@@ -61,7 +61,7 @@ type orderedAggregate struct {
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
 // that a primitive is needed to handle the aggregation, it builds an orderedAggregate
-// primitive and returns it. It returns a groupByHandler if there is aggregation it
+// or a hashAggregate primitive and returns it. It returns a groupByHandler if there is aggregation it
 // can handle.
 func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	rb, isRoute := pb.bldr.(*route)
@@ -83,12 +83,14 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 		return nil
 	}
 
-	// The query has aggregates. We can proceed only
-	// if the underlying primitive is a route because
-	// we need the ability to push down group by and
-	// order by clauses.
+	// The query has aggregates. If the underlying primitive
+	// is not a route, we can't push down group by and order by
+	// clauses. The aggregation has to be performed by vtgate
+	// using a hash based aggregator.
 	if !isRoute {
-		return errors.New("unsupported: cross-shard query with aggregates")
+		pb.bldr = newHashAggregate(pb.bldr, sel)
+		pb.bldr.Reorder(0)
+		return nil
 	}
 
 	// If there is a distinct clause, we can check the select list
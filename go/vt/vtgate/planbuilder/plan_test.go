@@ -222,6 +222,10 @@ func (vw *vschemaWrapper) TargetString() string {
 	return "targetString"
 }
 
+func (vw *vschemaWrapper) LockTarget() (*vindexes.Keyspace, key.Destination, error) {
+	return vw.v.Keyspaces["main"].Keyspace, key.DestinationKeyspaceID{0}, nil
+}
+
 // For the purposes of this set of tests, just compare the actual plan
 // and ignore all the metrics.
 type testPlan struct {
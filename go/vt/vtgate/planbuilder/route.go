@@ -56,6 +56,11 @@ type route struct {
 	// are added to be used for collation of text columns.
 	weightStrings map[*resultColumn]int
 
+	// groupByAll is set if the route computes partial aggregates
+	// for a hashAggregate. Its rows are then grouped by all the
+	// select expressions that are not aggregates.
+	groupByAll bool
+
 	routeOptions []*routeOption
 }
 
@@ -241,7 +246,9 @@ func (rb *route) SetUpperLimit(count *sqlparser.SQLVal) {
 
 // PushMisc satisfies the builder interface.
 func (rb *route) PushMisc(sel *sqlparser.Select) {
+	rb.Select.(*sqlparser.Select).With = sel.With
 	rb.Select.(*sqlparser.Select).Comments = sel.Comments
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
 	rb.Select.(*sqlparser.Select).Lock = sel.Lock
 }
 
@@ -272,9 +279,16 @@ func (rb *route) Wireup(bldr builder, jt *jointab) error {
 		}
 	}
 
+	if rb.groupByAll {
+		rb.groupBySelectExprs()
+	}
+
 	// Fix up the AST.
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
 		switch node := node.(type) {
+		case *sqlparser.With:
+			// Passed through as is.
+			return false, nil
 		case *sqlparser.Select:
 			if len(node.SelectExprs) == 0 {
 				node.SelectExprs = sqlparser.SelectExprs([]sqlparser.SelectExpr{
@@ -301,6 +315,9 @@ func (rb *route) Wireup(bldr builder, jt *jointab) error {
 	// Generate query while simultaneously resolving values.
 	varFormatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
 		switch node := node.(type) {
+		case *sqlparser.With:
+			formatWith(buf, node)
+			return
 		case *sqlparser.ColName:
 			if !rb.isLocal(node) {
 				joinVar := jt.Procure(bldr, node, rb.Order())
@@ -324,6 +341,34 @@ func (rb *route) Wireup(bldr builder, jt *jointab) error {
 	return nil
 }
 
+// groupBySelectExprs groups the rows of the route by all
+// the select expressions that are not aggregates. Constants
+// are skipped because they would be treated as ordinals.
+func (rb *route) groupBySelectExprs() {
+	sel := rb.Select.(*sqlparser.Select)
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok || nodeHasAggregates(aliased.Expr) {
+			continue
+		}
+		switch aliased.Expr.(type) {
+		case *sqlparser.SQLVal, *sqlparser.NullVal, sqlparser.BoolVal:
+			continue
+		}
+		sel.GroupBy = append(sel.GroupBy, aliased.Expr)
+	}
+}
+
+// formatWith formats a WITH clause that is passed through as is.
+// The common table expressions are opaque to the route. Their
+// column references are not resolved, and only the keyspace
+// qualifiers of their tables need to be removed.
+func formatWith(buf *sqlparser.TrackedBuffer, with *sqlparser.With) {
+	wbuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	with.Format(wbuf)
+	buf.WriteString(wbuf.String())
+}
+
 func systemTable(qualifier string) bool {
 	return strings.EqualFold(qualifier, "information_schema") ||
 		strings.EqualFold(qualifier, "performance_schema") ||
@@ -367,12 +412,29 @@ func (rb *route) isLocal(col *sqlparser.ColName) bool {
 	return col.Metadata.(*column).Origin() == rb
 }
 
+// hasExternalReference returns true if the route references any
+// column that's not local, other than the specified one.
+func (rb *route) hasExternalReference(except *sqlparser.ColName) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && col != except && !rb.isLocal(col) {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, rb.Select)
+	return found
+}
+
 // generateFieldQuery generates a query with an impossible where.
 // This will be used on the RHS node to fetch field info if the LHS
 // returns no result.
 func (rb *route) generateFieldQuery(sel sqlparser.SelectStatement, jt *jointab) string {
 	formatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
 		switch node := node.(type) {
+		case *sqlparser.With:
+			formatWith(buf, node)
+			return
 		case *sqlparser.ColName:
 			if !rb.isLocal(node) {
 				_, joinVar := jt.Lookup(node)
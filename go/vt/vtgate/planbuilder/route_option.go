@@ -72,12 +72,19 @@ func newRouteOption(rb *route, vst *vindexes.Table, sub *tableSubstitution, vind
 	}
 }
 
-func (ro *routeOption) JoinCanMerge(pb *primitiveBuilder, rro *routeOption, ajoin *sqlparser.JoinTableExpr) bool {
+// JoinCanMerge returns true if the two routeOptions can be merged into
+// one for the specified join. The where clause is only specified for
+// ',' joins of multi-table DMLs, where it can be used as join constraint.
+func (ro *routeOption) JoinCanMerge(pb *primitiveBuilder, rro *routeOption, ajoin *sqlparser.JoinTableExpr, where *sqlparser.Where) bool {
 	return ro.canMerge(rro, func() bool {
-		if ajoin == nil {
-			return false
+		var filters []sqlparser.Expr
+		if ajoin != nil {
+			filters = splitAndExpression(filters, ajoin.Condition.On)
 		}
-		for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+		if where != nil {
+			filters = splitAndExpression(filters, where.Expr)
+		}
+		for _, filter := range filters {
 			if ro.canMergeOnFilter(pb, rro, filter) {
 				return true
 			}
@@ -200,7 +207,10 @@ func (ro *routeOption) canMergeOnFilter(pb *primitiveBuilder, rro *routeOption,
 // the route.
 func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	switch ro.eroute.Opcode {
-	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference:
+	case engine.SelectDBA:
+		ro.updateSystemTableSchema(filter)
+		return
+	case engine.SelectUnsharded, engine.SelectNext, engine.SelectReference:
 		return
 	}
 	opcode, vindex, values := ro.computePlan(pb, filter)
@@ -238,6 +248,34 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 }
 
+// updateSystemTableSchema looks for a table_schema or schema_name
+// equality in the filter of a query on the system tables. The value is
+// moved into a bind variable, which lets the route send the query to the
+// keyspace it names, and the tablet replace it with its database name.
+func (ro *routeOption) updateSystemTableSchema(filter sqlparser.Expr) {
+	if !ro.eroute.SysTableSchema.IsNull() {
+		return
+	}
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return
+	}
+	col, ok := comparison.Left.(*sqlparser.ColName)
+	if !ok || !(col.Name.EqualString("table_schema") || col.Name.EqualString("schema_name")) {
+		return
+	}
+	val, ok := comparison.Right.(*sqlparser.SQLVal)
+	if !ok || (val.Type != sqlparser.StrVal && val.Type != sqlparser.ValArg) {
+		return
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil || pv.Key == sqlparser.SchemaNameVarName {
+		return
+	}
+	ro.eroute.SysTableSchema = pv
+	comparison.Right = sqlparser.NewValArg([]byte(":" + sqlparser.SchemaNameVarName))
+}
+
 func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 	ro.eroute.Opcode = opcode
 	ro.eroute.Vindex = vindex
@@ -26,6 +26,15 @@ import (
 
 // buildSelectPlan is the new function to build a Select plan.
 func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	if hasLockFunction(sel) {
+		return buildLockPlan(sel, vschema)
+	}
+	if sel.SQLCalcFoundRows {
+		return buildSQLCalcFoundRowsPlan(sel, vschema)
+	}
+	if dualPlan := buildDualPlan(sel); dualPlan != nil {
+		return dualPlan, nil
+	}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
 		return nil, err
@@ -56,6 +65,10 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Prim
 // So, a typical plan would either be a simple leaf node, or may consist
 // of leaf nodes tied together by join nodes.
 //
+// Common table expressions are expanded into derived tables before
+// the FROM clause is analyzed. A recursive WITH clause can only be passed
+// through as is, which requires the query to be a single unsharded route.
+//
 // If a query has aggregates that cannot be pushed down, an aggregator
 // primitive is built. The current orderedAggregate primitive can only
 // be built on top of a route. The orderedAggregate expects the rows
@@ -63,6 +76,9 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Prim
 // underlying route. This means that a compatible ORDER BY clause
 // can also be handled by this combination of primitives. In this case,
 // the tree would consist of an orderedAggregate whose input is a route.
+// If the aggregates are on top of a join or a cross-shard subquery,
+// a hashAggregate is built instead, which performs the grouping entirely
+// at the vtgate level. Any ORDER BY is then handled by a memorySort.
 //
 // If a query has an ORDER BY, but the route is a scatter, then the
 // ordering is pushed down into the route itself. This results in a simple
@@ -72,6 +88,15 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Prim
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab) error {
+	if sel.SQLCalcFoundRows {
+		// Only the outermost select of a query can count its rows.
+		return errors.New("unsupported: sql_calc_found_rows in a union or a subquery")
+	}
+	if sel.With != nil {
+		if err := pb.processWith(sel, sel.With); err != nil {
+			return err
+		}
+	}
 	if err := pb.processTableExprs(sel.From); err != nil {
 		return err
 	}
@@ -99,6 +124,17 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 			return err
 		}
 	}
+	if sel.With != nil {
+		// Only a recursive WITH clause remains at this point.
+		// The check is done after the WHERE clause, which can
+		// route the query to a single shard.
+		if err := pb.checkRecursiveWith(); err != nil {
+			return err
+		}
+	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -120,6 +156,42 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	return nil
 }
 
+// checkWindowFunctions verifies that the window functions of the
+// query can be pushed down. A window can span rows from multiple
+// shards, and vtgate cannot compute window functions by itself.
+// So, they are allowed only if the query goes to a single shard.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	if len(sel.Windows) == 0 && !nodeHasWindowFunctions(sel.SelectExprs) && !nodeHasWindowFunctions(sel.OrderBy) {
+		return nil
+	}
+	if rb, ok := pb.bldr.(*route); ok && rb.removeMultishardOptions() {
+		// The named windows are pushed down as is by PushMisc.
+		// Their column references still need to be resolved.
+		return pb.st.ResolveSymbols(sel.Windows)
+	}
+	return errors.New("unsupported: window functions in cross-shard query")
+}
+
+// nodeHasWindowFunctions returns true if the node contains
+// a function call with an OVER clause.
+func nodeHasWindowFunctions(node sqlparser.SQLNode) bool {
+	hasWindowFunctions := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Over != nil {
+				hasWindowFunctions = true
+				return false, errors.New("unused error")
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFunctions
+}
+
 // pushFilter identifies the target route for the specified bool expr,
 // pushes it down, and updates the route info if the new constraint improves
 // the primitive. This function can push to a WHERE or HAVING clause.
@@ -127,7 +199,7 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	filters := splitAndExpression(nil, in)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
-		pullouts, origin, expr, err := pb.findOrigin(filter)
+		pullouts, sj, origin, expr, err := pb.findFilterOrigin(filter, whereType == sqlparser.WhereStr)
 		if err != nil {
 			return err
 		}
@@ -138,6 +210,9 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 			}
 		}
 		pb.addPullouts(pullouts)
+		if sj != nil {
+			pb.addSemiJoin(sj)
+		}
 	}
 	return nil
 }
@@ -171,6 +246,13 @@ func (pb *primitiveBuilder) addPullouts(pullouts []*pulloutSubquery) {
 	}
 }
 
+// addSemiJoin adds the semiJoin to the primitiveBuilder.
+func (pb *primitiveBuilder) addSemiJoin(sj *semiJoin) {
+	sj.setUnderlying(pb.bldr)
+	pb.bldr = sj
+	pb.bldr.Reorder(0)
+}
+
 // pushSelectExprs identifies the target route for the
 // select expressions and pushes them down.
 func (pb *primitiveBuilder) pushSelectExprs(sel *sqlparser.Select) error {
@@ -0,0 +1,334 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ builder = (*semiJoin)(nil)
+
+// semiJoin is the builder for engine.SemiJoin.
+// This gets built for a WHERE clause filter that is an EXISTS
+// or IN construct with a correlated subquery, if the subquery
+// cannot be merged with the route it's correlated to. Unlike
+// pulloutSubquery, the underlying primitive executes first,
+// because the subquery needs values from its rows.
+type semiJoin struct {
+	order         int
+	resultColumns []*resultColumn
+	weightStrings map[*resultColumn]int
+	underlying    builder
+	subquery      builder
+
+	// col is the LHS of an IN construct. It's supplied
+	// by the underlying primitive during wireup.
+	col *sqlparser.ColName
+
+	eSemiJoin *engine.SemiJoin
+}
+
+// newSemiJoin builds a new semiJoin. col must be
+// set only for the IN opcodes.
+func newSemiJoin(opcode engine.SemiJoinOpcode, col *sqlparser.ColName, subquery builder) *semiJoin {
+	return &semiJoin{
+		weightStrings: make(map[*resultColumn]int),
+		subquery:      subquery,
+		col:           col,
+		eSemiJoin: &engine.SemiJoin{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+}
+
+// setUnderlying sets the underlying primitive.
+func (sj *semiJoin) setUnderlying(underlying builder) {
+	sj.underlying = underlying
+	sj.subquery.Reorder(sj.underlying.Order())
+	sj.order = sj.subquery.Order() + 1
+}
+
+// isOnLeft returns true if the specified route number
+// is on the underlying side of the semiJoin.
+func (sj *semiJoin) isOnLeft(nodeNum int) bool {
+	return nodeNum <= sj.underlying.Order()
+}
+
+// Order satisfies the builder interface.
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder satisfies the builder interface.
+func (sj *semiJoin) Reorder(order int) {
+	sj.underlying.Reorder(order)
+	sj.subquery.Reorder(sj.underlying.Order())
+	sj.order = sj.subquery.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.eSemiJoin.Left = sj.underlying.Primitive()
+	sj.eSemiJoin.Right = sj.subquery.Primitive()
+	return sj.eSemiJoin
+}
+
+// First satisfies the builder interface.
+func (sj *semiJoin) First() builder {
+	return sj.underlying.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.resultColumns
+}
+
+// PushFilter satisfies the builder interface.
+func (sj *semiJoin) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	if !sj.isOnLeft(origin.Order()) {
+		return errors.New("unsupported: filter on the subquery of a cross-shard correlated subquery")
+	}
+	return sj.underlying.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (sj *semiJoin) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	rc, colNumber, err = sj.underlying.PushSelect(pb, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	sj.eSemiJoin.Cols = append(sj.eSemiJoin.Cols, colNumber)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (sj *semiJoin) MakeDistinct() error {
+	return errors.New("unsupported: distinct on cross-shard correlated subquery")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (sj *semiJoin) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if groupBy == nil {
+		return nil
+	}
+	return errors.New("unsupported: group by on cross-shard correlated subquery")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The semiJoin preserves the order of the underlying rows.
+// So, the ORDER BY can be pushed down.
+func (sj *semiJoin) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := sj.underlying.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	sj.underlying = bldr
+	return sj, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the semiJoin filters the rows
+// of the underlying primitive.
+func (sj *semiJoin) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (sj *semiJoin) PushMisc(sel *sqlparser.Select) {
+	sj.underlying.PushMisc(sel)
+	sj.subquery.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+func (sj *semiJoin) Wireup(bldr builder, jt *jointab) error {
+	if sj.col != nil {
+		var err error
+		var rc *resultColumn
+		rc, sj.eSemiJoin.Col = sj.underlying.SupplyCol(sj.col)
+		// The values that may be text are matched by their weight
+		// strings, because we can't mimic mysql's collation behavior.
+		if mayBeText(rc.column.typ) {
+			if sj.eSemiJoin.ColWeightString, err = sj.underlying.SupplyWeightString(sj.eSemiJoin.Col); err != nil {
+				return err
+			}
+		}
+		if mayBeText(sj.subquery.ResultColumns()[0].column.typ) {
+			if sj.eSemiJoin.SubqueryWeightString, err = sj.subquery.SupplyWeightString(0); err != nil {
+				return err
+			}
+		}
+	}
+	if err := sj.planBatch(jt); err != nil {
+		return err
+	}
+	if err := sj.subquery.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return sj.underlying.Wireup(bldr, jt)
+}
+
+// planBatch makes the semiJoin execute the subquery only once for
+// all the underlying rows if the subquery is a scatter or unsharded
+// route, and its only reference to the underlying primitive is an
+// equality between one of its columns and an underlying column.
+// The equality is then rewritten as an IN clause that receives the
+// list of all the underlying values. The subquery must not group
+// or limit its rows, because they now belong to all the values.
+// This function must be called before the subquery gets wired up.
+func (sj *semiJoin) planBatch(jt *jointab) error {
+	rb, ok := sj.subquery.(*route)
+	if !ok {
+		return nil
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || nodeHasAggregates(sel.SelectExprs) {
+		return nil
+	}
+	rb.finalizeOptions()
+	switch rb.routeOptions[0].eroute.Opcode {
+	case engine.SelectScatter, engine.SelectUnsharded:
+	default:
+		return nil
+	}
+	for _, filter := range splitAndExpression(nil, sel.Where.Expr) {
+		cmp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualStr {
+			continue
+		}
+		lhsCol, rhsCol := sj.batchColumns(rb, cmp)
+		if lhsCol == nil {
+			continue
+		}
+		if rb.hasExternalReference(lhsCol) {
+			return nil
+		}
+		esj := sj.eSemiJoin
+		var lhsRC, rhsRC *resultColumn
+		lhsRC, esj.LHSKey = sj.underlying.SupplyCol(lhsCol)
+		rhsRC, esj.RHSKey = rb.SupplyCol(rhsCol)
+		var err error
+		if mayBeText(lhsRC.column.typ) {
+			if esj.LHSWeightString, err = sj.underlying.SupplyWeightString(esj.LHSKey); err != nil {
+				return err
+			}
+		}
+		if mayBeText(rhsRC.column.typ) {
+			if esj.RHSWeightString, err = rb.SupplyWeightString(esj.RHSKey); err != nil {
+				return err
+			}
+		}
+		esj.ListVar = jt.GenerateSemiJoinVar()
+		// Rewrite 'rhs.col = lhs.col' as 'rhs.col in ::list'.
+		cmp.Left = rhsCol
+		cmp.Operator = sqlparser.InStr
+		cmp.Right = sqlparser.ListArg("::" + esj.ListVar)
+		return nil
+	}
+	return nil
+}
+
+// batchColumns returns the underlying and the subquery columns
+// of the comparison, if it's between two such columns.
+func (sj *semiJoin) batchColumns(rb *route, cmp *sqlparser.ComparisonExpr) (lhsCol, rhsCol *sqlparser.ColName) {
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	switch {
+	case rb.isLocal(right) && sj.isUnderlying(left):
+		return left, right
+	case rb.isLocal(left) && sj.isUnderlying(right):
+		return right, left
+	}
+	return nil, nil
+}
+
+// isUnderlying returns true if the column
+// comes from the underlying primitive.
+func (sj *semiJoin) isUnderlying(col *sqlparser.ColName) bool {
+	c, ok := col.Metadata.(*column)
+	if !ok {
+		return false
+	}
+	order := c.Origin().Order()
+	return order >= sj.underlying.First().Order() && order <= sj.underlying.Order()
+}
+
+// mayBeText returns false if the values of the type
+// are known to not be text values.
+func mayBeText(typ querypb.Type) bool {
+	return !sqltypes.IsIntegral(typ) && !sqltypes.IsFloat(typ) && typ != sqltypes.Decimal
+}
+
+// SupplyVar satisfies the builder interface.
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if !sj.isOnLeft(from) {
+		sj.subquery.SupplyVar(from, to, col, varname)
+		return
+	}
+	if sj.isOnLeft(to) {
+		sj.underlying.SupplyVar(from, to, col, varname)
+		return
+	}
+	if _, ok := sj.eSemiJoin.Vars[varname]; ok {
+		// Looks like somebody else already requested this.
+		return
+	}
+	_, sj.eSemiJoin.Vars[varname] = sj.underlying.SupplyCol(col)
+}
+
+// SupplyCol satisfies the builder interface.
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range sj.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+
+	rc, sourceCol := sj.underlying.SupplyCol(col)
+	sj.eSemiJoin.Cols = append(sj.eSemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	return rc, len(sj.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	rc := sj.resultColumns[colNumber]
+	if weightcolNumber, ok := sj.weightStrings[rc]; ok {
+		return weightcolNumber, nil
+	}
+	sourceCol, err := sj.underlying.SupplyWeightString(sj.eSemiJoin.Cols[colNumber])
+	if err != nil {
+		return 0, err
+	}
+	sj.eSemiJoin.Cols = append(sj.eSemiJoin.Cols, sourceCol)
+	sj.resultColumns = append(sj.resultColumns, rc)
+	sj.weightStrings[rc] = len(sj.resultColumns) - 1
+	return len(sj.resultColumns) - 1, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// buildSQLCalcFoundRowsPlan builds the plan for a select with
+// SQL_CALC_FOUND_ROWS. The tablets can't count the rows, because
+// FOUND_ROWS() is scoped to the MySQL connection, which is returned
+// to the pool. Instead, the rows are counted by a separate count
+// query built from the select without its limit.
+// Without a limit, the select returns all the rows it finds, and the
+// plan is the plain select: vtgate counts the rows it returns.
+func buildSQLCalcFoundRowsPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	sel.SQLCalcFoundRows = false
+	if sel.Limit == nil {
+		return buildSelectPlan(sel, vschema)
+	}
+
+	// The count query is built before the select is planned,
+	// because planning can modify the AST.
+	countSel, err := buildCountSelect(sel)
+	if err != nil {
+		return nil, err
+	}
+	limitPrimitive, err := buildSelectPlan(sel, vschema)
+	if err != nil {
+		return nil, err
+	}
+	countPrimitive, err := buildSelectPlan(countSel, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.SQLCalcFoundRows{
+		LimitPrimitive: limitPrimitive,
+		CountPrimitive: countPrimitive,
+	}, nil
+}
+
+// buildCountSelect returns a select that counts the rows the
+// select would return without its limit. Rows are counted directly
+// unless they're grouped or made distinct, in which case the select
+// is counted as a derived table.
+func buildCountSelect(sel *sqlparser.Select) (*sqlparser.Select, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	if sel.GroupBy == nil && sel.Having == nil && sel.Distinct == "" {
+		buf.Myprintf("%vselect count(*) from %v%v", sel.With, sel.From, sel.Where)
+	} else {
+		inner := *sel
+		inner.With = nil
+		inner.OrderBy = nil
+		inner.Limit = nil
+		buf.Myprintf("%vselect count(*) from (%v) as t", sel.With, &inner)
+	}
+	// The count query is parsed again, to get an AST
+	// that doesn't share any nodes with the select.
+	stmt, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	countSel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected count statement type: %T", stmt)
+	}
+	return countSel, nil
+}
@@ -89,8 +89,9 @@ func newSymtabWithRoute(rb *route) *symtab {
 // list of vindex maps, one for each input.
 func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vindexes.Table, rb *route) (vindexMaps []map[*column]vindexes.SingleColumn, err error) {
 	t := &table{
-		alias:  alias,
-		origin: rb,
+		alias:        alias,
+		origin:       rb,
+		vschemaTable: vschemaTables[0],
 	}
 
 	vindexMaps = make([]map[*column]vindexes.SingleColumn, len(vschemaTables))
@@ -455,6 +456,10 @@ type table struct {
 	columnNames     []sqlparser.ColIdent
 	isAuthoritative bool
 	origin          builder
+
+	// vschemaTable is set only if the table originates from
+	// a vschema table. It's used only for multi-table DMLs.
+	vschemaTable *vindexes.Table
 }
 
 func (t *table) addColumn(alias sqlparser.ColIdent, c *column) {
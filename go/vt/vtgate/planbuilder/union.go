@@ -37,6 +37,11 @@ func buildUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (engine.Prim
 }
 
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
+	if union.With != nil {
+		if err := pb.processWith(union, union.With); err != nil {
+			return err
+		}
+	}
 	if err := pb.processPart(union.Left, outer); err != nil {
 		return err
 	}
@@ -48,6 +53,12 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 	if err := unionRouteMerge(union, pb.bldr, rpb.bldr); err != nil {
 		return err
 	}
+	if union.With != nil {
+		// Only a recursive WITH clause remains at this point.
+		if err := pb.checkRecursiveWith(); err != nil {
+			return err
+		}
+	}
 	pb.st.Outer = outer
 
 	if err := pb.pushOrderBy(union.OrderBy); err != nil {
@@ -80,6 +91,6 @@ func unionRouteMerge(union *sqlparser.Union, left, right builder) error {
 	if !lroute.MergeUnion(rroute) {
 		return errors.New("unsupported: UNION cannot be executed as a single route")
 	}
-	lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
+	lroute.Select = &sqlparser.Union{With: union.With, Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
 	return nil
 }
@@ -28,7 +28,7 @@ import (
 
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Update, error) {
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dml, st, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +41,13 @@ func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Upd
 		return eupd, nil
 	}
 
+	if eupd.Table == nil {
+		if err := buildMultiTableChangedVindexesValues(upd, eupd, st); err != nil {
+			return nil, err
+		}
+		return eupd, nil
+	}
+
 	if eupd.ChangedVindexValues, err = buildChangedVindexesValues(upd, eupd.Table.ColumnVindexes); err != nil {
 		return nil, err
 	}
@@ -99,6 +106,54 @@ func buildChangedVindexesValues(update *sqlparser.Update, colVindexes []*vindexe
 	return changedVindexes, nil
 }
 
+// buildMultiTableChangedVindexesValues finds the table whose vindexes are changed
+// by a multi-table update, and makes it the table of the plan. Only one table can
+// have changing vindexes. If there's none, the first table is used. Reference
+// tables can't be updated.
+func buildMultiTableChangedVindexesValues(upd *sqlparser.Update, eupd *engine.Update, st *symtab) error {
+	for _, t := range st.AllTables() {
+		if t.vschemaTable == nil {
+			continue
+		}
+		if eupd.Table == nil {
+			eupd.Table = t.vschemaTable
+		}
+
+		// Only the assignments that can refer to the table are analyzed.
+		tableUpd := *upd
+		tableUpd.Exprs = nil
+		for _, assignment := range upd.Exprs {
+			if assignment.Name.Qualifier.IsEmpty() || assignment.Name.Qualifier == t.alias {
+				tableUpd.Exprs = append(tableUpd.Exprs, assignment)
+			}
+		}
+		// Every shard has its own copy of a reference table, but the
+		// update is only sent to the shards of the tables it joins.
+		if t.vschemaTable.Type == vindexes.TypeReference && len(tableUpd.Exprs) != 0 {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update of reference table %s", t.vschemaTable.Name.String())
+		}
+		changedVindexes, err := buildChangedVindexesValues(&tableUpd, t.vschemaTable.ColumnVindexes)
+		if err != nil {
+			return err
+		}
+		if len(changedVindexes) == 0 {
+			continue
+		}
+		if len(eupd.ChangedVindexValues) != 0 {
+			return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update that changes the vindexes of more than one table")
+		}
+		ksidVindex, ksidCol, err := getKsidVindex(t.vschemaTable)
+		if err != nil {
+			return err
+		}
+		eupd.Table = t.vschemaTable
+		eupd.ChangedVindexValues = changedVindexes
+		eupd.OwnedVindexQuery = generateMultiTableDMLSubquery(upd.TableExprs, upd.Where, t.alias, t.vschemaTable, ksidCol)
+		eupd.KsidVindex = ksidVindex
+	}
+	return nil
+}
+
 // extractValueFromUpdate given an UpdateExpr attempts to extracts the Value
 // it's holding. At the moment it only supports: StrVal, HexVal, IntVal, ValArg.
 // If a complex expression is provided (e.g set name = name + 1), the update will be rejected.
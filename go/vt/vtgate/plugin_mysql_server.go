@@ -94,6 +94,7 @@ func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
 	vh.connections[c] = true
+	vh.vtg.executor.processList.add(c.ConnectionID, c.User, c.RemoteAddr().String(), c.Close)
 }
 
 func (vh *vtgateHandler) numConnections() int {
@@ -108,18 +109,24 @@ func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 	if session.InTransaction {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
-	_, _, err := vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
+	err := vh.vtg.CloseSession(ctx, session)
 	if err != nil {
 		log.Errorf("Error happened in transaction rollback: %v", err)
 	}
+	// Resetting the connection also resets its system variables
+	// and drops its temporary tables.
+	session.SystemVariables = nil
+	session.TemporaryTables = nil
 }
 
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
-	// Rollback if there is an ongoing transaction. Ignore error.
+	// Rollback if there is an ongoing transaction and release
+	// reserved connections. Ignore error.
 	defer func() {
 		vh.mu.Lock()
 		defer vh.mu.Unlock()
 		delete(vh.connections, c)
+		vh.vtg.executor.processList.remove(c.ConnectionID)
 	}()
 
 	var ctx context.Context
@@ -134,7 +141,7 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 	if session.InTransaction {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
-	_, _, _ = vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
+	_ = vh.vtg.CloseSession(ctx, session)
 }
 
 // Regexp to extract parent span id over the sql query
@@ -208,6 +215,11 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		}
 	}()
 
+	ctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	vh.startQuery(c, query, cancel)
+	defer vh.endQuery(c)
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
 		return mysql.NewSQLErrorFromError(err)
@@ -297,6 +309,11 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
+	ctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	vh.startQuery(c, prepare.PrepareStmt, cancel)
+	defer vh.endQuery(c)
+
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
@@ -310,6 +327,16 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	return callback(qr)
 }
 
+// startQuery records the query in the process list, where it can
+// be seen by SHOW PROCESSLIST, and cancelled by KILL.
+func (vh *vtgateHandler) startQuery(c *mysql.Conn, query string, cancel context.CancelFunc) {
+	vh.vtg.executor.processList.startQuery(c.ConnectionID, vh.session(c).TargetString, query, cancel)
+}
+
+func (vh *vtgateHandler) endQuery(c *mysql.Conn) {
+	vh.vtg.executor.processList.endQuery(c.ConnectionID)
+}
+
 func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 	return uint16(len(vh.session(c).GetWarnings()))
 }
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// processList is the registry of the client connections of vtgate
+// and the queries they're running. It backs SHOW PROCESSLIST and
+// KILL [CONNECTION | QUERY].
+//
+// Users can always see and kill their own connections. Acting on
+// the connections of other users requires the MONITORING role to
+// list them, and the ADMIN role to kill them.
+type processList struct {
+	mu        sync.Mutex
+	processes map[uint32]*process
+}
+
+// process is a client connection in the processList.
+// The fields that change with every query are protected
+// by processList.mu.
+type process struct {
+	id   uint32
+	user string
+	host string
+	// closeConn closes the client connection.
+	closeConn func()
+
+	db    string
+	query string
+	// since is the time the process entered its current state.
+	since time.Time
+	// cancel cancels the context of the running query,
+	// which also kills the queries it sent to the tablets.
+	cancel context.CancelFunc
+}
+
+func newProcessList() *processList {
+	return &processList{
+		processes: make(map[uint32]*process),
+	}
+}
+
+// add registers a new client connection.
+func (pl *processList) add(id uint32, user, host string, closeConn func()) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	pl.processes[id] = &process{
+		id:        id,
+		user:      user,
+		host:      host,
+		closeConn: closeConn,
+		since:     time.Now(),
+	}
+}
+
+// remove unregisters a client connection.
+func (pl *processList) remove(id uint32) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	delete(pl.processes, id)
+}
+
+// startQuery records the query a connection is running, along with
+// the function that cancels it.
+func (pl *processList) startQuery(id uint32, db, query string, cancel context.CancelFunc) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	p, ok := pl.processes[id]
+	if !ok {
+		return
+	}
+	p.db = db
+	p.query = query
+	p.since = time.Now()
+	p.cancel = cancel
+}
+
+// endQuery records that a connection is done with its query.
+func (pl *processList) endQuery(id uint32) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	p, ok := pl.processes[id]
+	if !ok {
+		return
+	}
+	p.query = ""
+	p.since = time.Now()
+	p.cancel = nil
+}
+
+// kill cancels the running query of a connection. Unless queryOnly
+// is set, it also closes the connection.
+func (pl *processList) kill(actor string, id uint32, queryOnly bool) error {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	p, ok := pl.processes[id]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "Unknown thread id: %d", id)
+	}
+	if p.user != actor && acl.CheckAccessActor(actor, acl.ADMIN) != nil {
+		return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "You are not owner of thread %d", id)
+	}
+	if p.cancel != nil {
+		p.cancel()
+	}
+	if !queryOnly {
+		p.closeConn()
+	}
+	return nil
+}
+
+var processListFields = []*querypb.Field{
+	{Name: "Id", Type: sqltypes.Uint64},
+	{Name: "User", Type: sqltypes.VarChar},
+	{Name: "Host", Type: sqltypes.VarChar},
+	{Name: "db", Type: sqltypes.VarChar},
+	{Name: "Command", Type: sqltypes.VarChar},
+	{Name: "Time", Type: sqltypes.Int64},
+	{Name: "State", Type: sqltypes.VarChar},
+	{Name: "Info", Type: sqltypes.VarChar},
+}
+
+// result returns the processes the actor is allowed to see,
+// in the format of SHOW PROCESSLIST.
+func (pl *processList) result(actor string) *sqltypes.Result {
+	seeAll := acl.CheckAccessActor(actor, acl.MONITORING) == nil
+
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	processes := make([]*process, 0, len(pl.processes))
+	for _, p := range pl.processes {
+		if seeAll || p.user == actor {
+			processes = append(processes, p)
+		}
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].id < processes[j].id
+	})
+
+	now := time.Now()
+	rows := make([][]sqltypes.Value, 0, len(processes))
+	for _, p := range processes {
+		command, state, info := "Sleep", "", sqltypes.NULL
+		if p.query != "" {
+			command, state, info = "Query", "executing", sqltypes.NewVarChar(p.query)
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewUint64(uint64(p.id)),
+			sqltypes.NewVarChar(p.user),
+			sqltypes.NewVarChar(p.host),
+			sqltypes.NewVarChar(p.db),
+			sqltypes.NewVarChar(command),
+			sqltypes.NewInt64(int64(now.Sub(p.since) / time.Second)),
+			sqltypes.NewVarChar(state),
+			info,
+		})
+	}
+	return &sqltypes.Result{
+		Fields:       processListFields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}
+}
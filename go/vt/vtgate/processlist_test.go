@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestProcessListResult(t *testing.T) {
+	pl := newProcessList()
+	pl.add(2, "user2", "host2:3306", func() {})
+	pl.add(1, "user1", "host1:3306", func() {})
+	pl.startQuery(2, "ks", "select 1 from dual", func() {})
+
+	result := pl.result("user1")
+	assert.Equal(t, processListFields, result.Fields)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewUint64(1),
+		sqltypes.NewVarChar("user1"),
+		sqltypes.NewVarChar("host1:3306"),
+		sqltypes.NewVarChar(""),
+		sqltypes.NewVarChar("Sleep"),
+		sqltypes.NewInt64(0),
+		sqltypes.NewVarChar(""),
+		sqltypes.NULL,
+	}, {
+		sqltypes.NewUint64(2),
+		sqltypes.NewVarChar("user2"),
+		sqltypes.NewVarChar("host2:3306"),
+		sqltypes.NewVarChar("ks"),
+		sqltypes.NewVarChar("Query"),
+		sqltypes.NewInt64(0),
+		sqltypes.NewVarChar("executing"),
+		sqltypes.NewVarChar("select 1 from dual"),
+	}}
+	assert.Equal(t, wantRows, result.Rows)
+
+	pl.endQuery(2)
+	pl.remove(1)
+	result = pl.result("user1")
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, "Sleep", result.Rows[0][4].ToString())
+	assert.True(t, result.Rows[0][7].IsNull())
+}
+
+func TestProcessListKill(t *testing.T) {
+	pl := newProcessList()
+	closed := false
+	pl.add(1, "user1", "host1:3306", func() { closed = true })
+
+	// Killing the query of an idle connection is a no-op.
+	err := pl.kill("user1", 1, true)
+	require.NoError(t, err)
+	assert.False(t, closed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pl.startQuery(1, "", "select sleep(100) from dual", cancel)
+	err = pl.kill("user1", 1, true)
+	require.NoError(t, err)
+	assert.Error(t, ctx.Err(), "query context should be cancelled")
+	assert.False(t, closed, "KILL QUERY should not close the connection")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	pl.startQuery(1, "", "select sleep(100) from dual", cancel)
+	err = pl.kill("user1", 1, false)
+	require.NoError(t, err)
+	assert.Error(t, ctx.Err(), "query context should be cancelled")
+	assert.True(t, closed, "KILL should close the connection")
+
+	err = pl.kill("user1", 2, false)
+	assert.EqualError(t, err, "Unknown thread id: 2")
+}
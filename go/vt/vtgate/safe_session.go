@@ -17,6 +17,8 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -82,10 +84,16 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	// Autocommit sessions are short-lived and must not pin
+	// reserved connections that nobody would release.
+	newSession.SystemVariables = nil
+	newSession.TemporaryTables = nil
 	return NewSafeSession(newSession)
 }
 
-// Reset clears the session
+// Reset clears the transaction state of the session.
+// Shard sessions that hold a reserved connection are retained,
+// because the connection outlives the transaction.
 func (session *SafeSession) Reset() {
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -93,7 +101,16 @@ func (session *SafeSession) Reset() {
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
 	session.SingleDb = false
-	session.ShardSessions = nil
+	var reserved []*vtgatepb.Session_ShardSession
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.ReservedId == 0 {
+			continue
+		}
+		shardSession.TransactionId = 0
+		reserved = append(reserved, shardSession)
+	}
+	session.ShardSessions = reserved
+	session.Savepoints = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
@@ -150,8 +167,8 @@ func (session *SafeSession) InTransaction() bool {
 	return session.Session.InTransaction
 }
 
-// Find returns the transactionId, if any, for a session
-func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) int64 {
+// Find returns the transactionId and reservedId, if any, for a session
+func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) (transactionID, reservedID int64) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
 	sessions := session.ShardSessions
@@ -163,10 +180,151 @@ func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.T
 	}
 	for _, shardSession := range sessions {
 		if keyspace == shardSession.Target.Keyspace && tabletType == shardSession.Target.TabletType && shard == shardSession.Target.Shard {
-			return shardSession.TransactionId
+			return shardSession.TransactionId, shardSession.ReservedId
 		}
 	}
-	return 0
+	return 0, 0
+}
+
+// InReservedConn returns true if the queries of the session have to
+// run on reserved connections, i.e. system variables have been set
+// or temporary tables created. Only the normal commit order uses
+// reserved connections.
+func (session *SafeSession) InReservedConn() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return (len(session.SystemVariables) > 0 || len(session.TemporaryTables) > 0) && session.commitOrder == vtgatepb.CommitOrder_NORMAL
+}
+
+// SetSystemVariable sets the system variable to the given SQL expression.
+// An empty expression removes the variable.
+func (session *SafeSession) SetSystemVariable(name, expr string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if expr == "" {
+		delete(session.SystemVariables, name)
+		return
+	}
+	if session.SystemVariables == nil {
+		session.SystemVariables = make(map[string]string)
+	}
+	session.SystemVariables[name] = expr
+}
+
+// SetPreQueries returns the set statements that have to be executed
+// on a newly reserved connection, sorted by variable name.
+func (session *SafeSession) SetPreQueries() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	names := make([]string, 0, len(session.SystemVariables))
+	for name := range session.SystemVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	preQueries := make([]string, 0, len(names))
+	for _, name := range names {
+		preQueries = append(preQueries, fmt.Sprintf("set %s = %s", name, session.SystemVariables[name]))
+	}
+	return preQueries
+}
+
+// AddTemporaryTable records a temporary table created in the keyspace.
+// It returns false if the session already had it.
+func (session *SafeSession) AddTemporaryTable(keyspace, name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	qualified := keyspace + "." + name
+	for _, table := range session.TemporaryTables {
+		if table == qualified {
+			return false
+		}
+	}
+	session.TemporaryTables = append(session.TemporaryTables, qualified)
+	return true
+}
+
+// RemoveTemporaryTable forgets a temporary table of the keyspace.
+// It returns false if the session didn't have it.
+func (session *SafeSession) RemoveTemporaryTable(keyspace, name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	qualified := keyspace + "." + name
+	for i, table := range session.TemporaryTables {
+		if table == qualified {
+			session.TemporaryTables = append(session.TemporaryTables[:i], session.TemporaryTables[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// HasTemporaryTable returns true if the session created
+// the temporary table in the keyspace.
+func (session *SafeSession) HasTemporaryTable(keyspace, name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	qualified := keyspace + "." + name
+	for _, table := range session.TemporaryTables {
+		if table == qualified {
+			return true
+		}
+	}
+	return false
+}
+
+// TransactionSavepoints returns the savepoint statements that have to be
+// replayed on a shard joining the transaction, so that a later rollback
+// to one of them also undoes the work done on that shard. Savepoints only
+// apply to the normal commit order.
+func (session *SafeSession) TransactionSavepoints() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.commitOrder != vtgatepb.CommitOrder_NORMAL {
+		return nil
+	}
+	return session.Savepoints
+}
+
+// SetSavepoints replaces the savepoint statements of the transaction.
+func (session *SafeSession) SetSavepoints(savepoints []string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Savepoints = savepoints
+}
+
+// SetLockSession sets the shard session holding the connection the
+// advisory lock functions of the session are executed on.
+func (session *SafeSession) SetLockSession(lockSession *vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.LockSession = lockSession
+}
+
+// ReservedSessions returns the shard sessions that hold a reserved connection.
+func (session *SafeSession) ReservedSessions() []*vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	var reserved []*vtgatepb.Session_ShardSession
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.ReservedId != 0 {
+			reserved = append(reserved, shardSession)
+		}
+	}
+	return reserved
+}
+
+// ClearReservedSessions removes all the shard sessions that hold a
+// reserved connection.
+func (session *SafeSession) ClearReservedSessions() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	var remaining []*vtgatepb.Session_ShardSession
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.ReservedId == 0 {
+			remaining = append(remaining, shardSession)
+		}
+	}
+	session.ShardSessions = remaining
 }
 
 // Append adds a new ShardSession
@@ -174,22 +332,24 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 	session.mu.Lock()
 	defer session.mu.Unlock()
 
-	if session.autocommitState == autocommitted {
+	if session.autocommitState == autocommitted && shardSession.TransactionId != 0 {
 		// Unreachable.
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.Append: unexpected autocommit state")
 	}
-	if !session.Session.InTransaction {
+	if !session.Session.InTransaction && shardSession.ReservedId == 0 {
 		// Unreachable.
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.Append: not in transaction")
 	}
-	session.autocommitState = notAutocommittable
+	if session.Session.InTransaction {
+		session.autocommitState = notAutocommittable
+	}
 
 	// Always append, in order for rollback to succeed.
 	switch session.commitOrder {
 	case vtgatepb.CommitOrder_NORMAL:
-		session.ShardSessions = append(session.ShardSessions, shardSession)
+		session.appendOrUpdate(shardSession)
 		// isSingle is enforced only for normmal commit order operations.
-		if session.isSingleDB(txMode) && len(session.ShardSessions) > 1 {
+		if session.isSingleDB(txMode) && session.numTransactions() > 1 {
 			session.mustRollback = true
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "multi-db transaction attempted: %v", session.ShardSessions)
 		}
@@ -201,6 +361,37 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 	return nil
 }
 
+// appendOrUpdate appends the shard session to ShardSessions. If there
+// already is one for the same target, which happens when a transaction
+// is started on a reserved connection or vice versa, it's updated instead.
+func (session *SafeSession) appendOrUpdate(shardSession *vtgatepb.Session_ShardSession) {
+	target := shardSession.Target
+	for _, existing := range session.ShardSessions {
+		if target.Keyspace == existing.Target.Keyspace && target.TabletType == existing.Target.TabletType && target.Shard == existing.Target.Shard {
+			if shardSession.TransactionId != 0 {
+				existing.TransactionId = shardSession.TransactionId
+			}
+			if shardSession.ReservedId != 0 {
+				existing.ReservedId = shardSession.ReservedId
+			}
+			return
+		}
+	}
+	session.ShardSessions = append(session.ShardSessions, shardSession)
+}
+
+// numTransactions returns the number of shard sessions
+// that are part of the transaction.
+func (session *SafeSession) numTransactions() int {
+	count := 0
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId != 0 {
+			count++
+		}
+	}
+	return count
+}
+
 func (session *SafeSession) isSingleDB(txMode vtgatepb.TransactionMode) bool {
 	return session.SingleDb ||
 		session.TransactionMode == vtgatepb.TransactionMode_SINGLE ||
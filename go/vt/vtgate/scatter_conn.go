@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -64,13 +65,45 @@ type ScatterConn struct {
 type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
 
 // shardActionTransactionFunc defines the contract for a shard action
-// that may be in a transaction. Every such function executes the
-// necessary action on a shard (with an optional Begin call), aggregates
-// the results, and return an error if any.
+// that may be in a transaction or on a reserved connection. Every such
+// function executes the necessary action on a shard (with an optional
+// Begin or Reserve call), aggregates the results, and returns the
+// updated shardActionInfo and an error if any.
 // multiGoTransaction is capable of executing multiple
 // shardActionTransactionFunc actions in parallel and consolidating
 // the results and errors for the caller.
-type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error)
+type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error)
+
+// actionNeeded is the action that has to be performed on a shard
+// before the query can be executed.
+type actionNeeded int
+
+const (
+	nothing = actionNeeded(iota)
+	begin
+	reserve
+	reserveBegin
+)
+
+// shardActionInfo describes what needs to be done on a shard
+// and which connection the query has to run on.
+type shardActionInfo struct {
+	actionNeeded  actionNeeded
+	transactionID int64
+	reservedID    int64
+	preQueries    []string
+	// savepoints are replayed after the transaction is started.
+	savepoints []string
+}
+
+// connID returns the id of the tablet connection the query must run on,
+// or 0 if any connection can be used.
+func (info *shardActionInfo) connID() int64 {
+	if info.transactionID != 0 {
+		return info.transactionID
+	}
+	return info.reservedID
+}
 
 // NewScatterConn creates a new ScatterConn.
 func NewScatterConn(statsName string, txConn *TxConn, gw gateway.Gateway, hc discovery.HealthCheck) *ScatterConn {
@@ -139,20 +172,10 @@ func (stc *ScatterConn) Execute(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
-			var innerqr *sqltypes.Result
-			if shouldBegin {
-				var err error
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, options)
-				if err != nil {
-					return transactionID, err
-				}
-			} else {
-				var err error
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, query, bindVars, transactionID, options)
-				if err != nil {
-					return transactionID, err
-				}
+		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
+			innerqr, info, err := stc.executeOnShard(ctx, rs, info, query, bindVars, options)
+			if err != nil {
+				return info, err
 			}
 
 			mu.Lock()
@@ -161,7 +184,7 @@ func (stc *ScatterConn) Execute(
 			if len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
-			return transactionID, nil
+			return info, nil
 		},
 	)
 
@@ -199,7 +222,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
 			var (
 				innerqr *sqltypes.Result
 				err     error
@@ -210,15 +233,21 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			}
 
 			switch {
+			case autocommit && (info.actionNeeded == reserve || info.actionNeeded == reserveBegin || info.reservedID != 0):
+				// The query has to run on a reserved connection, where
+				// MySQL autocommits it without a transaction.
+				info.actionNeeded = nothing
+				if info.reservedID == 0 {
+					info.actionNeeded = reserve
+				}
+				innerqr, info, err = stc.executeOnShard(ctx, rs, info, queries[i].Sql, queries[i].BindVariables, opts)
 			case autocommit:
 				innerqr, err = stc.executeAutocommit(ctx, rs, queries[i].Sql, queries[i].BindVariables, opts)
-			case shouldBegin:
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, opts)
 			default:
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, transactionID, opts)
+				innerqr, info, err = stc.executeOnShard(ctx, rs, info, queries[i].Sql, queries[i].BindVariables, opts)
 			}
 			if err != nil {
-				return transactionID, err
+				return info, err
 			}
 
 			mu.Lock()
@@ -227,7 +256,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
-			return transactionID, nil
+			return info, nil
 		},
 	)
 
@@ -238,6 +267,88 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	return qr, allErrors.GetErrors()
 }
 
+// executeOnShard executes the query on the shard, starting a transaction
+// and reserving a connection as requested by info. It returns the
+// shardActionInfo describing the connection the query ran on.
+func (stc *ScatterConn) executeOnShard(ctx context.Context, rs *srvtopo.ResolvedShard, info *shardActionInfo, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, *shardActionInfo, error) {
+	if len(info.savepoints) != 0 && (info.actionNeeded == begin || info.actionNeeded == reserveBegin) {
+		// The transaction is started with the first savepoint,
+		// and the rest of them are replayed before the query.
+		beginInfo := *info
+		beginInfo.savepoints = nil
+		_, newInfo, err := stc.executeOnShard(ctx, rs, &beginInfo, info.savepoints[0], nil, options)
+		if err != nil {
+			return nil, newInfo, err
+		}
+		for _, savepoint := range info.savepoints[1:] {
+			if _, err := rs.QueryService.Execute(ctx, rs.Target, savepoint, nil, newInfo.connID(), options); err != nil {
+				return nil, newInfo, err
+			}
+		}
+		qr, err := rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, newInfo.connID(), options)
+		return qr, newInfo, err
+	}
+
+	var (
+		qr  *sqltypes.Result
+		id  int64
+		err error
+	)
+	newInfo := *info
+	switch info.actionNeeded {
+	case begin:
+		qr, id, err = rs.QueryService.BeginExecute(ctx, rs.Target, sql, bindVariables, options)
+		newInfo.transactionID = id
+	case reserve:
+		qr, id, err = rs.QueryService.ReserveExecute(ctx, rs.Target, info.preQueries, sql, bindVariables, info.transactionID, options)
+		newInfo.reservedID = id
+	case reserveBegin:
+		qr, id, err = rs.QueryService.ReserveBeginExecute(ctx, rs.Target, info.preQueries, sql, bindVariables, info.reservedID, options)
+		newInfo.transactionID = id
+		newInfo.reservedID = id
+	default:
+		qr, err = rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, info.connID(), options)
+	}
+	return qr, &newInfo, err
+}
+
+// ExecuteLock executes the advisory lock query on the dedicated lock
+// connection of the session. The connection is reserved on first use,
+// and held until the session is closed, so the locks acquired on it
+// aren't lost when the query completes.
+func (stc *ScatterConn) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error) {
+	startTime, statsKey := stc.startAction("ExecuteLock", rs.Target)
+	defer stc.timings.Record(statsKey, startTime)
+
+	if rs.Target.TabletType != topodatapb.TabletType_MASTER {
+		// Like other reserved connections, the lock connection can only
+		// be held on a master.
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "lock functions are only supported on master, not on %s", topoproto.TabletTypeLString(rs.Target.TabletType))
+	}
+	opts := session.GetOptions()
+	lockSession := session.GetLockSession()
+	if lockSession == nil {
+		qr, reservedID, err := rs.QueryService.ReserveExecute(ctx, rs.Target, nil, query.Sql, query.BindVariables, 0, opts)
+		if reservedID != 0 {
+			session.SetLockSession(&vtgatepb.Session_ShardSession{
+				Target:     rs.Target,
+				ReservedId: reservedID,
+			})
+		}
+		return qr, err
+	}
+	if !proto.Equal(lockSession.Target, rs.Target) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "lock connection of the session is on %s/%s, cannot lock on %s/%s", lockSession.Target.Keyspace, lockSession.Target.Shard, rs.Target.Keyspace, rs.Target.Shard)
+	}
+	qr, err := rs.QueryService.Execute(ctx, rs.Target, query.Sql, query.BindVariables, lockSession.ReservedId, opts)
+	if vterrors.Code(err) == vtrpcpb.Code_ABORTED {
+		// The connection is gone, and the locks held on it with it.
+		// The next lock query reserves a new connection.
+		session.SetLockSession(nil)
+	}
+	return qr, err
+}
+
 func (stc *ScatterConn) executeAutocommit(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	queries := []*querypb.BoundQuery{{
 		Sql:           sql,
@@ -275,23 +386,16 @@ func (stc *ScatterConn) ExecuteEntityIds(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
-			var innerqr *sqltypes.Result
-			var err error
-
-			if shouldBegin {
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, sqls[i], bindVars[i], options)
-			} else {
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, sqls[i], bindVars[i], transactionID, options)
-			}
+		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
+			innerqr, info, err := stc.executeOnShard(ctx, rs, info, sqls[i], bindVars[i], options)
 			if err != nil {
-				return transactionID, err
+				return info, err
 			}
 
 			mu.Lock()
 			defer mu.Unlock()
 			qr.AppendResult(innerqr)
-			return transactionID, nil
+			return info, nil
 		})
 
 	return qr, allErrors.AggrError(vterrors.Aggregate)
@@ -784,7 +888,9 @@ func (stc *ScatterConn) multiGo(
 // ResolvedShards in parallel. For each shard, if the requested
 // session is in a transaction, it opens a new transactions on the connection,
 // and updates the Session with the transaction id. If the session already
-// contains a transaction id for the shard, it reuses it.
+// contains a transaction id for the shard, it reuses it. Likewise, if the
+// session has system variables set, the action runs on a reserved
+// connection carrying them, which is recorded in the Session.
 // The action function must match the shardActionTransactionFunc signature.
 //
 // It returns an error recorder in which each shard error is recorded positionally,
@@ -811,12 +917,17 @@ func (stc *ScatterConn) multiGoTransaction(
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
-		shouldBegin, transactionID := transactionInfo(rs.Target, session, notInTransaction)
-		transactionID, err = action(rs, i, shouldBegin, transactionID)
-		if shouldBegin && transactionID != 0 {
+		var info, newInfo *shardActionInfo
+		info, err = actionInfo(rs.Target, session, notInTransaction)
+		if err != nil {
+			return
+		}
+		newInfo, err = action(rs, i, info)
+		if info.actionNeeded != nothing && (newInfo.transactionID != 0 || newInfo.reservedID != 0) {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 				Target:        rs.Target,
-				TransactionId: transactionID,
+				TransactionId: newInfo.transactionID,
+				ReservedId:    newInfo.reservedID,
 			}, stc.txConn.mode); appendErr != nil {
 				err = appendErr
 			}
@@ -848,6 +959,53 @@ end:
 	return allErrors
 }
 
+// actionInfo looks at the current session, and returns the
+// shardActionInfo for the target: whether a transaction has to be
+// started or a connection reserved, and the ids to use.
+// Connections are only reserved on masters, because other
+// tablet types may be served by a different tablet on every call:
+// a session that needs a reserved connection cannot target them.
+func actionInfo(target *querypb.Target, session *SafeSession, notInTransaction bool) (*shardActionInfo, error) {
+	inTransaction := session.InTransaction()
+	inReservedConn := session.InReservedConn()
+	if inReservedConn && target.TabletType != topodatapb.TabletType_MASTER {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "system variables and temporary tables are only supported on master: cannot target %v", topoproto.TabletTypeLString(target.TabletType))
+	}
+	if !inTransaction && !inReservedConn {
+		return &shardActionInfo{}, nil
+	}
+	// No need to protect ourselves from the race condition between
+	// Find and Append. The higher level functions ensure that no
+	// duplicate (target) tuples can execute
+	// this at the same time.
+	transactionID, reservedID := session.Find(target.Keyspace, target.Shard, target.TabletType)
+	info := &shardActionInfo{
+		transactionID: transactionID,
+		reservedID:    reservedID,
+	}
+	// We are in a transaction at higher level,
+	// but client requires not to start a transaction for this query.
+	// If a transaction was started on this conn, we will use it.
+	shouldBegin := inTransaction && transactionID == 0 && !notInTransaction
+	shouldReserve := inReservedConn && reservedID == 0
+	if shouldBegin {
+		info.savepoints = session.TransactionSavepoints()
+	}
+	switch {
+	case shouldBegin && reservedID != 0:
+		info.actionNeeded = reserveBegin
+	case shouldBegin && shouldReserve:
+		info.actionNeeded = reserveBegin
+		info.preQueries = session.SetPreQueries()
+	case shouldBegin:
+		info.actionNeeded = begin
+	case shouldReserve:
+		info.actionNeeded = reserve
+		info.preQueries = session.SetPreQueries()
+	}
+	return info, nil
+}
+
 // transactionInfo looks at the current session, and returns:
 // - shouldBegin: if we should call 'Begin' to get a transactionID
 // - transactionID: the transactionID to use, or 0 if not in a transaction.
@@ -863,7 +1021,7 @@ func transactionInfo(
 	// Find and Append. The higher level functions ensure that no
 	// duplicate (target) tuples can execute
 	// this at the same time.
-	transactionID = session.Find(target.Keyspace, target.Shard, target.TabletType)
+	transactionID, _ = session.Find(target.Keyspace, target.Shard, target.TabletType)
 	if transactionID != 0 {
 		return false, transactionID
 	}
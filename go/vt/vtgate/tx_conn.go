@@ -106,6 +106,10 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId == 0 {
+			// Reserved connection that is not part of the transaction.
+			continue
+		}
 		if err := txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
 			shardSession.TransactionId = 0
 			_ = txc.Rollback(ctx, session)
@@ -130,16 +134,23 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "pre or post actions not allowed for 2PC commits")
 	}
 
+	var shardSessions []*vtgatepb.Session_ShardSession
+	for _, s := range session.ShardSessions {
+		if s.TransactionId != 0 {
+			shardSessions = append(shardSessions, s)
+		}
+	}
+
 	// If the number of participants is one or less, then it's a normal commit.
-	if len(session.ShardSessions) <= 1 {
+	if len(shardSessions) <= 1 {
 		return txc.commitNormal(ctx, session)
 	}
 
-	participants := make([]*querypb.Target, 0, len(session.ShardSessions)-1)
-	for _, s := range session.ShardSessions[1:] {
+	participants := make([]*querypb.Target, 0, len(shardSessions)-1)
+	for _, s := range shardSessions[1:] {
 		participants = append(participants, s.Target)
 	}
-	mmShard := session.ShardSessions[0]
+	mmShard := shardSessions[0]
 	dtid := dtids.New(mmShard)
 	err := txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
 	if err != nil {
@@ -148,7 +159,7 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
-	err = txc.runSessions(session.ShardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
+	err = txc.runSessions(shardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
 		return txc.gateway.Prepare(ctx, s.Target, s.TransactionId, dtid)
 	})
 	if err != nil {
@@ -166,7 +177,7 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
-	err = txc.runSessions(session.ShardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
+	err = txc.runSessions(shardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
 		return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
 	})
 	if err != nil {
@@ -194,6 +205,54 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Savepoint executes the savepoint statement on all the shards that
+// participate in the transaction. Shards joining the transaction later
+// replay the savepoints recorded in the session instead.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, sql string) error {
+	var shardSessions []*vtgatepb.Session_ShardSession
+	for _, s := range session.ShardSessions {
+		if s.TransactionId != 0 {
+			shardSessions = append(shardSessions, s)
+		}
+	}
+	return txc.runSessions(shardSessions, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, sql, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
+// Release releases all the reserved connections of the session,
+// including the lock connection. Any ongoing transaction is rolled
+// back first.
+func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
+	if err := txc.Rollback(ctx, session); err != nil {
+		log.Warningf("Rollback failed before releasing reserved connections: %v", err)
+	}
+	reserved := session.ReservedSessions()
+	if lockSession := session.GetLockSession(); lockSession != nil {
+		reserved = append(reserved, lockSession)
+		defer session.SetLockSession(nil)
+	}
+	if len(reserved) == 0 {
+		return nil
+	}
+	defer session.ClearReservedSessions()
+
+	return txc.runSessions(reserved, func(s *vtgatepb.Session_ShardSession) error {
+		return txc.gateway.Release(ctx, s.Target, s.ReservedId)
+	})
+}
+
+// SetSystemVariables applies the set statement to all the reserved
+// connections of the session, so they keep carrying the session's
+// system variables.
+func (txc *TxConn) SetSystemVariables(ctx context.Context, session *SafeSession, sql string) error {
+	return txc.runSessions(session.ReservedSessions(), func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, sql, nil, s.ReservedId, nil)
+		return err
+	})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
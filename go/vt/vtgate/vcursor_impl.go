@@ -52,6 +52,9 @@ type vcursorImpl struct {
 	// executed. If there was a subsequent failure, the transaction
 	// must be forced to rollback.
 	hasPartialDML bool
+	// foundRowsSet is set to true if the plan already recorded
+	// the value of FOUND_ROWS() for the query.
+	foundRowsSet bool
 }
 
 // newVcursorImpl creates a vcursorImpl. Before creating this object, you have to separate out any marginComments that came with
@@ -80,6 +83,11 @@ func (vc *vcursorImpl) MaxMemoryRows() int {
 	return *maxMemoryRows
 }
 
+// InTransaction returns true if the session is in a transaction.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
 // SetContextTimeout updates context and sets a timeout.
 func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	ctx, cancel := context.WithTimeout(vc.ctx, timeout)
@@ -102,6 +110,9 @@ func (vc *vcursorImpl) FindTable(name sqlparser.TableName) (*vindexes.Table, str
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
+	if table := vc.temporaryTable(destKeyspace, name.Name.String()); table != nil {
+		return table, destKeyspace, destTabletType, dest, nil
+	}
 	table, err := vc.executor.VSchema().FindTable(destKeyspace, name.Name.String())
 	if err != nil {
 		return nil, "", destTabletType, nil, err
@@ -118,6 +129,9 @@ func (vc *vcursorImpl) FindTablesOrVindex(name sqlparser.TableName) ([]*vindexes
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
+	if table := vc.temporaryTable(destKeyspace, name.Name.String()); table != nil {
+		return []*vindexes.Table{table}, nil, destKeyspace, destTabletType, dest, nil
+	}
 	tables, vindex, err := vc.executor.VSchema().FindTablesOrVindex(destKeyspace, name.Name.String(), vc.tabletType)
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
@@ -125,6 +139,36 @@ func (vc *vcursorImpl) FindTablesOrVindex(name sqlparser.TableName) ([]*vindexes
 	return tables, vindex, destKeyspace, destTabletType, dest, nil
 }
 
+// temporaryTableKeyspaceID is the keyspace id of the shard that holds
+// the temporary tables created in a sharded keyspace: its first shard.
+var temporaryTableKeyspaceID = []byte{0}
+
+// temporaryTableShard is the destination of temporaryTableKeyspaceID.
+var temporaryTableShard = key.DestinationKeyspaceID(temporaryTableKeyspaceID)
+
+// temporaryTable returns the table for a temporary table created by
+// the session in the keyspace, or nil if there is none. Temporary
+// tables shadow the tables of the vschema, and they have no vindexes:
+// in a sharded keyspace, they're pinned to temporaryTableKeyspaceID.
+func (vc *vcursorImpl) temporaryTable(keyspace, name string) *vindexes.Table {
+	if vc.safeSession == nil || !vc.safeSession.HasTemporaryTable(keyspace, name) {
+		return nil
+	}
+	ks, ok := vc.executor.VSchema().Keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	table := &vindexes.Table{
+		Name:      sqlparser.NewTableIdent(name),
+		Keyspace:  ks.Keyspace,
+		Temporary: true,
+	}
+	if ks.Keyspace.Sharded {
+		table.Pinned = temporaryTableKeyspaceID
+	}
+	return table
+}
+
 // DefaultKeyspace returns the default keyspace of the current request
 // if there is one. If the keyspace specified in the target cannot be
 // identified, it returns an error.
@@ -139,6 +183,32 @@ func (vc *vcursorImpl) DefaultKeyspace() (*vindexes.Keyspace, error) {
 	return ks.Keyspace, nil
 }
 
+// LockTarget returns the keyspace and the shard that hold the advisory
+// locks of all the sessions. They're set by the lock_keyspace and
+// lock_shard flags. Without lock_keyspace, the vschema must have only
+// one keyspace. Without lock_shard, the first shard of the keyspace
+// is used.
+func (vc *vcursorImpl) LockTarget() (*vindexes.Keyspace, key.Destination, error) {
+	keyspaces := vc.executor.VSchema().Keyspaces
+	name := *lockKeyspace
+	if name == "" {
+		if len(keyspaces) != 1 {
+			return nil, nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "lock functions require the lock_keyspace flag when there is more than one keyspace")
+		}
+		for ks := range keyspaces {
+			name = ks
+		}
+	}
+	ks, ok := keyspaces[name]
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "lock keyspace %s not found in vschema", name)
+	}
+	if *lockShard != "" {
+		return ks.Keyspace, key.DestinationShard(*lockShard), nil
+	}
+	return ks.Keyspace, key.DestinationKeyspaceID{0}, nil
+}
+
 // TargetString returns the current TargetString of the session.
 func (vc *vcursorImpl) TargetString() string {
 	return vc.safeSession.TargetString
@@ -193,6 +263,19 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	return qr, vterrors.Aggregate(errs)
 }
 
+// SetFoundRows is part of the engine.VCursor interface.
+func (vc *vcursorImpl) SetFoundRows(count uint64) {
+	vc.safeSession.FoundRows = count
+	vc.foundRowsSet = true
+}
+
+// ExecuteLock is part of the engine.VCursor interface.
+func (vc *vcursorImpl) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	atomic.AddUint32(&vc.logStats.ShardQueries, 1)
+	query.Sql = vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing
+	return vc.executor.scatterConn.ExecuteLock(vc.ctx, rs, query, vc.safeSession)
+}
+
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
@@ -81,16 +81,18 @@ func (rr *RoutingRule) MarshalJSON() ([]byte, error) {
 
 // Table represents a table in VSchema.
 type Table struct {
-	Type                    string               `json:"type,omitempty"`
-	Name                    sqlparser.TableIdent `json:"name"`
-	Keyspace                *Keyspace            `json:"-"`
-	ColumnVindexes          []*ColumnVindex      `json:"column_vindexes,omitempty"`
-	Ordered                 []*ColumnVindex      `json:"ordered,omitempty"`
-	Owned                   []*ColumnVindex      `json:"owned,omitempty"`
-	AutoIncrement           *AutoIncrement       `json:"auto_increment,omitempty"`
-	Columns                 []Column             `json:"columns,omitempty"`
-	Pinned                  []byte               `json:"pinned,omitempty"`
-	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	Type                    string                 `json:"type,omitempty"`
+	Name                    sqlparser.TableIdent   `json:"name"`
+	Keyspace                *Keyspace              `json:"-"`
+	ColumnVindexes          []*ColumnVindex        `json:"column_vindexes,omitempty"`
+	Ordered                 []*ColumnVindex        `json:"ordered,omitempty"`
+	Owned                   []*ColumnVindex        `json:"owned,omitempty"`
+	AutoIncrement           *AutoIncrement         `json:"auto_increment,omitempty"`
+	Columns                 []Column               `json:"columns,omitempty"`
+	Pinned                  []byte                 `json:"pinned,omitempty"`
+	ColumnListAuthoritative bool                   `json:"column_list_authoritative,omitempty"`
+	UniqueKeys              [][]sqlparser.ColIdent `json:"unique_keys,omitempty"`
+	Temporary               bool                   `json:"temporary,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -277,6 +279,18 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Columns = append(t.Columns, Column{Name: name, Type: col.Type})
 		}
 
+		// Initialize UniqueKeys.
+		for _, uk := range table.UniqueKeys {
+			if len(uk.Columns) == 0 {
+				return fmt.Errorf("must specify at least one column for unique key of table: %s", tname)
+			}
+			var columns []sqlparser.ColIdent
+			for _, col := range uk.Columns {
+				columns = append(columns, sqlparser.NewColIdent(col))
+			}
+			t.UniqueKeys = append(t.UniqueKeys, columns)
+		}
+
 		// Initialize ColumnVindexes.
 		for i, ind := range table.ColumnVindexes {
 			vindexInfo, ok := ks.Vindexes[ind.Name]
@@ -372,6 +372,50 @@ func TestVSchemaColumnListAuthoritative(t *testing.T) {
 	}
 }
 
+func TestVSchemaUniqueKeys(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						UniqueKeys: []*vschemapb.UniqueKey{{
+							Columns: []string{"id"},
+						}, {
+							Columns: []string{"c1", "c2"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	got, err := BuildVSchema(&good)
+	require.NoError(t, err)
+	want := [][]sqlparser.ColIdent{
+		{sqlparser.NewColIdent("id")},
+		{sqlparser.NewColIdent("c1"), sqlparser.NewColIdent("c2")},
+	}
+	if gotKeys := got.Keyspaces["unsharded"].Tables["t1"].UniqueKeys; !reflect.DeepEqual(gotKeys, want) {
+		t.Errorf("BuildVSchema: %v, want %v", gotKeys, want)
+	}
+
+	bad := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						UniqueKeys: []*vschemapb.UniqueKey{{}},
+					},
+				},
+			},
+		},
+	}
+	got, _ = BuildVSchema(&bad)
+	wantErr := "must specify at least one column for unique key of table: t1"
+	if err := got.Keyspaces["unsharded"].Error; err == nil || err.Error() != wantErr {
+		t.Errorf("BuildVSchema: %v, want %s", err, wantErr)
+	}
+}
+
 func TestVSchemaColumnsFail(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -62,6 +62,8 @@ var (
 	_                  = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows      = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows     = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	lockKeyspace       = flag.String("lock_keyspace", "", "the keyspace that holds the advisory locks taken with GET_LOCK. Required if the vschema has more than one keyspace.")
+	lockShard          = flag.String("lock_shard", "", "the shard of lock_keyspace that holds the advisory locks. Defaults to the first shard of the keyspace.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -819,6 +821,12 @@ func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
 }
 
+// CloseSession closes the session, rolling back any implicit transaction
+// and releasing the reserved connections held by it.
+func (vtg *VTGate) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	return formatError(vtg.txConn.Release(ctx, NewSafeSession(session)))
+}
+
 // Prepare supports non-streaming prepare statement query with multi shards
 func (vtg *VTGate) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (newSession *vtgatepb.Session, fld []*querypb.Field, err error) {
 	// In this context, we don't care if we can't fully parse destination
@@ -1228,6 +1228,36 @@ func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, clien
 	expectHandleRPCPanic(t, "RestoreFromBackup", true /*verbose*/, err)
 }
 
+//
+// Throttler related methods
+//
+
+var testCheckThrottlerApp = "vreplication"
+var testCheckThrottlerResponse = &tabletmanagerdatapb.CheckThrottlerResponse{
+	StatusCode: 429,
+	Lag:        12.5,
+	Threshold:  10,
+	Message:    "replication lag 12.5s exceeds the threshold of 10s",
+}
+
+func (fra *fakeRPCAgent) CheckThrottler(ctx context.Context, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CheckThrottler app", app, testCheckThrottlerApp)
+	return testCheckThrottlerResponse, nil
+}
+
+func agentRPCTestCheckThrottler(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.CheckThrottler(ctx, tablet, testCheckThrottlerApp)
+	compareError(t, "CheckThrottler", err, response, testCheckThrottlerResponse)
+}
+
+func agentRPCTestCheckThrottlerPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CheckThrottler(ctx, tablet, testCheckThrottlerApp)
+	expectHandleRPCPanic(t, "CheckThrottler", false /*verbose*/, err)
+}
+
 //
 // RPC helpers
 //
@@ -1303,6 +1333,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestBackup(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackup(ctx, t, client, tablet)
 
+	// Throttler related methods
+	agentRPCTestCheckThrottler(ctx, t, client, tablet)
+
 	//
 	// Tests panic handling everywhere now
 	//
@@ -1357,5 +1390,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestBackupPanic(ctx, t, client, tablet)
 	agentRPCTestRestoreFromBackupPanic(ctx, t, client, tablet)
 
+	// Throttler related methods
+	agentRPCTestCheckThrottlerPanic(ctx, t, client, tablet)
+
 	client.Close()
 }
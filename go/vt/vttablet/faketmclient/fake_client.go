@@ -23,6 +23,7 @@ package faketmclient
 
 import (
 	"io"
+	"net/http"
 	"time"
 
 	"golang.org/x/net/context"
@@ -315,6 +316,15 @@ func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, ta
 	return &eofEventStream{}, nil
 }
 
+//
+// Throttler related methods
+//
+
+// CheckThrottler is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error) {
+	return &tabletmanagerdatapb.CheckThrottlerResponse{StatusCode: http.StatusOK}, nil
+}
+
 //
 // Management related methods
 //
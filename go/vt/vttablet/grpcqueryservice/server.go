@@ -291,6 +291,70 @@ func (q *query) BeginExecuteBatch(ctx context.Context, request *querypb.BeginExe
 	}, nil
 }
 
+// ReserveExecute is part of the queryservice.QueryServer interface
+func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExecuteRequest) (response *querypb.ReserveExecuteResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+
+	result, reservedID, err := q.server.ReserveExecute(ctx, request.Target, request.PreQueries, request.Query.Sql, request.Query.BindVariables, request.TransactionId, request.Options)
+	if err != nil {
+		// if we have a valid reservedID, return the error in-band
+		if reservedID != 0 {
+			return &querypb.ReserveExecuteResponse{
+				Error:      vterrors.ToVTRPC(err),
+				ReservedId: reservedID,
+			}, nil
+		}
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.ReserveExecuteResponse{
+		Result:     sqltypes.ResultToProto3(result),
+		ReservedId: reservedID,
+	}, nil
+}
+
+// ReserveBeginExecute is part of the queryservice.QueryServer interface
+func (q *query) ReserveBeginExecute(ctx context.Context, request *querypb.ReserveBeginExecuteRequest) (response *querypb.ReserveBeginExecuteResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+
+	result, reservedID, err := q.server.ReserveBeginExecute(ctx, request.Target, request.PreQueries, request.Query.Sql, request.Query.BindVariables, request.ReservedId, request.Options)
+	if err != nil {
+		// if we have a valid reservedID, return the error in-band
+		if reservedID != 0 {
+			return &querypb.ReserveBeginExecuteResponse{
+				Error:      vterrors.ToVTRPC(err),
+				ReservedId: reservedID,
+			}, nil
+		}
+		return nil, vterrors.ToGRPC(err)
+	}
+	return &querypb.ReserveBeginExecuteResponse{
+		Result:     sqltypes.ResultToProto3(result),
+		ReservedId: reservedID,
+	}, nil
+}
+
+// Release is part of the queryservice.QueryServer interface
+func (q *query) Release(ctx context.Context, request *querypb.ReleaseRequest) (response *querypb.ReleaseResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	if err := q.server.Release(ctx, request.Target, request.ReservedId); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+
+	return &querypb.ReleaseResponse{}, nil
+}
+
 // MessageStream is part of the queryservice.QueryServer interface
 func (q *query) MessageStream(request *querypb.MessageStreamRequest, stream queryservicepb.Query_MessageStreamServer) (err error) {
 	defer q.server.HandlePanic(&err)
@@ -490,6 +490,87 @@ func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *quer
 	return sqltypes.Proto3ToResults(reply.Results), reply.TransactionId, nil
 }
 
+// ReserveExecute reserves a connection and runs an Execute on it.
+func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, reservedID int64, err error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, 0, tabletconn.ConnClosed
+	}
+
+	req := &querypb.ReserveExecuteRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Query: &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: bindVars,
+		},
+		TransactionId: transactionID,
+		Options:       options,
+		PreQueries:    preQueries,
+	}
+	reply, err := conn.c.ReserveExecute(ctx, req)
+	if err != nil {
+		return nil, 0, tabletconn.ErrorFromGRPC(err)
+	}
+	if reply.Error != nil {
+		return nil, reply.ReservedId, tabletconn.ErrorFromVTRPC(reply.Error)
+	}
+	return sqltypes.Proto3ToResult(reply.Result), reply.ReservedId, nil
+}
+
+// ReserveBeginExecute starts a transaction on a reserved connection and runs an Execute in it.
+func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, id int64, err error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, 0, tabletconn.ConnClosed
+	}
+
+	req := &querypb.ReserveBeginExecuteRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		Query: &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: bindVars,
+		},
+		Options:    options,
+		PreQueries: preQueries,
+		ReservedId: reservedID,
+	}
+	reply, err := conn.c.ReserveBeginExecute(ctx, req)
+	if err != nil {
+		return nil, 0, tabletconn.ErrorFromGRPC(err)
+	}
+	if reply.Error != nil {
+		return nil, reply.ReservedId, tabletconn.ErrorFromVTRPC(reply.Error)
+	}
+	return sqltypes.Proto3ToResult(reply.Result), reply.ReservedId, nil
+}
+
+// Release releases a reserved connection.
+func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return tabletconn.ConnClosed
+	}
+
+	req := &querypb.ReleaseRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		ReservedId:        reservedID,
+	}
+	_, err := conn.c.Release(ctx, req)
+	if err != nil {
+		return tabletconn.ErrorFromGRPC(err)
+	}
+	return nil
+}
+
 // MessageStream streams messages.
 func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
 	// Please see comments in StreamExecute to see how this works.
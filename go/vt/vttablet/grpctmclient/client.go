@@ -839,6 +839,20 @@ func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.
 	}, nil
 }
 
+//
+// Throttler related methods
+//
+
+// CheckThrottler is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	return c.CheckThrottler(ctx, &tabletmanagerdatapb.CheckThrottlerRequest{App: app})
+}
+
 // Close is part of the tmclient.TabletManagerClient interface.
 func (client *Client) Close() {
 	client.mu.Lock()
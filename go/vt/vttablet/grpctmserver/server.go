@@ -477,6 +477,16 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 	return s.agent.RestoreFromBackup(ctx, logger)
 }
 
+//
+// Throttler related methods
+//
+
+func (s *server) CheckThrottler(ctx context.Context, request *tabletmanagerdatapb.CheckThrottlerRequest) (response *tabletmanagerdatapb.CheckThrottlerResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "CheckThrottler", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.agent.CheckThrottler(ctx, request.App)
+}
+
 // registration glue
 
 func init() {
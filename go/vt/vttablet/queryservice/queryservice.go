@@ -88,6 +88,26 @@ type QueryService interface {
 	BeginExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
 	BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error)
 
+	// Reserved connections carry session settings, like MySQL system
+	// variables, that are applied by executing the preQueries on them.
+	// They're accessed through the reservedID like transactions, and
+	// must be released once done.
+
+	// ReserveExecute reserves a connection, executes the preQueries and then
+	// the query on it. If transactionID is non-zero, the connection of that
+	// transaction is reserved, and the returned reservedID is the same.
+	ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+
+	// ReserveBeginExecute starts a transaction on the reserved connection
+	// and executes the query in it. If reservedID is zero, a new connection
+	// is reserved with the preQueries first. The transaction id is the
+	// returned reservedID.
+	ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+
+	// Release releases the reserved connection, rolling back its
+	// transaction if there's one.
+	Release(ctx context.Context, target *querypb.Target, reservedID int64) error
+
 	// Messaging methods.
 	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
 	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
@@ -65,6 +65,8 @@ type SandboxConn struct {
 	SetRollbackCount         sync2.AtomicInt64
 	ConcludeTransactionCount sync2.AtomicInt64
 	ReadTransactionCount     sync2.AtomicInt64
+	ReserveCount             sync2.AtomicInt64
+	ReleaseCount             sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -305,6 +307,55 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 	return results, transactionID, err
 }
 
+// ReserveExecute is part of the QueryService interface.
+func (sbc *SandboxConn) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := sbc.reserve(preQueries, transactionID)
+	if err != nil {
+		return nil, reservedID, err
+	}
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute is part of the QueryService interface.
+func (sbc *SandboxConn) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		reservedID, err = sbc.reserve(preQueries, 0)
+		if err != nil {
+			return nil, reservedID, err
+		}
+	}
+	sbc.BeginCount.Add(1)
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+// reserve records the preQueries like the other queries, and
+// returns a new id unless a transactionID is specified.
+func (sbc *SandboxConn) reserve(preQueries []string, transactionID int64) (int64, error) {
+	sbc.ReserveCount.Add(1)
+	for _, query := range preQueries {
+		sbc.Queries = append(sbc.Queries, &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: map[string]*querypb.BindVariable{},
+		})
+	}
+	if err := sbc.getError(); err != nil {
+		return transactionID, err
+	}
+	if transactionID != 0 {
+		return transactionID, nil
+	}
+	return sbc.TransactionID.Add(1), nil
+}
+
+// Release is part of the QueryService interface.
+func (sbc *SandboxConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	sbc.ReleaseCount.Add(1)
+	return sbc.getError()
+}
+
 // MessageStream is part of the QueryService interface.
 func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
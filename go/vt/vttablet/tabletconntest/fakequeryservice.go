@@ -672,6 +672,21 @@ var (
 	}}
 )
 
+// ReserveExecute is part of the queryservice.QueryService interface
+func (f *FakeQueryService) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	panic("not implemented")
+}
+
+// ReserveBeginExecute is part of the queryservice.QueryService interface
+func (f *FakeQueryService) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	panic("not implemented")
+}
+
+// Release is part of the queryservice.QueryService interface
+func (f *FakeQueryService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	panic("not implemented")
+}
+
 // MessageStream is part of the queryservice.QueryService interface
 func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	if f.HasError {
@@ -132,6 +132,10 @@ type RPCAgent interface {
 
 	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
 
+	// Throttler related methods
+
+	CheckThrottler(ctx context.Context, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error)
+
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
 	HandleRPCPanic(ctx context.Context, name string, args, reply interface{}, verbose bool, err *error)
@@ -0,0 +1,35 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"golang.org/x/net/context"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// CheckThrottler asks the lag throttler of the tablet server whether
+// app may write to the shard right now.
+func (agent *ActionAgent) CheckThrottler(ctx context.Context, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error) {
+	result := agent.QueryServiceControl.CheckThrottler(app)
+	return &tabletmanagerdatapb.CheckThrottlerResponse{
+		StatusCode: int32(result.StatusCode),
+		Lag:        result.Lag,
+		Threshold:  result.Threshold,
+		Message:    result.Message,
+	}, nil
+}
@@ -59,6 +59,9 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	pool    *Pool
 	current sync2.AtomicString
+	// quotaClass is the class the connection is charged to,
+	// if its pool is partitioned.
+	quotaClass *quotaClass
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -300,6 +303,8 @@ func (dbc *DBConn) IsClosed() bool {
 
 // Recycle returns the DBConn to the pool.
 func (dbc *DBConn) Recycle() {
+	qc := dbc.quotaClass
+	dbc.quotaClass = nil
 	switch {
 	case dbc.pool == nil:
 		dbc.Close()
@@ -308,6 +313,9 @@ func (dbc *DBConn) Recycle() {
 	default:
 		dbc.pool.Put(dbc)
 	}
+	if qc != nil {
+		dbc.pool.quotas.release(qc)
+	}
 }
 
 // Kill kills the currently executing query both on MySQL side
@@ -67,6 +67,8 @@ type Pool struct {
 	dbaPool            *dbconnpool.ConnectionPool
 	checker            MySQLChecker
 	appDebugParams     dbconfigs.Connector
+	// quotas partitions the pool between classes, if set.
+	quotas *quotas
 }
 
 // New creates a new Pool. The name is used
@@ -102,6 +104,15 @@ func New(
 	return cp
 }
 
+// SetClasses partitions the pool between the classes. It must be
+// called before Open. Connections are then charged to a class with
+// GetForClass.
+func (cp *Pool) SetClasses(classes []tabletenv.QueryPoolClass) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.quotas = newQuotas(cp.name, cp.capacity, classes)
+}
+
 func (cp *Pool) pool() (p *pools.ResourcePool) {
 	cp.mu.Lock()
 	p = cp.connections
@@ -144,9 +155,17 @@ func (cp *Pool) Close() {
 	cp.dbaPool.Close()
 }
 
-// Get returns a connection.
+// Get returns a connection, charged to DefaultClass if
+// the pool is partitioned.
 // You must call Recycle on DBConn once done.
 func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
+	return cp.GetForClass(ctx, DefaultClass)
+}
+
+// GetForClass returns a connection, charged to the class if the pool
+// is partitioned. It waits until the class may use one more connection.
+// You must call Recycle on DBConn once done.
+func (cp *Pool) GetForClass(ctx context.Context, class string) (*DBConn, error) {
 	span, ctx := trace.NewSpan(ctx, "Pool.Get")
 	defer span.Finish()
 
@@ -162,11 +181,24 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	span.Annotate("available", p.Available())
 	span.Annotate("active", p.Active())
 
+	var qc *quotaClass
+	if cp.quotas != nil {
+		span.Annotate("class", class)
+		var err error
+		if qc, err = cp.quotas.acquire(ctx, class); err != nil {
+			return nil, err
+		}
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if qc != nil {
+			cp.quotas.release(qc)
+		}
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	conn.quotaClass = qc
+	return conn, nil
 }
 
 // Put puts a connection into the pool.
@@ -192,6 +224,9 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 			return err
 		}
 	}
+	if cp.quotas != nil {
+		cp.quotas.setCapacity(capacity)
+	}
 	cp.capacity = capacity
 	return nil
 }
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// DefaultClass is the class of the connections that are
+// requested without a class, or with a class that's not configured.
+const DefaultClass = "default"
+
+// quotas partitions the slots of a Pool between classes of queries.
+// Every class is guaranteed its minimum number of slots, which no other
+// class can use. The other slots are shared: a query takes a free shared
+// slot if there is one, or waits for one otherwise. A shared slot that's
+// freed goes to the waiting class that uses the fewest shared slots
+// relative to its weight, so that a burst of one class can't starve
+// the others.
+type quotas struct {
+	mu sync.Mutex
+	// shared is the number of slots that are not guaranteed to any class.
+	shared      int
+	sharedInUse int
+	minTotal    int
+	classes     map[string]*quotaClass
+
+	waits *stats.Timings
+}
+
+type quotaClass struct {
+	name   string
+	min    int
+	weight int
+	inUse  int
+	// waiters are the queries waiting for a slot, in arrival order.
+	// A slot is granted by sending on the channel.
+	waiters []chan struct{}
+}
+
+func newQuotas(name string, capacity int, classes []tabletenv.QueryPoolClass) *quotas {
+	q := &quotas{
+		classes: make(map[string]*quotaClass),
+	}
+	for _, class := range classes {
+		q.classes[class.Name] = &quotaClass{name: class.Name, min: class.Min, weight: class.Weight}
+		q.minTotal += class.Min
+	}
+	if q.classes[DefaultClass] == nil {
+		q.classes[DefaultClass] = &quotaClass{name: DefaultClass, weight: 1}
+	}
+	q.setCapacity(capacity)
+
+	if name == "" || usedNames[name+"Class"] {
+		q.waits = stats.NewTimings("", "", "Class")
+		return q
+	}
+	usedNames[name+"Class"] = true
+	q.waits = stats.NewTimings(name+"ClassWaits", "Tablet server conn pool waits by class", "Class")
+	stats.NewGaugesFuncWithMultiLabels(name+"ClassInUse", "Tablet server conn pool in use by class", []string{"Class"}, q.inUse)
+	stats.NewGaugesFuncWithMultiLabels(name+"ClassWaiting", "Tablet server conn pool waiters by class", []string{"Class"}, q.waiting)
+	return q
+}
+
+// setCapacity changes the number of slots. If there
+// are fewer slots than guaranteed, none are shared.
+func (q *quotas) setCapacity(capacity int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.shared = capacity - q.minTotal
+	if q.shared < 0 {
+		q.shared = 0
+	}
+	q.dispatch()
+}
+
+// class returns the class of name.
+func (q *quotas) class(name string) *quotaClass {
+	if c := q.classes[name]; c != nil {
+		return c
+	}
+	return q.classes[DefaultClass]
+}
+
+// acquire waits for a slot for a query of the class, and returns the
+// class that was charged for it. It fails if ctx expires first.
+func (q *quotas) acquire(ctx context.Context, name string) (*quotaClass, error) {
+	c := q.class(name)
+	q.mu.Lock()
+	if len(c.waiters) == 0 && q.admissible(c) {
+		q.take(c)
+		q.mu.Unlock()
+		return c, nil
+	}
+	ready := make(chan struct{}, 1)
+	c.waiters = append(c.waiters, ready)
+	q.mu.Unlock()
+
+	start := time.Now()
+	defer q.waits.Record(c.name, start)
+	select {
+	case <-ready:
+		return c, nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	for i, waiter := range c.waiters {
+		if waiter == ready {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			q.mu.Unlock()
+			return nil, pools.ErrTimeout
+		}
+	}
+	q.mu.Unlock()
+	// The slot was granted after ctx expired.
+	q.release(c)
+	return nil, pools.ErrTimeout
+}
+
+// release frees a slot of the class.
+func (q *quotas) release(c *quotaClass) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	c.inUse--
+	if c.inUse >= c.min {
+		q.sharedInUse--
+	}
+	q.dispatch()
+}
+
+func (q *quotas) admissible(c *quotaClass) bool {
+	return c.inUse < c.min || q.sharedInUse < q.shared
+}
+
+func (q *quotas) take(c *quotaClass) {
+	if c.inUse >= c.min {
+		q.sharedInUse++
+	}
+	c.inUse++
+}
+
+// dispatch grants the free slots to the waiting queries. Classes
+// below their minimum go first, then the classes that use the fewest
+// shared slots relative to their weight.
+func (q *quotas) dispatch() {
+	for {
+		var next *quotaClass
+		for _, c := range q.classes {
+			if len(c.waiters) == 0 || !q.admissible(c) {
+				continue
+			}
+			if next == nil || c.before(next) {
+				next = c
+			}
+		}
+		if next == nil {
+			return
+		}
+		q.take(next)
+		next.waiters[0] <- struct{}{}
+		next.waiters = next.waiters[1:]
+	}
+}
+
+// before returns true if c should get a slot before other.
+func (c *quotaClass) before(other *quotaClass) bool {
+	if (c.inUse < c.min) != (other.inUse < other.min) {
+		return c.inUse < c.min
+	}
+	// Compare (shared+1)/weight of both classes.
+	left := (c.sharedInUse() + 1) * other.weight
+	right := (other.sharedInUse() + 1) * c.weight
+	if left != right {
+		return left < right
+	}
+	return c.name < other.name
+}
+
+func (c *quotaClass) sharedInUse() int {
+	if c.inUse <= c.min {
+		return 0
+	}
+	return c.inUse - c.min
+}
+
+func (q *quotas) inUse() map[string]int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	result := make(map[string]int64, len(q.classes))
+	for name, c := range q.classes {
+		result[name] = int64(c.inUse)
+	}
+	return result
+}
+
+func (q *quotas) waiting() map[string]int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	result := make(map[string]int64, len(q.classes))
+	for name, c := range q.classes {
+		result[name] = int64(len(c.waiters))
+	}
+	return result
+}
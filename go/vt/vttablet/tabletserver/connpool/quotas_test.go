@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func shortContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 10*time.Millisecond)
+}
+
+func TestQuotasMinimums(t *testing.T) {
+	q := newQuotas("", 4, []tabletenv.QueryPoolClass{{Name: "oltp", Min: 2, Weight: 1}})
+
+	// The default class can only use the shared slots.
+	for i := 0; i < 2; i++ {
+		if _, err := q.acquire(context.Background(), "olap"); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+	}
+	ctx, cancel := shortContext()
+	defer cancel()
+	if _, err := q.acquire(ctx, "olap"); err != pools.ErrTimeout {
+		t.Errorf("acquire: %v, want %v", err, pools.ErrTimeout)
+	}
+
+	// The slots of oltp are still free.
+	var oltp *quotaClass
+	for i := 0; i < 2; i++ {
+		c, err := q.acquire(context.Background(), "oltp")
+		if err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		oltp = c
+	}
+	ctx, cancel = shortContext()
+	defer cancel()
+	if _, err := q.acquire(ctx, "oltp"); err != pools.ErrTimeout {
+		t.Errorf("acquire: %v, want %v", err, pools.ErrTimeout)
+	}
+
+	q.release(oltp)
+	want := map[string]int64{"oltp": 1, DefaultClass: 2}
+	if got := q.inUse(); !reflect.DeepEqual(got, want) {
+		t.Errorf("inUse: %v, want %v", got, want)
+	}
+	want = map[string]int64{"oltp": 0, DefaultClass: 0}
+	if got := q.waiting(); !reflect.DeepEqual(got, want) {
+		t.Errorf("waiting: %v, want %v", got, want)
+	}
+}
+
+func TestQuotasWeightedSharing(t *testing.T) {
+	q := newQuotas("", 4, []tabletenv.QueryPoolClass{
+		{Name: "oltp", Min: 0, Weight: 3},
+		{Name: "olap", Min: 0, Weight: 1},
+	})
+	// olap takes all the slots in a burst.
+	var olap *quotaClass
+	for i := 0; i < 4; i++ {
+		c, err := q.acquire(context.Background(), "olap")
+		if err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		olap = c
+	}
+
+	granted := make(chan string, 8)
+	wait := func(class string, waiting int64) {
+		go func() {
+			if _, err := q.acquire(context.Background(), class); err != nil {
+				t.Errorf("acquire: %v", err)
+			}
+			granted <- class
+		}()
+		for q.waiting()[class] != waiting {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	for i := int64(1); i <= 4; i++ {
+		wait("olap", i)
+		wait("oltp", i)
+	}
+
+	// oltp gets 3 of the 4 slots that olap frees.
+	var got []string
+	for i := 0; i < 4; i++ {
+		q.release(olap)
+		got = append(got, <-granted)
+	}
+	want := []string{"oltp", "oltp", "oltp", "olap"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("granted: %v, want %v", got, want)
+	}
+}
+
+func TestQuotasSetCapacity(t *testing.T) {
+	q := newQuotas("", 1, nil)
+	c, err := q.acquire(context.Background(), "")
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	done := make(chan error)
+	go func() {
+		_, err := q.acquire(context.Background(), "")
+		done <- err
+	}()
+	for q.waiting()[DefaultClass] != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	q.setCapacity(2)
+	if err := <-done; err != nil {
+		t.Errorf("acquire: %v", err)
+	}
+	q.release(c)
+}
+
+func TestConnPoolGetForClass(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := New("", 2, 0, 10*time.Second, checker)
+	connPool.SetClasses([]tabletenv.QueryPoolClass{{Name: "oltp", Min: 1, Weight: 1}})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	dbConn, err := connPool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	ctx, cancel := shortContext()
+	defer cancel()
+	if _, err := connPool.GetForClass(ctx, "olap"); err != pools.ErrTimeout {
+		t.Errorf("GetForClass: %v, want %v", err, pools.ErrTimeout)
+	}
+	oltpConn, err := connPool.GetForClass(context.Background(), "oltp")
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	oltpConn.Recycle()
+
+	// Recycle gives the slot back to the class.
+	dbConn.Recycle()
+	dbConn, err = connPool.GetForClass(context.Background(), "olap")
+	if err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	dbConn.Recycle()
+}
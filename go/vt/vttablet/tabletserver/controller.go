@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	"time"
 
@@ -82,6 +83,9 @@ type Controller interface {
 	// package, if heartbeat is enabled. Otherwise returns 0.
 	HeartbeatLag() (time.Duration, error)
 
+	// CheckThrottler asks the lag throttler whether app may write now.
+	CheckThrottler(app string) *throttle.CheckResult
+
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
 }
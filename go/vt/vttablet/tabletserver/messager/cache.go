@@ -31,6 +31,7 @@ type MessageRow struct {
 	TimeNext    int64
 	Epoch       int64
 	TimeCreated int64
+	Priority    int64
 	Row         []sqltypes.Value
 
 	// defunct is set if the row was asked to be removed
@@ -45,8 +46,12 @@ func (mh messageHeap) Len() int {
 }
 
 func (mh messageHeap) Less(i, j int) bool {
-	// Lower epoch is more important.
+	// Lower priority is more important.
+	// If priorities match, lower epoch is more important.
 	// If epochs match, newer messages are more important.
+	if mh[i].Priority != mh[j].Priority {
+		return mh[i].Priority < mh[j].Priority
+	}
 	return mh[i].Epoch < mh[j].Epoch ||
 		(mh[i].Epoch == mh[j].Epoch && mh[i].TimeNext > mh[j].TimeNext)
 }
@@ -80,6 +85,9 @@ func (mh *messageHeap) Pop() interface{} {
 type cache struct {
 	mu   sync.Mutex
 	size int
+	// priorityIndex is the index of the priority column
+	// in MessageRow.Row, or 0 if there is none.
+	priorityIndex int
 
 	sendQueue messageHeap
 	// inQueue is used to efficiently find items in sendQueue.
@@ -93,11 +101,12 @@ type cache struct {
 }
 
 // NewMessagerCache creates a new cache.
-func newCache(size int) *cache {
+func newCache(size, priorityIndex int) *cache {
 	mc := &cache{
-		size:     size,
-		inQueue:  make(map[string]*MessageRow),
-		inFlight: make(map[string]bool),
+		size:          size,
+		priorityIndex: priorityIndex,
+		inQueue:       make(map[string]*MessageRow),
+		inFlight:      make(map[string]bool),
 	}
 	return mc
 }
@@ -126,6 +135,10 @@ func (mc *cache) Add(mr *MessageRow) bool {
 	if _, ok := mc.inQueue[id]; ok {
 		return true
 	}
+	if mc.priorityIndex != 0 {
+		// A NULL priority is the default priority 0.
+		mr.Priority, _ = sqltypes.ToInt64(mr.Row[mc.priorityIndex])
+	}
 	heap.Push(&mc.sendQueue, mr)
 	mc.inQueue[id] = mr
 	return true
@@ -24,7 +24,7 @@ import (
 )
 
 func TestMessagerCacheOrder(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, 0)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -76,8 +76,39 @@ func TestMessagerCacheOrder(t *testing.T) {
 	}
 }
 
+func TestMessagerCachePriority(t *testing.T) {
+	mc := newCache(10, 1)
+	rows := []struct {
+		id       string
+		epoch    int64
+		priority sqltypes.Value
+	}{
+		{"row0", 0, sqltypes.NewInt64(1)},
+		{"row1", 1, sqltypes.NewInt64(0)},
+		{"row2", 0, sqltypes.NULL},
+		{"row3", 0, sqltypes.NewInt64(-1)},
+	}
+	for _, row := range rows {
+		if !mc.Add(&MessageRow{
+			Epoch: row.epoch,
+			Row:   []sqltypes.Value{sqltypes.NewVarBinary(row.id), row.priority},
+		}) {
+			t.Fatal("Add returned false")
+		}
+	}
+	var got []string
+	for range rows {
+		got = append(got, mc.Pop().Row[0].ToString())
+	}
+	// A NULL priority is 0.
+	want := []string{"row3", "row2", "row1", "row0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop order: %+v, want %+v", got, want)
+	}
+}
+
 func TestMessagerCacheDupKey(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, 0)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -111,7 +142,7 @@ func TestMessagerCacheDupKey(t *testing.T) {
 }
 
 func TestMessagerCacheDiscard(t *testing.T) {
-	mc := newCache(10)
+	mc := newCache(10, 0)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -161,7 +192,7 @@ func TestMessagerCacheDiscard(t *testing.T) {
 }
 
 func TestMessagerCacheFull(t *testing.T) {
-	mc := newCache(2)
+	mc := newCache(2, 0)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -186,7 +217,7 @@ func TestMessagerCacheFull(t *testing.T) {
 }
 
 func TestMessagerCacheEmpty(t *testing.T) {
-	mc := newCache(2)
+	mc := newCache(2, 0)
 	if !mc.Add(&MessageRow{
 		TimeNext: 1,
 		Epoch:    0,
@@ -43,6 +43,7 @@ type TabletService interface {
 	CheckMySQL()
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeNext int64) (count int64, err error)
 }
 
 // Engine is the engine for handling messages.
@@ -260,6 +261,22 @@ func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map
 	return query, bv, nil
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages to the dead-letter table.
+func (me *Engine) GenerateDeadLetterQueries(name string, timeNext int64) ([]string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	if mm.maxEpoch == 0 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s has no dead-letter table", name)
+	}
+	queries, bv := mm.GenerateDeadLetterQueries(timeNext)
+	return queries, bv, nil
+}
+
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
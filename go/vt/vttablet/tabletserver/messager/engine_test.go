@@ -251,6 +251,11 @@ func TestEngineGenerate(t *testing.T) {
 	if _, _, err := engine.GeneratePurgeQuery("t2", 0); err == nil || err.Error() != want {
 		t.Errorf("engine.GeneratePurgeQuery(invalid): %v, want %s", err, want)
 	}
+
+	want = "message table t1 has no dead-letter table"
+	if _, _, err := engine.GenerateDeadLetterQueries("t1", 0); err == nil || err.Error() != want {
+		t.Errorf("engine.GenerateDeadLetterQueries(no dead-letter table): %v, want %s", err, want)
+	}
 }
 
 func newTestEngine(db *fakesqldb.DB) *Engine {
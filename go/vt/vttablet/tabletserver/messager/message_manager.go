@@ -17,6 +17,7 @@ limitations under the License.
 package messager
 
 import (
+	"fmt"
 	"io"
 	"sync"
 	"time"
@@ -175,6 +176,9 @@ type messageManager struct {
 	purgeTicks   *timer.Timer
 	conns        *connpool.Pool
 	postponeSema *sync2.Semaphore
+	// maxEpoch is the number of sends after which messages are
+	// moved to the dead-letter table. 0 means no limit.
+	maxEpoch int
 
 	mu     sync.Mutex
 	isOpen bool
@@ -198,6 +202,9 @@ type messageManager struct {
 	ackQuery          *sqlparser.ParsedQuery
 	postponeQuery     *sqlparser.ParsedQuery
 	purgeQuery        *sqlparser.ParsedQuery
+	// deadLetterQueries copy the messages that reached maxEpoch
+	// to the dead-letter table, and delete them.
+	deadLetterQueries []*sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -213,18 +220,29 @@ func newMessageManager(tsv TabletService, table *schema.Table, conns *connpool.P
 		ackWaitTime:  table.MessageInfo.AckWaitDuration,
 		purgeAfter:   table.MessageInfo.PurgeAfterDuration,
 		batchSize:    table.MessageInfo.BatchSize,
-		cache:        newCache(table.MessageInfo.CacheSize),
+		cache:        newCache(table.MessageInfo.CacheSize, table.MessageInfo.PriorityIndex),
 		pollerTicks:  timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:   timer.NewTimer(table.MessageInfo.PollInterval),
 		conns:        conns,
 		postponeSema: postponeSema,
+		maxEpoch:     table.MessageInfo.MaxEpoch,
 	}
 	mm.cond.L = &mm.mu
 
 	columnList := buildSelectColumnList(table)
+	// The messages that reached maxEpoch wait for the dead-letter
+	// table instead of being resent.
+	epochCond := ""
+	if mm.maxEpoch != 0 {
+		epochCond = fmt.Sprintf(" and epoch < %d", mm.maxEpoch)
+	}
+	orderBy := "time_next desc"
+	if table.MessageInfo.PriorityIndex != 0 {
+		orderBy = "priority asc, time_next desc"
+	}
 	mm.readByTimeNext = sqlparser.BuildParsedQuery(
-		"select time_next, epoch, time_created, %s from %v where time_next < %a order by time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+		"select time_next, epoch, time_created, %s from %v where time_next < %a%s order by %s limit %a",
+		columnList, mm.name, ":time_next", epochCond, orderBy, ":max")
 	mm.loadMessagesQuery = sqlparser.BuildParsedQuery(
 		"select time_next, epoch, time_created, %s from %v where %a",
 		columnList, mm.name, ":#pk")
@@ -237,6 +255,19 @@ func newMessageManager(tsv TabletService, table *schema.Table, conns *connpool.P
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_scheduled < %a and time_acked is not null limit 500",
 		mm.name, ":time_scheduled")
+	if mm.maxEpoch != 0 {
+		// Both queries must match the same rows. The
+		// select locks them until the delete.
+		allColumns := buildAllColumnList(table)
+		mm.deadLetterQueries = []*sqlparser.ParsedQuery{
+			sqlparser.BuildParsedQuery(
+				"insert into %v(%s) select %s from %v where time_next < %a and epoch >= %a and time_acked is null order by id asc limit 500 for update",
+				sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable), allColumns, allColumns, mm.name, ":time_next", ":max_epoch"),
+			sqlparser.BuildParsedQuery(
+				"delete from %v where time_next < %a and epoch >= %a and time_acked is null order by id asc limit 500",
+				mm.name, ":time_next", ":max_epoch"),
+		}
+	}
 	return mm
 }
 
@@ -255,6 +286,20 @@ func buildSelectColumnList(t *schema.Table) string {
 	return buf.String()
 }
 
+// buildAllColumnList builds the list of all the
+// columns of the table, user-defined or not.
+func buildAllColumnList(t *schema.Table) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, c := range t.Columns {
+		if i == 0 {
+			buf.Myprintf("%v", c.Name)
+		} else {
+			buf.Myprintf(", %v", c.Name)
+		}
+	}
+	return buf.String()
+}
+
 // Open starts the messageManager service.
 func (mm *messageManager) Open() {
 	mm.mu.Lock()
@@ -607,6 +652,9 @@ func (mm *messageManager) runPoller() {
 
 func (mm *messageManager) runPurge() {
 	go purge(mm.tsv, mm.name.String(), mm.purgeAfter, mm.purgeTicks.Interval())
+	if mm.maxEpoch != 0 {
+		go deadLetter(mm.tsv, mm.name.String(), mm.purgeTicks.Interval())
+	}
 }
 
 // purge is a non-member because it should be called asynchronously and should
@@ -632,6 +680,30 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 	}
 }
 
+// deadLetter moves the messages that were sent the max number of times
+// without being acked to the dead-letter table. Like purge, it's a
+// non-member.
+func deadLetter(tsv TabletService, name string, interval time.Duration) {
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), interval)
+	defer func() {
+		tabletenv.LogError()
+		cancel()
+	}()
+	for {
+		count, err := tsv.DeadLetterMessages(ctx, nil, name, time.Now().UnixNano())
+		if err != nil {
+			MessageStats.Add([]string{name, "DeadLetterFailed"}, 1)
+			log.Errorf("Unable to move messages to the dead-letter table: %v", err)
+			return
+		}
+		MessageStats.Add([]string{name, "DeadLettered"}, count)
+		// If moved 500 or more, we should continue.
+		if count < 500 {
+			return
+		}
+	}
+}
+
 // GenerateAckQuery returns the query and bind vars for acking a message.
 func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	idbvs := &querypb.BindVariable{
@@ -676,6 +748,20 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// the messages that are due at timeNext after maxEpoch sends to the
+// dead-letter table. The queries must run in the same transaction.
+func (mm *messageManager) GenerateDeadLetterQueries(timeNext int64) ([]string, map[string]*querypb.BindVariable) {
+	queries := make([]string, 0, len(mm.deadLetterQueries))
+	for _, pq := range mm.deadLetterQueries {
+		queries = append(queries, pq.Query)
+	}
+	return queries, map[string]*querypb.BindVariable{
+		"time_next": sqltypes.Int64BindVariable(timeNext),
+		"max_epoch": sqltypes.Int64BindVariable(int64(mm.maxEpoch)),
+	}
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	timeNext, err := sqltypes.ToInt64(row[0])
@@ -753,6 +753,66 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	tsv := newFakeTabletServer()
+
+	// Make a buffered channel so the thread doesn't block on repeated calls.
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+
+	ti := newMMTable()
+	ti.MessageInfo.PollInterval = 1 * time.Millisecond
+	ti.MessageInfo.MaxEpoch = 3
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	mm := newMessageManager(tsv, ti, newMMConnPool(db), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+	// Ensure DeadLetterMessages got called, along with purge.
+	for got := <-ch; got != "deadletter"; got = <-ch {
+		if got != "purge" {
+			t.Fatalf("got %s, want deadletter or purge", got)
+		}
+	}
+}
+
+func TestMMGenerateDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ti := newMMTable()
+	for _, name := range []string{"id", "priority", "time_scheduled", "time_next", "epoch", "time_created", "time_acked", "message"} {
+		ti.AddColumn(name, sqltypes.Int64, sqltypes.NULL, "")
+	}
+	ti.MessageInfo.Fields = []*querypb.Field{testFields[0], testFields[1], {Name: "priority", Type: sqltypes.Int64}, testFields[2]}
+	ti.MessageInfo.PriorityIndex = 2
+	ti.MessageInfo.MaxEpoch = 3
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	mm := newMessageManager(newFakeTabletServer(), ti, newMMConnPool(db), sync2.NewSemaphore(1, 0))
+
+	wantQuery := "select time_next, epoch, time_created, id, time_scheduled, priority, message from foo where time_next < :time_next and epoch < 3 order by priority asc, time_next desc limit :max"
+	if got := mm.readByTimeNext.Query; got != wantQuery {
+		t.Errorf("readByTimeNext: %s, want %s", got, wantQuery)
+	}
+
+	queries, bv := mm.GenerateDeadLetterQueries(5)
+	columns := "id, priority, time_scheduled, time_next, epoch, time_created, time_acked, message"
+	wantQueries := []string{
+		"insert into foo_dead(" + columns + ") select " + columns + " from foo where time_next < :time_next and epoch >= :max_epoch and time_acked is null order by id asc limit 500 for update",
+		"delete from foo where time_next < :time_next and epoch >= :max_epoch and time_acked is null order by id asc limit 500",
+	}
+	if !reflect.DeepEqual(queries, wantQueries) {
+		t.Errorf("GenerateDeadLetterQueries queries:\n%v, want\n%v", queries, wantQueries)
+	}
+	wantbv := map[string]*querypb.BindVariable{
+		"time_next": sqltypes.Int64BindVariable(5),
+		"max_epoch": sqltypes.Int64BindVariable(3),
+	}
+	if !reflect.DeepEqual(bv, wantbv) {
+		t.Errorf("GenerateDeadLetterQueries bv: %v, want %v", bv, wantbv)
+	}
+}
+
 type fakeTabletServer struct {
 	postponeCount sync2.AtomicInt64
 	purgeCount    sync2.AtomicInt64
@@ -793,6 +853,16 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeNext int64) (count int64, err error) {
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return 0, nil
+}
+
 func newMMConnPool(db *fakesqldb.DB) *connpool.Pool {
 	pool := connpool.New("", 20, 0, time.Duration(10*time.Minute), newFakeTabletServer())
 	params, _ := db.ConnParams().MysqlParams()
@@ -24,6 +24,8 @@ import (
 // DDLPlan provides a plan for DDLs.
 type DDLPlan struct {
 	Action string
+	// Temporary is set if the DDL creates or drops a temporary table.
+	Temporary bool
 }
 
 // DDLParse parses a DDL and produces a DDLPlan.
@@ -37,7 +39,8 @@ func DDLParse(sql string) (plan *DDLPlan) {
 		return &DDLPlan{Action: ""}
 	}
 	return &DDLPlan{
-		Action: stmt.Action,
+		Action:    stmt.Action,
+		Temporary: stmt.Temporary,
 	}
 }
 
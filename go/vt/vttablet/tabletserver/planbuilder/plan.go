@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -87,6 +88,8 @@ const (
 	PlanMessageStream
 	// PlanSelectImpossible is used for where or having clauses that can never be true.
 	PlanSelectImpossible
+	// PlanSavepoint is for SAVEPOINT, ROLLBACK TO and RELEASE SAVEPOINT statements.
+	PlanSavepoint
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -111,6 +114,7 @@ var planName = [NumPlans]string{
 	"OTHER_ADMIN",
 	"MESSAGE_STREAM",
 	"SELECT_IMPOSSIBLE",
+	"SAVEPOINT",
 }
 
 func (pt PlanType) String() string {
@@ -229,6 +233,15 @@ type Plan struct {
 
 	// For PlanInsertSubquery: pk columns in the subquery result.
 	SubqueryPKColumns []int
+
+	// QueryTimeout is the timeout requested by the query
+	// through a comment directive, if any.
+	QueryTimeout time.Duration
+
+	// UsesLocks is set if the query calls GET_LOCK(). The named
+	// locks are held by the connection, so the query is only
+	// allowed on a reserved connection.
+	UsesLocks bool
 }
 
 // TableName returns the table name for the plan.
@@ -250,11 +263,7 @@ func (plan *Plan) setTable(tableName sqlparser.TableIdent, tables map[string]*sc
 // Build builds a plan based on the schema.
 func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Plan, error) {
 	var plan *Plan
-
-	err := checkForPoolingUnsafeConstructs(statement)
-	if err != nil {
-		return nil, err
-	}
+	var err error
 
 	switch stmt := statement.(type) {
 	case *sqlparser.Union:
@@ -281,6 +290,8 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		plan, err = &Plan{PlanID: PlanSavepoint, FullQuery: GenerateFullQuery(stmt)}, nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
@@ -288,6 +299,8 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.UsesLocks = usesLocks(statement)
+	plan.QueryTimeout = queryTimeout(statement)
 	return plan, nil
 }
 
@@ -304,9 +317,10 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:       PlanSelectStream,
+		FullQuery:    GenerateFullQuery(statement),
+		Permissions:  BuildPermissions(statement),
+		QueryTimeout: queryTimeout(statement),
 	}
 
 	switch stmt := statement.(type) {
@@ -345,14 +359,30 @@ func BuildMessageStreaming(name string, tables map[string]*schema.Table) (*Plan,
 	return plan, nil
 }
 
+// queryTimeout returns the timeout set by the query timeout
+// directive of the statement, or 0 if there is none.
+func queryTimeout(statement sqlparser.Statement) time.Duration {
+	return time.Duration(sqlparser.QueryTimeoutDirective(statement)) * time.Millisecond
+}
+
 // checkForPoolingUnsafeConstructs returns an error if the SQL expression contains
 // a call to GET_LOCK(), which is unsafe with server-side connection pooling.
 // For more background, see https://github.com/vitessio/vitess/issues/3631.
 func checkForPoolingUnsafeConstructs(expr sqlparser.SQLNode) error {
-	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+	if usesLocks(expr) {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed")
+	}
+	return nil
+}
+
+// usesLocks returns true if the SQL expression contains a call to GET_LOCK().
+func usesLocks(expr sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		if f, ok := node.(*sqlparser.FuncExpr); ok {
 			if f.Name.Lowered() == "get_lock" {
-				return false, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed")
+				found = true
+				return false, nil
 			}
 		}
 
@@ -360,4 +390,5 @@ func checkForPoolingUnsafeConstructs(expr sqlparser.SQLNode) error {
 		// function calls.
 		return true, nil
 	}, expr)
+	return found
 }
@@ -54,6 +54,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues []sqltypes.PlanValue   `json:",omitempty"`
 		WhereClause       *sqlparser.ParsedQuery `json:",omitempty"`
 		SubqueryPKColumns []int                  `json:",omitempty"`
+		QueryTimeout      string                 `json:",omitempty"`
+		UsesLocks         bool                   `json:",omitempty"`
 	}{
 		PlanID:            p.PlanID,
 		Reason:            p.Reason,
@@ -69,6 +71,10 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues: p.SecondaryPKValues,
 		WhereClause:       p.WhereClause,
 		SubqueryPKColumns: p.SubqueryPKColumns,
+		UsesLocks:         p.UsesLocks,
+	}
+	if p.QueryTimeout != 0 {
+		mplan.QueryTimeout = p.QueryTimeout.String()
 	}
 	return json.Marshal(&mplan)
 }
@@ -182,6 +188,9 @@ func TestDDLPlan(t *testing.T) {
 			t.Fatalf("Error marshalling %v", plan)
 		}
 		matchString(t, tcase.lineno, expected["Action"], plan.Action)
+		if temp := expected["Temporary"] == true; plan.Temporary != temp {
+			t.Errorf("Line %d: expected Temporary: %v, received %v", tcase.lineno, temp, plan.Temporary)
+		}
 	}
 }
 
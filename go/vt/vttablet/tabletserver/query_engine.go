@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
@@ -134,9 +136,13 @@ type QueryEngine struct {
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
+	// poolClassBy is what the class of a query in conns and
+	// streamConns is, if they are partitioned between classes.
+	poolClassBy string
 
 	// Services
 	consolidator *sync2.Consolidator
+	resultCache  *resultCache
 	// txSerializer protects vttablet from applications which try to concurrently
 	// UPDATE (or DELETE) a "hot" row (or range of rows).
 	// Such queries would be serialized by MySQL anyway. This serializer prevents
@@ -207,10 +213,23 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		time.Duration(config.IdleTimeout*1e9),
 		checker,
 	)
+	if len(config.QueryPoolClasses) != 0 {
+		classes, err := tabletenv.ParseQueryPoolClasses(config.QueryPoolClasses)
+		if err != nil {
+			log.Errorf("Invalid query pool classes, the query pools won't be partitioned: %v", err)
+		} else {
+			// OLAP queries are streamed, so both pools are
+			// partitioned for a burst of them not to starve the others.
+			qe.conns.SetClasses(classes)
+			qe.streamConns.SetClasses(classes)
+			qe.poolClassBy = config.QueryPoolClassBy
+		}
+	}
 	qe.enableConsolidator = config.EnableConsolidator
 	qe.enableConsolidatorReplicas = config.EnableConsolidatorReplicas
 	qe.enableQueryPlanFieldCaching = config.EnableQueryPlanFieldCaching
 	qe.consolidator = sync2.NewConsolidator()
+	qe.resultCache = newResultCache(config.ResultCacheTTL, config.ResultCacheSize)
 	qe.txSerializer = txserializer.New(config.EnableHotRowProtectionDryRun,
 		config.HotRowProtectionMaxQueueSize,
 		config.HotRowProtectionMaxGlobalQueueSize,
@@ -262,6 +281,8 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		stats.Publish("QueryCacheOldest", stats.StringFunc(func() string {
 			return fmt.Sprintf("%v", qe.plans.Oldest())
 		}))
+		stats.NewGaugeFunc("ResultCacheLength", "Query engine result cache length", qe.resultCache.Length)
+		stats.NewGaugeFunc("ResultCacheSize", "Query engine result cache size in bytes", qe.resultCache.Size)
 		queryCounts = stats.NewCountersWithMultiLabels("QueryCounts", "query counts", []string{"Table", "Plan"})
 		queryTimes = stats.NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 		queryRowCounts = stats.NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
@@ -353,7 +374,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
 		if qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
-			conn, err := qe.getQueryConn(ctx)
+			conn, err := qe.getQueryConn(ctx, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -379,7 +400,8 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 
 // getQueryConn returns a connection from the query pool using either
 // the conn pool timeout if configured, or the original context query timeout
-func (qe *QueryEngine) getQueryConn(ctx context.Context) (*connpool.DBConn, error) {
+func (qe *QueryEngine) getQueryConn(ctx context.Context, options *querypb.ExecuteOptions) (*connpool.DBConn, error) {
+	class := qe.queryPoolClass(ctx, options)
 	waiterCount := qe.queryPoolWaiters.Add(1)
 	defer qe.queryPoolWaiters.Add(-1)
 
@@ -391,13 +413,39 @@ func (qe *QueryEngine) getQueryConn(ctx context.Context) (*connpool.DBConn, erro
 	if timeout != 0 {
 		ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
-		conn, err := qe.conns.Get(ctxTimeout)
+		conn, err := qe.conns.GetForClass(ctxTimeout, class)
 		if err != nil {
 			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool wait time exceeded")
 		}
 		return conn, err
 	}
-	return qe.conns.Get(ctx)
+	return qe.conns.GetForClass(ctx, class)
+}
+
+// getStreamConn returns a connection from the stream pool, charged
+// to the class of a query with the options if the pool is partitioned.
+func (qe *QueryEngine) getStreamConn(ctx context.Context, options *querypb.ExecuteOptions) (*connpool.DBConn, error) {
+	return qe.streamConns.GetForClass(ctx, qe.queryPoolClass(ctx, options))
+}
+
+// queryPoolClass returns the class of the query pools that
+// a query with the options is charged to.
+func (qe *QueryEngine) queryPoolClass(ctx context.Context, options *querypb.ExecuteOptions) string {
+	switch qe.poolClassBy {
+	case tabletenv.QueryPoolClassByWorkload:
+		if options != nil && options.Workload != querypb.ExecuteOptions_UNSPECIFIED {
+			return strings.ToLower(options.Workload.String())
+		}
+	case tabletenv.QueryPoolClassByUsername:
+		if immediate := callerid.ImmediateCallerIDFromContext(ctx); immediate != nil {
+			return callerid.GetUsername(immediate)
+		}
+	case tabletenv.QueryPoolClassByPrincipal:
+		if effective := callerid.EffectiveCallerIDFromContext(ctx); effective != nil {
+			return callerid.GetPrincipal(effective)
+		}
+	}
+	return connpool.DefaultClass
 }
 
 // GetStreamPlan is similar to GetPlan, but doesn't use the cache
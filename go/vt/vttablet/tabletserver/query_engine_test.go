@@ -30,8 +30,10 @@ import (
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/tableacl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema/schematest"
@@ -292,6 +294,92 @@ func TestStatsURL(t *testing.T) {
 	qe.ServeHTTP(response, request)
 }
 
+func TestQueryPoolClass(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.QueryPoolClasses = []string{"oltp:4:3", "olap:0:1"}
+	qe := NewQueryEngine(DummyChecker, schema.NewEngine(DummyChecker, config), config)
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("principal", "", ""), callerid.NewImmediateCallerID("user"))
+
+	testcases := []struct {
+		classBy string
+		options *querypb.ExecuteOptions
+		want    string
+	}{{
+		classBy: tabletenv.QueryPoolClassByWorkload,
+		options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP},
+		want:    "olap",
+	}, {
+		classBy: tabletenv.QueryPoolClassByWorkload,
+		options: &querypb.ExecuteOptions{},
+		want:    connpool.DefaultClass,
+	}, {
+		classBy: tabletenv.QueryPoolClassByWorkload,
+		want:    connpool.DefaultClass,
+	}, {
+		classBy: tabletenv.QueryPoolClassByUsername,
+		want:    "user",
+	}, {
+		classBy: tabletenv.QueryPoolClassByPrincipal,
+		want:    "principal",
+	}}
+	for _, tcase := range testcases {
+		qe.poolClassBy = tcase.classBy
+		if got := qe.queryPoolClass(ctx, tcase.options); got != tcase.want {
+			t.Errorf("queryPoolClass(%v, %v): %v, want %v", tcase.classBy, tcase.options, got, tcase.want)
+		}
+	}
+	if got := qe.queryPoolClass(context.Background(), nil); got != connpool.DefaultClass {
+		t.Errorf("queryPoolClass without caller id: %v, want %v", got, connpool.DefaultClass)
+	}
+}
+
+func TestStreamPoolClasses(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	config := tabletenv.DefaultQsConfig
+	config.StreamPoolSize = 3
+	config.QueryPoolClasses = []string{"oltp:1:1"}
+	qe := NewQueryEngine(DummyChecker, schema.NewEngine(DummyChecker, config), config)
+	qe.InitDBConfig(dbcfgs)
+	qe.se.InitDBConfig(dbcfgs.DbaWithDB())
+	qe.se.Open()
+	if err := qe.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer qe.Close()
+
+	// A burst of OLAP streams takes all the connections that are not
+	// guaranteed to OLTP.
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	for i := 0; i < 2; i++ {
+		conn, err := qe.getStreamConn(context.Background(), olap)
+		if err != nil {
+			t.Fatalf("getStreamConn(olap) #%d: %v", i, err)
+		}
+		defer conn.Recycle()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if conn, err := qe.getStreamConn(ctx, olap); err == nil {
+		conn.Recycle()
+		t.Errorf("getStreamConn(olap) succeeded, want it to wait for a shared connection")
+	}
+
+	// An OLTP stream still gets its guaranteed connection.
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := qe.getStreamConn(ctx, &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP})
+	if err != nil {
+		t.Fatalf("getStreamConn(oltp): %v", err)
+	}
+	conn.Recycle()
+}
+
 func newTestQueryEngine(queryPlanCacheSize int, idleTimeout time.Duration, strict bool, dbcfgs *dbconfigs.DBConfigs) *QueryEngine {
 	config := tabletenv.DefaultQsConfig
 	config.QueryPlanCacheSize = queryPlanCacheSize
@@ -57,6 +57,8 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodata.TabletType
+	// rewrites are the REWRITE rules the query fired.
+	rewrites []*rules.Rule
 }
 
 var sequenceFields = []*querypb.Field{
@@ -94,6 +96,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		tabletenv.ResultStats.Add(int64(len(reply.Rows)))
 	}(time.Now())
 
+	done, err := qre.checkRules()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
@@ -115,6 +122,10 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		}
 	}
 
+	if qre.plan.UsesLocks && qre.transactionID == 0 {
+		return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed outside of a reserved connection")
+	}
+
 	if qre.transactionID != 0 {
 		// Need upfront connection for DMLs and transactions
 		conn, err := qre.tsv.te.txPool.Get(qre.transactionID, "for query")
@@ -122,6 +133,14 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		defer conn.Recycle()
+		if qre.plan.UsesLocks {
+			// The named locks would be released with the connection
+			// at the end of a transaction, or when it's idle.
+			if !conn.Reserved {
+				return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed outside of a reserved connection")
+			}
+			conn.HoldsLocks = true
+		}
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassDML:
 			if !qre.tsv.qe.allowUnsafeDMLs && (qre.tsv.qe.binlogFormat != connpool.BinlogFormatRow) {
@@ -142,7 +161,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.execSQL(conn, qre.query, true)
 		case planbuilder.PlanUpsertPK:
 			return qre.execUpsertPK(conn)
-		case planbuilder.PlanSet:
+		case planbuilder.PlanSet, planbuilder.PlanSavepoint:
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, true)
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectImpossible:
 			return qre.execDirect(conn)
@@ -160,7 +179,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectImpossible:
 			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 		case planbuilder.PlanSet:
 			return qre.execSet()
@@ -212,6 +231,11 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 		tabletenv.RecordUserQuery(qre.ctx, qre.plan.TableName(), "Stream", int64(time.Since(start)))
 	}(time.Now())
 
+	done, err := qre.checkRules()
+	if err != nil {
+		return err
+	}
+	defer done()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
@@ -251,6 +275,11 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 		tabletenv.RecordUserQuery(qre.ctx, qre.plan.TableName(), "MessageStream", int64(time.Since(start)))
 	}(time.Now())
 
+	ruleDone, err := qre.checkRules()
+	if err != nil {
+		return err
+	}
+	defer ruleDone()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
@@ -328,15 +357,16 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltype
 	return reply, nil
 }
 
-// checkPermissions returns an error if the query does not pass all checks
-// (query blacklisting, table ACL).
-func (qre *QueryExecutor) checkPermissions() error {
-	// Skip permissions check if the context is local.
+// checkRules applies the query rules the query fires. It returns an
+// error if the query is blacklisted, or can't run within the limits of
+// the rules. Otherwise, it returns the function to call once the query
+// is done, and qre.ctx has the deadline the rules set for the query.
+func (qre *QueryExecutor) checkRules() (done func(), err error) {
+	// Skip the rules if the context is local.
 	if tabletenv.IsLocalContext(qre.ctx) {
-		return nil
+		return func() {}, nil
 	}
 
-	// Check if the query is blacklisted.
 	remoteAddr := ""
 	username := ""
 	ci, ok := callinfo.FromContext(qre.ctx)
@@ -344,12 +374,62 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
+	// The FAIL and FAIL_RETRY rules come first, so a blacklisted
+	// query doesn't wait for the limits of the other rules.
+	var dones []func()
+	done = func() {
+		for i := len(dones) - 1; i >= 0; i-- {
+			dones[i]()
+		}
+	}
+	for _, qr := range qre.plan.Rules.GetRules(remoteAddr, username, qre.bindVars) {
+		ruleDone, err := qre.applyRule(qr)
+		if err != nil {
+			done()
+			return nil, err
+		}
+		dones = append(dones, ruleDone)
+	}
+	return done, nil
+}
+
+// applyRule applies a query rule the query fired, and counts it.
+func (qre *QueryExecutor) applyRule(qr *rules.Rule) (done func(), err error) {
+	defer func() {
+		result := "Allowed"
+		if err != nil {
+			result = "Rejected"
+		}
+		tabletenv.QueryRuleStats.Add([]string{qr.Name, qr.Action().String(), result}, 1)
+	}()
+	switch qr.Action() {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	case rules.QRRewrite:
+		qre.rewrites = append(qre.rewrites, qr)
+		return func() {}, nil
+	}
+	ctx, done, err := qr.Acquire(qre.ctx)
+	if err != nil {
+		return nil, err
+	}
+	qre.ctx = ctx
+	return done, nil
+}
+
+// checkPermissions returns an error if the query does not pass the table ACL.
+func (qre *QueryExecutor) checkPermissions() error {
+	// Skip permissions check if the context is local.
+	if tabletenv.IsLocalContext(qre.ctx) {
+		return nil
+	}
+
+	username := ""
+	ci, ok := callinfo.FromContext(qre.ctx)
+	if ok {
+		username = ci.Username()
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -420,12 +500,16 @@ func (qre *QueryExecutor) execDDL() (*sqltypes.Result, error) {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "DDL is not understood")
 	}
 
-	defer func() {
-		err := qre.tsv.se.Reload(qre.ctx)
-		if err != nil {
-			log.Errorf("failed to reload schema %v", err)
-		}
-	}()
+	// Temporary tables are not part of the schema, and their
+	// DDL doesn't implicitly commit the ongoing transaction.
+	if !ddlPlan.Temporary {
+		defer func() {
+			err := qre.tsv.se.Reload(qre.ctx)
+			if err != nil {
+				log.Errorf("failed to reload schema %v", err)
+			}
+		}()
+	}
 
 	if qre.transactionID != 0 {
 		conn, err := qre.tsv.te.txPool.Get(qre.transactionID, "DDL begin again")
@@ -437,6 +521,12 @@ func (qre *QueryExecutor) execDDL() (*sqltypes.Result, error) {
 		if err != nil {
 			return nil, err
 		}
+		if ddlPlan.Temporary || !conn.InTransaction {
+			// There's no implicit commit to make up for, and a
+			// reserved connection outside a transaction must not
+			// start one.
+			return result, nil
+		}
 		err = conn.BeginAgain(qre.ctx)
 		if err != nil {
 			return nil, err
@@ -545,7 +635,7 @@ func (qre *QueryExecutor) execDirect(conn *TxConnection) (*sqltypes.Result, erro
 // reuses the result. If the plan is missng field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
-		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
+		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars, false)
 		if err != nil {
 			return nil, err
 		}
@@ -554,6 +644,9 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 		newResult.Fields = qre.plan.Fields
 		return &newResult, nil
 	}
+	if qre.resultCacheTables() != nil {
+		return qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars, true)
+	}
 	conn, err := qre.getConn()
 	if err != nil {
 		return nil, err
@@ -799,7 +892,7 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.getQueryConn(ctx)
+	conn, err := qre.tsv.qe.getQueryConn(ctx, qre.options)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -815,7 +908,7 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	conn, err := qre.tsv.qe.getStreamConn(ctx, qre.options)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -826,11 +919,55 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	return nil, err
 }
 
-func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(parsedQuery, bindVars, nil, "")
 	if err != nil {
 		return nil, err
 	}
+	tables := qre.resultCacheTables()
+	if tables == nil {
+		return qre.consolidatedFetch(logStats, sql, sqlWithoutComments, wantfields)
+	}
+	rc := qre.tsv.qe.resultCache
+	if result := rc.get(string(sqlWithoutComments)); result != nil {
+		logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return result, nil
+	}
+	generation := rc.start()
+	// The cached results always have fields, so
+	// that they can serve all the callers.
+	result, err := qre.consolidatedFetch(logStats, sql, sqlWithoutComments, true)
+	if err != nil {
+		return nil, err
+	}
+	rc.put(string(sqlWithoutComments), tables, result, generation)
+	return result, nil
+}
+
+// resultCacheTables returns the tables read by the query if its result
+// can be cached, or nil. Only the results of the queries that read
+// tables are cached, because only the changes to tables invalidate them.
+// The queries that want event tokens are not cached either, because the
+// token of a cached result would be newer than its rows.
+func (qre *QueryExecutor) resultCacheTables() []string {
+	if !qre.tsv.qe.resultCache.enabled() || qre.tabletType == topodata.TabletType_MASTER || qre.plan.PlanID != planbuilder.PlanPassSelect {
+		return nil
+	}
+	if qre.options.GetIncludeEventToken() || qre.options.GetCompareEventToken() != nil {
+		return nil
+	}
+	var tables []string
+	for _, permission := range qre.plan.Permissions {
+		if permission.TableName == "dual" {
+			return nil
+		}
+		tables = append(tables, permission.TableName)
+	}
+	return tables
+}
+
+// consolidatedFetch fetches the result of sql, through the consolidator if it's enabled.
+func (qre *QueryExecutor) consolidatedFetch(logStats *tabletenv.LogStats, sql, sqlWithoutComments string, wantfields bool) (*sqltypes.Result, error) {
 	// Check tablet type.
 	if qre.tsv.qe.enableConsolidator || (qre.tsv.qe.enableConsolidatorReplicas && qre.tabletType != topodata.TabletType_MASTER) {
 		q, original := qre.tsv.qe.consolidator.Create(string(sqlWithoutComments))
@@ -842,7 +979,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 				q.Err = err
 			} else {
 				defer conn.Recycle()
-				q.Result, q.Err = qre.execSQL(conn, sql, false)
+				q.Result, q.Err = qre.execSQL(conn, sql, wantfields)
 			}
 		} else {
 			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
@@ -860,7 +997,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 		return nil, err
 	}
 	defer conn.Recycle()
-	res, err := qre.execSQL(conn, sql, false)
+	res, err := qre.execSQL(conn, sql, wantfields)
 	if err != nil {
 		return nil, err
 	}
@@ -912,6 +1049,9 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
+	for _, qr := range qre.rewrites {
+		query = qr.Rewrite(query)
+	}
 	buf.WriteString(query)
 	if buildStreamComment != "" {
 		buf.WriteString(buildStreamComment)
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -69,6 +71,33 @@ func TestQueryExecutorPlanDDL(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanTemporaryDDL(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "create temporary table temp_table (\n\tid bigint\n)"
+	want := &sqltypes.Result{}
+	db.AddQuery(query, want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	txid := newTransaction(tsv, nil)
+	qre := newTestQueryExecutor(ctx, tsv, query, txid)
+	checkPlanID(t, planbuilder.PlanDDL, qre.plan.PlanID)
+	commits := db.GetQueryCalledNum("commit")
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	// Creating a temporary table does not commit the transaction.
+	if n := db.GetQueryCalledNum("commit"); n != commits {
+		t.Errorf("commit called %d times, want %d", n, commits)
+	}
+	testCommitHelper(t, tsv, qre)
+}
+
 func TestQueryExecutorPlanPassDmlRBR(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1160,6 +1189,35 @@ func TestQueryExecutorPlanOtherWithinATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanSavepoint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "savepoint a"
+	db.AddQuery(query, &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// Savepoints are only allowed in a transaction.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	defer testCommitHelper(t, tsv, qre)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	wantqueries := []string{query}
+	if gotqueries := fetchRecordedQueries(qre); !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+}
+
 func TestQueryExecutorPlanPassSelectWithInATransaction(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1220,6 +1278,60 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanPassSelectGetLock(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select get_lock('a', 10) from dual limit 10001"
+	want := &sqltypes.Result{
+		Fields:       []*querypb.Field{{Name: "get_lock('a', 10)", Type: sqltypes.Int64}},
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt64(1)}},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select get_lock('a', 10) from dual where 1 != 1", &sqltypes.Result{
+		Fields: want.Fields,
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// The locks would be lost on a pooled connection or at
+	// the end of a transaction.
+	qre := newTestQueryExecutor(ctx, tsv, "select get_lock('a', 10) from dual", 0)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("qre.Execute outside of a transaction: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, "select get_lock('a', 10) from dual", txid)
+	_, err = qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("qre.Execute in a transaction: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+	tsv.Rollback(ctx, &tsv.target, txid)
+
+	reservedID, err := tsv.te.txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qre = newTestQueryExecutor(ctx, tsv, "select get_lock('a', 10) from dual", reservedID)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+	conn, err := tsv.te.txPool.Get(reservedID, "for test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !conn.HoldsLocks {
+		t.Error("HoldsLocks: false, want true")
+	}
+	conn.Recycle()
+}
+
 func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -2015,6 +2127,304 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRuleLimits(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	expandedQuery := "select pk from test_table use index (`index`) where name = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, expected)
+	db.AddQuery(expandedQuery, expected)
+
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	limitRule := rules.NewQueryRule("limit selects", "limit_selects", rules.QRConcurrencyLimit)
+	limitRule.SetQueryCond("select.*")
+	limitRule.SetConcurrencyLimit(1)
+	timeoutRule := rules.NewQueryRule("time out selects", "timeout_selects", rules.QRMaxExecutionTime)
+	timeoutRule.SetQueryCond("select.*")
+	timeoutRule.SetMaxExecutionTime(time.Minute)
+
+	rulesName := "limitRules"
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	qrs := rules.New()
+	qrs.Add(limitRule)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, qrs); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+
+	// Take the only slot of the rule, which is shared by the plans.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, release, err := qre.plan.Rules.GetRules("", "", nil)[0].Acquire(ctx)
+	if err != nil {
+		t.Fatalf("Acquire failed: %v", err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	qre.ctx = timeoutCtx
+	_, err = qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Execute: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+	release()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	wantCounts := map[string]int64{
+		"limit_selects.CONCURRENCY_LIMIT.Rejected": 1,
+		"limit_selects.CONCURRENCY_LIMIT.Allowed":  1,
+	}
+	for key, want := range wantCounts {
+		if got := tabletenv.QueryRuleStats.Counts()[key]; got != want {
+			t.Errorf("QueryRuleStats[%s] = %d, want %d", key, got, want)
+		}
+	}
+
+	// The query runs with the max execution time of the rule.
+	qrs = rules.New()
+	qrs.Add(timeoutRule)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, qrs); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+	tsv.qe.ClearQueryPlanCache()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if _, ok := qre.ctx.Deadline(); !ok {
+		t.Errorf("qre.ctx has no deadline, want the max execution time of the rule")
+	}
+
+	// All the rules the query fires apply, but a FAIL rule comes first.
+	failRule := rules.NewQueryRule("fail selects", "fail_selects", rules.QRFail)
+	failRule.SetQueryCond("select.*")
+	failRule.SetUserCond("banned")
+	qrs = rules.New()
+	qrs.Add(limitRule)
+	qrs.Add(timeoutRule)
+	qrs.Add(failRule)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, qrs); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+	tsv.qe.ClearQueryPlanCache()
+	before := tabletenv.QueryRuleStats.Counts()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if _, ok := qre.ctx.Deadline(); !ok {
+		t.Errorf("qre.ctx has no deadline, want the max execution time of the rule")
+	}
+	bannedCtx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{User: "banned"})
+	qre = newTestQueryExecutor(bannedCtx, tsv, query, 0)
+	if _, err := qre.Execute(); vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Fatalf("qre.Execute: %v, want %v", err, vtrpcpb.Code_INVALID_ARGUMENT)
+	}
+	after := tabletenv.QueryRuleStats.Counts()
+	wantCounts = map[string]int64{
+		"limit_selects.CONCURRENCY_LIMIT.Allowed":    1,
+		"timeout_selects.MAX_EXECUTION_TIME.Allowed": 1,
+		"fail_selects.FAIL.Rejected":                 1,
+	}
+	for key, want := range wantCounts {
+		if got := after[key] - before[key]; got != want {
+			t.Errorf("QueryRuleStats[%s] grew by %d, want %d", key, got, want)
+		}
+	}
+	// The failed query didn't keep the slot of the limit rule.
+	acquireCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, release, err = qre.plan.Rules.GetRules("", "", nil)[0].Acquire(acquireCtx)
+	if err != nil {
+		t.Fatalf("Acquire failed: %v", err)
+	}
+	release()
+}
+
+func TestQueryExecutorRuleRewrite(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	rewrittenQuery := "select /*+ MAX_EXECUTION_TIME(1000) */ * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(rewrittenQuery, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	rewriteRule := rules.NewQueryRule("hint selects", "hint_selects", rules.QRRewrite)
+	rewriteRule.SetQueryCond("select.*")
+	if err := rewriteRule.SetRewrite("^select ", "select /*+ MAX_EXECUTION_TIME(1000) */ "); err != nil {
+		t.Fatal(err)
+	}
+
+	rulesName := "rewriteRules"
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	qrs := rules.New()
+	qrs.Add(rewriteRule)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, qrs); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+
+	// Only the rewritten query is known to MySQL.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("qre.Execute() = %v, want: %v", got, want)
+	}
+}
+
+func TestQueryExecutorResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields:       getTestTableFields(),
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.resultCache = newResultCache(time.Minute, 1<<20)
+
+	execute := func(tabletType topodatapb.TabletType) *QueryExecutor {
+		t.Helper()
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		qre.tabletType = tabletType
+		got, err := qre.Execute()
+		if err != nil {
+			t.Fatalf("qre.Execute() = %v, want nil", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got: %v, want: %v", got, want)
+		}
+		return qre
+	}
+	execute(topodatapb.TabletType_REPLICA)
+	qre := execute(topodatapb.TabletType_REPLICA)
+	if got := db.GetQueryCalledNum(query); got != 1 {
+		t.Errorf("query was executed %d times, want 1", got)
+	}
+	if got, want := qre.logStats.FmtQuerySources(), "resultcache"; got != want {
+		t.Errorf("FmtQuerySources: %s, want %s", got, want)
+	}
+
+	// A change to the table drops the result.
+	tsv.qe.resultCache.invalidate("test_table")
+	execute(topodatapb.TabletType_REPLICA)
+	if got := db.GetQueryCalledNum(query); got != 2 {
+		t.Errorf("query was executed %d times, want 2", got)
+	}
+
+	// The master doesn't use the result cache.
+	execute(topodatapb.TabletType_MASTER)
+	if got := db.GetQueryCalledNum(query); got != 3 {
+		t.Errorf("query was executed %d times, want 3", got)
+	}
+
+	// Neither do the queries that want event tokens.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.tabletType = topodatapb.TabletType_REPLICA
+	qre.options = &querypb.ExecuteOptions{IncludeEventToken: true}
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got := db.GetQueryCalledNum(query); got != 4 {
+		t.Errorf("query was executed %d times, want 4", got)
+	}
+}
+
+func TestQueryExecutorResultCacheConcurrentHits(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields:       getTestTableFields(),
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.resultCache = newResultCache(time.Minute, 1<<20)
+	// Without field caching, execSelect returns the result of qFetch as is.
+	tsv.qe.enableQueryPlanFieldCaching = false
+
+	// qreExecute sets the extras of the results, and strips their fields
+	// if asked to, so the hits must not share them.
+	execute := func(options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+		logStats := tabletenv.NewLogStats(ctx, "TestQueryExecutor")
+		plan, err := tsv.qe.GetPlan(ctx, logStats, query, false)
+		if err != nil {
+			return nil, err
+		}
+		return tsv.qreExecute(ctx, query, sqlparser.MarginComments{}, make(map[string]*querypb.BindVariable), 0, options, plan, logStats, topodatapb.TabletType_REPLICA)
+	}
+	if _, err := execute(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			options := &querypb.ExecuteOptions{}
+			if i%2 == 0 {
+				options.IncludedFields = querypb.ExecuteOptions_TYPE_ONLY
+			}
+			<-start
+			for j := 0; j < 20; j++ {
+				got, err := execute(options)
+				if err != nil {
+					t.Errorf("execute: %v", err)
+					return
+				}
+				if !reflect.DeepEqual(got.Rows, want.Rows) {
+					t.Errorf("got rows: %v, want: %v", got.Rows, want.Rows)
+				}
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	if got := db.GetQueryCalledNum(query); got != 1 {
+		t.Errorf("query was executed %d times, want 1", got)
+	}
+}
+
 type executorFlags int64
 
 const (
@@ -38,6 +38,8 @@ import (
 // ReplicationWatcher is a tabletserver service that watches the
 // replication stream. It can tell you the current event token,
 // and it will trigger schema reloads if a DDL is encountered.
+// It also drops the results of the result cache that are
+// made stale by the replicated changes.
 type ReplicationWatcher struct {
 	dbconfigs *dbconfigs.DBConfigs
 
@@ -48,6 +50,7 @@ type ReplicationWatcher struct {
 
 	watchReplication bool
 	se               *schema.Engine
+	resultCache      *resultCache
 
 	mu         sync.Mutex
 	eventToken *querypb.EventToken
@@ -56,10 +59,11 @@ type ReplicationWatcher struct {
 var replOnce sync.Once
 
 // NewReplicationWatcher creates a new ReplicationWatcher.
-func NewReplicationWatcher(se *schema.Engine, config tabletenv.TabletConfig) *ReplicationWatcher {
+func NewReplicationWatcher(se *schema.Engine, rc *resultCache, config tabletenv.TabletConfig) *ReplicationWatcher {
 	rpw := &ReplicationWatcher{
 		watchReplication: config.WatchReplication,
 		se:               se,
+		resultCache:      rc,
 	}
 	replOnce.Do(func() {
 		stats.Publish("EventTokenPosition", stats.StringFunc(func() string {
@@ -91,6 +95,8 @@ func (rpw *ReplicationWatcher) Open() {
 	if rpw.isOpen || !rpw.watchReplication {
 		return
 	}
+	// The results cached before were not kept up to date.
+	rpw.resultCache.invalidateAll()
 	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
 	rpw.cancel = cancel
 	rpw.wg.Add(1)
@@ -105,6 +111,8 @@ func (rpw *ReplicationWatcher) Close() {
 	}
 	rpw.cancel()
 	rpw.wg.Wait()
+	// The results can't be kept up to date anymore.
+	rpw.resultCache.invalidateAll()
 	rpw.isOpen = false
 }
 
@@ -117,12 +125,16 @@ func (rpw *ReplicationWatcher) Process(ctx context.Context, dbconfigs *dbconfigs
 
 	for {
 		log.Infof("Starting a binlog Streamer from current replication position to monitor binlogs")
+		// The changes since the last streamer stopped are lost.
+		rpw.resultCache.invalidateAll()
 		streamer := binlog.NewStreamer(dbconfigs.DbaWithDB(), rpw.se, nil /*clientCharset*/, mysql.Position{}, 0 /*timestamp*/, func(eventToken *querypb.EventToken, statements []binlog.FullBinlogStatement) error {
 			// Save the event token.
 			rpw.mu.Lock()
 			rpw.eventToken = eventToken
 			rpw.mu.Unlock()
 
+			rpw.invalidateResults(statements)
+
 			// If it's a DDL, trigger a schema reload.
 			for _, statement := range statements {
 				if statement.Statement.Category != binlogdatapb.BinlogTransaction_Statement_BL_DDL {
@@ -191,6 +203,34 @@ func (rpw *ReplicationWatcher) ComputeExtras(options *querypb.ExecuteOptions) *q
 	return extras
 }
 
+// invalidateResults drops the cached results of the tables changed by
+// statements. The statements that don't name their table, such as
+// the DDLs and the statements of statement-based replication, drop
+// all the results.
+func (rpw *ReplicationWatcher) invalidateResults(statements []binlog.FullBinlogStatement) {
+	if !rpw.resultCache.enabled() {
+		return
+	}
+	for _, statement := range statements {
+		switch statement.Statement.Category {
+		case binlogdatapb.BinlogTransaction_Statement_BL_BEGIN,
+			binlogdatapb.BinlogTransaction_Statement_BL_COMMIT,
+			binlogdatapb.BinlogTransaction_Statement_BL_ROLLBACK,
+			binlogdatapb.BinlogTransaction_Statement_BL_SET:
+			continue
+		case binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+			binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+			binlogdatapb.BinlogTransaction_Statement_BL_DELETE:
+			if statement.Table != "" {
+				rpw.resultCache.invalidate(statement.Table)
+				continue
+			}
+		}
+		rpw.resultCache.invalidateAll()
+		return
+	}
+}
+
 // EventToken returns the current event token.
 func (rpw *ReplicationWatcher) EventToken() *querypb.EventToken {
 	rpw.mu.Lock()
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+)
+
+// resultCacheCounts counts the hits, misses, evictions and
+// invalidations of the result cache.
+var resultCacheCounts = stats.NewCountersWithSingleLabel("ResultCacheCounts", "Result cache events", "Event")
+
+// resultCache keeps the results of read queries for a short time, so
+// that a query that's repeated right after it ran doesn't go to MySQL.
+// It extends the consolidator, which only merges the queries that are
+// in flight at the same time. The results are keyed by the final SQL,
+// which includes the bind variables. The results of a table are dropped
+// as soon as the replication watcher sees a change to the table.
+type resultCache struct {
+	ttl      time.Duration
+	maxBytes int64
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru has the most recently used entries at the front.
+	lru *list.List
+	// byTable has the keys of the entries that read each table.
+	byTable map[string]map[string]bool
+	size    int64
+
+	// generation is incremented by every invalidation, so that the result
+	// of a query that started before an invalidation is not cached.
+	// invalidated has the generation of the last invalidation of each
+	// table, and allInvalidated that of the last invalidation of all.
+	generation     int64
+	invalidated    map[string]int64
+	allInvalidated int64
+}
+
+type resultCacheEntry struct {
+	key     string
+	tables  []string
+	result  *sqltypes.Result
+	size    int64
+	expires time.Time
+}
+
+// newResultCache creates a resultCache. It's disabled if ttl is 0.
+func newResultCache(ttl time.Duration, maxBytes int64) *resultCache {
+	return &resultCache{
+		ttl:         ttl,
+		maxBytes:    maxBytes,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		byTable:     make(map[string]map[string]bool),
+		invalidated: make(map[string]int64),
+	}
+}
+
+func (rc *resultCache) enabled() bool {
+	return rc.ttl > 0
+}
+
+// get returns a copy of the cached result of key, or nil. The copy
+// can be modified, but its rows and fields are shared with the other
+// callers and must not be.
+func (rc *resultCache) get(key string) *sqltypes.Result {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	elem, ok := rc.entries[key]
+	if !ok {
+		resultCacheCounts.Add("Miss", 1)
+		return nil
+	}
+	entry := elem.Value.(*resultCacheEntry)
+	if time.Now().After(entry.expires) {
+		rc.remove(elem)
+		resultCacheCounts.Add("Miss", 1)
+		return nil
+	}
+	rc.lru.MoveToFront(elem)
+	resultCacheCounts.Add("Hit", 1)
+	return shallowCopy(entry.result)
+}
+
+// start returns the generation to pass to put
+// for a query that's about to run.
+func (rc *resultCache) start() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.generation
+}
+
+// put caches the result of a query that read tables, unless one of them
+// was invalidated since the query started at generation.
+func (rc *resultCache) put(key string, tables []string, result *sqltypes.Result, generation int64) {
+	size := resultSize(key, result)
+	if size > rc.maxBytes {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.allInvalidated > generation {
+		return
+	}
+	for _, table := range tables {
+		if rc.invalidated[table] > generation {
+			return
+		}
+	}
+	if elem, ok := rc.entries[key]; ok {
+		rc.remove(elem)
+	}
+	for rc.size+size > rc.maxBytes {
+		rc.remove(rc.lru.Back())
+		resultCacheCounts.Add("Eviction", 1)
+	}
+	entry := &resultCacheEntry{
+		key:     key,
+		tables:  tables,
+		result:  shallowCopy(result),
+		size:    size,
+		expires: time.Now().Add(rc.ttl),
+	}
+	rc.entries[key] = rc.lru.PushFront(entry)
+	rc.size += size
+	for _, table := range tables {
+		keys := rc.byTable[table]
+		if keys == nil {
+			keys = make(map[string]bool)
+			rc.byTable[table] = keys
+		}
+		keys[key] = true
+	}
+}
+
+// invalidate drops the results that read table.
+func (rc *resultCache) invalidate(table string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	rc.invalidated[table] = rc.generation
+	for key := range rc.byTable[table] {
+		rc.remove(rc.entries[key])
+		resultCacheCounts.Add("Invalidation", 1)
+	}
+}
+
+// invalidateAll drops all the results.
+func (rc *resultCache) invalidateAll() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	rc.allInvalidated = rc.generation
+	// The generations of the tables are superseded.
+	rc.invalidated = make(map[string]int64)
+	resultCacheCounts.Add("Invalidation", int64(len(rc.entries)))
+	rc.entries = make(map[string]*list.Element)
+	rc.lru.Init()
+	rc.byTable = make(map[string]map[string]bool)
+	rc.size = 0
+}
+
+func (rc *resultCache) remove(elem *list.Element) {
+	entry := rc.lru.Remove(elem).(*resultCacheEntry)
+	delete(rc.entries, entry.key)
+	for _, table := range entry.tables {
+		delete(rc.byTable[table], entry.key)
+		if len(rc.byTable[table]) == 0 {
+			delete(rc.byTable, table)
+		}
+	}
+	rc.size -= entry.size
+}
+
+// Size returns the size in bytes of the cached results.
+func (rc *resultCache) Size() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.size
+}
+
+// Length returns the number of cached results.
+func (rc *resultCache) Length() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return int64(len(rc.entries))
+}
+
+// resultSize estimates the memory used by a result cached under key.
+func resultSize(key string, result *sqltypes.Result) int64 {
+	size := int64(len(key))
+	for _, field := range result.Fields {
+		size += int64(len(field.Name) + len(field.Table) + len(field.OrgTable) + len(field.Database) + len(field.OrgName))
+	}
+	for _, row := range result.Rows {
+		for _, value := range row {
+			// Add the overhead of the value itself.
+			size += int64(value.Len()) + 32
+		}
+	}
+	return size
+}
+
+// shallowCopy copies the result and its list of rows, but not the rows
+// and the fields. Extras are not copied, since they describe the state
+// of the tablet when the result is returned.
+func shallowCopy(result *sqltypes.Result) *sqltypes.Result {
+	return &sqltypes.Result{
+		Fields:       result.Fields,
+		RowsAffected: result.RowsAffected,
+		InsertID:     result.InsertID,
+		Rows:         append([][]sqltypes.Value(nil), result.Rows...),
+	}
+}
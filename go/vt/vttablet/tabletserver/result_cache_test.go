@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func newTestResult(value string) *sqltypes.Result {
+	return &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{sqltypes.NewVarChar(value)}},
+	}
+}
+
+func TestResultCacheGetPut(t *testing.T) {
+	rc := newResultCache(time.Hour, 1<<20)
+	if got := rc.get("q1"); got != nil {
+		t.Errorf("get(q1): %v, want nil", got)
+	}
+	result := newTestResult("a")
+	rc.put("q1", []string{"t1"}, result, rc.start())
+	got := rc.get("q1")
+	if !reflect.DeepEqual(got, result) {
+		t.Errorf("get(q1): %v, want %v", got, result)
+	}
+	if got, want := rc.Size(), resultSize("q1", result); got != want {
+		t.Errorf("Size: %d, want %d", got, want)
+	}
+	// Every caller gets its own copy.
+	got.Rows = append(got.Rows, got.Rows[0])
+	if got := rc.get("q1"); !reflect.DeepEqual(got, result) {
+		t.Errorf("get(q1) after a change to a copy: %v, want %v", got, result)
+	}
+	result.Rows = nil
+	if got := rc.get("q1"); len(got.Rows) != 1 {
+		t.Errorf("get(q1) after a change to the put result: %v, want 1 row", got)
+	}
+
+	rc.ttl = -time.Second
+	rc.put("q2", []string{"t1"}, newTestResult("a"), rc.start())
+	if got := rc.get("q2"); got != nil {
+		t.Errorf("get(q2) of an expired result: %v, want nil", got)
+	}
+	if got := rc.Length(); got != 1 {
+		t.Errorf("Length: %d, want 1", got)
+	}
+}
+
+func TestResultCacheEviction(t *testing.T) {
+	result := newTestResult("a")
+	size := resultSize("q1", result)
+	rc := newResultCache(time.Hour, 2*size)
+	rc.put("q1", []string{"t1"}, result, rc.start())
+	rc.put("q2", []string{"t1"}, result, rc.start())
+	// q1 becomes the most recently used.
+	rc.get("q1")
+	rc.put("q3", []string{"t2"}, result, rc.start())
+	if got := rc.get("q2"); got != nil {
+		t.Errorf("get(q2): %v, want nil", got)
+	}
+	if got := rc.get("q1"); got == nil {
+		t.Errorf("get(q1): nil, want a result")
+	}
+	if got, want := rc.Size(), 2*size; got != want {
+		t.Errorf("Size: %d, want %d", got, want)
+	}
+
+	// A result larger than the cache is not cached.
+	rc.put("q4", []string{"t1"}, newTestResult(strings.Repeat("a", 100)), rc.start())
+	if got := rc.get("q4"); got != nil {
+		t.Errorf("get(q4): %v, want nil", got)
+	}
+}
+
+func TestResultCacheInvalidate(t *testing.T) {
+	rc := newResultCache(time.Hour, 1<<20)
+	result := newTestResult("a")
+	rc.put("q1", []string{"t1"}, result, rc.start())
+	rc.put("q2", []string{"t1", "t2"}, result, rc.start())
+	rc.put("q3", []string{"t3"}, result, rc.start())
+
+	rc.invalidate("t2")
+	if got := rc.get("q2"); got != nil {
+		t.Errorf("get(q2): %v, want nil", got)
+	}
+	if got := rc.get("q1"); got == nil {
+		t.Errorf("get(q1): nil, want a result")
+	}
+
+	// The result of a query that started before an
+	// invalidation of one of its tables is not cached.
+	generation := rc.start()
+	rc.invalidate("t1")
+	rc.put("q1", []string{"t1"}, result, generation)
+	rc.put("q2", []string{"t2"}, result, generation)
+	if got := rc.get("q1"); got != nil {
+		t.Errorf("get(q1): %v, want nil", got)
+	}
+	if got := rc.get("q2"); got == nil {
+		t.Errorf("get(q2): nil, want a result")
+	}
+
+	generation = rc.start()
+	rc.invalidateAll()
+	rc.put("q1", []string{"t1"}, result, generation)
+	if got := rc.Length(); got != 0 {
+		t.Errorf("Length: %d, want 0", got)
+	}
+	if got := rc.Size(); got != 0 {
+		t.Errorf("Size: %d, want 0", got)
+	}
+}
+
+func TestReplicationWatcherInvalidateResults(t *testing.T) {
+	rc := newResultCache(time.Hour, 1<<20)
+	rpw := &ReplicationWatcher{resultCache: rc}
+	result := newTestResult("a")
+	statement := func(category binlogdatapb.BinlogTransaction_Statement_Category, table string) binlog.FullBinlogStatement {
+		return binlog.FullBinlogStatement{
+			Statement: &binlogdatapb.BinlogTransaction_Statement{Category: category},
+			Table:     table,
+		}
+	}
+
+	rc.put("q1", []string{"t1"}, result, rc.start())
+	rc.put("q2", []string{"t2"}, result, rc.start())
+	rpw.invalidateResults([]binlog.FullBinlogStatement{
+		statement(binlogdatapb.BinlogTransaction_Statement_BL_SET, ""),
+		statement(binlogdatapb.BinlogTransaction_Statement_BL_UPDATE, "t1"),
+	})
+	if got := rc.get("q1"); got != nil {
+		t.Errorf("get(q1): %v, want nil", got)
+	}
+	if got := rc.get("q2"); got == nil {
+		t.Errorf("get(q2): nil, want a result")
+	}
+
+	// A statement-based DML doesn't name its table.
+	rpw.invalidateResults([]binlog.FullBinlogStatement{
+		statement(binlogdatapb.BinlogTransaction_Statement_BL_INSERT, ""),
+	})
+	if got := rc.Length(); got != 0 {
+		t.Errorf("Length: %d, want 0", got)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"math"
+	"regexp"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// limiter holds the state of the limits of a Rule.
+type limiter struct {
+	// slots has one element for every query that runs
+	// under a CONCURRENCY_LIMIT rule.
+	slots chan struct{}
+	// bucket is the token bucket of a THROTTLE rule.
+	bucket *rate.Limiter
+}
+
+// Action returns the action performed when the Rule fires.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// SetConcurrencyLimit sets the number of queries that can run at the same
+// time under a CONCURRENCY_LIMIT rule. The other queries wait for one of
+// them to finish.
+func (qr *Rule) SetConcurrencyLimit(maxConcurrency int) {
+	qr.maxConcurrency = maxConcurrency
+	qr.limiter = &limiter{slots: make(chan struct{}, maxConcurrency)}
+}
+
+// SetRateLimit sets the rate of the queries that run under a THROTTLE rule,
+// which can run in bursts of up to burst queries. If burst is 0, it's
+// maxQPS rounded up. The queries above the rate are delayed.
+func (qr *Rule) SetRateLimit(maxQPS float64, burst int) {
+	if burst == 0 {
+		burst = int(math.Ceil(maxQPS))
+	}
+	qr.maxQPS = maxQPS
+	qr.burst = burst
+	qr.limiter = &limiter{bucket: rate.NewLimiter(rate.Limit(maxQPS), burst)}
+}
+
+// SetMaxExecutionTime sets the time after which the queries
+// that run under a MAX_EXECUTION_TIME rule are killed.
+func (qr *Rule) SetMaxExecutionTime(maxExecutionTime time.Duration) {
+	qr.maxExecutionTime = maxExecutionTime
+}
+
+// SetRewrite sets the regular expression that a REWRITE rule replaces
+// in the query sent to MySQL, and its replacement. Unlike the Query
+// condition, pattern can match a substring of the query, and rewrite
+// can refer to its submatches as in regexp.Regexp.ReplaceAllString.
+func (qr *Rule) SetRewrite(pattern, rewrite string) (err error) {
+	qr.rewritePattern.name = pattern
+	qr.rewritePattern.Regexp, err = regexp.Compile(pattern)
+	qr.rewrite = rewrite
+	return err
+}
+
+// Rewrite returns the query to send to MySQL for a query that fired
+// a REWRITE rule. The queries that don't match the pattern of the Rule
+// are returned unchanged.
+func (qr *Rule) Rewrite(query string) string {
+	if qr.act != QRRewrite || qr.rewritePattern.Regexp == nil {
+		return query
+	}
+	return qr.rewritePattern.ReplaceAllString(query, qr.rewrite)
+}
+
+// Acquire applies the limits of the Rule to a query it fired for.
+// It waits until the query is allowed to run, or fails if it can't be
+// within the deadline of ctx. It returns the context to run the query
+// with, and the function to call once the query is done.
+func (qr *Rule) Acquire(ctx context.Context) (context.Context, func(), error) {
+	switch {
+	case qr.act == QRConcurrencyLimit && qr.limiter != nil:
+		select {
+		case qr.limiter.slots <- struct{}{}:
+			return ctx, func() { <-qr.limiter.slots }, nil
+		case <-ctx.Done():
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "concurrency limit of %d exceeded due to rule: %s", qr.maxConcurrency, qr.Description)
+		}
+	case qr.act == QRThrottle && qr.limiter != nil:
+		if err := qr.limiter.bucket.Wait(ctx); err != nil {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limit of %v queries per second exceeded due to rule: %s", qr.maxQPS, qr.Description)
+		}
+	case qr.act == QRMaxExecutionTime && qr.maxExecutionTime != 0:
+		ctx, cancel := context.WithTimeout(ctx, qr.maxExecutionTime)
+		return ctx, cancel, nil
+	}
+	return ctx, func() {}, nil
+}
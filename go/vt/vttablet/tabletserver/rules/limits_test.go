@@ -0,0 +1,242 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestLimitsJSON(t *testing.T) {
+	jsondata := `[{
+		"Name": "limit",
+		"Action": "CONCURRENCY_LIMIT",
+		"MaxConcurrency": 2
+	},{
+		"Name": "throttle",
+		"Action": "THROTTLE",
+		"MaxQPS": 2.5
+	},{
+		"Name": "timeout",
+		"Action": "MAX_EXECUTION_TIME",
+		"MaxExecutionTimeMs": 1500
+	},{
+		"Name": "rewrite",
+		"Action": "REWRITE",
+		"RewritePattern": "^select ",
+		"Rewrite": "select /*+ MAX_EXECUTION_TIME(1000) */ "
+	}]`
+	qrs := New()
+	if err := qrs.UnmarshalJSON([]byte(jsondata)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := qrs.rules[0]; got.act != QRConcurrencyLimit || got.maxConcurrency != 2 || cap(got.limiter.slots) != 2 {
+		t.Errorf("concurrency limit rule: %+v", got)
+	}
+	if got := qrs.rules[1]; got.act != QRThrottle || got.maxQPS != 2.5 || got.burst != 3 || got.limiter.bucket == nil {
+		t.Errorf("throttle rule: %+v", got)
+	}
+	if got := qrs.rules[2]; got.act != QRMaxExecutionTime || got.maxExecutionTime != 1500*time.Millisecond {
+		t.Errorf("max execution time rule: %+v", got)
+	}
+	if got := qrs.rules[3]; got.act != QRRewrite || got.rewritePattern.Regexp == nil || got.rewrite != "select /*+ MAX_EXECUTION_TIME(1000) */ " {
+		t.Errorf("rewrite rule: %+v", got)
+	}
+
+	want := `[{"Description":"","Name":"limit","Action":"CONCURRENCY_LIMIT","MaxConcurrency":2}
+,{"Description":"","Name":"throttle","Action":"THROTTLE","MaxQPS":2.5,"Burst":3}
+,{"Description":"","Name":"timeout","Action":"MAX_EXECUTION_TIME","MaxExecutionTimeMs":1500}
+,{"Description":"","Name":"rewrite","Action":"REWRITE","RewritePattern":"^select ","Rewrite":"select /*+ MAX_EXECUTION_TIME(1000) */ "}
+]`
+	got, err := qrs.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("MarshalJSON:\n%s, want\n%s", got, want)
+	}
+	other := New()
+	if err := other.UnmarshalJSON(got); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !qrs.Equal(other) {
+		t.Errorf("rules don't survive a round trip through JSON: %s", got)
+	}
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	qr := NewQueryRule("limit", "limit", QRConcurrencyLimit)
+	qr.SetConcurrencyLimit(1)
+	// Copies share the limit.
+	qrCopy := qr.Copy()
+
+	_, done, err := qr.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = qrCopy.Acquire(ctx)
+	want := "concurrency limit of 1 exceeded due to rule: limit"
+	if err == nil || err.Error() != want {
+		t.Errorf("Acquire: %v, want %s", err, want)
+	}
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Acquire: %v, want %v", code, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+
+	done()
+	_, done, err = qrCopy.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	done()
+}
+
+func TestThrottle(t *testing.T) {
+	qr := NewQueryRule("throttle", "throttle", QRThrottle)
+	qr.SetRateLimit(0.1, 1)
+
+	_, done, err := qr.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	done()
+	// The next token is 10s away, which is past the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = qr.Acquire(ctx)
+	want := "rate limit of 0.1 queries per second exceeded due to rule: throttle"
+	if err == nil || err.Error() != want {
+		t.Errorf("Acquire: %v, want %s", err, want)
+	}
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Acquire: %v, want %v", code, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+}
+
+func TestMaxExecutionTime(t *testing.T) {
+	qr := NewQueryRule("timeout", "timeout", QRMaxExecutionTime)
+	qr.SetMaxExecutionTime(time.Minute)
+
+	ctx, done, err := qr.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > time.Minute {
+		t.Errorf("Acquire: deadline %v, want within a minute", deadline)
+	}
+	done()
+	if ctx.Err() == nil {
+		t.Errorf("the context should be cancelled once the query is done")
+	}
+}
+
+func TestLimitsInvalidJSON(t *testing.T) {
+	testcases := []struct {
+		rule string
+		want string
+	}{{
+		rule: `{"Action": "CONCURRENCY_LIMIT"}`,
+		want: "MaxConcurrency is required for Action CONCURRENCY_LIMIT",
+	}, {
+		rule: `{"Action": "FAIL", "MaxQPS": 1}`,
+		want: "limits don't apply to Action FAIL",
+	}, {
+		rule: `{"Action": "REWRITE", "RewritePattern": "a"}`,
+		want: "RewritePattern and Rewrite are required for Action REWRITE",
+	}, {
+		rule: `{"Action": "REWRITE", "RewritePattern": "(", "Rewrite": "b"}`,
+		want: "could not set RewritePattern: (",
+	}, {
+		rule: `{"Action": "THROTTLE", "MaxQPS": 1, "Rewrite": "b"}`,
+		want: "rewrites don't apply to Action THROTTLE",
+	}}
+	for _, tcase := range testcases {
+		qrs := New()
+		err := qrs.UnmarshalJSON([]byte("[" + tcase.rule + "]"))
+		if err == nil || err.Error() != tcase.want {
+			t.Errorf("UnmarshalJSON(%s): %v, want %s", tcase.rule, err, tcase.want)
+		}
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	qr := NewQueryRule("rewrite", "rewrite", QRRewrite)
+	if err := qr.SetRewrite("from (\\w+) where", "from $1 force index (primary) where"); err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		query string
+		want  string
+	}{{
+		query: "select a from t1 where b = 1",
+		want:  "select a from t1 force index (primary) where b = 1",
+	}, {
+		// The queries that don't match are unchanged.
+		query: "select a from t1",
+		want:  "select a from t1",
+	}}
+	for _, tcase := range testcases {
+		if got := qr.Rewrite(tcase.query); got != tcase.want {
+			t.Errorf("Rewrite(%s): %s, want %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestGetRules(t *testing.T) {
+	limit := NewQueryRule("limit", "limit", QRConcurrencyLimit)
+	limit.SetConcurrencyLimit(1)
+	timeout := NewQueryRule("timeout", "timeout", QRMaxExecutionTime)
+	timeout.SetMaxExecutionTime(time.Minute)
+	fail := NewQueryRule("fail", "fail", QRFail)
+	fail.SetUserCond("banned")
+	qrs := New()
+	qrs.Add(limit)
+	qrs.Add(timeout)
+	qrs.Add(fail)
+
+	// All the rules fire, the FAIL rule first.
+	var got []string
+	for _, qr := range qrs.GetRules("", "banned", nil) {
+		got = append(got, qr.Name)
+	}
+	want := []string{"fail", "limit", "timeout"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRules: %v, want %v", got, want)
+	}
+	if act, _ := qrs.GetAction("", "banned", nil); act != QRFail {
+		t.Errorf("GetAction: %v, want %v", act, QRFail)
+	}
+
+	got = nil
+	for _, qr := range qrs.GetRules("", "other", nil) {
+		got = append(got, qr.Name)
+	}
+	want = []string{"limit", "timeout"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRules: %v, want %v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ package rules
 import (
 	"encoding/json"
 	"errors"
+	"sort"
 	"sync"
 
 	"vitess.io/vitess/go/vt/log"
@@ -86,12 +87,19 @@ func (qri *Map) Get(ruleSource string) (*Rules, error) {
 
 // FilterByPlan creates a new Rules by prefiltering on all query rules that are contained in internal
 // Rules structures, in other words, query rules from all predefined sources will be applied.
+// The sources are visited in the order of their names, so that the rules of every plan are in
+// the same order.
 func (qri *Map) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqrs *Rules) {
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
+	sources := make([]string, 0, len(qri.queryRulesMap))
+	for source := range qri.queryRulesMap {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
 	newqrs = New()
-	for _, rules := range qri.queryRulesMap {
-		newqrs.Append(rules.FilterByPlan(query, planid, tableName))
+	for _, source := range sources {
+		newqrs.Append(qri.queryRulesMap[source].FilterByPlan(query, planid, tableName))
 	}
 	return newqrs
 }
@@ -201,6 +201,13 @@ func TestMapFilterByPlan(t *testing.T) {
 	if l := len(qrs.rules); l != 2 {
 		t.Errorf("Insert into bannedtable2 matches %d rules: %v, but we expect %d rules to be matched", l, qrs.rules, 2)
 	}
+	// The rules are in the order of the names of their sources.
+	for i := 0; i < 10; i++ {
+		qrs = qri.FilterByPlan("select * from bannedtable2", planbuilder.PlanPassSelect, "bannedtable2")
+		if !strings.HasPrefix(qrs.rules[0].Name, "blacklisted_table") || qrs.rules[1].Name != "customrule_ban_bindvar" {
+			t.Fatalf("Select from bannedtable2 matches rules %v, but we expect the blacklist rule first", qrs.rules)
+		}
+	}
 }
 
 func TestMapJSON(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -154,13 +155,29 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// A FAIL or FAIL_RETRY action takes precedence over the other actions.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	if fired := qrs.GetRules(ip, user, bindVars); len(fired) != 0 {
+		return fired[0].act, fired[0].Description
+	}
+	return QRContinue, ""
+}
+
+// GetRules runs the input against the rules engine and returns all the
+// rules that fire. The FAIL and FAIL_RETRY rules come first, and the
+// others keep their order.
+func (qrs *Rules) GetRules(ip, user string, bindVars map[string]*querypb.BindVariable) []*Rule {
+	var failed, fired []*Rule
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+		switch qr.GetAction(ip, user, bindVars) {
+		case QRContinue:
+		case QRFail, QRFailRetry:
+			failed = append(failed, qr)
+		default:
+			fired = append(fired, qr)
 		}
 	}
-	return QRContinue, ""
+	return append(failed, fired...)
 }
 
 //-----------------------------------------------
@@ -193,6 +210,22 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the CONCURRENCY_LIMIT, THROTTLE and
+	// MAX_EXECUTION_TIME actions.
+	maxConcurrency   int
+	maxQPS           float64
+	burst            int
+	maxExecutionTime time.Duration
+
+	// The REWRITE action replaces rewritePattern
+	// with rewrite in the query sent to MySQL.
+	rewritePattern namedRegexp
+	rewrite        string
+
+	// limiter is shared by the copies of the Rule, so that
+	// its limits apply to all the plans the Rule is part of.
+	limiter *limiter
 }
 
 type namedRegexp struct {
@@ -215,7 +248,6 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
-	// We ignore act because there's only one action right now
 	return &Rule{Description: description, Name: name, act: act}
 }
 
@@ -232,7 +264,13 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.maxQPS == other.maxQPS &&
+		qr.burst == other.burst &&
+		qr.maxExecutionTime == other.maxExecutionTime &&
+		qr.rewritePattern.Equal(other.rewritePattern) &&
+		qr.rewrite == other.rewrite)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -244,6 +282,14 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		user:        qr.user,
 		query:       qr.query,
 		act:         qr.act,
+
+		maxConcurrency:   qr.maxConcurrency,
+		maxQPS:           qr.maxQPS,
+		burst:            qr.burst,
+		maxExecutionTime: qr.maxExecutionTime,
+		rewritePattern:   qr.rewritePattern,
+		rewrite:          qr.rewrite,
+		limiter:          qr.limiter,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -286,6 +332,20 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+		safeEncode(b, `,"Burst":`, qr.burst)
+	}
+	if qr.maxExecutionTime != 0 {
+		safeEncode(b, `,"MaxExecutionTimeMs":`, qr.maxExecutionTime.Nanoseconds()/1e6)
+	}
+	if qr.rewritePattern.Regexp != nil {
+		safeEncode(b, `,"RewritePattern":`, qr.rewritePattern)
+		safeEncode(b, `,"Rewrite":`, qr.rewrite)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -474,25 +534,39 @@ func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool
 type Action int
 
 // These are actions.
+// QRConcurrencyLimit, QRThrottle and QRMaxExecutionTime let the query
+// run, within the limits set on the Rule: see Rule.Acquire.
+// QRRewrite changes the query sent to MySQL: see Rule.Rewrite.
 const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRConcurrencyLimit
+	QRThrottle
+	QRMaxExecutionTime
+	QRRewrite
 )
 
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRConcurrencyLimit: "CONCURRENCY_LIMIT",
+	QRThrottle:         "THROTTLE",
+	QRMaxExecutionTime: "MAX_EXECUTION_TIME",
+	QRRewrite:          "REWRITE",
+}
+
+// String returns the name of the action in the JSON rules.
+func (act Action) String() string {
+	if str, ok := actionNames[act]; ok {
+		return str
+	}
+	return "INVALID"
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
-		str = "INVALID"
-	}
-	return json.Marshal(str)
+	return json.Marshal(act.String())
 }
 
 // BindVarCond represents a bind var condition.
@@ -774,16 +848,26 @@ func MapStrOperator(strop string) (op Operator, err error) {
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
+	var maxConcurrency, burst int
+	var maxQPS float64
+	var maxExecutionTime time.Duration
+	var rewritePattern, rewrite *string
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var nv float64
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "RewritePattern", "Rewrite":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "MaxConcurrency", "MaxQPS", "Burst", "MaxExecutionTimeMs":
+			nv, ok = numberValue(v)
+			if !ok || nv <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive number for %s", k)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
@@ -844,19 +928,84 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 			}
 		case "Action":
-			switch sv {
-			case "FAIL":
-				qr.act = QRFail
-			case "FAIL_RETRY":
-				qr.act = QRFailRetry
-			default:
+			act, ok := actionByName(sv)
+			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+			qr.act = act
+		case "MaxConcurrency":
+			maxConcurrency = int(nv)
+		case "MaxQPS":
+			maxQPS = nv
+		case "Burst":
+			burst = int(nv)
+		case "MaxExecutionTimeMs":
+			maxExecutionTime = time.Duration(nv * float64(time.Millisecond))
+		case "RewritePattern":
+			rewritePattern = &sv
+		case "Rewrite":
+			rewrite = &sv
+		}
+	}
+	// The limits are checked once all the tags are read,
+	// since they depend on the action.
+	switch qr.act {
+	case QRConcurrencyLimit:
+		if maxConcurrency == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency is required for Action %v", qr.act)
+		}
+		qr.SetConcurrencyLimit(maxConcurrency)
+	case QRThrottle:
+		if maxQPS == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS is required for Action %v", qr.act)
+		}
+		qr.SetRateLimit(maxQPS, burst)
+	case QRMaxExecutionTime:
+		if maxExecutionTime == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTimeMs is required for Action %v", qr.act)
+		}
+		qr.SetMaxExecutionTime(maxExecutionTime)
+	case QRRewrite:
+		if rewritePattern == nil || rewrite == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "RewritePattern and Rewrite are required for Action %v", qr.act)
+		}
+		if err := qr.SetRewrite(*rewritePattern, *rewrite); err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not set RewritePattern: %v", *rewritePattern)
 		}
 	}
+	if (maxConcurrency != 0 && qr.act != QRConcurrencyLimit) ||
+		((maxQPS != 0 || burst != 0) && qr.act != QRThrottle) ||
+		(maxExecutionTime != 0 && qr.act != QRMaxExecutionTime) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "limits don't apply to Action %v", qr.act)
+	}
+	if (rewritePattern != nil || rewrite != nil) && qr.act != QRRewrite {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "rewrites don't apply to Action %v", qr.act)
+	}
 	return qr, nil
 }
 
+func actionByName(name string) (Action, bool) {
+	for act, str := range actionNames {
+		if str == name {
+			return act, true
+		}
+	}
+	return QRContinue, false
+}
+
+// numberValue converts a JSON number, decoded with or
+// without UseNumber, to a float64.
+func numberValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
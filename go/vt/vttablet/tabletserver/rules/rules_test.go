@@ -672,6 +672,13 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Action": "CONCURRENCY_LIMIT" }]`, "MaxConcurrency is required for Action CONCURRENCY_LIMIT"},
+	{`[{"Action": "THROTTLE" }]`, "MaxQPS is required for Action THROTTLE"},
+	{`[{"Action": "MAX_EXECUTION_TIME" }]`, "MaxExecutionTimeMs is required for Action MAX_EXECUTION_TIME"},
+	{`[{"Action": "THROTTLE", "MaxQPS": -1 }]`, "want positive number for MaxQPS"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": "1" }]`, "want positive number for MaxConcurrency"},
+	{`[{"Action": "FAIL", "MaxConcurrency": 1 }]`, "limits don't apply to Action FAIL"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": 1, "Burst": 1 }]`, "limits don't apply to Action CONCURRENCY_LIMIT"},
 }
 
 func TestInvalidJSON(t *testing.T) {
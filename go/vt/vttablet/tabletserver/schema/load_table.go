@@ -183,6 +183,17 @@ func loadMessageInfo(ta *Table, comment string) error {
 	if ta.MessageInfo.PollInterval, err = getDuration(keyvals, "vt_poller_interval"); err != nil {
 		return err
 	}
+	if keyvals["vt_max_epoch"] != "" {
+		if ta.MessageInfo.MaxEpoch, err = getNum(keyvals, "vt_max_epoch"); err != nil {
+			return err
+		}
+		if ta.MessageInfo.MaxEpoch <= 0 {
+			return fmt.Errorf("vt_max_epoch must be positive for message table: %s", ta.Name.String())
+		}
+		if ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]; ta.MessageInfo.DeadLetterTable == "" {
+			return fmt.Errorf("attribute vt_dead_letter_table not specified for message table with vt_max_epoch: %s", ta.Name.String())
+		}
+	}
 	for _, col := range orderedColumns {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -219,6 +230,10 @@ func loadMessageInfo(ta *Table, comment string) error {
 			continue
 		}
 
+		// The optional priority column is also returned with the stream.
+		if c.Name.EqualString("priority") {
+			ta.MessageInfo.PriorityIndex = len(ta.MessageInfo.Fields)
+		}
 		ta.MessageInfo.Fields = append(ta.MessageInfo.Fields, &querypb.Field{
 			Name: c.Name.String(),
 			Type: c.Type,
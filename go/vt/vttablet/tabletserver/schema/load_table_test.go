@@ -200,6 +200,48 @@ func TestLoadTableMessage(t *testing.T) {
 	}
 }
 
+func TestLoadTableMessageDeadLetter(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	queries := getMessageTableQueries()
+	// Add the optional priority column.
+	fields := queries["select * from test_table where 1 != 1"]
+	fields.Fields = append(fields.Fields, &querypb.Field{
+		Name: "priority",
+		Type: sqltypes.Int64,
+	})
+	describe := queries["describe test_table"]
+	describe.Rows = append(describe.Rows, mysql.DescribeTableRow("priority", "bigint(20)", true, "", "0"))
+	describe.RowsAffected++
+	for query, result := range queries {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=5,vt_dead_letter_table=test_dead", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.MessageInfo.Fields[table.MessageInfo.PriorityIndex].Name, "priority"; got != want {
+		t.Errorf("priority field: %s, want %s", got, want)
+	}
+	if got, want := table.MessageInfo.MaxEpoch, 5; got != want {
+		t.Errorf("MaxEpoch: %d, want %d", got, want)
+	}
+	if got, want := table.MessageInfo.DeadLetterTable, "test_dead"; got != want {
+		t.Errorf("DeadLetterTable: %s, want %s", got, want)
+	}
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=5", db)
+	wanterr := "attribute vt_dead_letter_table not specified for message table with vt_max_epoch: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_epoch=0,vt_dead_letter_table=test_dead", db)
+	wanterr = "vt_max_epoch must be positive for message table: test_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+}
+
 func TestLoadTableMessageTopic(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -134,6 +134,21 @@ type MessageInfo struct {
 	// PollInterval specifies the polling frequency to
 	// look for messages to be sent.
 	PollInterval time.Duration
+
+	// PriorityIndex is the index of the optional priority
+	// column in Fields, or 0 if there is none. Messages with
+	// a lower priority are sent first.
+	PriorityIndex int
+
+	// MaxEpoch is the number of times a message is sent
+	// before it's moved to DeadLetterTable. If it's 0,
+	// messages are resent until they're acked.
+	MaxEpoch int
+
+	// DeadLetterTable is the table where the messages are
+	// moved after MaxEpoch sends. It must have the columns
+	// of the message table.
+	DeadLetterTable string
 }
 
 // NewTable creates a new Table.
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -100,13 +102,23 @@ func init() {
 	flag.BoolVar(&Config.TransactionLimitByComponent, "transaction_limit_by_component", DefaultQsConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&Config.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", DefaultQsConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
 
+	flagutil.StringListVar(&Config.QueryPoolClasses, "queryserver-config-pool-classes", DefaultQsConfig.QueryPoolClasses, "A comma-separated list of name:min:weight classes that partition the query pool and the stream pool. In each pool, each class is guaranteed min connections, and the connections that are not guaranteed to any class are shared between the waiting classes in proportion to their weight. Queries that don't match a class use the 'default' class, which has a min of 0 and a weight of 1 unless it's listed.")
+	flag.StringVar(&Config.QueryPoolClassBy, "queryserver-config-pool-class-by", DefaultQsConfig.QueryPoolClassBy, "What the class of a query in the query and stream pools is: 'workload' for the workload of the session (oltp, olap or dba), 'username' for the immediate caller ID username, or 'principal' for the effective caller ID principal.")
+
 	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&Config.HeartbeatInterval, "heartbeat_interval", DefaultQsConfig.HeartbeatInterval, "How frequently to read and write replication heartbeat.")
 
+	flag.BoolVar(&Config.EnableLagThrottler, "enable_lag_throttler", DefaultQsConfig.EnableLagThrottler, "If true, the master answers the /throttler/check requests and CheckThrottler RPCs of batch jobs based on the replication lag of the shard's replicas. Requires -heartbeat_enable.")
+	flag.DurationVar(&Config.LagThrottlerThreshold, "lag_throttler_threshold", DefaultQsConfig.LagThrottlerThreshold, "Replication lag above which the lag throttler throttles batch jobs.")
+	flagutil.StringListVar(&Config.LagThrottlerHealthCheckCells, "lag_throttler_healthcheck_cells", DefaultQsConfig.LagThrottlerHealthCheckCells, "A comma-separated list of cells. The master watches the replication lag of the replicas of its shard in these cells for the lag throttler. Defaults to all the cells.")
+	flagutil.StringListVar(&Config.LagThrottlerExemptApps, "lag_throttler_exempt_apps", DefaultQsConfig.LagThrottlerExemptApps, "A comma-separated list of apps that are never throttled by the lag throttler.")
+
 	flag.BoolVar(&Config.EnforceStrictTransTables, "enforce_strict_trans_tables", DefaultQsConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flag.BoolVar(&Config.EnableConsolidator, "enable-consolidator", DefaultQsConfig.EnableConsolidator, "This option enables the query consolidator.")
 	flag.BoolVar(&Config.EnableConsolidatorReplicas, "enable-consolidator-replicas", DefaultQsConfig.EnableConsolidatorReplicas, "This option enables the query consolidator only on replicas.")
 	flag.BoolVar(&Config.EnableQueryPlanFieldCaching, "enable-query-plan-field-caching", DefaultQsConfig.EnableQueryPlanFieldCaching, "This option fetches & caches fields (columns) when storing query plans")
+	flag.DurationVar(&Config.ResultCacheTTL, "queryserver-config-result-cache-ttl", DefaultQsConfig.ResultCacheTTL, "How long replicas keep the results of read queries to serve the identical queries that follow. The results of a table are dropped as soon as the replication stream changes the table. 0 disables the result cache. Requires -watch_replication_stream.")
+	flag.Int64Var(&Config.ResultCacheSize, "queryserver-config-result-cache-size", DefaultQsConfig.ResultCacheSize, "Maximum size in bytes of the results kept by the result cache.")
 }
 
 // Init must be called after flag.Parse, and before doing any other operations.
@@ -178,13 +190,24 @@ type TabletConfig struct {
 
 	TransactionLimitConfig
 
+	QueryPoolClasses []string
+	QueryPoolClassBy string
+
 	HeartbeatEnable   bool
 	HeartbeatInterval time.Duration
 
+	EnableLagThrottler           bool
+	LagThrottlerThreshold        time.Duration
+	LagThrottlerHealthCheckCells []string
+	LagThrottlerExemptApps       []string
+
 	EnforceStrictTransTables    bool
 	EnableConsolidator          bool
 	EnableConsolidatorReplicas  bool
 	EnableQueryPlanFieldCaching bool
+
+	ResultCacheTTL  time.Duration
+	ResultCacheSize int64
 }
 
 // TransactionLimitConfig captures configuration of transaction pool slots
@@ -259,13 +282,24 @@ var DefaultQsConfig = TabletConfig{
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
+	QueryPoolClasses: []string{},
+	QueryPoolClassBy: QueryPoolClassByWorkload,
+
 	HeartbeatEnable:   false,
 	HeartbeatInterval: 1 * time.Second,
 
+	EnableLagThrottler:           false,
+	LagThrottlerThreshold:        1 * time.Second,
+	LagThrottlerHealthCheckCells: []string{},
+	LagThrottlerExemptApps:       []string{},
+
 	EnforceStrictTransTables:    true,
 	EnableConsolidator:          true,
 	EnableConsolidatorReplicas:  false,
 	EnableQueryPlanFieldCaching: true,
+
+	ResultCacheTTL:  0,
+	ResultCacheSize: 64 * 1024 * 1024,
 }
 
 // defaultTxThrottlerConfig formats the default throttlerdata.Configuration
@@ -327,6 +361,71 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// Values of QueryPoolClassBy.
+const (
+	QueryPoolClassByWorkload  = "workload"
+	QueryPoolClassByUsername  = "username"
+	QueryPoolClassByPrincipal = "principal"
+)
+
+// QueryPoolClass is a class of queries that gets its own share of the query pool.
+type QueryPoolClass struct {
+	Name   string
+	Min    int
+	Weight int
+}
+
+// ParseQueryPoolClasses parses classes in the name:min:weight format.
+func ParseQueryPoolClasses(specs []string) ([]QueryPoolClass, error) {
+	classes := make([]QueryPoolClass, 0, len(specs))
+	names := make(map[string]bool)
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid query pool class %q, want name:min:weight", spec)
+		}
+		if names[parts[0]] {
+			return nil, fmt.Errorf("duplicate query pool class %v", parts[0])
+		}
+		names[parts[0]] = true
+		min, err := strconv.Atoi(parts[1])
+		if err != nil || min < 0 {
+			return nil, fmt.Errorf("invalid min for query pool class %q, want a number >= 0", spec)
+		}
+		weight, err := strconv.Atoi(parts[2])
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid weight for query pool class %q, want a number > 0", spec)
+		}
+		classes = append(classes, QueryPoolClass{Name: parts[0], Min: min, Weight: weight})
+	}
+	return classes, nil
+}
+
+// verifyQueryPoolClasses checks that the query pool classes can be parsed,
+// and that their minimums fit in the query pool and the stream pool.
+func (c *TabletConfig) verifyQueryPoolClasses() error {
+	switch c.QueryPoolClassBy {
+	case QueryPoolClassByWorkload, QueryPoolClassByUsername, QueryPoolClassByPrincipal:
+	default:
+		return fmt.Errorf("-queryserver-config-pool-class-by must be one of %v, %v or %v (specified value: %v)", QueryPoolClassByWorkload, QueryPoolClassByUsername, QueryPoolClassByPrincipal, c.QueryPoolClassBy)
+	}
+	classes, err := ParseQueryPoolClasses(c.QueryPoolClasses)
+	if err != nil {
+		return err
+	}
+	total := 0
+	for _, class := range classes {
+		total += class.Min
+	}
+	if total > c.PoolSize {
+		return fmt.Errorf("the sum of the query pool class minimums must be <= -queryserver-config-pool-size (%v > %v)", total, c.PoolSize)
+	}
+	if total > c.StreamPoolSize {
+		return fmt.Errorf("the sum of the query pool class minimums must be <= -queryserver-config-stream-pool-size (%v > %v)", total, c.StreamPoolSize)
+	}
+	return nil
+}
+
 // Config contains all the current config values. It's read-only,
 // except for tests.
 var Config TabletConfig
@@ -336,6 +435,15 @@ func VerifyConfig() error {
 	if err := Config.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if err := Config.verifyQueryPoolClasses(); err != nil {
+		return err
+	}
+	if Config.ResultCacheTTL > 0 && !Config.WatchReplication {
+		return errors.New("-queryserver-config-result-cache-ttl requires -watch_replication_stream")
+	}
+	if v := Config.ResultCacheSize; Config.ResultCacheTTL > 0 && v <= 0 {
+		return fmt.Errorf("-queryserver-config-result-cache-size must be > 0 (specified value: %v)", v)
+	}
 	if actual, dryRun := Config.EnableHotRowProtection, Config.EnableHotRowProtectionDryRun; actual && dryRun {
 		return errors.New("only one of two flags allowed: -enable_hot_row_protection or -enable_hot_row_protection_dry_run")
 	}
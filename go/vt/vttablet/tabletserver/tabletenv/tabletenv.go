@@ -111,6 +111,12 @@ var (
 		"TableACLPseudoDenied",
 		"ACL pseudodenials",
 		[]string{"TableName", "TableGroup", "PlanID", "Username"})
+	// QueryRuleStats counts the queries that fired a query rule,
+	// by rule and by whether they were allowed to run.
+	QueryRuleStats = stats.NewCountersWithMultiLabels(
+		"QueryRuleStats",
+		"Queries that fired a query rule",
+		[]string{"Rule", "Action", "Result"})
 	// Infof can be overridden during tests
 	Infof = log.Infof
 	// Warningf can be overridden during tests
@@ -63,6 +63,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/splitquery"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
@@ -182,6 +183,10 @@ type TabletServer struct {
 	txThrottler *txthrottler.TxThrottler
 	topoServer  *topo.Server
 
+	// lagThrottler answers the checks of batch jobs based on
+	// the replication lag of the shard.
+	lagThrottler *throttle.Throttler
+
 	// streamHealthMutex protects all the following fields
 	streamHealthMutex          sync.Mutex
 	streamHealthIndex          int
@@ -249,6 +254,22 @@ type TxPoolController interface {
 
 	// Rollback rolls back the specified transaction.
 	Rollback(ctx context.Context, transactionID int64) error
+
+	// Reserve reserves a connection, and executes the preQueries on it.
+	// Subsequent statements can access the connection through the returned id.
+	Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string) (int64, error)
+
+	// ReserveTransaction executes the preQueries on the connection of the
+	// transaction, and keeps it reserved once the transaction is concluded.
+	ReserveTransaction(ctx context.Context, transactionID int64, preQueries []string) error
+
+	// BeginReserved begins a transaction on the reserved connection, and
+	// returns the statement(s) used to execute the begin (if any).
+	BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error)
+
+	// Release releases the reserved connection, rolling back its
+	// transaction if any.
+	Release(ctx context.Context, reservedID int64) error
 }
 
 var tsOnce sync.Once
@@ -280,8 +301,9 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	tsv.hw = heartbeat.NewWriter(tsv, alias, config)
 	tsv.hr = heartbeat.NewReader(tsv, config)
 	tsv.txThrottler = txthrottler.CreateTxThrottlerFromTabletConfig(topoServer)
+	tsv.lagThrottler = throttle.NewThrottler(config, topoServer)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, config)
-	tsv.watcher = NewReplicationWatcher(tsv.se, config)
+	tsv.watcher = NewReplicationWatcher(tsv.se, tsv.qe.resultCache, config)
 	tsv.updateStreamList = &binlog.StreamList{}
 	// FIXME(alainjobart) could we move this to the Register method below?
 	// So that vtcombo doesn't even call it once, on the first tablet.
@@ -319,6 +341,7 @@ func (tsv *TabletServer) Register() {
 	tsv.registerQueryzHandler()
 	tsv.registerStreamQueryzHandlers()
 	tsv.registerTwopczHandler()
+	tsv.registerThrottlerHandler()
 }
 
 // RegisterQueryRuleSource registers ruleSource for setting query rules.
@@ -577,12 +600,14 @@ func (tsv *TabletServer) serveNewType() (err error) {
 		tsv.messager.Open()
 		tsv.hr.Close()
 		tsv.hw.Open()
+		tsv.lagThrottler.Open(tsv.target)
 	} else {
 		tsv.teCtrl.AcceptReadOnly()
 		tsv.messager.Close()
 		tsv.hr.Open()
 		tsv.hw.Close()
 		tsv.watcher.Open()
+		tsv.lagThrottler.Open(tsv.target)
 
 		// Reset the sequences.
 		tsv.se.MakeNonMaster()
@@ -639,6 +664,7 @@ func (tsv *TabletServer) waitForShutdown() {
 	tsv.watcher.Close()
 	tsv.requests.Wait()
 	tsv.txThrottler.Close()
+	tsv.lagThrottler.Close()
 }
 
 // closeAll is called if TabletServer fails to start.
@@ -653,6 +679,7 @@ func (tsv *TabletServer) closeAll() {
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.txThrottler.Close()
+	tsv.lagThrottler.Close()
 	tsv.transition(StateNotConnected)
 }
 
@@ -1008,11 +1035,17 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			ctx, cancel := withQueryTimeout(ctx, plan.QueryTimeout)
+			defer cancel()
+			bindVariables, schemaNameResolved := tsv.resolveSchemaName(target, query, bindVariables)
 			if plan.PlanID == planbuilder.PlanInsertTopic {
 				result, err = tsv.topicExecute(ctx, query, comments, bindVariables, transactionID, options, plan, logStats, target.GetTabletType())
 			} else {
 				result, err = tsv.qreExecute(ctx, query, comments, bindVariables, transactionID, options, plan, logStats, target.GetTabletType())
 			}
+			if err == nil && schemaNameResolved {
+				result = tsv.restoreKeyspaceName(target, schemaColumns(result.Fields), result)
+			}
 
 			return err
 		},
@@ -1078,6 +1111,9 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
+			ctx, cancel := withQueryTimeout(ctx, plan.QueryTimeout)
+			defer cancel()
+			bindVariables, schemaNameResolved := tsv.resolveSchemaName(target, query, bindVariables)
 			qre := &QueryExecutor{
 				query:          query,
 				marginComments: comments,
@@ -1089,6 +1125,16 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				logStats:       logStats,
 				tsv:            tsv,
 			}
+			if schemaNameResolved {
+				// Only the first result of the stream has the fields.
+				var cols []int
+				return qre.Stream(func(result *sqltypes.Result) error {
+					if result.Fields != nil {
+						cols = schemaColumns(result.Fields)
+					}
+					return callback(tsv.restoreKeyspaceName(target, cols, result))
+				})
+			}
 			return qre.Stream(callback)
 		},
 	)
@@ -1293,6 +1339,95 @@ func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.
 	return results, transactionID, err
 }
 
+// ReserveExecute reserves a connection with the preQueries applied, and
+// executes the query on it. If transactionID is specified, the connection
+// of the transaction is reserved instead, and its id is returned.
+func (tsv *TabletServer) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := tsv.reserve(ctx, target, preQueries, transactionID, options)
+	if err != nil {
+		return nil, reservedID, err
+	}
+
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute begins a transaction on the reserved connection,
+// reserving one with the preQueries applied if reservedID is zero, and
+// executes the query in the transaction.
+func (tsv *TabletServer) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		reservedID, err = tsv.reserve(ctx, target, preQueries, 0, options)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	err := tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Begin", "begin", nil,
+		target, options, true /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			startTime := time.Now()
+			if tsv.txThrottler.Throttle() {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "Transaction throttled")
+			}
+			logStats.TransactionID = reservedID
+			beginSQL, err := tsv.teCtrl.BeginReserved(ctx, reservedID, options)
+			logStats.OriginalSQL = beginSQL
+			if beginSQL != "" {
+				tabletenv.QueryStats.Record("BEGIN", startTime)
+			} else {
+				logStats.Method = ""
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, reservedID, err
+	}
+
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+func (tsv *TabletServer) reserve(ctx context.Context, target *querypb.Target, preQueries []string, transactionID int64, options *querypb.ExecuteOptions) (reservedID int64, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Reserve", strings.Join(preQueries, "; "), nil,
+		target, options, transactionID == 0 /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RESERVE", time.Now())
+			if transactionID != 0 {
+				reservedID = transactionID
+				logStats.TransactionID = reservedID
+				return tsv.teCtrl.ReserveTransaction(ctx, transactionID, preQueries)
+			}
+			var err error
+			reservedID, err = tsv.teCtrl.Reserve(ctx, options, preQueries)
+			logStats.TransactionID = reservedID
+			return err
+		},
+	)
+	return reservedID, err
+}
+
+// Release releases the reserved connection. Its transaction,
+// if any, is rolled back.
+func (tsv *TabletServer) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	return tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Release", "release", nil,
+		target, nil, false /* isBegin */, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RELEASE", time.Now())
+			logStats.TransactionID = reservedID
+			return tsv.teCtrl.Release(ctx, reservedID)
+		},
+	)
+}
+
 // MessageStream streams messages from the requested table.
 func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
@@ -1349,14 +1484,32 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the messages that are due at timeNext after the max
+// number of sends to the dead-letter table of a given message table. It moves at
+// most 500 messages. It returns the number of messages successfully moved.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeNext int64) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateDeadLetterQueries(name, timeNext)
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		query, bv, err := queryGenerator()
+		return []string{query}, bv, err
+	})
+}
+
+// execDMLs executes the generated queries in a transaction,
+// and returns the number of rows affected by the last one.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.startRequest(ctx, target, true /* isBegin */, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.endRequest(true)
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, bv, err := queryGenerator()
 	if err != nil {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 	}
@@ -1372,9 +1525,11 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, nil)
-	if err != nil {
-		return 0, err
+	var qr *sqltypes.Result
+	for _, query := range queries {
+		if qr, err = tsv.Execute(ctx, target, query, bv, transactionID, nil); err != nil {
+			return 0, err
+		}
 	}
 	if err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
@@ -1993,6 +2148,11 @@ func (tsv *TabletServer) HeartbeatLag() (time.Duration, error) {
 	return tsv.hr.GetLatest()
 }
 
+// CheckThrottler asks the lag throttler whether app may write now.
+func (tsv *TabletServer) CheckThrottler(app string) *throttle.CheckResult {
+	return tsv.lagThrottler.Check(app)
+}
+
 // TopoServer returns the topo server.
 func (tsv *TabletServer) TopoServer() *topo.Server {
 	return tsv.topoServer
@@ -2143,6 +2303,16 @@ func (tsv *TabletServer) registerTwopczHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerThrottlerHandler() {
+	http.HandleFunc(throttle.CheckPath, func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.MONITORING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		throttle.WriteResult(w, tsv.lagThrottler.Check(r.FormValue("app")))
+	})
+}
+
 // SetPoolSize changes the pool size to the specified value.
 // This function should only be used for testing.
 func (tsv *TabletServer) SetPoolSize(val int) {
@@ -2346,6 +2516,104 @@ func withTimeout(ctx context.Context, timeout time.Duration, options *querypb.Ex
 	return context.WithTimeout(ctx, timeout)
 }
 
+// withQueryTimeout bounds the context with the timeout requested by
+// the query, if any. The deadline of the context can only be shortened,
+// so the query can't extend the timeout of the tablet.
+func withQueryTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// resolveSchemaName handles the queries on the system tables that vtgate
+// sends to the keyspace they're about. If the schema name bind variable
+// of a query on information_schema or of a SHOW statement holds the
+// keyspace of the tablet, it's replaced with the name of the database
+// of the tablet. The returned bool is true if it was, in which case the
+// results must go through restoreKeyspaceName.
+func (tsv *TabletServer) resolveSchemaName(target *querypb.Target, query string, bindVariables map[string]*querypb.BindVariable) (map[string]*querypb.BindVariable, bool) {
+	bv, ok := bindVariables[sqlparser.SchemaNameVarName]
+	if !ok || target.GetKeyspace() == "" || string(bv.Value) != target.GetKeyspace() || !readsSchemaNames(query) {
+		return bindVariables, false
+	}
+	newBindVariables := make(map[string]*querypb.BindVariable, len(bindVariables))
+	for k, v := range bindVariables {
+		newBindVariables[k] = v
+	}
+	newBindVariables[sqlparser.SchemaNameVarName] = sqltypes.StringBindVariable(tsv.dbconfigs.DBName.Get())
+	return newBindVariables, true
+}
+
+// readsSchemaNames returns true if the query is a SHOW statement or
+// reads a table of information_schema.
+func readsSchemaNames(query string) bool {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return false
+	}
+	if _, ok := stmt.(*sqlparser.Show); ok {
+		return true
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && strings.EqualFold(tableName.Qualifier.String(), "information_schema") {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, stmt)
+	return found
+}
+
+// restoreKeyspaceName replaces the name of the database of the tablet
+// with its keyspace in the given schema columns of the result, so that
+// clients only ever see keyspace names. The result may be shared, so
+// it's left untouched: the rows that change are copied into a new
+// result, which is returned.
+func (tsv *TabletServer) restoreKeyspaceName(target *querypb.Target, cols []int, result *sqltypes.Result) *sqltypes.Result {
+	dbName := tsv.dbconfigs.DBName.Get()
+	keyspace := sqltypes.NewVarChar(target.GetKeyspace())
+	var rows [][]sqltypes.Value
+	for i, row := range result.Rows {
+		var newRow []sqltypes.Value
+		for _, col := range cols {
+			if row[col].ToString() != dbName {
+				continue
+			}
+			if newRow == nil {
+				newRow = append([]sqltypes.Value(nil), row...)
+			}
+			newRow[col] = keyspace
+		}
+		if newRow == nil {
+			continue
+		}
+		if rows == nil {
+			rows = append([][]sqltypes.Value(nil), result.Rows...)
+		}
+		rows[i] = newRow
+	}
+	if rows == nil {
+		return result
+	}
+	restored := *result
+	restored.Rows = rows
+	return &restored
+}
+
+// schemaColumns returns the columns of the result of a query on the
+// system tables that hold schema names, like TABLE_SCHEMA.
+func schemaColumns(fields []*querypb.Field) []int {
+	var cols []int
+	for i, field := range fields {
+		if strings.Contains(strings.ToLower(field.Name), "schema") || strings.Contains(strings.ToLower(field.OrgName), "schema") {
+			cols = append(cols, i)
+		}
+	}
+	return cols
+}
+
 // skipQueryPlanCache returns true if the query plan should be cached
 func skipQueryPlanCache(options *querypb.ExecuteOptions) bool {
 	if options == nil {
@@ -36,6 +36,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
@@ -1111,6 +1112,136 @@ func TestTabletServerStreamExecute(t *testing.T) {
 	}
 }
 
+func TestTabletServerExecuteSchemaName(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	executeSQL := "select table_schema, table_name from information_schema.`tables` where table_schema = :__vtschemaname"
+	fields := []*querypb.Field{
+		{Name: "TABLE_SCHEMA", Type: sqltypes.VarChar},
+		{Name: "TABLE_NAME", Type: sqltypes.VarChar},
+	}
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where 1 != 1", &sqltypes.Result{Fields: fields})
+	result := &sqltypes.Result{
+		Fields:       fields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("vt_ks"), sqltypes.NewVarChar("vt_ks")},
+		},
+	}
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where table_schema = 'vt_ks' limit 10001", result)
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where table_schema = 'vt_ks'", result)
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where table_schema = 'other' limit 10001", &sqltypes.Result{Fields: fields})
+
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	dbcfgs.DBName.Set("vt_ks")
+	target := querypb.Target{Keyspace: "ks", TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbcfgs)
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	// The keyspace is mapped to the database name and back,
+	// but only in the schema columns of the result.
+	bv := map[string]*querypb.BindVariable{
+		sqlparser.SchemaNameVarName: sqltypes.StringBindVariable("ks"),
+	}
+	want := [][]sqltypes.Value{
+		{sqltypes.NewVarChar("ks"), sqltypes.NewVarChar("vt_ks")},
+	}
+	got, err := tsv.Execute(ctx, &target, executeSQL, bv, 0, nil)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("Execute rows: %v, want %v", got.Rows, want)
+	}
+	var streamed [][]sqltypes.Value
+	err = tsv.StreamExecute(ctx, &target, executeSQL, bv, 0, nil, func(result *sqltypes.Result) error {
+		streamed = append(streamed, result.Rows...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamExecute failed: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, want) {
+		t.Errorf("StreamExecute rows: %v, want %v", streamed, want)
+	}
+
+	// Other schemas are left alone.
+	bv = map[string]*querypb.BindVariable{
+		sqlparser.SchemaNameVarName: sqltypes.StringBindVariable("other"),
+	}
+	if _, err := tsv.Execute(ctx, &target, executeSQL, bv, 0, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+}
+
+func TestReadsSchemaNames(t *testing.T) {
+	testcases := []struct {
+		query string
+		want  bool
+	}{{
+		query: "select table_name from information_schema.`tables` where table_schema = :__vtschemaname",
+		want:  true,
+	}, {
+		query: "select * from t where id in (select 1 from INFORMATION_SCHEMA.columns)",
+		want:  true,
+	}, {
+		query: "show tables from ks",
+		want:  true,
+	}, {
+		query: "select schema_name from test_table where name = :__vtschemaname",
+		want:  false,
+	}, {
+		query: "syntax error",
+		want:  false,
+	}}
+	for _, tcase := range testcases {
+		if got := readsSchemaNames(tcase.query); got != tcase.want {
+			t.Errorf("readsSchemaNames(%s): %v, want %v", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestRestoreKeyspaceName(t *testing.T) {
+	tsv := &TabletServer{dbconfigs: &dbconfigs.DBConfigs{}}
+	tsv.dbconfigs.DBName.Set("vt_ks")
+	target := &querypb.Target{Keyspace: "ks"}
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "TABLE_SCHEMA", Type: sqltypes.VarChar},
+			{Name: "TABLE_NAME", Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("vt_ks"), sqltypes.NewVarChar("a")},
+			{sqltypes.NewVarChar("other"), sqltypes.NewVarChar("b")},
+		},
+	}
+	shared := result.Copy()
+
+	got := tsv.restoreKeyspaceName(target, []int{0}, result)
+	want := [][]sqltypes.Value{
+		{sqltypes.NewVarChar("ks"), sqltypes.NewVarChar("a")},
+		{sqltypes.NewVarChar("other"), sqltypes.NewVarChar("b")},
+	}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("restoreKeyspaceName: %v, want %v", got.Rows, want)
+	}
+	// The result may be shared, e.g. by the result cache.
+	if !reflect.DeepEqual(result, shared) {
+		t.Errorf("restoreKeyspaceName changed its input: %v, want %v", result, shared)
+	}
+	// Without changes, the result is returned as is.
+	if got := tsv.restoreKeyspaceName(target, []int{1}, result); got != result {
+		t.Errorf("restoreKeyspaceName without changes: %p, want %p", got, result)
+	}
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -2246,6 +2377,26 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	ctx := context.Background()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", 0)
+	want := "message table nonmsg not found in schema"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.DeadLetterMessages(invalid): %v, want %s", err, want)
+	}
+
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", 0)
+	want = "message table msg has no dead-letter table"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("tsv.DeadLetterMessages(no dead-letter table): %v, want %s", err, want)
+	}
+}
+
 func TestTabletServerSplitQuery(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -0,0 +1,349 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle contains a replication lag based throttler meant for
+// batch jobs. Before writing a batch, a job asks the master of the shard
+// whether it may write now, on /throttler/check?app=<name> or with the
+// CheckThrottler tabletmanager RPC, and the master answers based on the
+// highest replication lag of the shard's replicas.
+//
+// The master watches the REPLICA tablets of its shard with a healthcheck,
+// which carries the replication lag they measure with their heartbeat
+// readers.
+package throttle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// CheckPath is the HTTP path of the check of the master.
+const CheckPath = "/throttler/check"
+
+var (
+	// checks counts the checks answered by the throttler, by app and result.
+	checks = stats.NewCountersWithMultiLabels("ThrottlerChecks", "Checks answered by the lag throttler", []string{"App", "Result"})
+	// shardLagNs is the highest replication lag of the shard, as last measured by the master.
+	shardLagNs = stats.NewGauge("ThrottlerShardLagNs", "Highest replication lag of the shard, as measured by the lag throttler")
+)
+
+// TopologyWatcherInterface is the part of discovery.TopologyWatcher
+// the throttler uses. It allows mocking it out in tests.
+type TopologyWatcherInterface interface {
+	WaitForInitialTopology() error
+	Stop()
+}
+
+// These vars store the functions used to create the healthcheck
+// and the topology watchers, so that tests can override them.
+var (
+	healthCheckFactory     = discovery.NewDefaultHealthCheck
+	topologyWatcherFactory = newTopologyWatcher
+)
+
+func newTopologyWatcher(topoServer *topo.Server, tr discovery.TabletRecorder, cell, keyspace, shard string) TopologyWatcherInterface {
+	return discovery.NewShardReplicationWatcher(context.Background(), topoServer, tr, cell, keyspace, shard, discovery.DefaultTopologyWatcherRefreshInterval, discovery.DefaultTopoReadConcurrency)
+}
+
+// CheckResult is the answer to a check. StatusCode is
+// http.StatusOK if the caller may write, and is also used as the
+// HTTP status code of the answer.
+type CheckResult struct {
+	StatusCode int
+	// Lag is the replication lag that was observed, in seconds.
+	Lag float64
+	// Threshold is the lag above which writes are throttled, in seconds.
+	Threshold float64
+	Message   string
+}
+
+// OK returns true if the caller may write.
+func (cr *CheckResult) OK() bool {
+	return cr.StatusCode == http.StatusOK
+}
+
+// Throttler answers checks based on replication lag. It must be
+// opened for a target before it answers checks. On a master, it
+// also watches the replicas of the shard while it's open.
+type Throttler struct {
+	enabled    bool
+	threshold  time.Duration
+	cells      []string
+	exemptApps map[string]bool
+	topoServer *topo.Server
+
+	// runMu protects the following fields.
+	runMu            sync.Mutex
+	isOpen           bool
+	target           querypb.Target
+	healthCheck      discovery.HealthCheck
+	topologyWatchers []TopologyWatcherInterface
+
+	// lagMu protects the following fields.
+	lagMu sync.Mutex
+	// generation changes every time the throttler starts watching
+	// the replicas, so that the stats of a previous healthcheck
+	// are ignored.
+	generation int
+	// ready is set once the replicas of the shard are known,
+	// and their first health stats are in.
+	ready bool
+	// replicas are the last health stats of the REPLICA tablets
+	// of the shard, by tablet key.
+	replicas map[string]*discovery.TabletStats
+}
+
+// NewThrottler creates a Throttler from the config. The throttler needs
+// heartbeats to measure lag, so it's disabled if they aren't enabled.
+func NewThrottler(config tabletenv.TabletConfig, topoServer *topo.Server) *Throttler {
+	t := &Throttler{
+		enabled:    config.EnableLagThrottler,
+		threshold:  config.LagThrottlerThreshold,
+		cells:      config.LagThrottlerHealthCheckCells,
+		exemptApps: make(map[string]bool),
+		topoServer: topoServer,
+	}
+	if t.enabled && !config.HeartbeatEnable {
+		log.Errorf("The lag throttler needs -heartbeat_enable to measure replication lag, it will be disabled.")
+		t.enabled = false
+	}
+	for _, app := range config.LagThrottlerExemptApps {
+		t.exemptApps[app] = true
+	}
+	return t
+}
+
+// Open starts serving checks for the target. If the tablet is a
+// master, it also starts watching the replicas of the shard.
+func (t *Throttler) Open(target querypb.Target) {
+	if !t.enabled {
+		return
+	}
+	t.runMu.Lock()
+	defer t.runMu.Unlock()
+	if t.isOpen {
+		if t.target.TabletType == target.TabletType {
+			return
+		}
+		t.closeLocked()
+	}
+	t.target = target
+	t.isOpen = true
+	if target.TabletType == topodatapb.TabletType_MASTER {
+		t.watchReplicasLocked()
+	}
+}
+
+// watchReplicasLocked starts the healthcheck of the replicas of the
+// shard, in the cells of the config, or in all the cells if it has none.
+func (t *Throttler) watchReplicasLocked() {
+	cells := t.cells
+	if len(cells) == 0 {
+		var err error
+		cells, err = t.topoServer.GetCellInfoNames(context.Background())
+		if err != nil {
+			log.Errorf("The lag throttler cannot read the cells of the replicas: %v", err)
+		}
+	}
+
+	t.lagMu.Lock()
+	t.generation++
+	generation := t.generation
+	t.replicas = make(map[string]*discovery.TabletStats)
+	t.lagMu.Unlock()
+
+	t.healthCheck = healthCheckFactory()
+	t.healthCheck.SetListener(&listener{t, generation}, false /* sendDownEvents */)
+	for _, cell := range cells {
+		t.topologyWatchers = append(t.topologyWatchers, topologyWatcherFactory(t.topoServer, t.healthCheck, cell, t.target.Keyspace, t.target.Shard))
+	}
+
+	healthCheck, topologyWatchers := t.healthCheck, t.topologyWatchers
+	go func() {
+		for _, watcher := range topologyWatchers {
+			if err := watcher.WaitForInitialTopology(); err != nil {
+				return
+			}
+		}
+		healthCheck.WaitForInitialStatsUpdates()
+
+		t.lagMu.Lock()
+		defer t.lagMu.Unlock()
+		if t.generation == generation {
+			t.ready = true
+		}
+	}()
+}
+
+// Close stops serving checks, and stops watching the replicas.
+func (t *Throttler) Close() {
+	if !t.enabled {
+		return
+	}
+	t.runMu.Lock()
+	defer t.runMu.Unlock()
+	t.closeLocked()
+}
+
+func (t *Throttler) closeLocked() {
+	if !t.isOpen {
+		return
+	}
+	for _, watcher := range t.topologyWatchers {
+		watcher.Stop()
+	}
+	t.topologyWatchers = nil
+	if t.healthCheck != nil {
+		t.healthCheck.Close()
+		t.healthCheck = nil
+	}
+	t.isOpen = false
+
+	t.lagMu.Lock()
+	defer t.lagMu.Unlock()
+	t.generation++
+	t.ready = false
+	t.replicas = nil
+}
+
+// listener records the health stats of the replicas
+// for the generation of the throttler it was created for.
+type listener struct {
+	t          *Throttler
+	generation int
+}
+
+// StatsUpdate is part of the discovery.HealthCheckStatsListener interface.
+// RDONLY tablets are not candidates for becoming master, and serving
+// stale data from them is acceptable, so only REPLICA tablets count.
+func (l *listener) StatsUpdate(ts *discovery.TabletStats) {
+	l.t.lagMu.Lock()
+	defer l.t.lagMu.Unlock()
+	if l.t.generation != l.generation {
+		return
+	}
+	if !ts.Up || ts.Target == nil || ts.Target.TabletType != topodatapb.TabletType_REPLICA {
+		delete(l.t.replicas, ts.Key)
+		return
+	}
+	l.t.replicas[ts.Key] = ts
+}
+
+// Check tells app whether it may write to the shard now. It must
+// be called on the master.
+func (t *Throttler) Check(app string) *CheckResult {
+	result := t.check(app)
+	checks.Add([]string{app, http.StatusText(result.StatusCode)}, 1)
+	return result
+}
+
+func (t *Throttler) check(app string) *CheckResult {
+	if !t.enabled {
+		return &CheckResult{StatusCode: http.StatusOK, Message: "throttler is disabled"}
+	}
+	t.runMu.Lock()
+	defer t.runMu.Unlock()
+	if !t.isOpen {
+		return t.newResult(http.StatusServiceUnavailable, 0, "throttler is not open")
+	}
+	if t.target.TabletType != topodatapb.TabletType_MASTER {
+		return t.newResult(http.StatusExpectationFailed, 0, fmt.Sprintf("tablet is %v, checks must be sent to the master", t.target.TabletType))
+	}
+	t.lagMu.Lock()
+	defer t.lagMu.Unlock()
+	lag, err := t.shardLagLocked()
+	if t.exemptApps[app] {
+		return t.newResult(http.StatusOK, lag, fmt.Sprintf("app %s is exempt", app))
+	}
+	if err != nil {
+		return t.newResult(http.StatusInternalServerError, lag, err.Error())
+	}
+	return t.lagResult(lag)
+}
+
+// shardLagLocked returns the highest replication lag of the replicas.
+// It fails if a replica's lag is unknown, since it could be too high.
+func (t *Throttler) shardLagLocked() (time.Duration, error) {
+	if !t.ready {
+		return 0, fmt.Errorf("replication lag has not been measured yet")
+	}
+	keys := make([]string, 0, len(t.replicas))
+	for key := range t.replicas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var maxLag time.Duration
+	for _, key := range keys {
+		ts := t.replicas[key]
+		switch {
+		case ts.LastError != nil:
+			return 0, fmt.Errorf("cannot read the replication lag of %v: %v", topoproto.TabletAliasString(ts.Tablet.Alias), ts.LastError)
+		case ts.Stats == nil:
+			return 0, fmt.Errorf("cannot read the replication lag of %v: no health stats", topoproto.TabletAliasString(ts.Tablet.Alias))
+		case ts.Stats.HealthError != "":
+			return 0, fmt.Errorf("cannot read the replication lag of %v: %v", topoproto.TabletAliasString(ts.Tablet.Alias), ts.Stats.HealthError)
+		}
+		if lag := time.Duration(ts.Stats.SecondsBehindMaster) * time.Second; lag > maxLag {
+			maxLag = lag
+		}
+	}
+	shardLagNs.Set(maxLag.Nanoseconds())
+	return maxLag, nil
+}
+
+func (t *Throttler) lagResult(lag time.Duration) *CheckResult {
+	if lag > t.threshold {
+		return t.newResult(http.StatusTooManyRequests, lag, fmt.Sprintf("replication lag %v is above threshold %v", lag, t.threshold))
+	}
+	return t.newResult(http.StatusOK, lag, "")
+}
+
+func (t *Throttler) newResult(statusCode int, lag time.Duration, message string) *CheckResult {
+	return &CheckResult{
+		StatusCode: statusCode,
+		Lag:        lag.Seconds(),
+		Threshold:  t.threshold.Seconds(),
+		Message:    message,
+	}
+}
+
+// WriteResult writes the result of a check as the answer to an HTTP request.
+func WriteResult(w http.ResponseWriter, result *CheckResult) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(result.StatusCode)
+	w.Write(b)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeHealthCheck records the listener of the throttler,
+// so that tests can send it health stats.
+type fakeHealthCheck struct {
+	*discovery.FakeHealthCheck
+	listener discovery.HealthCheckStatsListener
+	closed   bool
+}
+
+func (fhc *fakeHealthCheck) SetListener(listener discovery.HealthCheckStatsListener, sendDownEvents bool) {
+	fhc.listener = listener
+}
+
+func (fhc *fakeHealthCheck) Close() error {
+	fhc.closed = true
+	return nil
+}
+
+// fakeTopologyWatcher finds the initial topology once initial is closed.
+type fakeTopologyWatcher struct {
+	cell    string
+	initial chan struct{}
+	stopped bool
+}
+
+func (ftw *fakeTopologyWatcher) WaitForInitialTopology() error {
+	<-ftw.initial
+	return nil
+}
+
+func (ftw *fakeTopologyWatcher) Stop() {
+	ftw.stopped = true
+}
+
+// testFactories overrides the factories of the healthcheck
+// and the topology watchers, and records what they create.
+type testFactories struct {
+	mu           sync.Mutex
+	healthChecks []*fakeHealthCheck
+	watchers     []*fakeTopologyWatcher
+	initial      chan struct{}
+}
+
+func setTestFactories(t *testing.T, ts *topo.Server) *testFactories {
+	t.Helper()
+	f := &testFactories{initial: make(chan struct{})}
+	healthCheckFactory = func() discovery.HealthCheck {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		fhc := &fakeHealthCheck{FakeHealthCheck: discovery.NewFakeHealthCheck()}
+		f.healthChecks = append(f.healthChecks, fhc)
+		return fhc
+	}
+	topologyWatcherFactory = func(topoServer *topo.Server, tr discovery.TabletRecorder, cell, keyspace, shard string) TopologyWatcherInterface {
+		if topoServer != ts {
+			t.Errorf("topologyWatcherFactory: got topo server %v, want %v", topoServer, ts)
+		}
+		if keyspace != "ks" || shard != "0" {
+			t.Errorf("topologyWatcherFactory: got shard %v/%v, want ks/0", keyspace, shard)
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		ftw := &fakeTopologyWatcher{cell: cell, initial: f.initial}
+		f.watchers = append(f.watchers, ftw)
+		return ftw
+	}
+	return f
+}
+
+func resetFactories() {
+	healthCheckFactory = discovery.NewDefaultHealthCheck
+	topologyWatcherFactory = newTopologyWatcher
+}
+
+func newTestThrottler(ts *topo.Server) *Throttler {
+	config := tabletenv.DefaultQsConfig
+	config.HeartbeatEnable = true
+	config.EnableLagThrottler = true
+	config.LagThrottlerThreshold = time.Second
+	config.LagThrottlerExemptApps = []string{"exempt"}
+	return NewThrottler(config, ts)
+}
+
+// waitForReady waits until the throttler has seen the initial
+// topology and stats of the replicas.
+func waitForReady(t *testing.T, throttler *Throttler) {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(time.Millisecond) {
+		throttler.lagMu.Lock()
+		ready := throttler.ready
+		throttler.lagMu.Unlock()
+		if ready {
+			return
+		}
+	}
+	t.Fatalf("the throttler never became ready")
+}
+
+func replicaStats(uid uint32, tabletType topodatapb.TabletType, lag uint32) *discovery.TabletStats {
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+		Hostname: "host",
+		PortMap:  map[string]int32{"vt": int32(uid)},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     tabletType,
+	}
+	return &discovery.TabletStats{
+		Key:    discovery.TabletToMapKey(tablet),
+		Tablet: tablet,
+		Target: &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: tabletType},
+		Up:     true,
+		Stats:  &querypb.RealtimeStats{SecondsBehindMaster: lag},
+	}
+}
+
+func TestThrottlerCheck(t *testing.T) {
+	ts := memorytopo.NewServer("cell1", "cell2")
+	defer resetFactories()
+	f := setTestFactories(t, ts)
+	throttler := newTestThrottler(ts)
+
+	want := &CheckResult{StatusCode: http.StatusServiceUnavailable, Threshold: 1, Message: "throttler is not open"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	throttler.Open(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER})
+	// Without cells in the config, the replicas of all the cells are watched.
+	var cells []string
+	for _, watcher := range f.watchers {
+		cells = append(cells, watcher.cell)
+	}
+	sort.Strings(cells)
+	if want := []string{"cell1", "cell2"}; !reflect.DeepEqual(cells, want) {
+		t.Errorf("watched cells: %v, want %v", cells, want)
+	}
+	want = &CheckResult{StatusCode: http.StatusInternalServerError, Threshold: 1, Message: "replication lag has not been measured yet"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	close(f.initial)
+	waitForReady(t, throttler)
+	listener := f.healthChecks[0].listener
+	listener.StatsUpdate(replicaStats(101, topodatapb.TabletType_REPLICA, 0))
+	listener.StatsUpdate(replicaStats(102, topodatapb.TabletType_REPLICA, 1))
+	// RDONLY tablets are ignored.
+	listener.StatsUpdate(replicaStats(103, topodatapb.TabletType_RDONLY, 3600))
+	want = &CheckResult{StatusCode: http.StatusOK, Lag: 1, Threshold: 1}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	listener.StatsUpdate(replicaStats(101, topodatapb.TabletType_REPLICA, 2))
+	want = &CheckResult{StatusCode: http.StatusTooManyRequests, Lag: 2, Threshold: 1, Message: "replication lag 2s is above threshold 1s"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+	want = &CheckResult{StatusCode: http.StatusOK, Lag: 2, Threshold: 1, Message: "app exempt is exempt"}
+	if got := throttler.Check("exempt"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	// A replica whose lag is unknown throttles everyone.
+	unhealthy := replicaStats(102, topodatapb.TabletType_REPLICA, 0)
+	unhealthy.Stats.HealthError = "no heartbeat"
+	listener.StatsUpdate(unhealthy)
+	want = &CheckResult{StatusCode: http.StatusInternalServerError, Threshold: 1, Message: "cannot read the replication lag of cell1-0000000102: no heartbeat"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+	unreachable := replicaStats(102, topodatapb.TabletType_REPLICA, 0)
+	unreachable.LastError = errors.New("connection refused")
+	listener.StatsUpdate(unreachable)
+	want = &CheckResult{StatusCode: http.StatusInternalServerError, Threshold: 1, Message: "cannot read the replication lag of cell1-0000000102: connection refused"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	// A replica that went down doesn't count anymore.
+	down := replicaStats(102, topodatapb.TabletType_REPLICA, 0)
+	down.Up = false
+	listener.StatsUpdate(down)
+	want = &CheckResult{StatusCode: http.StatusTooManyRequests, Lag: 2, Threshold: 1, Message: "replication lag 2s is above threshold 1s"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+	if got := checks.Counts()["app.Too Many Requests"]; got != 2 {
+		t.Errorf("checks[app.Too Many Requests] = %d, want 2", got)
+	}
+
+	throttler.Close()
+	if !f.healthChecks[0].closed {
+		t.Errorf("the healthcheck was not closed")
+	}
+	for _, watcher := range f.watchers {
+		if !watcher.stopped {
+			t.Errorf("the topology watcher of %v was not stopped", watcher.cell)
+		}
+	}
+	want = &CheckResult{StatusCode: http.StatusServiceUnavailable, Threshold: 1, Message: "throttler is not open"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+
+	// The stats of a previous healthcheck are ignored.
+	throttler.Open(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER})
+	defer throttler.Close()
+	waitForReady(t, throttler)
+	listener.StatsUpdate(replicaStats(101, topodatapb.TabletType_REPLICA, 5))
+	f.healthChecks[1].listener.StatsUpdate(replicaStats(101, topodatapb.TabletType_REPLICA, 0))
+	want = &CheckResult{StatusCode: http.StatusOK, Threshold: 1}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+}
+
+func TestThrottlerHealthCheckCells(t *testing.T) {
+	ts := memorytopo.NewServer("cell1", "cell2")
+	defer resetFactories()
+	f := setTestFactories(t, ts)
+	config := tabletenv.DefaultQsConfig
+	config.HeartbeatEnable = true
+	config.EnableLagThrottler = true
+	config.LagThrottlerHealthCheckCells = []string{"cell2"}
+	throttler := NewThrottler(config, ts)
+
+	throttler.Open(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER})
+	defer throttler.Close()
+	if len(f.watchers) != 1 || f.watchers[0].cell != "cell2" {
+		t.Errorf("watchers: %+v, want one for cell2", f.watchers)
+	}
+}
+
+func TestThrottlerReplica(t *testing.T) {
+	ts := memorytopo.NewServer("cell1")
+	defer resetFactories()
+	f := setTestFactories(t, ts)
+	throttler := newTestThrottler(ts)
+
+	throttler.Open(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA})
+	defer throttler.Close()
+	if len(f.healthChecks) != 0 {
+		t.Errorf("a replica must not watch the other replicas")
+	}
+	want := &CheckResult{StatusCode: http.StatusExpectationFailed, Threshold: 1, Message: "tablet is REPLICA, checks must be sent to the master"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+}
+
+func TestThrottlerDisabled(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	// Without heartbeats, the throttler can't measure lag.
+	config.EnableLagThrottler = true
+	throttler := NewThrottler(config, nil)
+	throttler.Open(querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER})
+	defer throttler.Close()
+
+	want := &CheckResult{StatusCode: http.StatusOK, Message: "throttler is disabled"}
+	if got := throttler.Check("app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check: %+v, want %+v", got, want)
+	}
+}
@@ -274,28 +274,78 @@ func (te *TxEngine) AcceptReadOnly() error {
 func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Begin")
 	defer span.Finish()
+
+	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	if err := te.startBegin(isWriteTransaction); err != nil {
+		return 0, "", err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Begin(ctx, options)
+}
+
+// Reserve reserves a connection, and executes the preQueries on it.
+// Subsequent statements can access the connection through the returned id.
+func (te *TxEngine) Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Reserve")
+	defer span.Finish()
+
+	if err := te.startBegin(false /* isWriteTransaction */); err != nil {
+		return 0, err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Reserve(ctx, options, preQueries)
+}
+
+// ReserveTransaction executes the preQueries on the connection of the
+// transaction, and keeps it reserved once the transaction is concluded.
+func (te *TxEngine) ReserveTransaction(ctx context.Context, transactionID int64, preQueries []string) error {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.ReserveTransaction")
+	defer span.Finish()
+	return te.txPool.ReserveTransaction(ctx, transactionID, preQueries)
+}
+
+// BeginReserved begins a transaction on the reserved connection, and
+// returns the statement(s) used to execute the begin (if any).
+func (te *TxEngine) BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.BeginReserved")
+	defer span.Finish()
+
+	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	if err := te.startBegin(isWriteTransaction); err != nil {
+		return "", err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.BeginReserved(ctx, reservedID, options)
+}
+
+// Release releases the reserved connection.
+func (te *TxEngine) Release(ctx context.Context, reservedID int64) error {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Release")
+	defer span.Finish()
+	return te.txPool.Release(ctx, reservedID)
+}
+
+// startBegin verifies that the engine is in a state where new
+// transactions can be started. If so, it adds to beginRequests,
+// and the caller must call beginRequests.Done once finished.
+func (te *TxEngine) startBegin(isWriteTransaction bool) error {
 	te.stateLock.Lock()
+	defer te.stateLock.Unlock()
 
 	canOpenTransactions := te.state == AcceptingReadOnly || te.state == AcceptingReadAndWrite
 	if !canOpenTransactions {
 		// We are not in a state where we can start new transactions. Abort.
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
 	}
 
-	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
 	if te.state == AcceptingReadOnly && isWriteTransaction {
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
 	}
 
 	// By Add() to beginRequests, we block others from initiating state
 	// changes until we have finished adding this transaction
 	te.beginRequests.Add(1)
-	te.stateLock.Unlock()
-
-	defer te.beginRequests.Done()
-	return te.txPool.Begin(ctx, options)
+	return nil
 }
 
 // Commit commits the specified transaction.
@@ -446,6 +496,9 @@ func (te *TxEngine) close(immediate bool) {
 			log.Info("Transactions completed before grace period: shutting down.")
 		}
 	}()
+	// Reserved connections that are not in a transaction
+	// have nothing to wait for.
+	te.txPool.ReleaseNonBusy()
 	te.txPool.WaitForEmpty()
 	// If the goroutine is still running, signal that it can exit.
 	close(poolEmpty)
@@ -52,6 +52,7 @@ const (
 	TxRollback = "rollback"
 	TxPrepare  = "prepare"
 	TxKill     = "kill"
+	TxRelease  = "release"
 )
 
 const txLogInterval = time.Duration(1 * time.Minute)
@@ -175,12 +176,31 @@ func (axp *TxPool) AdjustLastID(id int64) {
 	}
 }
 
-// RollbackNonBusy rolls back all transactions that are not in use.
+// RollbackNonBusy rolls back all transactions that are not in use,
+// and releases the reserved connections that are not in use.
 // Transactions can be in use for situations like executing statements
 // or in prepared state.
 func (axp *TxPool) RollbackNonBusy(ctx context.Context) {
 	for _, v := range axp.activePool.GetOutdated(time.Duration(0), "for transition") {
-		axp.LocalConclude(ctx, v.(*TxConnection))
+		conn := v.(*TxConnection)
+		if conn.Reserved {
+			axp.localRelease(conn)
+			continue
+		}
+		axp.LocalConclude(ctx, conn)
+	}
+}
+
+// ReleaseNonBusy releases the reserved connections that are
+// neither in use nor in a transaction.
+func (axp *TxPool) ReleaseNonBusy() {
+	for _, v := range axp.activePool.GetOutdated(time.Duration(0), "for release") {
+		conn := v.(*TxConnection)
+		if !conn.Reserved || conn.InTransaction {
+			conn.Recycle()
+			continue
+		}
+		axp.localRelease(conn)
 	}
 }
 
@@ -188,11 +208,34 @@ func (axp *TxPool) transactionKiller() {
 	defer tabletenv.LogError()
 	for _, v := range axp.activePool.GetOutdated(time.Duration(axp.Timeout()), "for tx killer rollback") {
 		conn := v.(*TxConnection)
+		// The transaction timeout applies to reserved connections
+		// only while they're in a transaction.
+		if conn.Reserved && (!conn.InTransaction || time.Since(conn.StartTime) < axp.Timeout()) {
+			conn.Recycle()
+			continue
+		}
 		log.Warningf("killing transaction (exceeded timeout: %v): %s", axp.Timeout(), conn.Format(nil))
 		tabletenv.KillStats.Add("Transactions", 1)
 		conn.Close()
 		conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", axp.Timeout()))
 	}
+	// Reserved connections are released once they've been idle
+	// for longer than the idle timeout of the pool, unless they
+	// hold named locks, which would be released with them.
+	idleTimeout := axp.conns.IdleTimeout()
+	if idleTimeout <= 0 {
+		return
+	}
+	for _, v := range axp.activePool.GetIdle(idleTimeout, "for reserved connection killer") {
+		conn := v.(*TxConnection)
+		if !conn.Reserved || conn.HoldsLocks {
+			conn.Recycle()
+			continue
+		}
+		log.Warningf("releasing reserved connection (exceeded idle timeout: %v): %s", idleTimeout, conn.Format(nil))
+		tabletenv.KillStats.Add("ReservedConnections", 1)
+		axp.localRelease(conn)
+	}
 }
 
 // WaitForEmpty waits until all active transactions are completed.
@@ -208,91 +251,215 @@ func (axp *TxPool) WaitForEmpty() {
 func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Begin")
 	defer span.Finish()
-	var conn *connpool.DBConn
-	var err error
+
+	conn, err := axp.getConn(ctx, options)
+	if err != nil {
+		return 0, "", err
+	}
+	autocommitTransaction, beginQueries, err := beginTransaction(ctx, conn, options)
+	if err != nil {
+		axp.putConn(ctx, conn)
+		return 0, "", err
+	}
+
+	transactionID := axp.lastID.Add(1)
+	axp.activePool.Register(
+		transactionID,
+		newTxConnection(
+			conn,
+			transactionID,
+			axp,
+			callerid.ImmediateCallerIDFromContext(ctx),
+			callerid.EffectiveCallerIDFromContext(ctx),
+			autocommitTransaction,
+		),
+		options.GetWorkload() != querypb.ExecuteOptions_DBA,
+	)
+	return transactionID, beginQueries, nil
+}
+
+// Reserve reserves a connection, and executes the preQueries on it.
+// It returns the id through which subsequent statements can access
+// the connection, like a transaction id. Reserved connections are not
+// returned to the pool, because they carry session settings: they
+// must be released instead.
+func (axp *TxPool) Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.Reserve")
+	defer span.Finish()
+
+	conn, err := axp.getConn(ctx, options)
+	if err != nil {
+		return 0, err
+	}
+	for _, query := range preQueries {
+		if _, err := conn.Exec(ctx, query, 1, false); err != nil {
+			// The connection may already carry some of the settings.
+			conn.Close()
+			axp.putConn(ctx, conn)
+			return 0, err
+		}
+	}
+
+	reservedID := axp.lastID.Add(1)
+	txc := newTxConnection(
+		conn,
+		reservedID,
+		axp,
+		callerid.ImmediateCallerIDFromContext(ctx),
+		callerid.EffectiveCallerIDFromContext(ctx),
+		false,
+	)
+	txc.Reserved = true
+	txc.InTransaction = false
+	axp.activePool.Register(reservedID, txc, options.GetWorkload() != querypb.ExecuteOptions_DBA)
+	return reservedID, nil
+}
+
+// ReserveTransaction executes the preQueries on the connection of
+// the transaction, and reserves it: the connection isn't returned
+// to the pool once the transaction is concluded.
+func (axp *TxPool) ReserveTransaction(ctx context.Context, transactionID int64, preQueries []string) error {
+	span, ctx := trace.NewSpan(ctx, "TxPool.ReserveTransaction")
+	defer span.Finish()
+
+	conn, err := axp.Get(transactionID, "for reserve")
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	// The connection must be marked as reserved before executing
+	// the preQueries, because a failure can leave some of them applied.
+	conn.Reserved = true
+	for _, query := range preQueries {
+		if _, err := conn.Exec(ctx, query, 1, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeginReserved begins a transaction on the reserved connection, and
+// returns the statements (if any) executed to initiate the transaction.
+// The transaction id is the reserved id.
+func (axp *TxPool) BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.BeginReserved")
+	defer span.Finish()
+
+	conn, err := axp.Get(reservedID, "for begin")
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+	if !conn.Reserved {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "connection %d is not reserved", reservedID)
+	}
+	if conn.InTransaction {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "reserved connection %d is already in a transaction", reservedID)
+	}
+	autocommitTransaction, beginQueries, err := beginTransaction(ctx, conn.DBConn, options)
+	if err != nil {
+		return "", err
+	}
+	conn.InTransaction = true
+	conn.Autocommit = autocommitTransaction
+	conn.StartTime = time.Now()
+	return beginQueries, nil
+}
+
+// Release releases the reserved connection. Its transaction,
+// if any, is rolled back.
+func (axp *TxPool) Release(ctx context.Context, reservedID int64) error {
+	span, _ := trace.NewSpan(ctx, "TxPool.Release")
+	defer span.Finish()
+
+	conn, err := axp.Get(reservedID, "for release")
+	if err != nil {
+		return err
+	}
+	axp.localRelease(conn)
+	return nil
+}
+
+func (axp *TxPool) localRelease(conn *TxConnection) {
+	// Closing the connection rolls back the transaction, if any.
+	conn.Close()
+	conn.conclude(TxRelease, "reserved connection released")
+}
+
+// getConn fetches a connection for a new transaction or reserved
+// connection, after checking the per-user limits. If the connection
+// doesn't get registered, it must be returned with putConn.
+func (axp *TxPool) getConn(ctx context.Context, options *querypb.ExecuteOptions) (*connpool.DBConn, error) {
 	immediateCaller := callerid.ImmediateCallerIDFromContext(ctx)
 	effectiveCaller := callerid.EffectiveCallerIDFromContext(ctx)
 
 	if !axp.limiter.Get(immediateCaller, effectiveCaller) {
-		return 0, "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "per-user transaction pool connection limit exceeded")
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "per-user transaction pool connection limit exceeded")
 	}
 
 	waiterCount := axp.waiters.Add(1)
 	defer axp.waiters.Add(-1)
 
 	if waiterCount > axp.waiterCap.Get() {
-		return 0, "", vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool waiter count exceeded")
-	}
-
-	var beginSucceeded bool
-	defer func() {
-		if beginSucceeded {
-			return
-		}
-
-		if conn != nil {
-			conn.Recycle()
-		}
 		axp.limiter.Release(immediateCaller, effectiveCaller)
-	}()
+		return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool waiter count exceeded")
+	}
 
 	poolCtx, poolCancel := context.WithTimeout(ctx, axp.transactionPoolTimeout.Get())
 	defer poolCancel()
+	var conn *connpool.DBConn
+	var err error
 	if options.GetClientFoundRows() {
 		conn, err = axp.foundRowsPool.Get(poolCtx)
 	} else {
 		conn, err = axp.conns.Get(poolCtx)
 	}
 	if err != nil {
+		axp.limiter.Release(immediateCaller, effectiveCaller)
 		switch err {
 		case connpool.ErrConnPoolClosed:
-			return 0, "", err
+			return nil, err
 		case pools.ErrCtxTimeout:
 			axp.LogActive()
-			return 0, "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool aborting request due to already expired context")
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool aborting request due to already expired context")
 		case pools.ErrTimeout:
 			axp.LogActive()
-			return 0, "", vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
 		}
-		return 0, "", err
+		return nil, err
 	}
+	return conn, nil
+}
 
-	autocommitTransaction := false
-	beginQueries := ""
-	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
-		if queries.setIsolationLevel != "" {
-			if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
-				return 0, "", err
-			}
+// putConn returns a connection obtained with getConn to the pool.
+func (axp *TxPool) putConn(ctx context.Context, conn *connpool.DBConn) {
+	conn.Recycle()
+	axp.limiter.Release(callerid.ImmediateCallerIDFromContext(ctx), callerid.EffectiveCallerIDFromContext(ctx))
+}
 
-			beginQueries = queries.setIsolationLevel + "; "
+// beginTransaction opens a transaction on the connection, and returns
+// whether it's an autocommit transaction, along with the statements
+// executed to open it.
+func beginTransaction(ctx context.Context, conn *connpool.DBConn, options *querypb.ExecuteOptions) (bool, string, error) {
+	queries, ok := txIsolations[options.GetTransactionIsolation()]
+	if !ok {
+		if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
+			return true, "", nil
 		}
+		return false, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+	}
 
-		if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
-			return 0, "", err
+	beginQueries := ""
+	if queries.setIsolationLevel != "" {
+		if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+			return false, "", err
 		}
-		beginQueries = beginQueries + queries.openTransaction
-	} else if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
-		autocommitTransaction = true
-	} else {
-		return 0, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+		beginQueries = queries.setIsolationLevel + "; "
 	}
-
-	beginSucceeded = true
-	transactionID := axp.lastID.Add(1)
-	axp.activePool.Register(
-		transactionID,
-		newTxConnection(
-			conn,
-			transactionID,
-			axp,
-			immediateCaller,
-			effectiveCaller,
-			autocommitTransaction,
-		),
-		options.GetWorkload() != querypb.ExecuteOptions_DBA,
-	)
-	return transactionID, beginQueries, nil
+	if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+		return false, "", err
+	}
+	return false, beginQueries + queries.openTransaction, nil
 }
 
 // Commit commits the specified transaction.
@@ -347,7 +514,7 @@ func (axp *TxPool) LocalBegin(ctx context.Context, options *querypb.ExecuteOptio
 func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messageCommitter) (string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalCommit")
 	defer span.Finish()
-	defer conn.conclude(TxCommit, "transaction committed")
+	defer conn.endTransaction(TxCommit, "transaction committed")
 	defer mc.LockDB(conn.NewMessages, conn.ChangedMessages)()
 
 	if conn.Autocommit {
@@ -374,7 +541,7 @@ func (axp *TxPool) LocalConclude(ctx context.Context, conn *TxConnection) {
 }
 
 func (axp *TxPool) localRollback(ctx context.Context, conn *TxConnection) error {
-	defer conn.conclude(TxRollback, "transaction rolled back")
+	defer conn.endTransaction(TxRollback, "transaction rolled back")
 	if _, err := conn.Exec(ctx, "rollback", 1, false); err != nil {
 		conn.Close()
 		return err
@@ -435,6 +602,15 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+	// Reserved is set if the connection carries session settings.
+	// It remains registered once its transaction is concluded,
+	// and it's closed instead of being returned to the pool.
+	Reserved      bool
+	InTransaction bool
+	// HoldsLocks is set once GET_LOCK() is called on a reserved
+	// connection. It's not released when it's idle, which would
+	// release the named locks it holds.
+	HoldsLocks bool
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
@@ -448,6 +624,7 @@ func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, i
 		ImmediateCallerID: immediate,
 		EffectiveCallerID: effective,
 		Autocommit:        autocommit,
+		InTransaction:     true,
 	}
 }
 
@@ -495,8 +672,28 @@ func (txc *TxConnection) RecordQuery(query string) {
 	txc.Queries = append(txc.Queries, query)
 }
 
+// endTransaction concludes the transaction. Reserved connections
+// stay registered for the subsequent statements of the session.
+func (txc *TxConnection) endTransaction(conclusion, reason string) {
+	if !txc.Reserved || txc.IsClosed() {
+		txc.conclude(conclusion, reason)
+		return
+	}
+	txc.InTransaction = false
+	txc.log(conclusion)
+	txc.Autocommit = false
+	txc.Queries = nil
+	txc.NewMessages = make(map[string][]*messager.MessageRow)
+	txc.ChangedMessages = make(map[string][]string)
+	txc.pool.activePool.Put(txc.TransactionID)
+}
+
 func (txc *TxConnection) conclude(conclusion, reason string) {
 	txc.pool.activePool.Unregister(txc.TransactionID, reason)
+	if txc.Reserved && !txc.IsClosed() {
+		// The session settings must not leak to other users of the pool.
+		txc.DBConn.Close()
+	}
 	txc.DBConn.Recycle()
 	txc.DBConn = nil
 	txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
@@ -143,6 +143,89 @@ func TestTxPoolRollbackNonBusy(t *testing.T) {
 	}
 }
 
+func TestTxPoolReserveRelease(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("set sql_mode = ''", &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	reservedID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, []string{"set sql_mode = ''"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := txPool.BeginReserved(ctx, reservedID, &querypb.ExecuteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := txPool.Commit(ctx, reservedID, &fakeMessageCommitter{}); err != nil {
+		t.Fatal(err)
+	}
+	// The reserved connection outlives the transaction.
+	conn, err := txPool.Get(reservedID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !conn.Reserved || conn.InTransaction {
+		t.Errorf("conn: Reserved %v, InTransaction %v, want true, false", conn.Reserved, conn.InTransaction)
+	}
+	dbConn := conn.DBConn
+	conn.Recycle()
+
+	if err := txPool.Release(ctx, reservedID); err != nil {
+		t.Fatal(err)
+	}
+	if sz := txPool.activePool.Size(); sz != 0 {
+		t.Errorf("txPool.activePool.Size(): %d, want 0", sz)
+	}
+	// The connection carrying the settings must not go back to the pool.
+	if !dbConn.IsClosed() {
+		t.Error("reserved connection was not closed on release")
+	}
+}
+
+func TestTxPoolReservedIdleKiller(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+	idleID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := txPool.Get(lockID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.HoldsLocks = true
+	conn.Recycle()
+
+	txPool.conns.SetIdleTimeout(time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	txPool.transactionKiller()
+
+	// The idle reserved connection is released, but not
+	// the one that holds named locks.
+	if _, err := txPool.Get(idleID, "for query"); err == nil {
+		t.Errorf("idle reserved connection %d was not released", idleID)
+	}
+	conn, err = txPool.Get(lockID, "for query")
+	if err != nil {
+		t.Fatalf("reserved connection holding locks was released: %v", err)
+	}
+	conn.Recycle()
+}
+
 func TestTxPoolTransactionKillerEnforceTimeoutEnabled(t *testing.T) {
 	sqlWithTimeout := "alter table test_table add test_column int"
 	sqlWithoutTimeout := "alter table test_table add test_column_no_timeout int"
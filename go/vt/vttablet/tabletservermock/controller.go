@@ -18,6 +18,7 @@ limitations under the License.
 package tabletservermock
 
 import (
+	"net/http"
 	"sync"
 
 	"golang.org/x/net/context"
@@ -29,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -203,6 +205,12 @@ func (tqsc *Controller) HeartbeatLag() (time.Duration, error) {
 	return 0, nil
 }
 
+// CheckThrottler is part of the tabletserver.Controller interface.
+// The mock has no lag throttler, so every app may write.
+func (tqsc *Controller) CheckThrottler(app string) *throttle.CheckResult {
+	return &throttle.CheckResult{StatusCode: http.StatusOK, Message: "throttler is disabled"}
+}
+
 // TopoServer is part of the tabletserver.Controller interface.
 func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
@@ -209,6 +209,14 @@ type TabletManagerClient interface {
 	// RestoreFromBackup deletes local data and restores database from backup
 	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
 
+	//
+	// Throttler related methods
+	//
+
+	// CheckThrottler asks the tablet's lag throttler whether the given
+	// app may write to the shard right now.
+	CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, app string) (*tabletmanagerdatapb.CheckThrottlerResponse, error)
+
 	//
 	// Management methods
 	//
@@ -212,6 +212,18 @@ func (tmc *testMaterializerTMClient) ExecuteFetchAsDba(ctx context.Context, tabl
 	return tmc.VReplicationExec(ctx, tablet, string(query))
 }
 
+func (tmc *testMaterializerTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return "MariaDB/5-456-892", nil
+}
+
+func (tmc *testMaterializerTMClient) VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error {
+	return nil
+}
+
+func (tmc *testMaterializerTMClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return nil
+}
+
 func (tmc *testMaterializerTMClient) verifyQueries(t *testing.T) {
 	t.Helper()
 
@@ -0,0 +1,347 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// An online schema migration applies an ALTER TABLE without locking the
+// table for the time it takes MySQL to rebuild it. The ALTER is applied
+// to an empty shadow table instead, which a vreplication stream of the
+// shard then backfills from the original table and keeps up to date with
+// its changes. Once the rows are copied, the cut-over stops the writes
+// to the table, waits for the stream to catch up, and swaps the two
+// tables with an atomic RENAME TABLE. The original table is kept, under
+// the name returned by onlineDDLOldTable.
+//
+// Online schema migrations are schema migrations with the online
+// strategy, see schema_migrations.go. Their vreplication streams use
+// the migration UUID as workflow.
+
+var (
+	// alterTableRegexp splits an ALTER TABLE statement into
+	// its table name and its alter specifications.
+	alterTableRegexp = regexp.MustCompile("(?is)^\\s*alter\\s+table\\s+(`[^`]+`|\\S+)\\s+(.+)$")
+	// renameRegexp matches the alter specifications that can rename the
+	// table or its columns: the stream copies the columns by name.
+	renameRegexp = regexp.MustCompile(`(?i)\b(rename\s+(to|as|column)|change)\b`)
+)
+
+// IsOnlineDDL returns true if the statement can run as an online
+// schema migration.
+func IsOnlineDDL(sql string) bool {
+	_, _, err := parseOnlineDDL(sql)
+	return err == nil
+}
+
+// parseOnlineDDL returns the table an online schema migration alters,
+// along with the alter specifications to apply to the shadow table.
+func parseOnlineDDL(sql string) (table, specs string, err error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", "", err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.AlterStr || ddl.Table.IsEmpty() {
+		return "", "", fmt.Errorf("online schema migrations only support ALTER TABLE: %s", sql)
+	}
+	match := alterTableRegexp.FindStringSubmatch(sql)
+	if match == nil {
+		return "", "", fmt.Errorf("cannot parse ALTER TABLE for online schema migration: %s", sql)
+	}
+	specs = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(match[2]), ";"))
+	if renameRegexp.MatchString(specs) {
+		return "", "", fmt.Errorf("online schema migrations cannot rename tables or columns, use MODIFY to change a column: %s", sql)
+	}
+	return ddl.Table.Name.String(), specs, nil
+}
+
+// onlineDDLShadowTable returns the name of the shadow table of a migration.
+// The dashes of its UUID are dropped to keep the name short.
+func onlineDDLShadowTable(migration string) string {
+	return fmt.Sprintf("_%s_gho", strings.Replace(migration, "-", "", -1))
+}
+
+func onlineDDLOldTable(migration string) string {
+	return fmt.Sprintf("_%s_del", strings.Replace(migration, "-", "", -1))
+}
+
+// runOnlineDDL starts an online schema migration over on the master.
+func (wr *Wrangler) runOnlineDDL(ctx context.Context, master *topo.TabletInfo, migration string, status *SchemaMigrationShardStatus) error {
+	query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(migration))
+	if _, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query); err != nil {
+		return err
+	}
+	return wr.startOnlineDDL(ctx, master, migration, status.Table, status.Statement)
+}
+
+// startOnlineDDL creates the shadow table of a migration, and the
+// vreplication stream that fills it.
+func (wr *Wrangler) startOnlineDDL(ctx context.Context, master *topo.TabletInfo, migration, table, ddl string) error {
+	_, specs, err := parseOnlineDDL(ddl)
+	if err != nil {
+		return err
+	}
+	shadow := onlineDDLShadowTable(migration)
+	queries := []string{
+		fmt.Sprintf("drop table if exists %s", sqlescape.EscapeID(shadow)),
+		fmt.Sprintf("create table %s like %s", sqlescape.EscapeID(shadow), sqlescape.EscapeID(table)),
+		fmt.Sprintf("alter table %s %s", sqlescape.EscapeID(shadow), specs),
+	}
+	for _, query := range queries {
+		if _, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false); err != nil {
+			return err
+		}
+	}
+
+	// Copy the columns the two tables have in common, by name.
+	sourceColumns, err := wr.onlineDDLColumns(ctx, master, table)
+	if err != nil {
+		return err
+	}
+	shadowColumns, err := wr.onlineDDLColumns(ctx, master, shadow)
+	if err != nil {
+		return err
+	}
+	inShadow := make(map[string]bool, len(shadowColumns))
+	for _, col := range shadowColumns {
+		inShadow[strings.ToLower(col)] = true
+	}
+	sel := &sqlparser.Select{
+		From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+			Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent(table)},
+		}},
+	}
+	for _, col := range sourceColumns {
+		if inShadow[strings.ToLower(col)] {
+			sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col)}})
+		}
+	}
+	if len(sel.SelectExprs) == 0 {
+		return fmt.Errorf("table %s has no columns left to copy after: %s", table, ddl)
+	}
+
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpRunning, master.DbName())
+	ig.AddRow(migration, &binlogdatapb.BinlogSource{
+		Keyspace: master.Keyspace,
+		Shard:    master.Shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  shadow,
+				Filter: sqlparser.String(sel),
+			}},
+		},
+	}, "", "", "master")
+	_, err = wr.tmc.VReplicationExec(ctx, master.Tablet, ig.String())
+	return err
+}
+
+func (wr *Wrangler) onlineDDLColumns(ctx context.Context, master *topo.TabletInfo, table string) ([]string, error) {
+	sd, err := wr.GetSchema(ctx, master.Alias, []string{table}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(sd.TableDefinitions) == 0 {
+		return nil, fmt.Errorf("table %s not found on %v", table, master.AliasString())
+	}
+	return sd.TableDefinitions[0].Columns, nil
+}
+
+// OnlineDDLStatus returns the status of an online schema migration
+// on every shard of the keyspace.
+func (wr *Wrangler) OnlineDDLStatus(ctx context.Context, keyspace, migration string) (map[string]*SchemaMigrationShardStatus, error) {
+	var mu sync.Mutex
+	statuses := make(map[string]*SchemaMigrationShardStatus)
+	err := wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		status, err := wr.readSchemaMigration(ctx, master, migration)
+		if err != nil {
+			return err
+		}
+		if status == nil {
+			return fmt.Errorf("migration %s not found on %v/%v", migration, master.Keyspace, master.Shard)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		statuses[master.Shard] = status
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// CompleteOnlineDDL performs the cut-over of an online schema migration
+// on the shards where it's still running. The writes to the table are
+// stopped for up to filteredReplicationWaitTime while the shadow table
+// catches up.
+func (wr *Wrangler) CompleteOnlineDDL(ctx context.Context, keyspace, migration string, filteredReplicationWaitTime time.Duration) (err error) {
+	// Changing the blacklisted tables of the shards requires the lock.
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "CompleteOnlineDDL")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	return wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		return wr.completeOnlineDDL(ctx, master, migration, filteredReplicationWaitTime)
+	})
+}
+
+func (wr *Wrangler) completeOnlineDDL(ctx context.Context, master *topo.TabletInfo, migration string, filteredReplicationWaitTime time.Duration) error {
+	status, err := wr.readSchemaMigration(ctx, master, migration)
+	if err != nil {
+		return err
+	}
+	switch {
+	case status == nil:
+		return fmt.Errorf("migration %s not found on %v/%v", migration, master.Keyspace, master.Shard)
+	case status.Strategy != SchemaMigrationStrategyOnline:
+		return fmt.Errorf("migration %s is not an online schema migration", migration)
+	case status.Status == SchemaMigrationStatusComplete:
+		return nil
+	case status.Status != SchemaMigrationStatusRunning:
+		return fmt.Errorf("migration %s is %s on %v/%v", migration, status.Status, master.Keyspace, master.Shard)
+	case status.StreamState != binlogplayer.BlpRunning:
+		return fmt.Errorf("stream of migration %s is not running on %v/%v: %s %s", migration, master.Keyspace, master.Shard, status.StreamState, status.StreamMessage)
+	case status.Copying:
+		return fmt.Errorf("migration %s is still copying rows on %v/%v", migration, master.Keyspace, master.Shard)
+	}
+
+	if err := wr.changeOnlineDDLWrites(ctx, master, status.Table, disallowWrites); err != nil {
+		return err
+	}
+	err = wr.cutOverOnlineDDL(ctx, master, migration, status, filteredReplicationWaitTime)
+	if allowErr := wr.changeOnlineDDLWrites(ctx, master, status.Table, allowWrites); err == nil {
+		err = allowErr
+	}
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(migration))
+	if _, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query); err != nil {
+		return err
+	}
+	return wr.UpdateSchemaMigration(ctx, master.Tablet, migration, SchemaMigrationStatusComplete, "")
+}
+
+// cutOverOnlineDDL waits for the shadow table of a migration to catch up
+// with the table, and swaps the two. Writes to the table must be stopped.
+func (wr *Wrangler) cutOverOnlineDDL(ctx context.Context, master *topo.TabletInfo, migration string, status *SchemaMigrationShardStatus, filteredReplicationWaitTime time.Duration) error {
+	pos, err := wr.tmc.MasterPosition(ctx, master.Tablet)
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
+	defer cancel()
+	if err := wr.tmc.VReplicationWaitForPos(waitCtx, master.Tablet, int(status.streamID), pos); err != nil {
+		return err
+	}
+	if _, err := wr.tmc.VReplicationExec(ctx, master.Tablet, binlogplayer.StopVReplication(status.streamID, "stopped for cutover")); err != nil {
+		return err
+	}
+	rename := fmt.Sprintf("rename table %s to %s, %s to %s",
+		sqlescape.EscapeID(status.Table), sqlescape.EscapeID(onlineDDLOldTable(migration)),
+		sqlescape.EscapeID(onlineDDLShadowTable(migration)), sqlescape.EscapeID(status.Table))
+	if _, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(rename), 0, false, true); err != nil {
+		// Resume the stream so that the cut-over can be tried again.
+		if _, startErr := wr.tmc.VReplicationExec(ctx, master.Tablet, binlogplayer.StartVReplication(status.streamID)); startErr != nil {
+			wr.Logger().Warningf("Could not restart stream %v of migration %v on %v: %v", status.streamID, migration, master.AliasString(), startErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// changeOnlineDDLWrites allows or disallows the writes to the table
+// on the master, through its blacklisted tables.
+func (wr *Wrangler) changeOnlineDDLWrites(ctx context.Context, master *topo.TabletInfo, table string, access accessType) error {
+	if _, err := wr.ts.UpdateShardFields(ctx, master.Keyspace, master.Shard, func(si *topo.ShardInfo) error {
+		return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, []string{table})
+	}); err != nil {
+		return err
+	}
+	return wr.tmc.RefreshState(ctx, master.Tablet)
+}
+
+// cancelOnlineDDL deletes the stream and the shadow table of a
+// migration on the master.
+func (wr *Wrangler) cancelOnlineDDL(ctx context.Context, master *topo.TabletInfo, migration string) error {
+	query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(migration))
+	if _, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query); err != nil {
+		return err
+	}
+	query = fmt.Sprintf("drop table if exists %s", sqlescape.EscapeID(onlineDDLShadowTable(migration)))
+	if _, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false); err != nil {
+		return err
+	}
+	return wr.UpdateSchemaMigration(ctx, master.Tablet, migration, SchemaMigrationStatusCancelled, "")
+}
+
+// readOnlineDDLStream adds the state of the vreplication stream
+// of a running migration to its status.
+func (wr *Wrangler) readOnlineDDLStream(ctx context.Context, master *topo.TabletInfo, migration string, status *SchemaMigrationShardStatus) error {
+	query := fmt.Sprintf("select id, state, message from _vt.vreplication where db_name=%s and workflow=%s", encodeString(master.DbName()), encodeString(migration))
+	p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+	if err != nil {
+		return err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil
+	}
+	id, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return err
+	}
+	status.streamID = uint32(id)
+	status.StreamState = qr.Rows[0][1].ToString()
+	status.StreamMessage = qr.Rows[0][2].ToString()
+
+	query = fmt.Sprintf("select count(*) from _vt.copy_state where vrepl_id=%d", id)
+	p3qr, err = wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+	if err != nil {
+		return err
+	}
+	qr = sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) != 0 {
+		count, err := sqltypes.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return err
+		}
+		status.Copying = count != 0
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	onlineDDLStatement      = "alter table t1 drop column c2, add column c3 int"
+	onlineDDLSelectStream   = "select id, state, message from _vt.vreplication where db_name='vt_ks' and workflow='m'"
+	onlineDDLDeleteStream   = "delete from _vt.vreplication where db_name='vt_ks' and workflow='m'"
+	onlineDDLInsertStream   = "/insert into _vt.vreplication.*'m'.*match:.*_m_gho.*filter:.*select id, c1 from t1.*'master'"
+	onlineDDLStreamFields   = "id|state|message"
+	onlineDDLStreamTypes    = "int64|varchar|varchar"
+	onlineDDLCopyStateQuery = "select count(*) from _vt.copy_state where vrepl_id=1"
+)
+
+func newTestOnlineDDLEnv(t *testing.T) *testMaterializerEnv {
+	env := newTestMaterializerEnv(t, &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+	}, []string{"0"}, []string{"0"})
+	env.tmc.schema["ks.t1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:    "t1",
+			Columns: []string{"id", "c1", "c2"},
+		}},
+	}
+	env.tmc.schema["ks._m_gho"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:    "_m_gho",
+			Columns: []string{"id", "c1", "c3"},
+		}},
+	}
+	return env
+}
+
+func (env *testMaterializerEnv) expectOnlineDDLStart() {
+	env.tmc.expectVRQuery(100, "drop table if exists `_m_gho`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "create table `_m_gho` like `t1`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "alter table `_m_gho` drop column c2, add column c3 int", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, onlineDDLInsertStream, &sqltypes.Result{})
+}
+
+func (env *testMaterializerEnv) expectOnlineDDLStatus(status, state string, copying int) {
+	env.expectSchemaMigrationStatus("t1", onlineDDLStatement, SchemaMigrationStrategyOnline, status)
+	if status != SchemaMigrationStatusRunning {
+		return
+	}
+	env.tmc.expectVRQuery(100, onlineDDLSelectStream, sqltypes.MakeTestResult(sqltypes.MakeTestFields(onlineDDLStreamFields, onlineDDLStreamTypes),
+		"1|"+state+"|",
+	))
+	env.tmc.expectVRQuery(100, onlineDDLCopyStateQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"),
+		[]string{"0", "1"}[copying],
+	))
+}
+
+func TestParseOnlineDDL(t *testing.T) {
+	testcases := []struct {
+		in, table, specs, err string
+	}{{
+		in:    "alter table t1 add column c3 int",
+		table: "t1",
+		specs: "add column c3 int",
+	}, {
+		in:    "ALTER TABLE `my t1` ADD INDEX (c1);",
+		table: "my t1",
+		specs: "ADD INDEX (c1)",
+	}, {
+		in:  "create table t1(id int)",
+		err: "online schema migrations only support ALTER TABLE: create table t1(id int)",
+	}, {
+		in:    "alter table t1 rename index i1 to i2",
+		table: "t1",
+		specs: "rename index i1 to i2",
+	}, {
+		in:  "alter table t1 change c1 c2 int",
+		err: "online schema migrations cannot rename tables or columns, use MODIFY to change a column: alter table t1 change c1 c2 int",
+	}}
+	for _, tcase := range testcases {
+		table, specs, err := parseOnlineDDL(tcase.in)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err, tcase.in)
+			continue
+		}
+		require.NoError(t, err, tcase.in)
+		assert.Equal(t, tcase.table, table, tcase.in)
+		assert.Equal(t, tcase.specs, specs, tcase.in)
+	}
+}
+
+func TestOnlineDDLShadowTable(t *testing.T) {
+	assert.Equal(t, "_m_gho", onlineDDLShadowTable("m"))
+	assert.Equal(t, "_6ba7b8109dad11d180b400c04fd430c8_del", onlineDDLOldTable("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+}
+
+func TestQueueAndRunOnlineDDL(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	env.expectCreateSchemaMigrations()
+	env.tmc.expectVRQuery(100, "select migration_uuid from _vt.schema_migrations where keyspace='ks' and shard='0' and mysql_table='t1' and strategy='online' and status in ('queued', 'running')", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "insert into _vt.schema_migrations(migration_uuid, keyspace, shard, mysql_table, migration_statement, strategy, status, time_updated) values ('m', 'ks', '0', 't1', '"+onlineDDLStatement+"', 'online', 'queued', unix_timestamp())", &sqltypes.Result{})
+	err := env.wr.QueueSchemaMigration(ctx, "ks", "m", SchemaMigrationStrategyOnline, onlineDDLStatement)
+	require.NoError(t, err)
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusQueued, "", 0)
+	env.expectSchemaMigrationStart(SchemaMigrationStatusQueued)
+	env.tmc.expectVRQuery(100, onlineDDLDeleteStream, &sqltypes.Result{})
+	env.expectOnlineDDLStart()
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestQueueOnlineDDLInProgress(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+
+	env.expectCreateSchemaMigrations()
+	env.tmc.expectVRQuery(100, "select migration_uuid from _vt.schema_migrations where keyspace='ks' and shard='0' and mysql_table='t1' and strategy='online' and status in ('queued', 'running')", sqltypes.MakeTestResult(sqltypes.MakeTestFields("migration_uuid", "varchar"), "other"))
+	// The clean up doesn't leave the migration behind.
+	env.tmc.expectVRQuery(100, "delete from _vt.schema_migrations where migration_uuid='m' and keyspace='ks' and shard='0'", &sqltypes.Result{})
+
+	err := env.wr.QueueSchemaMigration(context.Background(), "ks", "m", SchemaMigrationStrategyOnline, onlineDDLStatement)
+	assert.EqualError(t, err, "table t1 already has a migration in progress on ks/0: other")
+	env.tmc.verifyQueries(t)
+}
+
+func TestOnlineDDLStatus(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusRunning, "Running", 1)
+	statuses, err := env.wr.OnlineDDLStatus(context.Background(), "ks", "m")
+	require.NoError(t, err)
+	want := map[string]*SchemaMigrationShardStatus{
+		"0": {
+			Table:       "t1",
+			Statement:   onlineDDLStatement,
+			Strategy:    SchemaMigrationStrategyOnline,
+			Status:      SchemaMigrationStatusRunning,
+			StreamState: "Running",
+			Copying:     true,
+			streamID:    1,
+		},
+	}
+	assert.Equal(t, want, statuses)
+
+	env.tmc.expectVRQuery(100, schemaMigrationSelectStatus, &sqltypes.Result{})
+	_, err = env.wr.OnlineDDLStatus(context.Background(), "ks", "m")
+	assert.EqualError(t, err, "migration m not found on ks/0")
+	env.tmc.verifyQueries(t)
+}
+
+func TestCompleteOnlineDDL(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	// Not done copying.
+	env.expectOnlineDDLStatus(SchemaMigrationStatusRunning, "Running", 1)
+	err := env.wr.CompleteOnlineDDL(ctx, "ks", "m", time.Second)
+	assert.EqualError(t, err, "migration m is still copying rows on ks/0")
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusRunning, "Running", 0)
+	env.tmc.expectVRQuery(100, "update _vt.vreplication set state='Stopped', message='stopped for cutover' where id=1", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "rename table `t1` to `_m_del`, `_m_gho` to `t1`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, onlineDDLDeleteStream, &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "update _vt.schema_migrations set status='complete', message='', time_updated=unix_timestamp() where migration_uuid='m' and keyspace='ks' and shard='0'", &sqltypes.Result{})
+	err = env.wr.CompleteOnlineDDL(ctx, "ks", "m", time.Second)
+	require.NoError(t, err)
+
+	// The writes to the table are allowed again.
+	si, err := env.wr.ts.GetShard(ctx, "ks", "0")
+	require.NoError(t, err)
+	assert.Empty(t, si.TabletControls)
+
+	// Completing again is a no-op.
+	env.expectOnlineDDLStatus(SchemaMigrationStatusComplete, "", 0)
+	err = env.wr.CompleteOnlineDDL(ctx, "ks", "m", time.Second)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestCancelAndRetryOnlineDDL(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusRunning, "Error", 0)
+	env.tmc.expectVRQuery(100, onlineDDLDeleteStream, &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "drop table if exists `_m_gho`", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "update _vt.schema_migrations set status='cancelled', message='', time_updated=unix_timestamp() where migration_uuid='m' and keyspace='ks' and shard='0'", &sqltypes.Result{})
+	err := env.wr.CancelSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusCancelled, "", 0)
+	env.expectSchemaMigrationStart(SchemaMigrationStatusCancelled)
+	env.tmc.expectVRQuery(100, onlineDDLDeleteStream, &sqltypes.Result{})
+	env.expectOnlineDDLStart()
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+
+	env.expectOnlineDDLStatus(SchemaMigrationStatusComplete, "", 0)
+	err = env.wr.CancelSchemaMigration(ctx, "ks", "m")
+	assert.EqualError(t, err, "migration m is already complete on ks/0")
+	env.tmc.verifyQueries(t)
+}
@@ -0,0 +1,415 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	gouuid "github.com/pborman/uuid"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Every schema change applied by ApplySchema is recorded as a schema
+// migration, identified by a UUID. The masters keep the migrations of
+// their shard in _vt.schema_migrations, along with their status on the
+// shard. A migration is queued when it's recorded, and stays queued
+// until the migrations before it are done. It's then running until it
+// is complete or failed. A migration that's not complete can be
+// cancelled, and a failed or cancelled one can be retried.
+
+const (
+	// SchemaMigrationStrategyDirect is the strategy of a migration that
+	// runs its statement directly on the masters.
+	SchemaMigrationStrategyDirect = "direct"
+	// SchemaMigrationStrategyOnline is the strategy of an online schema
+	// migration, see online_ddl.go.
+	SchemaMigrationStrategyOnline = "online"
+)
+
+const (
+	// SchemaMigrationStatusQueued is the status of a migration that's
+	// waiting to run.
+	SchemaMigrationStatusQueued = "queued"
+	// SchemaMigrationStatusRunning is the status of a migration whose
+	// statement is running, or of an online schema migration that's
+	// copying or tailing the changes of its table.
+	SchemaMigrationStatusRunning = "running"
+	// SchemaMigrationStatusComplete is the status of a migration that
+	// was applied.
+	SchemaMigrationStatusComplete = "complete"
+	// SchemaMigrationStatusFailed is the status of a migration that
+	// failed. Its message is the error.
+	SchemaMigrationStatusFailed = "failed"
+	// SchemaMigrationStatusCancelled is the status of a cancelled migration.
+	SchemaMigrationStatusCancelled = "cancelled"
+)
+
+var sqlCreateSchemaMigrations = []string{
+	"create database if not exists _vt",
+	`create table if not exists _vt.schema_migrations (
+  id bigint(20) unsigned not null auto_increment,
+  migration_uuid varbinary(64) not null,
+  keyspace varbinary(256) not null,
+  shard varbinary(256) not null,
+  mysql_table varbinary(128) not null default '',
+  migration_statement blob not null,
+  strategy varbinary(32) not null,
+  status varbinary(32) not null,
+  message varbinary(1000) not null default '',
+  time_updated bigint(20) not null,
+  primary key (id),
+  unique key migration_idx (migration_uuid, keyspace, shard)
+) engine=InnoDB`,
+}
+
+// SchemaMigration is a schema migration of a keyspace, as listed by
+// ListSchemaMigrations.
+type SchemaMigration struct {
+	UUID      string
+	Table     string `json:",omitempty"`
+	Statement string
+	Strategy  string
+	// Shards has the status of the migration on every shard.
+	Shards map[string]*SchemaMigrationShard
+}
+
+// SchemaMigrationShard is the status of a schema migration on one shard.
+type SchemaMigrationShard struct {
+	Status  string
+	Message string `json:",omitempty"`
+}
+
+// SchemaMigrationShardStatus is the detailed status of a schema
+// migration on one shard.
+type SchemaMigrationShardStatus struct {
+	Table     string `json:",omitempty"`
+	Statement string
+	Strategy  string
+	Status    string
+	Message   string `json:",omitempty"`
+
+	// StreamState and StreamMessage describe the vreplication stream
+	// of a running online schema migration. Copying is set until the
+	// stream is done copying the rows of the table.
+	StreamState   string `json:",omitempty"`
+	StreamMessage string `json:",omitempty"`
+	Copying       bool   `json:",omitempty"`
+
+	streamID uint32
+}
+
+// NewSchemaMigrationUUID returns the UUID of a new schema migration.
+func NewSchemaMigrationUUID() string {
+	return gouuid.NewUUID().String()
+}
+
+// QueueSchemaMigration records a new schema migration of the statement
+// on every shard of the keyspace, where it's queued until it's run by
+// RunSchemaMigration.
+func (wr *Wrangler) QueueSchemaMigration(ctx context.Context, keyspace, migration, strategy, sql string) error {
+	table := ""
+	switch strategy {
+	case SchemaMigrationStrategyDirect:
+	case SchemaMigrationStrategyOnline:
+		var err error
+		if table, _, err = parseOnlineDDL(sql); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown schema migration strategy: %s", strategy)
+	}
+	err := wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		if err := wr.createSchemaMigrations(ctx, master); err != nil {
+			return err
+		}
+		if strategy == SchemaMigrationStrategyOnline {
+			// Only one online schema migration of a table can be in
+			// progress: they each swap in their own copy of the table.
+			query := fmt.Sprintf("select migration_uuid from _vt.schema_migrations where keyspace=%s and shard=%s and mysql_table=%s and strategy=%s and status in (%s, %s)",
+				encodeString(master.Keyspace), encodeString(master.Shard), encodeString(table), encodeString(strategy),
+				encodeString(SchemaMigrationStatusQueued), encodeString(SchemaMigrationStatusRunning))
+			p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 1, false, false)
+			if err != nil {
+				return err
+			}
+			if len(p3qr.Rows) != 0 {
+				qr := sqltypes.Proto3ToResult(p3qr)
+				return fmt.Errorf("table %s already has a migration in progress on %v/%v: %v", table, master.Keyspace, master.Shard, qr.Rows[0][0].ToString())
+			}
+		}
+		query := fmt.Sprintf("insert into _vt.schema_migrations(migration_uuid, keyspace, shard, mysql_table, migration_statement, strategy, status, time_updated) values (%s, %s, %s, %s, %s, %s, %s, unix_timestamp())",
+			encodeString(migration), encodeString(master.Keyspace), encodeString(master.Shard), encodeString(table),
+			encodeString(sql), encodeString(strategy), encodeString(SchemaMigrationStatusQueued))
+		_, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false)
+		return err
+	})
+	if err != nil {
+		// Don't leave the shards that succeeded behind.
+		if deleteErr := wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+			query := fmt.Sprintf("delete from _vt.schema_migrations where %s", schemaMigrationWhere(master.Tablet, migration))
+			_, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false)
+			return err
+		}); deleteErr != nil {
+			wr.Logger().Warningf("Could not clean up migration %v after failing to queue it: %v", migration, deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (wr *Wrangler) createSchemaMigrations(ctx context.Context, master *topo.TabletInfo) error {
+	for _, query := range sqlCreateSchemaMigrations {
+		if _, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 0, false, false); err != nil {
+			return vterrors.Wrapf(err, "cannot create _vt.schema_migrations on %v", master.AliasString())
+		}
+	}
+	return nil
+}
+
+// RunSchemaMigration runs a schema migration on the shards where it's
+// not complete: it's either queued, or it failed or was cancelled before.
+// An online schema migration that's still running is started over.
+func (wr *Wrangler) RunSchemaMigration(ctx context.Context, keyspace, migration string) error {
+	return wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		status, err := wr.readSchemaMigration(ctx, master, migration)
+		if err != nil {
+			return err
+		}
+		switch {
+		case status == nil:
+			return fmt.Errorf("migration %s not found on %v/%v", migration, master.Keyspace, master.Shard)
+		case status.Status == SchemaMigrationStatusComplete:
+			return nil
+		case status.Status == SchemaMigrationStatusRunning && status.Strategy == SchemaMigrationStrategyDirect:
+			return fmt.Errorf("migration %s is already running on %v/%v", migration, master.Keyspace, master.Shard)
+		}
+
+		// The migration may have been cancelled since its status was read.
+		if err := wr.StartSchemaMigration(ctx, master.Tablet, migration, status.Status); err != nil {
+			return err
+		}
+		if status.Strategy == SchemaMigrationStrategyOnline {
+			err = wr.runOnlineDDL(ctx, master, migration, status)
+		} else {
+			err = wr.runDirectSchemaMigration(ctx, master, migration, status)
+		}
+		if err != nil {
+			if updateErr := wr.UpdateSchemaMigration(ctx, master.Tablet, migration, SchemaMigrationStatusFailed, err.Error()); updateErr != nil {
+				wr.Logger().Warningf("Could not record the failure of migration %v on %v: %v", migration, master.AliasString(), updateErr)
+			}
+			return vterrors.Wrapf(err, "migration %s failed on %v/%v", migration, master.Keyspace, master.Shard)
+		}
+		return nil
+	})
+}
+
+func (wr *Wrangler) runDirectSchemaMigration(ctx context.Context, master *topo.TabletInfo, migration string, status *SchemaMigrationShardStatus) error {
+	if _, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(status.Statement), 0, false, true); err != nil {
+		return err
+	}
+	return wr.UpdateSchemaMigration(ctx, master.Tablet, migration, SchemaMigrationStatusComplete, "")
+}
+
+// CancelSchemaMigration cancels a schema migration on the shards where
+// it's not complete. The statement of a migration that's running
+// directly on a master can't be interrupted.
+func (wr *Wrangler) CancelSchemaMigration(ctx context.Context, keyspace, migration string) error {
+	return wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		status, err := wr.readSchemaMigration(ctx, master, migration)
+		if err != nil {
+			return err
+		}
+		switch {
+		case status == nil:
+			return fmt.Errorf("migration %s not found on %v/%v", migration, master.Keyspace, master.Shard)
+		case status.Status == SchemaMigrationStatusComplete:
+			return fmt.Errorf("migration %s is already complete on %v/%v", migration, master.Keyspace, master.Shard)
+		case status.Status == SchemaMigrationStatusCancelled:
+			return nil
+		case status.Strategy == SchemaMigrationStrategyOnline:
+			return wr.cancelOnlineDDL(ctx, master, migration)
+		case status.Status == SchemaMigrationStatusRunning:
+			return fmt.Errorf("migration %s is running on %v/%v and cannot be cancelled", migration, master.Keyspace, master.Shard)
+		}
+		return wr.UpdateSchemaMigration(ctx, master.Tablet, migration, SchemaMigrationStatusCancelled, "")
+	})
+}
+
+// ListSchemaMigrations returns the schema migrations of the keyspace,
+// in the order they were queued. If status is set, only the shards
+// where the migrations have this status are listed.
+func (wr *Wrangler) ListSchemaMigrations(ctx context.Context, keyspace, status string) ([]*SchemaMigration, error) {
+	var mu sync.Mutex
+	shardMigrations := make(map[string][]*SchemaMigration)
+	err := wr.forAllSchemaMigrationMasters(ctx, keyspace, func(master *topo.TabletInfo) error {
+		if err := wr.createSchemaMigrations(ctx, master); err != nil {
+			return err
+		}
+		query := fmt.Sprintf("select migration_uuid, mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where keyspace=%s and shard=%s",
+			encodeString(master.Keyspace), encodeString(master.Shard))
+		if status != "" {
+			query += fmt.Sprintf(" and status=%s", encodeString(status))
+		}
+		query += " order by id"
+		p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 10000, false, false)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		migrations := make([]*SchemaMigration, 0, len(qr.Rows))
+		for _, row := range qr.Rows {
+			migrations = append(migrations, &SchemaMigration{
+				UUID:      row[0].ToString(),
+				Table:     row[1].ToString(),
+				Statement: row[2].ToString(),
+				Strategy:  row[3].ToString(),
+				Shards: map[string]*SchemaMigrationShard{
+					master.Shard: {
+						Status:  row[4].ToString(),
+						Message: row[5].ToString(),
+					},
+				},
+			})
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		shardMigrations[master.Shard] = migrations
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge the migrations of the shards, keeping their order.
+	shards := make([]string, 0, len(shardMigrations))
+	for shard := range shardMigrations {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	var result []*SchemaMigration
+	byUUID := make(map[string]*SchemaMigration)
+	for _, shard := range shards {
+		for _, m := range shardMigrations[shard] {
+			if existing, ok := byUUID[m.UUID]; ok {
+				existing.Shards[shard] = m.Shards[shard]
+				continue
+			}
+			byUUID[m.UUID] = m
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+// UpdateSchemaMigration changes the status of a schema migration on
+// the master tablet.
+func (wr *Wrangler) UpdateSchemaMigration(ctx context.Context, master *topodatapb.Tablet, migration, status, message string) error {
+	query := fmt.Sprintf("update _vt.schema_migrations set status=%s, message=%s, time_updated=unix_timestamp() where %s",
+		encodeString(status), encodeString(binlogplayer.MessageTruncate(message)), schemaMigrationWhere(master, migration))
+	_, err := wr.tmc.ExecuteFetchAsDba(ctx, master, false, []byte(query), 0, false, false)
+	return err
+}
+
+// StartSchemaMigration changes the status of a schema migration on the
+// master tablet to running, only if it still has the given status. This
+// keeps a migration that was cancelled in the meantime from running.
+func (wr *Wrangler) StartSchemaMigration(ctx context.Context, master *topodatapb.Tablet, migration, status string) error {
+	query := fmt.Sprintf("update _vt.schema_migrations set status=%s, message='', time_updated=unix_timestamp() where %s and status=%s",
+		encodeString(SchemaMigrationStatusRunning), schemaMigrationWhere(master, migration), encodeString(status))
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master, false, []byte(query), 0, false, false)
+	if err != nil {
+		return err
+	}
+	if p3qr.RowsAffected == 0 {
+		return fmt.Errorf("migration %s is no longer %s on %v/%v", migration, status, master.Keyspace, master.Shard)
+	}
+	return nil
+}
+
+// readSchemaMigration returns the status of the migration on the master,
+// or nil if it doesn't know about it.
+func (wr *Wrangler) readSchemaMigration(ctx context.Context, master *topo.TabletInfo, migration string) (*SchemaMigrationShardStatus, error) {
+	query := fmt.Sprintf("select mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where %s", schemaMigrationWhere(master.Tablet, migration))
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, master.Tablet, false, []byte(query), 1, false, false)
+	if err != nil {
+		return nil, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	status := &SchemaMigrationShardStatus{
+		Table:     qr.Rows[0][0].ToString(),
+		Statement: qr.Rows[0][1].ToString(),
+		Strategy:  qr.Rows[0][2].ToString(),
+		Status:    qr.Rows[0][3].ToString(),
+		Message:   qr.Rows[0][4].ToString(),
+	}
+	if status.Strategy != SchemaMigrationStrategyOnline || status.Status != SchemaMigrationStatusRunning {
+		return status, nil
+	}
+	if err := wr.readOnlineDDLStream(ctx, master, migration, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func schemaMigrationWhere(master *topodatapb.Tablet, migration string) string {
+	return fmt.Sprintf("migration_uuid=%s and keyspace=%s and shard=%s", encodeString(migration), encodeString(master.Keyspace), encodeString(master.Shard))
+}
+
+// forAllSchemaMigrationMasters runs f in parallel on the master of every
+// shard of the keyspace.
+func (wr *Wrangler) forAllSchemaMigrationMasters(ctx context.Context, keyspace string, f func(*topo.TabletInfo) error) error {
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, si := range shards {
+		if si.MasterAlias == nil {
+			allErrors.RecordError(fmt.Errorf("shard has no master: %v", si.ShardName()))
+			continue
+		}
+		wg.Add(1)
+		go func(si *topo.ShardInfo) {
+			defer wg.Done()
+
+			master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "GetTablet(%v) failed", si.MasterAlias))
+				return
+			}
+			if err := f(master); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(si)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
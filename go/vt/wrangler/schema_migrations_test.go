@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	schemaMigrationStatement    = "create table t2(id int, primary key(id))"
+	schemaMigrationSelectStatus = "select mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where migration_uuid='m' and keyspace='ks' and shard='0'"
+	schemaMigrationStatusFields = "mysql_table|migration_statement|strategy|status|message"
+	schemaMigrationStatusTypes  = "varchar|varchar|varchar|varchar|varchar"
+	schemaMigrationListFields   = "migration_uuid|mysql_table|migration_statement|strategy|status|message"
+	schemaMigrationListTypes    = "varchar|varchar|varchar|varchar|varchar|varchar"
+)
+
+func (env *testMaterializerEnv) expectCreateSchemaMigrations() {
+	env.tmc.expectVRQuery(100, sqlCreateSchemaMigrations[0], &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, sqlCreateSchemaMigrations[1], &sqltypes.Result{})
+}
+
+func (env *testMaterializerEnv) expectSchemaMigrationStatus(table, statement, strategy, status string) {
+	env.tmc.expectVRQuery(100, schemaMigrationSelectStatus, sqltypes.MakeTestResult(sqltypes.MakeTestFields(schemaMigrationStatusFields, schemaMigrationStatusTypes),
+		table+"|"+statement+"|"+strategy+"|"+status+"|",
+	))
+}
+
+func (env *testMaterializerEnv) expectSchemaMigrationUpdate(status string) {
+	env.tmc.expectVRQuery(100, "update _vt.schema_migrations set status='"+status+"', message='', time_updated=unix_timestamp() where migration_uuid='m' and keyspace='ks' and shard='0'", &sqltypes.Result{})
+}
+
+func (env *testMaterializerEnv) expectSchemaMigrationStart(status string) {
+	env.tmc.expectVRQuery(100, "update _vt.schema_migrations set status='running', message='', time_updated=unix_timestamp() where migration_uuid='m' and keyspace='ks' and shard='0' and status='"+status+"'", &sqltypes.Result{RowsAffected: 1})
+}
+
+func TestQueueSchemaMigration(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	env.expectCreateSchemaMigrations()
+	env.tmc.expectVRQuery(100, "insert into _vt.schema_migrations(migration_uuid, keyspace, shard, mysql_table, migration_statement, strategy, status, time_updated) values ('m', 'ks', '0', '', '"+schemaMigrationStatement+"', 'direct', 'queued', unix_timestamp())", &sqltypes.Result{})
+	err := env.wr.QueueSchemaMigration(ctx, "ks", "m", SchemaMigrationStrategyDirect, schemaMigrationStatement)
+	require.NoError(t, err)
+
+	err = env.wr.QueueSchemaMigration(ctx, "ks", "m", "unknown", schemaMigrationStatement)
+	assert.EqualError(t, err, "unknown schema migration strategy: unknown")
+
+	err = env.wr.QueueSchemaMigration(ctx, "ks", "m", SchemaMigrationStrategyOnline, schemaMigrationStatement)
+	assert.EqualError(t, err, "online schema migrations only support ALTER TABLE: "+schemaMigrationStatement)
+	env.tmc.verifyQueries(t)
+}
+
+func TestRunSchemaMigration(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusQueued)
+	env.expectSchemaMigrationStart(SchemaMigrationStatusQueued)
+	env.tmc.expectVRQuery(100, schemaMigrationStatement, &sqltypes.Result{})
+	env.expectSchemaMigrationUpdate(SchemaMigrationStatusComplete)
+	err := env.wr.RunSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+
+	// The error of the statement is recorded.
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusCancelled)
+	env.expectSchemaMigrationStart(SchemaMigrationStatusCancelled)
+	env.tmc.expectVRQuery(100, "/update _vt.schema_migrations set status='failed', message='.*unexpected query create table t2.* where migration_uuid='m'", &sqltypes.Result{})
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	assert.Contains(t, err.Error(), "migration m failed on ks/0: tablet")
+
+	// Complete migrations are left alone, running ones can't run twice.
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusComplete)
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusRunning)
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	assert.EqualError(t, err, "migration m is already running on ks/0")
+
+	// A migration that's cancelled after its status is read isn't run.
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusQueued)
+	env.tmc.expectVRQuery(100, "update _vt.schema_migrations set status='running', message='', time_updated=unix_timestamp() where migration_uuid='m' and keyspace='ks' and shard='0' and status='queued'", &sqltypes.Result{})
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	assert.EqualError(t, err, "migration m is no longer queued on ks/0")
+
+	env.tmc.expectVRQuery(100, schemaMigrationSelectStatus, &sqltypes.Result{})
+	err = env.wr.RunSchemaMigration(ctx, "ks", "m")
+	assert.EqualError(t, err, "migration m not found on ks/0")
+	env.tmc.verifyQueries(t)
+}
+
+func TestCancelSchemaMigration(t *testing.T) {
+	env := newTestOnlineDDLEnv(t)
+	defer env.close()
+	ctx := context.Background()
+
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusQueued)
+	env.expectSchemaMigrationUpdate(SchemaMigrationStatusCancelled)
+	err := env.wr.CancelSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusCancelled)
+	err = env.wr.CancelSchemaMigration(ctx, "ks", "m")
+	require.NoError(t, err)
+
+	env.expectSchemaMigrationStatus("", schemaMigrationStatement, SchemaMigrationStrategyDirect, SchemaMigrationStatusRunning)
+	err = env.wr.CancelSchemaMigration(ctx, "ks", "m")
+	assert.EqualError(t, err, "migration m is running on ks/0 and cannot be cancelled")
+	env.tmc.verifyQueries(t)
+}
+
+func TestListSchemaMigrations(t *testing.T) {
+	env := newTestMaterializerEnv(t, &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "ks",
+		TargetKeyspace: "ks",
+	}, []string{"-80", "80-"}, []string{"-80", "80-"})
+	defer env.close()
+
+	for _, tabletID := range []int{100, 110} {
+		env.tmc.expectVRQuery(tabletID, sqlCreateSchemaMigrations[0], &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, sqlCreateSchemaMigrations[1], &sqltypes.Result{})
+	}
+	env.tmc.expectVRQuery(100, "select migration_uuid, mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where keyspace='ks' and shard='-80' order by id",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields(schemaMigrationListFields, schemaMigrationListTypes),
+			"m1||"+schemaMigrationStatement+"|direct|complete|",
+			"m2|t1|"+onlineDDLStatement+"|online|running|",
+		))
+	env.tmc.expectVRQuery(110, "select migration_uuid, mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where keyspace='ks' and shard='80-' order by id",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields(schemaMigrationListFields, schemaMigrationListTypes),
+			"m1||"+schemaMigrationStatement+"|direct|failed|table exists",
+			"m2|t1|"+onlineDDLStatement+"|online|queued|",
+		))
+
+	migrations, err := env.wr.ListSchemaMigrations(context.Background(), "ks", "")
+	require.NoError(t, err)
+	want := []*SchemaMigration{{
+		UUID:      "m1",
+		Statement: schemaMigrationStatement,
+		Strategy:  SchemaMigrationStrategyDirect,
+		Shards: map[string]*SchemaMigrationShard{
+			"-80": {Status: SchemaMigrationStatusComplete},
+			"80-": {Status: SchemaMigrationStatusFailed, Message: "table exists"},
+		},
+	}, {
+		UUID:      "m2",
+		Table:     "t1",
+		Statement: onlineDDLStatement,
+		Strategy:  SchemaMigrationStrategyOnline,
+		Shards: map[string]*SchemaMigrationShard{
+			"-80": {Status: SchemaMigrationStatusRunning},
+			"80-": {Status: SchemaMigrationStatusQueued},
+		},
+	}}
+	assert.Equal(t, want, migrations)
+
+	for _, tabletID := range []int{100, 110} {
+		env.tmc.expectVRQuery(tabletID, sqlCreateSchemaMigrations[0], &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, sqlCreateSchemaMigrations[1], &sqltypes.Result{})
+	}
+	env.tmc.expectVRQuery(100, "select migration_uuid, mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where keyspace='ks' and shard='-80' and status='failed' order by id", &sqltypes.Result{})
+	env.tmc.expectVRQuery(110, "select migration_uuid, mysql_table, migration_statement, strategy, status, message from _vt.schema_migrations where keyspace='ks' and shard='80-' and status='failed' order by id",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields(schemaMigrationListFields, schemaMigrationListTypes),
+			"m1||"+schemaMigrationStatement+"|direct|failed|table exists",
+		))
+	migrations, err = env.wr.ListSchemaMigrations(context.Background(), "ks", SchemaMigrationStatusFailed)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	assert.Equal(t, map[string]*SchemaMigrationShard{"80-": {Status: SchemaMigrationStatusFailed, Message: "table exists"}}, migrations[0].Shards)
+	env.tmc.verifyQueries(t)
+}
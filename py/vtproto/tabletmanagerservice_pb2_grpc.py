@@ -242,6 +242,11 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.RestoreFromBackupRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.RestoreFromBackupResponse.FromString,
         )
+    self.CheckThrottler = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/CheckThrottler',
+        request_serializer=tabletmanagerdata__pb2.CheckThrottlerRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.CheckThrottlerResponse.FromString,
+        )
 
 
 class TabletManagerServicer(object):
@@ -607,6 +612,18 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def CheckThrottler(self, request, context):
+    """
+    Throttler related methods
+
+
+    CheckThrottler asks the lag throttler of the master whether an app
+    may write to the shard now.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_TabletManagerServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -835,6 +852,11 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.RestoreFromBackupRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.RestoreFromBackupResponse.SerializeToString,
       ),
+      'CheckThrottler': grpc.unary_unary_rpc_method_handler(
+          servicer.CheckThrottler,
+          request_deserializer=tabletmanagerdata__pb2.CheckThrottlerRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.CheckThrottlerResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'tabletmanagerservice.TabletManager', rpc_method_handlers)